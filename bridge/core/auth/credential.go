@@ -12,7 +12,7 @@ import (
 )
 
 const (
-	configKeyPrefix     = "git-bug.auth"
+	configKeyPrefix     = "auth"
 	configKeyKind       = "kind"
 	configKeyUserId     = "userid"
 	configKeyTarget     = "target"
@@ -24,6 +24,7 @@ type CredentialKind string
 const (
 	KindToken         CredentialKind = "token"
 	KindLoginPassword CredentialKind = "login-password"
+	KindSecret        CredentialKind = "secret"
 )
 
 var ErrCredentialNotExist = errors.New("credential doesn't exist")
@@ -51,12 +52,17 @@ type Credential interface {
 	toConfig() map[string]string
 }
 
+// authConfig give access to the global config, namespaced under "git-bug."
+func authConfig(repo repository.RepoConfig) repository.Config {
+	return repository.NewGitBugConfig(repo).GlobalConfig()
+}
+
 // Load loads a credential from the repo config
 func LoadWithId(repo repository.RepoConfig, id entity.Id) (Credential, error) {
 	keyPrefix := fmt.Sprintf("%s.%s.", configKeyPrefix, id)
 
 	// read token config pairs
-	rawconfigs, err := repo.GlobalConfig().ReadAll(keyPrefix)
+	rawconfigs, err := authConfig(repo).ReadAll(keyPrefix)
 	if err != nil {
 		// Not exactly right due to the limitation of ReadAll()
 		return nil, ErrCredentialNotExist
@@ -107,11 +113,27 @@ func loadFromConfig(rawConfigs map[string]string, id entity.Id) (Credential, err
 		configs[newKey] = value
 	}
 
+	// the secrets are in the system keychain
+	if CredentialKind(configs[configKeyKind]) == KindKeychainRef {
+		secret, err := loadFromKeychain(id)
+		if err != nil {
+			return nil, err
+		}
+		for key, value := range secret {
+			configs[key] = value
+		}
+		if CredentialKind(configs[configKeyKind]) == KindKeychainRef {
+			return nil, fmt.Errorf("invalid credential in the system keychain")
+		}
+	}
+
 	var cred Credential
 
 	switch CredentialKind(configs[configKeyKind]) {
 	case KindToken:
 		cred = NewTokenFromConfig(configs)
+	case KindSecret:
+		cred = NewSecretFromConfig(configs)
 	case KindLoginPassword:
 	default:
 		return nil, fmt.Errorf("unknown credential type %s", configs[configKeyKind])
@@ -122,7 +144,7 @@ func loadFromConfig(rawConfigs map[string]string, id entity.Id) (Credential, err
 
 // List load all existing credentials
 func List(repo repository.RepoConfig, opts ...Option) ([]Credential, error) {
-	rawConfigs, err := repo.GlobalConfig().ReadAll(configKeyPrefix + ".")
+	rawConfigs, err := authConfig(repo).ReadAll(configKeyPrefix + ".")
 	if err != nil {
 		return nil, err
 	}
@@ -173,39 +195,64 @@ func PrefixExist(repo repository.RepoConfig, prefix string) bool {
 	return err == nil
 }
 
-// Store stores a credential in the global git config
+// Store stores a credential in the global git config. If the keychain is
+// enabled (see UseKeychain and KeychainEnv), the secrets are stored in the
+// system keychain instead, and the git config only hold a reference to them.
+// If the keychain can't be used, it fails unless KeychainFallback is set.
 func Store(repo repository.RepoConfig, cred Credential) error {
 	confs := cred.toConfig()
+	kind := cred.Kind()
+
+	if keychainEnabled() {
+		err := storeInKeychain(cred)
+		switch {
+		case err == nil:
+			confs = nil
+			kind = KindKeychainRef
+		case KeychainFallback:
+			_, _ = fmt.Fprintf(KeychainWarningOutput,
+				"warning: %v, the credential is stored in the git config instead\n", err)
+		default:
+			return err
+		}
+	}
 
 	prefix := fmt.Sprintf("%s.%s.", configKeyPrefix, cred.ID())
 
+	// remove the secrets of a previous version stored in the git config
+	if kind == KindKeychainRef {
+		for key := range cred.toConfig() {
+			_ = authConfig(repo).RemoveAll(prefix + key)
+		}
+	}
+
 	// Kind
-	err := repo.GlobalConfig().StoreString(prefix+configKeyKind, string(cred.Kind()))
+	err := authConfig(repo).StoreString(prefix+configKeyKind, string(kind))
 	if err != nil {
 		return err
 	}
 
 	// UserId
-	err = repo.GlobalConfig().StoreString(prefix+configKeyUserId, cred.UserId().String())
+	err = authConfig(repo).StoreString(prefix+configKeyUserId, cred.UserId().String())
 	if err != nil {
 		return err
 	}
 
 	// Target
-	err = repo.GlobalConfig().StoreString(prefix+configKeyTarget, cred.Target())
+	err = authConfig(repo).StoreString(prefix+configKeyTarget, cred.Target())
 	if err != nil {
 		return err
 	}
 
 	// CreateTime
-	err = repo.GlobalConfig().StoreTimestamp(prefix+configKeyCreateTime, cred.CreateTime())
+	err = authConfig(repo).StoreTimestamp(prefix+configKeyCreateTime, cred.CreateTime())
 	if err != nil {
 		return err
 	}
 
 	// Custom
 	for key, val := range confs {
-		err := repo.GlobalConfig().StoreString(prefix+key, val)
+		err := authConfig(repo).StoreString(prefix+key, val)
 		if err != nil {
 			return err
 		}
@@ -214,10 +261,38 @@ func Store(repo repository.RepoConfig, cred Credential) error {
 	return nil
 }
 
-// Remove removes a credential from the global git config
+// Remove removes a credential from the global git config, and its secrets
+// from the system keychain if they are stored there
 func Remove(repo repository.RepoConfig, id entity.Id) error {
 	keyPrefix := fmt.Sprintf("%s.%s", configKeyPrefix, id)
-	return repo.GlobalConfig().RemoveAll(keyPrefix)
+
+	kind, err := authConfig(repo).ReadString(keyPrefix + "." + configKeyKind)
+	if err == nil && CredentialKind(kind) == KindKeychainRef {
+		err = systemKeychain.Delete(keychainService, id.String())
+		if err != nil {
+			return err
+		}
+	}
+
+	return authConfig(repo).RemoveAll(keyPrefix)
+}
+
+// RevokeAll removes all the credentials for the given bridge target and
+// return the number of removed credentials
+func RevokeAll(repo repository.RepoConfig, target string) (int, error) {
+	list, err := List(repo, WithTarget(target))
+	if err != nil {
+		return 0, err
+	}
+
+	for i, cred := range list {
+		err = Remove(repo, cred.ID())
+		if err != nil {
+			return i, err
+		}
+	}
+
+	return len(list), nil
 }
 
 // ReplaceDefaultUser update all the credential attributed to the temporary "default user"
@@ -92,6 +92,17 @@ func TestCredential(t *testing.T) {
 	creds, err = List(repo)
 	assert.NoError(t, err)
 	sameIds(t, creds, []Credential{token4, token5})
+
+	// RevokeAll
+	storeToken(user2, "baz", "github")
+
+	count, err := RevokeAll(repo, "github")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, count)
+
+	creds, err = List(repo)
+	assert.NoError(t, err)
+	sameIds(t, creds, []Credential{token4})
 }
 
 func sameIds(t *testing.T, a []Credential, b []Credential) {
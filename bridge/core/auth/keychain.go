@@ -0,0 +1,214 @@
+package auth
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+
+	"github.com/MichaelMure/git-bug/entity"
+)
+
+const (
+	// KeychainEnv is the environment variable that, when set to "1", make Store
+	// save the credentials secrets in the system keychain.
+	KeychainEnv = "GIT_BUG_USE_KEYCHAIN"
+
+	// service name of the secrets in the system keychain
+	keychainService = "git-bug"
+)
+
+// KindKeychainRef is the kind recorded in the git config for a credential
+// whose secrets are stored in the system keychain. The real kind is stored
+// in the keychain along with the secrets.
+const KindKeychainRef CredentialKind = "keychain-ref"
+
+// UseKeychain make Store save the credentials secrets in the system keychain
+// instead of the git config, as with KeychainEnv.
+var UseKeychain = false
+
+// KeychainFallback allow Store to save the secrets in the git config when the
+// keychain is enabled but can't be used. A warning is written to
+// KeychainWarningOutput when it happens.
+var KeychainFallback = false
+
+// KeychainWarningOutput is where the warning about falling back to the git
+// config is written.
+var KeychainWarningOutput io.Writer = os.Stderr
+
+var errKeychainUnavailable = errors.New("system keychain unavailable")
+
+// keychain store secrets identified by a service and a user
+type keychain interface {
+	Available() bool
+	Set(service, user, secret string) error
+	Get(service, user string) (string, error)
+	Delete(service, user string) error
+}
+
+// systemKeychain is the keychain of the OS. It's a variable to be replaced
+// in the tests.
+var systemKeychain keychain = &commandKeychain{goos: runtime.GOOS}
+
+func keychainEnabled() bool {
+	return UseKeychain || os.Getenv(KeychainEnv) == "1"
+}
+
+// storeInKeychain save the kind and the specific properties of a credential
+// in the keychain
+func storeInKeychain(cred Credential) error {
+	if !systemKeychain.Available() {
+		return errKeychainUnavailable
+	}
+
+	secret := cred.toConfig()
+	secret[configKeyKind] = string(cred.Kind())
+
+	data, err := json.Marshal(secret)
+	if err != nil {
+		return err
+	}
+
+	err = systemKeychain.Set(keychainService, cred.ID().String(), string(data))
+	if err != nil {
+		return fmt.Errorf("storing the credential in the system keychain: %v", err)
+	}
+
+	return nil
+}
+
+// loadFromKeychain read back the kind and the specific properties of a
+// credential stored with storeInKeychain
+func loadFromKeychain(id entity.Id) (map[string]string, error) {
+	data, err := systemKeychain.Get(keychainService, id.String())
+	if err != nil {
+		return nil, fmt.Errorf("reading the credential from the system keychain: %v", err)
+	}
+
+	var secret map[string]string
+	err = json.Unmarshal([]byte(data), &secret)
+	if err != nil {
+		return nil, fmt.Errorf("invalid credential in the system keychain: %v", err)
+	}
+
+	return secret, nil
+}
+
+// commandKeychain use the command line tools of the OS to access its
+// keychain: security on macOS and secret-tool (libsecret) on the other
+// unixes. Windows is not supported.
+type commandKeychain struct {
+	goos string
+}
+
+func (ck *commandKeychain) tool() string {
+	switch ck.goos {
+	case "darwin":
+		return "security"
+	case "windows":
+		return ""
+	default:
+		return "secret-tool"
+	}
+}
+
+func (ck *commandKeychain) Available() bool {
+	tool := ck.tool()
+	if tool == "" {
+		return false
+	}
+	_, err := exec.LookPath(tool)
+	return err == nil
+}
+
+func (ck *commandKeychain) Set(service, user, secret string) error {
+	switch ck.tool() {
+	case "security":
+		// the arguments are visible to the other users in the process list,
+		// so the command is given on stdin to the interactive mode, with the
+		// secret hex encoded to not have to quote it
+		command := fmt.Sprintf("add-generic-password -U -s %s -a %s -X %s\n",
+			service, user, hex.EncodeToString([]byte(secret)))
+		return ck.runInteractive("security", command)
+	case "secret-tool":
+		label := fmt.Sprintf("%s credential %s", service, user)
+		_, err := ck.run(secret, "secret-tool", "store", "--label", label, "service", service, "username", user)
+		return err
+	default:
+		return errKeychainUnavailable
+	}
+}
+
+func (ck *commandKeychain) Get(service, user string) (string, error) {
+	var out string
+	var err error
+
+	switch ck.tool() {
+	case "security":
+		out, err = ck.run("", "security", "find-generic-password", "-s", service, "-a", user, "-w")
+	case "secret-tool":
+		out, err = ck.run("", "secret-tool", "lookup", "service", service, "username", user)
+	default:
+		return "", errKeychainUnavailable
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSuffix(out, "\n"), nil
+}
+
+func (ck *commandKeychain) Delete(service, user string) error {
+	switch ck.tool() {
+	case "security":
+		_, err := ck.run("", "security", "delete-generic-password", "-s", service, "-a", user)
+		return err
+	case "secret-tool":
+		_, err := ck.run("", "secret-tool", "clear", "service", service, "username", user)
+		return err
+	default:
+		return errKeychainUnavailable
+	}
+}
+
+// runInteractive run the commands given on stdin by the interactive mode of a
+// tool. The errors of the commands don't change the exit status, they are only
+// reported on stderr.
+func (ck *commandKeychain) runInteractive(name string, commands string) error {
+	var stderr bytes.Buffer
+
+	cmd := exec.Command(name, "-i")
+	cmd.Stdin = strings.NewReader(commands)
+	cmd.Stderr = &stderr
+
+	err := cmd.Run()
+	if err == nil && stderr.Len() > 0 {
+		err = errors.New("command failed")
+	}
+	if err != nil {
+		return fmt.Errorf("%s: %v: %s", name, err, strings.TrimSpace(stderr.String()))
+	}
+
+	return nil
+}
+
+func (ck *commandKeychain) run(stdin string, name string, args ...string) (string, error) {
+	var stdout, stderr bytes.Buffer
+
+	cmd := exec.Command(name, args...)
+	cmd.Stdin = strings.NewReader(stdin)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("%s: %v: %s", name, err, strings.TrimSpace(stderr.String()))
+	}
+
+	return stdout.String(), nil
+}
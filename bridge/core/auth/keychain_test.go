@@ -0,0 +1,140 @@
+package auth
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+type memKeychain struct {
+	available bool
+	secrets   map[string]string
+}
+
+func (mk *memKeychain) Available() bool {
+	return mk.available
+}
+
+func (mk *memKeychain) Set(service, user, secret string) error {
+	mk.secrets[service+"/"+user] = secret
+	return nil
+}
+
+func (mk *memKeychain) Get(service, user string) (string, error) {
+	secret, ok := mk.secrets[service+"/"+user]
+	if !ok {
+		return "", fmt.Errorf("not found")
+	}
+	return secret, nil
+}
+
+func (mk *memKeychain) Delete(service, user string) error {
+	delete(mk.secrets, service+"/"+user)
+	return nil
+}
+
+// withKeychain replace the system keychain and enable it, until the returned
+// function is called
+func withKeychain(kc keychain) func() {
+	previous, previousUse := systemKeychain, UseKeychain
+	systemKeychain, UseKeychain = kc, true
+	return func() {
+		systemKeychain, UseKeychain = previous, previousUse
+	}
+}
+
+func TestKeychain(t *testing.T) {
+	repo := repository.NewMockRepoForTest()
+	kc := &memKeychain{available: true, secrets: make(map[string]string)}
+	defer withKeychain(kc)()
+
+	token := NewToken(entity.Id("user"), "secret-value", "github")
+	require.NoError(t, Store(repo, token))
+
+	// only a reference is in the git config
+	prefix := fmt.Sprintf("%s.%s.", configKeyPrefix, token.ID())
+	kind, err := authConfig(repo).ReadString(prefix + configKeyKind)
+	require.NoError(t, err)
+	require.Equal(t, string(KindKeychainRef), kind)
+	_, err = authConfig(repo).ReadString(prefix + tokenValueKey)
+	require.Error(t, err)
+	require.Len(t, kc.secrets, 1)
+
+	loaded, err := LoadWithId(repo, token.ID())
+	require.NoError(t, err)
+	require.Equal(t, KindToken, loaded.Kind())
+	require.Equal(t, "secret-value", loaded.(*Token).Value)
+	require.Equal(t, "github", loaded.Target())
+
+	creds, err := List(repo, WithKind(KindToken))
+	require.NoError(t, err)
+	require.Len(t, creds, 1)
+
+	require.NoError(t, Remove(repo, token.ID()))
+	require.Empty(t, kc.secrets)
+	require.False(t, IdExist(repo, token.ID()))
+}
+
+func TestKeychainUnavailable(t *testing.T) {
+	repo := repository.NewMockRepoForTest()
+	kc := &memKeychain{available: false, secrets: make(map[string]string)}
+	defer withKeychain(kc)()
+
+	// the keychain was requested, the secret is not written in the git config
+	token := NewToken(entity.Id("user"), "secret-value", "github")
+	require.Error(t, Store(repo, token))
+	require.False(t, IdExist(repo, token.ID()))
+
+	// unless explicitly allowed, with a warning
+	var output bytes.Buffer
+	KeychainFallback, KeychainWarningOutput = true, &output
+	defer func() { KeychainFallback, KeychainWarningOutput = false, os.Stderr }()
+
+	require.NoError(t, Store(repo, token))
+	require.Empty(t, kc.secrets)
+	require.Contains(t, output.String(), "git config")
+
+	loaded, err := LoadWithId(repo, token.ID())
+	require.NoError(t, err)
+	require.Equal(t, "secret-value", loaded.(*Token).Value)
+}
+
+func TestCommandKeychainSecretNotInArgs(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("require a shell")
+	}
+
+	dir, err := ioutil.TempDir("", "keychain")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	// a fake security tool recording its arguments and stdin
+	script := fmt.Sprintf("#!/bin/sh\necho \"$@\" > %s/args\ncat > %s/stdin\n", dir, dir)
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "security"), []byte(script), 0755))
+
+	defer os.Setenv("PATH", os.Getenv("PATH"))
+	require.NoError(t, os.Setenv("PATH", dir+string(os.PathListSeparator)+os.Getenv("PATH")))
+
+	ck := &commandKeychain{goos: "darwin"}
+	secret := `{"token": "s3cr3t value"}`
+	require.NoError(t, ck.Set("git-bug", "1234", secret))
+
+	args, err := ioutil.ReadFile(filepath.Join(dir, "args"))
+	require.NoError(t, err)
+	require.Equal(t, "-i\n", string(args))
+
+	stdin, err := ioutil.ReadFile(filepath.Join(dir, "stdin"))
+	require.NoError(t, err)
+	require.NotContains(t, string(stdin), "s3cr3t")
+	require.Equal(t, "add-generic-password -U -s git-bug -a 1234 -X "+hex.EncodeToString([]byte(secret))+"\n", string(stdin))
+}
@@ -0,0 +1,98 @@
+package auth
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"time"
+
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+const (
+	secretValueKey = "value"
+)
+
+var _ Credential = &Secret{}
+
+// Secret holds a secret value not related to a bridge target, like the
+// address of a webhook or the password of a SMTP server
+type Secret struct {
+	userId     entity.Id
+	target     string
+	createTime time.Time
+	Value      string
+}
+
+// NewSecret instantiate a new secret. The target is a free-form name
+// telling what the secret is used for.
+func NewSecret(userId entity.Id, value, target string) *Secret {
+	return &Secret{
+		userId:     userId,
+		target:     target,
+		createTime: time.Now(),
+		Value:      value,
+	}
+}
+
+func NewSecretFromConfig(conf map[string]string) *Secret {
+	secret := &Secret{}
+
+	secret.userId = entity.Id(conf[configKeyUserId])
+	secret.target = conf[configKeyTarget]
+	if createTime, ok := conf[configKeyCreateTime]; ok {
+		if t, err := repository.ParseTimestamp(createTime); err == nil {
+			secret.createTime = t
+		}
+	}
+
+	secret.Value = conf[secretValueKey]
+
+	return secret
+}
+
+func (s *Secret) ID() entity.Id {
+	sum := sha256.Sum256([]byte(s.target + s.Value))
+	return entity.Id(fmt.Sprintf("%x", sum))
+}
+
+func (s *Secret) UserId() entity.Id {
+	return s.userId
+}
+
+func (s *Secret) updateUserId(id entity.Id) {
+	s.userId = id
+}
+
+func (s *Secret) Target() string {
+	return s.target
+}
+
+func (s *Secret) Kind() CredentialKind {
+	return KindSecret
+}
+
+func (s *Secret) CreateTime() time.Time {
+	return s.createTime
+}
+
+// Validate ensure secret important fields are valid. Unlike a Token, the
+// target is not required to be a bridge target.
+func (s *Secret) Validate() error {
+	if s.Value == "" {
+		return fmt.Errorf("missing value")
+	}
+	if s.target == "" {
+		return fmt.Errorf("missing target")
+	}
+	if s.createTime.IsZero() || s.createTime.Equal(time.Time{}) {
+		return fmt.Errorf("missing creation time")
+	}
+	return nil
+}
+
+func (s *Secret) toConfig() map[string]string {
+	return map[string]string{
+		secretValueKey: s.Value,
+	}
+}
@@ -4,6 +4,7 @@ package core
 import (
 	"context"
 	"fmt"
+	"os"
 	"reflect"
 	"regexp"
 	"sort"
@@ -24,7 +25,11 @@ const (
 
 	MetaKeyOrigin = "origin"
 
-	bridgeConfigKeyPrefix = "git-bug.bridge"
+	bridgeConfigKeyPrefix = "bridge"
+
+	// NonInteractiveEnv is the environment variable that, when set to "1",
+	// disable all the terminal prompts during the configuration of a bridge.
+	NonInteractiveEnv = "GIT_BUG_NON_INTERACTIVE"
 )
 
 var bridgeImpl map[string]reflect.Type
@@ -38,6 +43,128 @@ type BridgeParams struct {
 	BaseURL    string
 	CredPrefix string
 	TokenRaw   string
+
+	// ImportIterations enable the import of the iterations (sprints) the
+	// issues are assigned to, for the bridges supporting it
+	ImportIterations bool
+
+	// ImportMRComments enable the import of the comments of the merge
+	// requests related to an issue, for the bridges supporting it
+	ImportMRComments bool
+
+	// ImportBoards enable the import of the issue boards as views of the
+	// bugs, for the bridges supporting it
+	ImportBoards bool
+
+	// ImportPipelineStatus enable the import of the status of the CI
+	// pipelines of the merge requests related to an issue, for the bridges
+	// supporting it
+	ImportPipelineStatus bool
+
+	// ImportProjectBoard enable the synchronization of the columns of a
+	// project board as labels, for the bridges supporting it
+	ImportProjectBoard bool
+
+	// ProjectV2 is the number of a project (v2) of the owner whose Status
+	// field is synchronized with the status of the bugs, for the bridges
+	// supporting it
+	ProjectV2 int
+
+	// ProjectStatusMap map the options of the Status field of the project to
+	// a bug status, as "<option>=<open|closed>"
+	ProjectStatusMap []string
+
+	// ImportIssueTypes enable the synchronization of the structured issue
+	// types (bug, task, feature ...), for the bridges supporting it
+	ImportIssueTypes bool
+
+	// ImportWorkflowFailures enable the import of the failures of the CI
+	// workflows as bugs, for the bridges supporting it
+	ImportWorkflowFailures bool
+
+	// ImportCodeScanning enable the import of the code scanning alerts as
+	// bugs, for the bridges supporting it
+	ImportCodeScanning bool
+
+	// ImportDependabot enable the import of the Dependabot alerts as bugs,
+	// for the bridges supporting it
+	ImportDependabot bool
+
+	// ImportSecretScanning enable the import of the secret scanning alerts
+	// as bugs, for the bridges supporting it
+	ImportSecretScanning bool
+
+	// EMUSlug is the slug of the enterprise of the Enterprise Managed Users,
+	// stripped from their login, for the bridges supporting it
+	EMUSlug string
+
+	// EmailDomain is the domain of the corporate emails of the Enterprise
+	// Managed Users, replacing their noreply email, for the bridges
+	// supporting it
+	EmailDomain string
+
+	// Timeout limit the duration of a single API call, DefaultTimeout if zero
+	Timeout time.Duration
+
+	// TotalTimeout limit the duration of a whole import, unlimited if zero
+	TotalTimeout time.Duration
+
+	// MaxRetries is the number of retries of the API calls failing with a
+	// transient server error, for the bridges supporting it
+	MaxRetries int
+
+	// ExportLabelFilter restrict the export to the bugs having one of these
+	// labels. Empty means no restriction.
+	ExportLabelFilter []string
+
+	// ImportLabelFilter restrict the import to the issues having one of
+	// these labels, for the bridges supporting it. Empty means no restriction.
+	ImportLabelFilter []string
+
+	// ImportAssigneeFilter restrict the import to the issues assigned to one
+	// of these logins, for the bridges supporting it
+	ImportAssigneeFilter []string
+
+	// ImportCreatedAfter restrict the import to the issues created after
+	// this date, as YYYY-MM-DD or RFC3339, for the bridges supporting it
+	ImportCreatedAfter string
+
+	// NotifySlack is the id of the credential storing the URL of a Slack
+	// Incoming Webhook notified after each successful import
+	NotifySlack string
+
+	// NotifyWebhook is the id of the credential storing the URL of a webhook
+	// notified after each successful import
+	NotifyWebhook string
+
+	// NotifyEmailTo are the recipients of the email sent after each
+	// successful import, with NotifyEmailFrom as sender
+	NotifyEmailTo   []string
+	NotifyEmailFrom string
+
+	// NotifySMTPAddr is the address of the SMTP server sending the emails,
+	// as "host:port"
+	NotifySMTPAddr string
+
+	// NotifySMTPPassword is the id of the credential storing the password
+	// of the SMTP server, if it requires one
+	NotifySMTPPassword string
+
+	// NonInteractive disable all the terminal prompts. A missing required
+	// parameter is then reported as an error.
+	NonInteractive bool
+}
+
+// ErrMissingParam return the error reported when a required parameter is
+// missing and the configuration is not allowed to prompt for it.
+func ErrMissingParam(param string) error {
+	return fmt.Errorf("missing required parameter %s in non-interactive mode", param)
+}
+
+// NonInteractiveFromEnv return true if the environment request the bridge
+// configuration to not prompt the user.
+func NonInteractiveFromEnv() bool {
+	return os.Getenv(NonInteractiveEnv) == "1"
 }
 
 // Bridge is a wrapper around a BridgeImpl that will bind low-level
@@ -51,6 +178,8 @@ type Bridge struct {
 	conf           Configuration
 	initImportDone bool
 	initExportDone bool
+	events         bridgeEvents
+	importFilter   ImportFilter
 }
 
 // Register will register a new BridgeImpl
@@ -59,6 +188,7 @@ func Register(impl BridgeImpl) {
 		bridgeImpl = make(map[string]reflect.Type)
 	}
 	bridgeImpl[impl.Target()] = reflect.TypeOf(impl)
+	cache.RegisterBridgeTarget(impl.Target())
 }
 
 // Targets return all known bridge implementation target
@@ -89,6 +219,12 @@ func NewBridge(repo *cache.RepoCache, target string, name string) (*Bridge, erro
 
 	impl := reflect.New(implType).Elem().Interface().(BridgeImpl)
 
+	// all the bridges share the same proxy configuration
+	err := LoadProxyConfig(repo)
+	if err != nil {
+		return nil, err
+	}
+
 	bridge := &Bridge{
 		Name: name,
 		repo: repo,
@@ -143,7 +279,7 @@ func DefaultBridge(repo *cache.RepoCache) (*Bridge, error) {
 // ConfiguredBridges return the list of bridge that are configured for the given
 // repo
 func ConfiguredBridges(repo repository.RepoConfig) ([]string, error) {
-	configs, err := repo.LocalConfig().ReadAll(bridgeConfigKeyPrefix + ".")
+	configs, err := bridgeConfig(repo).ReadAll(bridgeConfigKeyPrefix + ".")
 	if err != nil {
 		return nil, errors.Wrap(err, "can't read configured bridges")
 	}
@@ -178,9 +314,9 @@ func ConfiguredBridges(repo repository.RepoConfig) ([]string, error) {
 
 // Check if a bridge exist
 func BridgeExist(repo repository.RepoConfig, name string) bool {
-	keyPrefix := fmt.Sprintf("git-bug.bridge.%s.", name)
+	keyPrefix := fmt.Sprintf("bridge.%s.", name)
 
-	conf, err := repo.LocalConfig().ReadAll(keyPrefix)
+	conf, err := bridgeConfig(repo).ReadAll(keyPrefix)
 
 	return err == nil && len(conf) > 0
 }
@@ -196,12 +332,16 @@ func RemoveBridge(repo repository.RepoConfig, name string) error {
 		return fmt.Errorf("bad bridge fullname: %s", name)
 	}
 
-	keyPrefix := fmt.Sprintf("git-bug.bridge.%s", name)
-	return repo.LocalConfig().RemoveAll(keyPrefix)
+	keyPrefix := fmt.Sprintf("bridge.%s", name)
+	return bridgeConfig(repo).RemoveAll(keyPrefix)
 }
 
 // Configure run the target specific configuration process
 func (b *Bridge) Configure(params BridgeParams) error {
+	if NonInteractiveFromEnv() {
+		params.NonInteractive = true
+	}
+
 	conf, err := b.impl.Configure(b.repo, params)
 	if err != nil {
 		return err
@@ -212,15 +352,63 @@ func (b *Bridge) Configure(params BridgeParams) error {
 		return fmt.Errorf("invalid configuration: %v", err)
 	}
 
+	if len(params.ExportLabelFilter) > 0 {
+		conf[ConfigKeyExportLabelFilter] = strings.Join(params.ExportLabelFilter, ",")
+	}
+	if len(params.ImportLabelFilter) > 0 {
+		conf[ConfigKeyImportLabelFilter] = strings.Join(params.ImportLabelFilter, ",")
+	}
+	if len(params.ImportAssigneeFilter) > 0 {
+		conf[ConfigKeyImportAssigneeFilter] = strings.Join(params.ImportAssigneeFilter, ",")
+	}
+	if params.ImportCreatedAfter != "" {
+		conf[ConfigKeyImportCreatedAfter] = params.ImportCreatedAfter
+	}
+	if params.Timeout > 0 {
+		conf[ConfigKeyTimeout] = params.Timeout.String()
+	}
+	if params.TotalTimeout > 0 {
+		conf[ConfigKeyTotalTimeout] = params.TotalTimeout.String()
+	}
+	if params.NotifySlack != "" {
+		conf[ConfigKeyNotifySlack] = params.NotifySlack
+	}
+	if params.NotifyWebhook != "" {
+		conf[ConfigKeyNotifyWebhook] = params.NotifyWebhook
+	}
+	if len(params.NotifyEmailTo) > 0 {
+		conf[ConfigKeyNotifyEmailTo] = strings.Join(params.NotifyEmailTo, ",")
+		conf[ConfigKeyNotifyEmailFrom] = params.NotifyEmailFrom
+		conf[ConfigKeyNotifySMTPAddr] = params.NotifySMTPAddr
+	}
+	if params.NotifySMTPPassword != "" {
+		conf[ConfigKeyNotifySMTPPassword] = params.NotifySMTPPassword
+	}
+
+	_, err = ImportFilterFromConfig(conf)
+	if err != nil {
+		return fmt.Errorf("invalid configuration: %v", err)
+	}
+
+	err = validateNotifyConfig(conf)
+	if err != nil {
+		return fmt.Errorf("invalid configuration: %v", err)
+	}
+
 	b.conf = conf
 	return b.storeConfig(conf)
 }
 
+// bridgeConfig give access to the local config, namespaced under "git-bug."
+func bridgeConfig(repo repository.RepoConfig) repository.Config {
+	return repository.NewGitBugConfig(repo).LocalConfig()
+}
+
 func (b *Bridge) storeConfig(conf Configuration) error {
 	for key, val := range conf {
-		storeKey := fmt.Sprintf("git-bug.bridge.%s.%s", b.Name, key)
+		storeKey := fmt.Sprintf("bridge.%s.%s", b.Name, key)
 
-		err := b.repo.LocalConfig().StoreString(storeKey, val)
+		err := bridgeConfig(b.repo).StoreString(storeKey, val)
 		if err != nil {
 			return errors.Wrap(err, "error while storing bridge configuration")
 		}
@@ -242,9 +430,9 @@ func (b *Bridge) ensureConfig() error {
 }
 
 func loadConfig(repo repository.RepoConfig, name string) (Configuration, error) {
-	keyPrefix := fmt.Sprintf("git-bug.bridge.%s.", name)
+	keyPrefix := fmt.Sprintf("bridge.%s.", name)
 
-	pairs, err := repo.LocalConfig().ReadAll(keyPrefix)
+	pairs, err := bridgeConfig(repo).ReadAll(keyPrefix)
 	if err != nil {
 		return nil, errors.Wrap(err, "error while reading bridge configuration")
 	}
@@ -258,6 +446,16 @@ func loadConfig(repo repository.RepoConfig, name string) (Configuration, error)
 	return result, nil
 }
 
+// Target return the target of the bridge (e.g.: "github")
+func (b *Bridge) Target() string {
+	return b.impl.Target()
+}
+
+// Capabilities return the set of features supported by the bridge
+func (b *Bridge) Capabilities() BridgeCapabilities {
+	return b.impl.Capabilities()
+}
+
 func (b *Bridge) getImporter() Importer {
 	if b.importer == nil {
 		b.importer = b.impl.NewImporter()
@@ -321,7 +519,7 @@ func (b *Bridge) ImportAllSince(ctx context.Context, since time.Time) (<-chan Im
 	importStartTime := time.Now().Add(-5 * time.Second)
 
 	importer := b.getImporter()
-	if importer == nil {
+	if importer == nil || !b.Capabilities().Has(CapImport) {
 		return nil, ErrImportNotSupported
 	}
 
@@ -335,14 +533,28 @@ func (b *Bridge) ImportAllSince(ctx context.Context, since time.Time) (<-chan Im
 		return nil, err
 	}
 
+	err = b.applyImportFilter(importer)
+	if err != nil {
+		return nil, err
+	}
+
+	cancel := func() {}
+	if totalTimeout := TotalTimeout(b.conf); totalTimeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, totalTimeout)
+	}
+
 	events, err := importer.ImportAll(ctx, b.repo, since)
 	if err != nil {
+		cancel()
 		return nil, err
 	}
 
+	b.emitEvent(ImportStarted{Since: since})
+
 	out := make(chan ImportResult)
 	go func() {
 		defer close(out)
+		defer cancel()
 		noError := true
 
 		// relay all events while checking that everything went well
@@ -350,13 +562,23 @@ func (b *Bridge) ImportAllSince(ctx context.Context, since time.Time) (<-chan Im
 			if event.Err != nil {
 				noError = false
 			}
+			if bridgeEvent, ok := b.bridgeEvent(event); ok {
+				b.emitEvent(bridgeEvent)
+			}
 			out <- event
 		}
 
+		b.finishEvents(b.Stats())
+
+		// an interrupted import is not complete
+		if ctx.Err() != nil {
+			noError = false
+		}
+
 		// store the last import time ONLY if no error happened
 		if noError {
-			key := fmt.Sprintf("git-bug.bridge.%s.lastImportTime", b.Name)
-			err = b.repo.LocalConfig().StoreTimestamp(key, importStartTime)
+			key := fmt.Sprintf("bridge.%s.lastImportTime", b.Name)
+			err = bridgeConfig(b.repo).StoreTimestamp(key, importStartTime)
 		}
 	}()
 
@@ -365,17 +587,42 @@ func (b *Bridge) ImportAllSince(ctx context.Context, since time.Time) (<-chan Im
 
 func (b *Bridge) ImportAll(ctx context.Context) (<-chan ImportResult, error) {
 	// If possible, restart from the last import time
-	lastImport, err := b.repo.LocalConfig().ReadTimestamp(fmt.Sprintf("git-bug.bridge.%s.lastImportTime", b.Name))
-	if err == nil {
-		return b.ImportAllSince(ctx, lastImport)
+	return b.ImportAllSince(ctx, b.LastImportTime())
+}
+
+// ImportAllWithProgress import all the bugs updated after the given time and
+// report the progress on the given channel. The progress channel is closed
+// when the import is done.
+func (b *Bridge) ImportAllWithProgress(ctx context.Context, since time.Time, progress chan<- ProgressEvent) error {
+	defer close(progress)
+
+	events, err := b.ImportAllSince(ctx, since)
+	if err != nil {
+		return err
 	}
 
-	return b.ImportAllSince(ctx, time.Time{})
+	return ImportProgress(ctx, events, progress, func(result ImportResult) string {
+		excerpt, err := b.repo.ResolveBugExcerpt(result.ID)
+		if err != nil {
+			return ""
+		}
+		return excerpt.Title
+	})
+}
+
+// LastImportTime return the time of the last successful import, or the zero
+// time if there is none.
+func (b *Bridge) LastImportTime() time.Time {
+	lastImport, err := bridgeConfig(b.repo).ReadTimestamp(fmt.Sprintf("bridge.%s.lastImportTime", b.Name))
+	if err != nil {
+		return time.Time{}
+	}
+	return lastImport
 }
 
 func (b *Bridge) ExportAll(ctx context.Context, since time.Time) (<-chan ExportResult, error) {
 	exporter := b.getExporter()
-	if exporter == nil {
+	if exporter == nil || !b.Capabilities().Has(CapExport) {
 		return nil, ErrExportNotSupported
 	}
 
@@ -0,0 +1,79 @@
+package core
+
+import "strings"
+
+// BridgeCapabilities is a bitmask describing what a bridge implementation
+// support
+type BridgeCapabilities uint
+
+const (
+	// CapImport means that the bridge can import bugs from the remote
+	CapImport BridgeCapabilities = 1 << iota
+	// CapExport means that the bridge can export bugs to the remote
+	CapExport
+	// CapReactions means that the bridge synchronize the reactions
+	CapReactions
+	// CapMilestones means that the bridge synchronize the milestones
+	CapMilestones
+	// CapAssignees means that the bridge synchronize the assignees
+	CapAssignees
+	// CapTimeTracking means that the bridge synchronize the time tracking
+	CapTimeTracking
+	// CapAttachments means that the bridge synchronize the attached files
+	CapAttachments
+	// CapMigration means that the bridge can import the issues from a bulk
+	// export of the remote, rather than one by one
+	CapMigration
+)
+
+// Capabilities list all the known capabilities, in display order
+var Capabilities = []BridgeCapabilities{
+	CapImport,
+	CapExport,
+	CapReactions,
+	CapMilestones,
+	CapAssignees,
+	CapTimeTracking,
+	CapAttachments,
+	CapMigration,
+}
+
+// Has return true if all the given capabilities are supported
+func (c BridgeCapabilities) Has(capabilities BridgeCapabilities) bool {
+	return c&capabilities == capabilities
+}
+
+// Name return the human readable name of a single capability
+func (c BridgeCapabilities) Name() string {
+	switch c {
+	case CapImport:
+		return "import"
+	case CapExport:
+		return "export"
+	case CapReactions:
+		return "reactions"
+	case CapMilestones:
+		return "milestones"
+	case CapAssignees:
+		return "assignees"
+	case CapTimeTracking:
+		return "time-tracking"
+	case CapAttachments:
+		return "attachments"
+	case CapMigration:
+		return "migration"
+	default:
+		return "unknown"
+	}
+}
+
+// String return the comma separated list of the supported capabilities
+func (c BridgeCapabilities) String() string {
+	var names []string
+	for _, capability := range Capabilities {
+		if c.Has(capability) {
+			names = append(names, capability.Name())
+		}
+	}
+	return strings.Join(names, ",")
+}
@@ -0,0 +1,20 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBridgeCapabilities(t *testing.T) {
+	caps := CapImport | CapExport | CapAttachments
+
+	assert.True(t, caps.Has(CapImport))
+	assert.True(t, caps.Has(CapImport|CapExport))
+	assert.False(t, caps.Has(CapReactions))
+	assert.False(t, caps.Has(CapImport|CapReactions))
+
+	assert.Equal(t, "import,export,attachments", caps.String())
+	assert.Equal(t, "", BridgeCapabilities(0).String())
+	assert.Equal(t, "time-tracking", CapTimeTracking.Name())
+}
@@ -0,0 +1,135 @@
+package core
+
+import (
+	"sync"
+	"time"
+
+	"github.com/MichaelMure/git-bug/entity"
+)
+
+// BridgeEvent is an event of an import, to follow its progress in real time.
+// It's one of ImportStarted, BugImported, BugSkipped, ImportError or
+// ImportFinished.
+type BridgeEvent interface {
+	isBridgeEvent()
+}
+
+// ImportStarted is emitted when an import start
+type ImportStarted struct {
+	// the import only consider the changes after this time, if not zero
+	Since time.Time
+}
+
+// BugImported is emitted when a new bug has been imported
+type BugImported struct {
+	ID    entity.Id
+	Title string
+}
+
+// BugSkipped is emitted when the importer didn't do anything for an item
+type BugSkipped struct {
+	ID     entity.Id
+	Reason string
+}
+
+// ImportError is emitted when an error happened during the import
+type ImportError struct {
+	ID  entity.Id
+	Err error
+}
+
+// ImportFinished is emitted at the end of an import, whether it succeeded or
+// not. It's the last event of an import.
+type ImportFinished struct {
+	Stats SyncStats
+}
+
+func (ImportStarted) isBridgeEvent()  {}
+func (BugImported) isBridgeEvent()    {}
+func (BugSkipped) isBridgeEvent()     {}
+func (ImportError) isBridgeEvent()    {}
+func (ImportFinished) isBridgeEvent() {}
+
+// eventsBufferSize is the number of events a slow receiver can lag behind
+// before the events are dropped for it
+const eventsBufferSize = 100
+
+// bridgeEvents hold the channels registered with Bridge.Events
+type bridgeEvents struct {
+	mu       sync.Mutex
+	channels []chan BridgeEvent
+}
+
+// Events return a channel receiving the events of the running import, or of
+// the next one if none is running. The channel is closed once this import is
+// finished, after the ImportFinished event.
+//
+// The channel is buffered and an event is dropped if the receiver is not
+// keeping up, so it's meant to display the progress rather than to
+// collect the results. Use the channel returned by ImportAll for that. The
+// ImportFinished event is always received.
+func (b *Bridge) Events() <-chan BridgeEvent {
+	b.events.mu.Lock()
+	defer b.events.mu.Unlock()
+
+	ch := make(chan BridgeEvent, eventsBufferSize)
+	b.events.channels = append(b.events.channels, ch)
+	return ch
+}
+
+// emitEvent send an event to the registered receivers, without blocking
+func (b *Bridge) emitEvent(event BridgeEvent) {
+	b.events.mu.Lock()
+	defer b.events.mu.Unlock()
+
+	for _, ch := range b.events.channels {
+		select {
+		case ch <- event:
+		default:
+		}
+	}
+}
+
+// finishEvents send the ImportFinished event and close the channels of the
+// registered receivers. Unlike the other events, ImportFinished is never
+// dropped: if a receiver is lagging behind, its oldest pending event is
+// dropped instead to make room for it.
+func (b *Bridge) finishEvents(stats SyncStats) {
+	event := ImportFinished{Stats: stats}
+
+	b.events.mu.Lock()
+	defer b.events.mu.Unlock()
+
+	for _, ch := range b.events.channels {
+		select {
+		case ch <- event:
+		default:
+			// as the only sender, there is room after receiving once
+			select {
+			case <-ch:
+			default:
+			}
+			ch <- event
+		}
+		close(ch)
+	}
+	b.events.channels = nil
+}
+
+// bridgeEvent convert the result of an importer into an event, if relevant
+func (b *Bridge) bridgeEvent(result ImportResult) (BridgeEvent, bool) {
+	switch result.Event {
+	case ImportEventBug:
+		event := BugImported{ID: result.ID}
+		if excerpt, err := b.repo.ResolveBugExcerpt(result.ID); err == nil {
+			event.Title = excerpt.Title
+		}
+		return event, true
+	case ImportEventNothing:
+		return BugSkipped{ID: result.ID, Reason: result.Reason}, true
+	case ImportEventError:
+		return ImportError{ID: result.ID, Err: result.Err}, true
+	default:
+		return nil, false
+	}
+}
@@ -0,0 +1,83 @@
+package core
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestBridgeEvents(t *testing.T) {
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	backend, err := cache.NewRepoCache(repo)
+	require.NoError(t, err)
+	defer backend.Close()
+
+	rene, err := backend.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	b, _, err := backend.NewBugRaw(rene, 1000, "imported", "message", nil, nil)
+	require.NoError(t, err)
+
+	bridge := &Bridge{Name: "test", repo: backend}
+
+	events := bridge.Events()
+
+	for _, result := range []ImportResult{
+		NewImportIdentity(rene.Id()),
+		NewImportBug(b.Id()),
+		NewImportNothing("12", "already imported"),
+		NewImportError(fmt.Errorf("oops"), ""),
+	} {
+		if event, ok := bridge.bridgeEvent(result); ok {
+			bridge.emitEvent(event)
+		}
+	}
+	bridge.finishEvents(SyncStats{RateLimitHits: 1})
+
+	var received []BridgeEvent
+	for event := range events {
+		received = append(received, event)
+	}
+
+	require.Equal(t, []BridgeEvent{
+		BugImported{ID: b.Id(), Title: "imported"},
+		BugSkipped{ID: entity.Id("12"), Reason: "already imported"},
+		ImportError{Err: fmt.Errorf("oops")},
+		ImportFinished{Stats: SyncStats{RateLimitHits: 1}},
+	}, received)
+
+	// a new receiver get the events of the next import only
+	events = bridge.Events()
+	bridge.finishEvents(SyncStats{})
+	require.Equal(t, ImportFinished{}, <-events)
+	_, ok := <-events
+	require.False(t, ok)
+}
+
+func TestBridgeEventsFinishedNotDropped(t *testing.T) {
+	bridge := &Bridge{Name: "test"}
+
+	events := bridge.Events()
+
+	// a receiver not keeping up
+	for i := 0; i < 2*eventsBufferSize; i++ {
+		bridge.emitEvent(BugSkipped{Reason: "already imported"})
+	}
+	bridge.finishEvents(SyncStats{RateLimitHits: 1})
+
+	var last BridgeEvent
+	count := 0
+	for event := range events {
+		last = event
+		count++
+	}
+
+	require.Equal(t, eventsBufferSize, count)
+	require.Equal(t, ImportFinished{Stats: SyncStats{RateLimitHits: 1}}, last)
+}
@@ -0,0 +1,55 @@
+package core
+
+import (
+	"strings"
+
+	"github.com/MichaelMure/git-bug/cache"
+)
+
+// ConfigKeyExportLabelFilter is the configuration key holding the comma
+// separated list of labels a bug must have one of to be exported
+const ConfigKeyExportLabelFilter = "export-label-filter"
+
+// ExportLabelFilter return the labels of the export label filter of a bridge
+// configuration, or nil if the bugs are not filtered
+func ExportLabelFilter(conf Configuration) []string {
+	var labels []string
+	for _, label := range strings.Split(conf[ConfigKeyExportLabelFilter], ",") {
+		label = strings.TrimSpace(label)
+		if label != "" {
+			labels = append(labels, label)
+		}
+	}
+	return labels
+}
+
+// ExportQuery return the query selecting the bugs to export according to the
+// export label filter of a bridge configuration. It returns nil, which match
+// all the bugs, if there is no filter.
+func ExportQuery(conf Configuration) *cache.Query {
+	labels := ExportLabelFilter(conf)
+	if len(labels) == 0 {
+		return nil
+	}
+
+	filters := make([]cache.Filter, len(labels))
+	for i, label := range labels {
+		filters[i] = cache.LabelFilter(label)
+	}
+
+	query := cache.NewQuery()
+	query.Label = []cache.Filter{cache.AnyFilter(filters...)}
+	return query
+}
+
+// SetExportLabelFilter override the export label filter of the bridge
+// configuration for the next exports, without storing it
+func (b *Bridge) SetExportLabelFilter(labels []string) error {
+	err := b.ensureConfig()
+	if err != nil {
+		return err
+	}
+
+	b.conf[ConfigKeyExportLabelFilter] = strings.Join(labels, ",")
+	return nil
+}
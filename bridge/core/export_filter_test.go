@@ -0,0 +1,41 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestExportLabelFilter(t *testing.T) {
+	require.Nil(t, ExportLabelFilter(Configuration{}))
+	require.Equal(t, []string{"security", "public"},
+		ExportLabelFilter(Configuration{ConfigKeyExportLabelFilter: "security, public,"}))
+}
+
+func TestExportQuery(t *testing.T) {
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	backend, err := cache.NewRepoCache(repo)
+	require.NoError(t, err)
+
+	iden, err := backend.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	err = backend.SetUserIdentity(iden)
+	require.NoError(t, err)
+
+	for _, label := range []string{"security", "public", "other"} {
+		b, _, err := backend.NewBug(label, "message")
+		require.NoError(t, err)
+		_, _, err = b.ChangeLabels([]string{label}, nil)
+		require.NoError(t, err)
+	}
+
+	require.Nil(t, ExportQuery(Configuration{}))
+
+	query := ExportQuery(Configuration{ConfigKeyExportLabelFilter: "security,public"})
+	require.Len(t, backend.QueryBugs(query), 2)
+}
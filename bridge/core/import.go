@@ -31,6 +31,9 @@ const (
 
 	// Error happened during import
 	ImportEventError
+
+	// Number of bugs to import, as known by the importer
+	ImportEventTotal
 )
 
 // ImportResult is an event that is emitted during the import process, to
@@ -41,6 +44,7 @@ type ImportResult struct {
 	Event  ImportEvent
 	ID     entity.Id
 	Reason string
+	Total  int
 }
 
 func (er ImportResult) String() string {
@@ -69,6 +73,8 @@ func (er ImportResult) String() string {
 			return fmt.Sprintf("import error at id %s: %s", er.ID, er.Err.Error())
 		}
 		return fmt.Sprintf("import error: %s", er.Err.Error())
+	case ImportEventTotal:
+		return fmt.Sprintf("%d issues to import", er.Total)
 	default:
 		panic("unknown import result")
 	}
@@ -138,3 +144,23 @@ func NewImportIdentity(id entity.Id) ImportResult {
 		Event: ImportEventIdentity,
 	}
 }
+
+// NewImportTotal tell how many bugs the import will process, when the
+// importer can know it in advance
+func NewImportTotal(total int) ImportResult {
+	return ImportResult{
+		Total: total,
+		Event: ImportEventTotal,
+	}
+}
+
+// WaitImport wait for an import to finish and return its first error, if any
+func WaitImport(results <-chan ImportResult) error {
+	var err error
+	for result := range results {
+		if result.Err != nil && err == nil {
+			err = result.Err
+		}
+	}
+	return err
+}
@@ -0,0 +1,223 @@
+package core
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	// ConfigKeyImportLabelFilter is the configuration key holding the comma
+	// separated list of labels an issue must have one of to be imported
+	ConfigKeyImportLabelFilter = "import-label-filter"
+
+	// ConfigKeyImportAssigneeFilter is the configuration key holding the
+	// comma separated list of logins an issue must be assigned to one of to
+	// be imported
+	ConfigKeyImportAssigneeFilter = "import-assignee-filter"
+
+	// ConfigKeyImportCreatedAfter is the configuration key holding the date
+	// (YYYY-MM-DD or RFC3339) before which the issues are not imported
+	ConfigKeyImportCreatedAfter = "import-created-after"
+)
+
+// ImportFilter decide if a remote issue should be imported. The importers
+// supporting it call the filter with the issue as received from the remote,
+// before creating any operation.
+type ImportFilter interface {
+	ShouldImport(rawIssue interface{}) bool
+}
+
+// ImportFilterFunc adapt a function to an ImportFilter
+type ImportFilterFunc func(rawIssue interface{}) bool
+
+func (f ImportFilterFunc) ShouldImport(rawIssue interface{}) bool {
+	return f(rawIssue)
+}
+
+// FilterableIssue is implemented by the issues given to the filters by the
+// importers supporting the built-in filters. The raw issue of the remote is
+// available with Raw.
+type FilterableIssue interface {
+	// Raw return the issue as received from the remote
+	Raw() interface{}
+	IssueLabels() []string
+	// IssueAssignees return the logins of the users assigned to the issue
+	IssueAssignees() []string
+	IssueCreatedAt() time.Time
+	// IssueCustomField return the value of a field specific to the remote,
+	// if the issue has it
+	IssueCustomField(name string) (string, bool)
+}
+
+// filterable wrap a built-in filter predicate. The issues not supporting the
+// built-in filters are imported.
+func filterable(predicate func(issue FilterableIssue) bool) ImportFilter {
+	return ImportFilterFunc(func(rawIssue interface{}) bool {
+		issue, ok := rawIssue.(FilterableIssue)
+		if !ok {
+			return true
+		}
+		return predicate(issue)
+	})
+}
+
+// LabelFilter return an ImportFilter matching the issues having one of the
+// given labels
+func LabelFilter(labels ...string) ImportFilter {
+	return filterable(func(issue FilterableIssue) bool {
+		return anyMatch(issue.IssueLabels(), labels)
+	})
+}
+
+// AssigneeFilter return an ImportFilter matching the issues assigned to one
+// of the given logins
+func AssigneeFilter(logins ...string) ImportFilter {
+	return filterable(func(issue FilterableIssue) bool {
+		return anyMatch(issue.IssueAssignees(), logins)
+	})
+}
+
+// CreatedAfterFilter return an ImportFilter matching the issues created
+// after the given time
+func CreatedAfterFilter(t time.Time) ImportFilter {
+	return filterable(func(issue FilterableIssue) bool {
+		return issue.IssueCreatedAt().After(t)
+	})
+}
+
+// CustomFieldFilter return an ImportFilter matching the issues having the
+// given value for a field specific to the remote
+func CustomFieldFilter(name string, value string) ImportFilter {
+	return filterable(func(issue FilterableIssue) bool {
+		v, ok := issue.IssueCustomField(name)
+		return ok && v == value
+	})
+}
+
+// And return an ImportFilter matching the issues matched by all the filters
+func And(filters ...ImportFilter) ImportFilter {
+	return ImportFilterFunc(func(rawIssue interface{}) bool {
+		for _, f := range filters {
+			if !f.ShouldImport(rawIssue) {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+// Or return an ImportFilter matching the issues matched by any of the filters
+func Or(filters ...ImportFilter) ImportFilter {
+	return ImportFilterFunc(func(rawIssue interface{}) bool {
+		for _, f := range filters {
+			if f.ShouldImport(rawIssue) {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// anyMatch return true if a value is in both lists, ignoring the case
+func anyMatch(values []string, wanted []string) bool {
+	for _, v := range values {
+		for _, w := range wanted {
+			if strings.EqualFold(v, w) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// splitList split a comma separated list of the configuration
+func splitList(value string) []string {
+	var result []string
+	for _, v := range strings.Split(value, ",") {
+		v = strings.TrimSpace(v)
+		if v != "" {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+// parseFilterDate parse a date of the configuration, as YYYY-MM-DD or RFC3339
+func parseFilterDate(value string) (time.Time, error) {
+	if t, err := time.Parse("2006-01-02", value); err == nil {
+		return t, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid date %s, expected YYYY-MM-DD or RFC3339", value)
+	}
+	return t, nil
+}
+
+// ImportFilterFromConfig return the filter combining the built-in filters of
+// a bridge configuration, or nil if the issues are not filtered
+func ImportFilterFromConfig(conf Configuration) (ImportFilter, error) {
+	var filters []ImportFilter
+
+	if labels := splitList(conf[ConfigKeyImportLabelFilter]); len(labels) > 0 {
+		filters = append(filters, LabelFilter(labels...))
+	}
+
+	if logins := splitList(conf[ConfigKeyImportAssigneeFilter]); len(logins) > 0 {
+		filters = append(filters, AssigneeFilter(logins...))
+	}
+
+	if value := conf[ConfigKeyImportCreatedAfter]; value != "" {
+		t, err := parseFilterDate(value)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", ConfigKeyImportCreatedAfter, err)
+		}
+		filters = append(filters, CreatedAfterFilter(t))
+	}
+
+	switch len(filters) {
+	case 0:
+		return nil, nil
+	case 1:
+		return filters[0], nil
+	default:
+		return And(filters...), nil
+	}
+}
+
+// FilteredImporter is implemented by the importers supporting an ImportFilter
+type FilteredImporter interface {
+	SetImportFilter(filter ImportFilter)
+}
+
+// SetImportFilter add a filter to the ones of the bridge configuration for
+// the next imports, without storing it. The importers not supporting the
+// filters import all the issues.
+func (b *Bridge) SetImportFilter(filter ImportFilter) {
+	b.importFilter = filter
+}
+
+// applyImportFilter give the filters of the bridge to the importer, if it
+// support them
+func (b *Bridge) applyImportFilter(importer Importer) error {
+	filtered, ok := importer.(FilteredImporter)
+	if !ok {
+		return nil
+	}
+
+	filter, err := ImportFilterFromConfig(b.conf)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case filter != nil && b.importFilter != nil:
+		filter = And(filter, b.importFilter)
+	case b.importFilter != nil:
+		filter = b.importFilter
+	}
+
+	filtered.SetImportFilter(filter)
+	return nil
+}
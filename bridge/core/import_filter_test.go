@@ -0,0 +1,71 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testIssue struct {
+	labels    []string
+	assignees []string
+	createdAt time.Time
+	fields    map[string]string
+}
+
+func (i testIssue) Raw() interface{}          { return i }
+func (i testIssue) IssueLabels() []string     { return i.labels }
+func (i testIssue) IssueAssignees() []string  { return i.assignees }
+func (i testIssue) IssueCreatedAt() time.Time { return i.createdAt }
+func (i testIssue) IssueCustomField(name string) (string, bool) {
+	v, ok := i.fields[name]
+	return v, ok
+}
+
+func TestImportFilters(t *testing.T) {
+	issue := testIssue{
+		labels:    []string{"bug", "ui"},
+		assignees: []string{"rene"},
+		createdAt: time.Date(2020, 3, 1, 0, 0, 0, 0, time.UTC),
+		fields:    map[string]string{"weight": "3"},
+	}
+
+	require.True(t, LabelFilter("feature", "Bug").ShouldImport(issue))
+	require.False(t, LabelFilter("feature").ShouldImport(issue))
+	require.True(t, AssigneeFilter("rene").ShouldImport(issue))
+	require.False(t, AssigneeFilter("blaise").ShouldImport(issue))
+	require.True(t, CreatedAfterFilter(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)).ShouldImport(issue))
+	require.False(t, CreatedAfterFilter(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)).ShouldImport(issue))
+	require.True(t, CustomFieldFilter("weight", "3").ShouldImport(issue))
+	require.False(t, CustomFieldFilter("milestone", "3").ShouldImport(issue))
+
+	require.False(t, And(LabelFilter("bug"), AssigneeFilter("blaise")).ShouldImport(issue))
+	require.True(t, Or(LabelFilter("feature"), AssigneeFilter("rene")).ShouldImport(issue))
+
+	// the issues not supporting the built-in filters are imported
+	require.True(t, LabelFilter("bug").ShouldImport("raw"))
+}
+
+func TestImportFilterFromConfig(t *testing.T) {
+	filter, err := ImportFilterFromConfig(Configuration{})
+	require.NoError(t, err)
+	require.Nil(t, filter)
+
+	filter, err = ImportFilterFromConfig(Configuration{
+		ConfigKeyImportLabelFilter:  "bug, feature",
+		ConfigKeyImportCreatedAfter: "2020-01-01",
+	})
+	require.NoError(t, err)
+	require.True(t, filter.ShouldImport(testIssue{
+		labels:    []string{"feature"},
+		createdAt: time.Date(2020, 3, 1, 0, 0, 0, 0, time.UTC),
+	}))
+	require.False(t, filter.ShouldImport(testIssue{
+		labels:    []string{"feature"},
+		createdAt: time.Date(2019, 3, 1, 0, 0, 0, 0, time.UTC),
+	}))
+
+	_, err = ImportFilterFromConfig(Configuration{ConfigKeyImportCreatedAfter: "last year"})
+	require.Error(t, err)
+}
@@ -17,6 +17,9 @@ type BridgeImpl interface {
 	// for future use
 	Configure(repo *cache.RepoCache, params BridgeParams) (Configuration, error)
 
+	// Capabilities return the set of features supported by the bridge
+	Capabilities() BridgeCapabilities
+
 	// ValidateConfig check the configuration for error
 	ValidateConfig(conf Configuration) error
 
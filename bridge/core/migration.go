@@ -0,0 +1,35 @@
+package core
+
+import (
+	"fmt"
+)
+
+// ConfigKeyUseMigrationAPI is the configuration key enabling the import of
+// the issues from a bulk export of the remote, when set to "true"
+const ConfigKeyUseMigrationAPI = "use-migration-api"
+
+// UseMigrationAPI return true if the import of a bridge configuration should
+// go through the migration API of the remote
+func UseMigrationAPI(conf Configuration) bool {
+	return conf[ConfigKeyUseMigrationAPI] == "true"
+}
+
+// SetUseMigrationAPI make the next imports go through the migration API of
+// the remote, without storing it in the configuration. The importer fall
+// back to the regular import if the migration fail.
+func (b *Bridge) SetUseMigrationAPI() error {
+	if !b.Capabilities().Has(CapMigration) {
+		return fmt.Errorf("the %s bridge doesn't support the migration API", b.Target())
+	}
+
+	err := b.ensureConfig()
+	if err != nil {
+		return err
+	}
+
+	b.conf[ConfigKeyUseMigrationAPI] = "true"
+
+	// the importer read its configuration on init
+	b.initImportDone = false
+	return nil
+}
@@ -0,0 +1,223 @@
+package core
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/smtp"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// ConfigKeyNotifySlack is the configuration key holding the id of the
+	// credential storing the URL of a Slack Incoming Webhook
+	ConfigKeyNotifySlack = "notify-slack"
+
+	// ConfigKeyNotifyWebhook is the configuration key holding the id of the
+	// credential storing the URL of a generic webhook
+	ConfigKeyNotifyWebhook = "notify-webhook"
+
+	// ConfigKeyNotifyEmailTo is the configuration key holding the comma
+	// separated recipients of the email notifications
+	ConfigKeyNotifyEmailTo = "notify-email-to"
+
+	// ConfigKeyNotifyEmailFrom is the configuration key holding the sender of
+	// the email notifications, also used as the SMTP username
+	ConfigKeyNotifyEmailFrom = "notify-email-from"
+
+	// ConfigKeyNotifySMTPAddr is the configuration key holding the address
+	// of the SMTP server, as "host:port"
+	ConfigKeyNotifySMTPAddr = "notify-smtp-addr"
+
+	// ConfigKeyNotifySMTPPassword is the configuration key holding the id of
+	// the credential storing the password of the SMTP server, if any
+	ConfigKeyNotifySMTPPassword = "notify-smtp-password"
+)
+
+// Notifier is told about the result of a successful import, to relay it to
+// the outside world.
+type Notifier interface {
+	// Notify relay a single event. Only BugImported and ImportFinished
+	// are notified, the other events are ignored.
+	Notify(event BridgeEvent) error
+}
+
+// SecretLoader return the secret value of a stored credential. The bridge
+// core doesn't have access to the credentials, so it has to be provided.
+type SecretLoader func(id string) (string, error)
+
+var _ Notifier = &SlackNotifier{}
+var _ Notifier = &EmailNotifier{}
+var _ Notifier = &WebhookNotifier{}
+
+// SlackNotifier post the events as messages with a Slack Incoming Webhook
+type SlackNotifier struct {
+	WebhookURL string
+}
+
+func (n *SlackNotifier) Notify(event BridgeEvent) error {
+	text, ok := notificationText(event)
+	if !ok {
+		return nil
+	}
+
+	return postJSON(n.WebhookURL, map[string]string{"text": text})
+}
+
+// EmailNotifier send the events by email through a SMTP server
+type EmailNotifier struct {
+	// Addr is the address of the SMTP server, as "host:port"
+	Addr string
+	From string
+	To   []string
+	// Auth authenticate with the SMTP server, nil if not required
+	Auth smtp.Auth
+}
+
+func (n *EmailNotifier) Notify(event BridgeEvent) error {
+	text, ok := notificationText(event)
+	if !ok {
+		return nil
+	}
+
+	msg := fmt.Sprintf("From: %s\r\nTo: %s\r\nSubject: %s\r\n\r\n%s\r\n",
+		n.From, strings.Join(n.To, ", "), text, text)
+
+	return smtp.SendMail(n.Addr, n.Auth, n.From, n.To, []byte(msg))
+}
+
+// WebhookNotifier post the events as JSON to an URL
+type WebhookNotifier struct {
+	URL string
+}
+
+// webhookPayload is the JSON body posted by the WebhookNotifier
+type webhookPayload struct {
+	Event string `json:"event"`
+	ID    string `json:"id,omitempty"`
+	Title string `json:"title,omitempty"`
+	Text  string `json:"text"`
+}
+
+func (n *WebhookNotifier) Notify(event BridgeEvent) error {
+	text, ok := notificationText(event)
+	if !ok {
+		return nil
+	}
+
+	payload := webhookPayload{Text: text}
+
+	switch event := event.(type) {
+	case BugImported:
+		payload.Event = "bug-imported"
+		payload.ID = event.ID.String()
+		payload.Title = event.Title
+	case ImportFinished:
+		payload.Event = "import-finished"
+	}
+
+	return postJSON(n.URL, payload)
+}
+
+// notificationText return the human readable text of an event, or false if
+// the event is not notified
+func notificationText(event BridgeEvent) (string, bool) {
+	switch event := event.(type) {
+	case BugImported:
+		return fmt.Sprintf("git-bug: imported bug %s: %s", event.ID.Human(), event.Title), true
+	case ImportFinished:
+		return "git-bug: import finished", true
+	default:
+		return "", false
+	}
+}
+
+func postJSON(url string, payload interface{}) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	resp, err := NewHTTPClient(DefaultTimeout).Post(url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	}
+
+	return nil
+}
+
+// Notifiers return the notifiers configured for the bridge
+func (b *Bridge) Notifiers(loadSecret SecretLoader) ([]Notifier, error) {
+	err := b.ensureConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	return notifiersFromConfig(b.conf, loadSecret)
+}
+
+func notifiersFromConfig(conf Configuration, loadSecret SecretLoader) ([]Notifier, error) {
+	var notifiers []Notifier
+
+	if id, ok := conf[ConfigKeyNotifySlack]; ok {
+		url, err := loadSecret(id)
+		if err != nil {
+			return nil, errors.Wrap(err, "loading the Slack webhook")
+		}
+		notifiers = append(notifiers, &SlackNotifier{WebhookURL: url})
+	}
+
+	if id, ok := conf[ConfigKeyNotifyWebhook]; ok {
+		url, err := loadSecret(id)
+		if err != nil {
+			return nil, errors.Wrap(err, "loading the webhook")
+		}
+		notifiers = append(notifiers, &WebhookNotifier{URL: url})
+	}
+
+	if to, ok := conf[ConfigKeyNotifyEmailTo]; ok {
+		n := &EmailNotifier{
+			Addr: conf[ConfigKeyNotifySMTPAddr],
+			From: conf[ConfigKeyNotifyEmailFrom],
+			To:   strings.Split(to, ","),
+		}
+
+		if id, ok := conf[ConfigKeyNotifySMTPPassword]; ok {
+			password, err := loadSecret(id)
+			if err != nil {
+				return nil, errors.Wrap(err, "loading the SMTP password")
+			}
+			host, _, err := net.SplitHostPort(n.Addr)
+			if err != nil {
+				return nil, errors.Wrap(err, "invalid SMTP address")
+			}
+			n.Auth = smtp.PlainAuth("", n.From, password, host)
+		}
+
+		notifiers = append(notifiers, n)
+	}
+
+	return notifiers, nil
+}
+
+// validateNotifyConfig ensure the email notifications have what they need
+func validateNotifyConfig(conf Configuration) error {
+	if _, ok := conf[ConfigKeyNotifyEmailTo]; !ok {
+		return nil
+	}
+	if conf[ConfigKeyNotifyEmailFrom] == "" {
+		return fmt.Errorf("missing %s for the email notifications", ConfigKeyNotifyEmailFrom)
+	}
+	if conf[ConfigKeyNotifySMTPAddr] == "" {
+		return fmt.Errorf("missing %s for the email notifications", ConfigKeyNotifySMTPAddr)
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+package core
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/entity"
+)
+
+func TestWebhookNotifiers(t *testing.T) {
+	var received []map[string]string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		var body map[string]string
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		received = append(received, body)
+	}))
+	defer server.Close()
+
+	id := entity.Id("0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef")
+
+	slack := &SlackNotifier{WebhookURL: server.URL}
+	require.NoError(t, slack.Notify(BugImported{ID: id, Title: "a bug"}))
+	require.NoError(t, slack.Notify(BugSkipped{ID: id}))
+	require.NoError(t, slack.Notify(ImportFinished{}))
+
+	require.Equal(t, []map[string]string{
+		{"text": "git-bug: imported bug 0123456: a bug"},
+		{"text": "git-bug: import finished"},
+	}, received)
+
+	received = nil
+	webhook := &WebhookNotifier{URL: server.URL}
+	require.NoError(t, webhook.Notify(BugImported{ID: id, Title: "a bug"}))
+
+	require.Equal(t, []map[string]string{{
+		"event": "bug-imported",
+		"id":    id.String(),
+		"title": "a bug",
+		"text":  "git-bug: imported bug 0123456: a bug",
+	}}, received)
+
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer failing.Close()
+
+	webhook = &WebhookNotifier{URL: failing.URL}
+	require.Error(t, webhook.Notify(ImportFinished{}))
+}
+
+func TestNotifiersFromConfig(t *testing.T) {
+	secrets := map[string]string{
+		"slack": "https://hooks.slack.com/services/xxx",
+		"smtp":  "password",
+	}
+	loadSecret := func(id string) (string, error) {
+		if secret, ok := secrets[id]; ok {
+			return secret, nil
+		}
+		return "", fmt.Errorf("unknown secret %s", id)
+	}
+
+	notifiers, err := notifiersFromConfig(Configuration{}, loadSecret)
+	require.NoError(t, err)
+	require.Empty(t, notifiers)
+
+	notifiers, err = notifiersFromConfig(Configuration{
+		ConfigKeyNotifySlack:        "slack",
+		ConfigKeyNotifyEmailTo:      "a@example.com,b@example.com",
+		ConfigKeyNotifyEmailFrom:    "git-bug@example.com",
+		ConfigKeyNotifySMTPAddr:     "smtp.example.com:587",
+		ConfigKeyNotifySMTPPassword: "smtp",
+	}, loadSecret)
+	require.NoError(t, err)
+	require.Len(t, notifiers, 2)
+	require.Equal(t, &SlackNotifier{WebhookURL: secrets["slack"]}, notifiers[0])
+
+	email := notifiers[1].(*EmailNotifier)
+	require.Equal(t, []string{"a@example.com", "b@example.com"}, email.To)
+	require.NotNil(t, email.Auth)
+
+	_, err = notifiersFromConfig(Configuration{ConfigKeyNotifyWebhook: "unknown"}, loadSecret)
+	require.Error(t, err)
+
+	require.Error(t, validateNotifyConfig(Configuration{ConfigKeyNotifyEmailTo: "a@example.com"}))
+}
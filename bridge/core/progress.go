@@ -0,0 +1,79 @@
+package core
+
+import (
+	"context"
+)
+
+// ProgressEvent is a snapshot of the state of an import, emitted each time
+// a bug has been processed.
+type ProgressEvent struct {
+	// Number of bugs processed so far
+	Done int
+	// Total number of bugs to process, or 0 if the importer can't know in advance
+	Total int
+	// Number of new bugs imported so far
+	Imported int
+	// Number of new identities imported so far
+	Identities int
+	// Title of the last processed bug
+	CurrentTitle string
+	// Errors encountered so far
+	Errors []error
+	// The import result this event has been emitted for
+	Result ImportResult
+}
+
+// ImportProgress consume the events of an import and report the progress on
+// the given channel, with one event for each of them. It returns when the import is finished or when the
+// context is canceled. As the importers commit each bug independently, the
+// bugs processed before a cancellation are kept.
+//
+// titleOf is used to resolve the title of the processed bug and can be nil.
+func ImportProgress(ctx context.Context, events <-chan ImportResult, progress chan<- ProgressEvent, titleOf func(result ImportResult) string) error {
+	var state ProgressEvent
+
+	for {
+		select {
+		case <-ctx.Done():
+			// drain the importer so that it can exit gracefully
+			for range events {
+			}
+			return ctx.Err()
+
+		case result, ok := <-events:
+			if !ok {
+				return nil
+			}
+
+			switch result.Event {
+			case ImportEventBug, ImportEventNothing:
+				state.Done++
+				if result.Event == ImportEventBug {
+					state.Imported++
+				}
+				if titleOf != nil {
+					state.CurrentTitle = titleOf(result)
+				}
+			case ImportEventIdentity:
+				state.Identities++
+			case ImportEventTotal:
+				state.Total = result.Total
+			case ImportEventError:
+				if result.Err == context.Canceled {
+					continue
+				}
+				state.Errors = append(state.Errors, result.Err)
+			}
+
+			// copy the errors to not share the backing array with the receiver
+			event := state
+			event.Result = result
+			event.Errors = append([]error(nil), state.Errors...)
+
+			select {
+			case progress <- event:
+			case <-ctx.Done():
+			}
+		}
+	}
+}
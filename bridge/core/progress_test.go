@@ -0,0 +1,55 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestImportProgress(t *testing.T) {
+	events := make(chan ImportResult)
+	go func() {
+		defer close(events)
+		events <- NewImportTotal(2)
+		events <- NewImportIdentity("abcd")
+		events <- NewImportBug("1234")
+		events <- NewImportComment("5678")
+		events <- NewImportError(errors.New("oops"), "")
+		events <- NewImportNothing("9012", "no imported operation")
+	}()
+
+	progress := make(chan ProgressEvent)
+	var received []ProgressEvent
+	finished := make(chan struct{})
+	go func() {
+		for event := range progress {
+			received = append(received, event)
+		}
+		close(finished)
+	}()
+
+	err := ImportProgress(context.Background(), events, progress, func(result ImportResult) string {
+		return "title " + result.ID.String()
+	})
+	close(progress)
+	<-finished
+
+	require.NoError(t, err)
+	require.Len(t, received, 6)
+	require.Equal(t, 2, received[0].Total)
+	require.Equal(t, 1, received[1].Identities)
+	require.Equal(t, 1, received[2].Done)
+	require.Equal(t, 1, received[2].Imported)
+	require.Equal(t, "title 1234", received[2].CurrentTitle)
+	require.Equal(t, ImportEventComment, received[3].Result.Event)
+	require.Len(t, received[4].Errors, 1)
+
+	last := received[5]
+	require.Equal(t, 2, last.Done)
+	require.Equal(t, 2, last.Total)
+	require.Equal(t, 1, last.Imported)
+	require.Equal(t, 1, last.Identities)
+	require.Equal(t, "title 9012", last.CurrentTitle)
+}
@@ -0,0 +1,75 @@
+package core
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+// ConfigKeyProxy is the config key, under the "git-bug." namespace, of the
+// proxy to use for the bridges. When set, it overrides the HTTP_PROXY,
+// HTTPS_PROXY and NO_PROXY environment variables.
+const ConfigKeyProxy = "proxy"
+
+var proxyState struct {
+	mu    sync.RWMutex
+	proxy func(*http.Request) (*url.URL, error)
+}
+
+// LoadProxyConfig read the proxy configuration of the repository, to be used
+// by the clients created with NewHTTPClient and NewTransport. Without a
+// git-bug.proxy config key, the proxy is taken from the environment.
+func LoadProxyConfig(repo repository.RepoConfig) error {
+	proxy := http.ProxyFromEnvironment
+
+	raw, err := repository.NewGitBugConfig(repo).LocalConfig().ReadString(ConfigKeyProxy)
+	switch err {
+	case nil:
+		proxyURL, err := url.Parse(raw)
+		if err != nil || proxyURL.Host == "" {
+			return fmt.Errorf("invalid %s%s value: %s", repository.GitBugNamespace, ConfigKeyProxy, raw)
+		}
+		proxy = http.ProxyURL(proxyURL)
+	case repository.ErrNoConfigEntry:
+	default:
+		return err
+	}
+
+	proxyState.mu.Lock()
+	proxyState.proxy = proxy
+	proxyState.mu.Unlock()
+
+	return nil
+}
+
+func proxyFunc(req *http.Request) (*url.URL, error) {
+	proxyState.mu.RLock()
+	proxy := proxyState.proxy
+	proxyState.mu.RUnlock()
+
+	if proxy == nil {
+		return http.ProxyFromEnvironment(req)
+	}
+	return proxy(req)
+}
+
+// NewTransport return a http.Transport with the default settings, going
+// through the configured proxy.
+func NewTransport() *http.Transport {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = proxyFunc
+	return transport
+}
+
+// NewHTTPClient return a http.Client going through the configured proxy.
+// A zero timeout means no timeout.
+func NewHTTPClient(timeout time.Duration) *http.Client {
+	return &http.Client{
+		Transport: NewTransport(),
+		Timeout:   timeout,
+	}
+}
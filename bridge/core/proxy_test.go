@@ -0,0 +1,42 @@
+package core
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestProxyConfig(t *testing.T) {
+	repo := repository.NewMockRepoForTest()
+	defer func() { _ = LoadProxyConfig(repository.NewMockRepoForTest()) }()
+
+	req, err := http.NewRequest("GET", "https://api.github.com/", nil)
+	require.NoError(t, err)
+
+	// without configuration, the environment is used
+	err = LoadProxyConfig(repo)
+	require.NoError(t, err)
+
+	expected, err := http.ProxyFromEnvironment(req)
+	require.NoError(t, err)
+	proxy, err := NewTransport().Proxy(req)
+	require.NoError(t, err)
+	require.Equal(t, expected, proxy)
+
+	// the config override the environment
+	config := repository.NewGitBugConfig(repo).LocalConfig()
+	require.NoError(t, config.StoreString(ConfigKeyProxy, "http://proxy.example.com:3128"))
+
+	err = LoadProxyConfig(repo)
+	require.NoError(t, err)
+
+	proxy, err = NewHTTPClient(0).Transport.(*http.Transport).Proxy(req)
+	require.NoError(t, err)
+	require.Equal(t, "http://proxy.example.com:3128", proxy.String())
+
+	require.NoError(t, config.StoreString(ConfigKeyProxy, "not a proxy"))
+	require.Error(t, LoadProxyConfig(repo))
+}
@@ -0,0 +1,41 @@
+package core
+
+import "time"
+
+// SyncStats gather statistics about a synchronization with a remote bug tracker
+type SyncStats struct {
+	// Number of times the importer or exporter paused to respect the
+	// rate limit of the remote API
+	RateLimitHits int
+	// Total time spent waiting for the rate limit to reset
+	RateLimitWaitDuration time.Duration
+}
+
+// Add return the sum of two SyncStats
+func (s SyncStats) Add(other SyncStats) SyncStats {
+	return SyncStats{
+		RateLimitHits:         s.RateLimitHits + other.RateLimitHits,
+		RateLimitWaitDuration: s.RateLimitWaitDuration + other.RateLimitWaitDuration,
+	}
+}
+
+// StatsReporter is implemented by the importers and exporters able to
+// report statistics about the synchronization
+type StatsReporter interface {
+	Stats() SyncStats
+}
+
+// Stats return the statistics of the synchronizations done so far by this
+// bridge, for the importer and exporter supporting it
+func (b *Bridge) Stats() SyncStats {
+	var stats SyncStats
+
+	if reporter, ok := b.importer.(StatsReporter); ok {
+		stats = stats.Add(reporter.Stats())
+	}
+	if reporter, ok := b.exporter.(StatsReporter); ok {
+		stats = stats.Add(reporter.Stats())
+	}
+
+	return stats
+}
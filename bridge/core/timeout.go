@@ -0,0 +1,59 @@
+package core
+
+import (
+	"time"
+)
+
+const (
+	// ConfigKeyTimeout is the configuration key holding the maximum duration
+	// of a single API call, as a duration like "30s"
+	ConfigKeyTimeout = "timeout"
+
+	// ConfigKeyTotalTimeout is the configuration key holding the maximum
+	// duration of a whole import, as a duration like "5m"
+	ConfigKeyTotalTimeout = "total-timeout"
+
+	// DefaultTimeout is the maximum duration of a single API call when not
+	// configured
+	DefaultTimeout = 30 * time.Second
+)
+
+// Timeout return the maximum duration of a single API call of a bridge
+// configuration, DefaultTimeout if not configured or invalid
+func Timeout(conf Configuration) time.Duration {
+	timeout, err := time.ParseDuration(conf[ConfigKeyTimeout])
+	if err != nil || timeout <= 0 {
+		return DefaultTimeout
+	}
+	return timeout
+}
+
+// TotalTimeout return the maximum duration of a whole import of a bridge
+// configuration, or zero if it's not limited
+func TotalTimeout(conf Configuration) time.Duration {
+	timeout, err := time.ParseDuration(conf[ConfigKeyTotalTimeout])
+	if err != nil || timeout < 0 {
+		return 0
+	}
+	return timeout
+}
+
+// SetTimeouts override the timeouts of the bridge configuration for the next
+// imports, without storing them. A zero value keep the configured timeout.
+func (b *Bridge) SetTimeouts(timeout, totalTimeout time.Duration) error {
+	err := b.ensureConfig()
+	if err != nil {
+		return err
+	}
+
+	if timeout > 0 {
+		b.conf[ConfigKeyTimeout] = timeout.String()
+	}
+	if totalTimeout > 0 {
+		b.conf[ConfigKeyTotalTimeout] = totalTimeout.String()
+	}
+
+	// the importer build its clients on init
+	b.initImportDone = false
+	return nil
+}
@@ -0,0 +1,18 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTimeout(t *testing.T) {
+	require.Equal(t, DefaultTimeout, Timeout(Configuration{}))
+	require.Equal(t, DefaultTimeout, Timeout(Configuration{ConfigKeyTimeout: "invalid"}))
+	require.Equal(t, 10*time.Second, Timeout(Configuration{ConfigKeyTimeout: "10s"}))
+
+	require.Zero(t, TotalTimeout(Configuration{}))
+	require.Zero(t, TotalTimeout(Configuration{ConfigKeyTotalTimeout: "invalid"}))
+	require.Equal(t, 5*time.Minute, TotalTimeout(Configuration{ConfigKeyTotalTimeout: "5m"}))
+}
@@ -0,0 +1,85 @@
+package github
+
+import (
+	"time"
+
+	"github.com/MichaelMure/git-bug/bridge/core"
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/cache"
+)
+
+// trackedAlert adapt an alert of the Github API, like a Dependabot alert or
+// a workflow failure, to ensureAlert
+type trackedAlert struct {
+	// creation metadata identifying the bug tracking the alert
+	metaKey   string
+	metaValue string
+
+	// whether the alert is resolved, and since when
+	closed   bool
+	closedAt time.Time
+	// last update of the alert, used when it's reopened
+	updatedAt time.Time
+
+	// metadata of the operations changing the status of the bug
+	statusMetadata map[string]string
+
+	// create the bug of an alert not tracked yet, or return a nil bug if the
+	// alert doesn't need one
+	create func() (*cache.BugCache, error)
+}
+
+// ensureAlert create the bug tracking an alert if needed, and close or
+// reopen it to match the state of the alert
+func (gi *githubImporter) ensureAlert(repo *cache.RepoCache, alert trackedAlert) error {
+	b, err := repo.ResolveBugCreateMetadata(alert.metaKey, alert.metaValue)
+	if err != nil && err != bug.ErrBugNotExist {
+		return err
+	}
+
+	if err == bug.ErrBugNotExist {
+		b, err = alert.create()
+		if err != nil || b == nil {
+			return err
+		}
+	}
+
+	snap := b.Snapshot()
+
+	// the API doesn't tell who changed the state of the alert
+	author, err := gi.getGhost(repo)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case alert.closed && snap.Status == bug.OpenStatus && alert.closedAt.After(lastStatusChange(snap)):
+		op, err := b.CloseRaw(author, alert.closedAt.Unix(), alert.statusMetadata)
+		if err != nil {
+			return err
+		}
+		gi.out <- core.NewImportStatusChange(op.Id())
+
+	case !alert.closed && snap.Status == bug.ClosedStatus && alert.updatedAt.After(lastStatusChange(snap)):
+		// an alert reopened after a resolution
+		op, err := b.OpenRaw(author, alert.updatedAt.Unix(), alert.statusMetadata)
+		if err != nil {
+			return err
+		}
+		gi.out <- core.NewImportStatusChange(op.Id())
+	}
+
+	return b.CommitAsNeeded()
+}
+
+// lastStatusChange return the time of the last status change of a bug, or
+// its creation time if the status never changed
+func lastStatusChange(snap *bug.Snapshot) time.Time {
+	last := snap.CreatedAt
+	for _, op := range snap.Operations {
+		if op, ok := op.(*bug.SetStatusOperation); ok {
+			last = op.Time()
+		}
+	}
+	return last
+}
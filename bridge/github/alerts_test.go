@@ -0,0 +1,69 @@
+package github
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/bridge/core"
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestEnsureAlert(t *testing.T) {
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	backend, err := cache.NewRepoCache(repo)
+	require.NoError(t, err)
+	defer backend.Close()
+
+	ghost, err := backend.NewIdentityRaw("Ghost", "", "ghost", "", map[string]string{
+		metaKeyGithubLogin: "ghost",
+	})
+	require.NoError(t, err)
+
+	out := make(chan core.ImportResult, 10)
+	gi := &githubImporter{out: out}
+
+	created := time.Now().Add(-time.Hour)
+	alert := trackedAlert{
+		metaKey:   "alert",
+		metaValue: "https://github.com/MichaelMure/git-bug/alerts/1",
+		updatedAt: created,
+		create: func() (*cache.BugCache, error) {
+			b, _, err := backend.NewBugRaw(ghost, created.Unix(), "alert", "message", nil, map[string]string{
+				"alert": "https://github.com/MichaelMure/git-bug/alerts/1",
+			})
+			return b, err
+		},
+	}
+
+	require.NoError(t, gi.ensureAlert(backend, alert))
+	b, err := backend.ResolveBugCreateMetadata("alert", alert.metaValue)
+	require.NoError(t, err)
+	require.Equal(t, bug.OpenStatus, b.Snapshot().Status)
+
+	// the bug follow the state of the alert
+	alert.closed, alert.closedAt = true, created.Add(time.Minute)
+	require.NoError(t, gi.ensureAlert(backend, alert))
+	require.Equal(t, bug.ClosedStatus, b.Snapshot().Status)
+
+	// but only once
+	require.NoError(t, gi.ensureAlert(backend, alert))
+	require.Len(t, b.Snapshot().Operations, 2)
+
+	alert.closed, alert.updatedAt = false, created.Add(2*time.Minute)
+	require.NoError(t, gi.ensureAlert(backend, alert))
+	require.Equal(t, bug.OpenStatus, b.Snapshot().Status)
+	require.False(t, b.NeedCommit())
+	require.Len(t, out, 2)
+
+	// an alert that doesn't need a bug
+	alert.metaValue = "https://github.com/MichaelMure/git-bug/alerts/2"
+	alert.create = func() (*cache.BugCache, error) { return nil, nil }
+	require.NoError(t, gi.ensureAlert(backend, alert))
+	require.Len(t, backend.AllBugsIds(), 1)
+}
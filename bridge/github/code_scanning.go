@@ -0,0 +1,201 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/MichaelMure/git-bug/bridge/core"
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/util/text"
+)
+
+const (
+	// enable the import of the code scanning alerts
+	keyImportCodeScanning = "import-code-scanning"
+
+	// origin of the bugs created from code scanning alerts. As it differs from
+	// the target, those bugs are not exported as Github issues.
+	codeScanningOrigin = "github-code-scanning"
+
+	// URL of the alert a bug has been created from
+	metaKeyCodeScanningAlert = "github-code-scanning-alert"
+	// location of the code of the alert, as "path:line"
+	metaKeyCodeScanningLocation = "github-code-scanning-location"
+
+	// labels set on the bugs created from code scanning alerts
+	labelSecurity     = "security"
+	labelCodeScanning = "code-scanning"
+	// prefix of the label holding the severity of the alert rule
+	labelPriorityPrefix = "priority:"
+
+	codeScanningPageSize = 100
+)
+
+type codeScanningAlert struct {
+	Number      int        `json:"number"`
+	State       string     `json:"state"`
+	HTMLURL     string     `json:"html_url"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+	DismissedAt *time.Time `json:"dismissed_at"`
+	FixedAt     *time.Time `json:"fixed_at"`
+	Rule        struct {
+		ID          string `json:"id"`
+		Severity    string `json:"severity"`
+		Description string `json:"description"`
+	} `json:"rule"`
+	Tool struct {
+		Name string `json:"name"`
+	} `json:"tool"`
+	MostRecentInstance struct {
+		Location struct {
+			Path      string `json:"path"`
+			StartLine int    `json:"start_line"`
+		} `json:"location"`
+	} `json:"most_recent_instance"`
+}
+
+// location return the location of the code of the alert, as "path:line"
+func (a codeScanningAlert) location() string {
+	loc := a.MostRecentInstance.Location
+	if loc.Path == "" {
+		return ""
+	}
+	return loc.Path + ":" + strconv.Itoa(loc.StartLine)
+}
+
+// title build the title of the bug tracking an alert
+func (a codeScanningAlert) title() string {
+	if path := a.MostRecentInstance.Location.Path; path != "" {
+		return fmt.Sprintf("Code scanning alert: %s in %s", a.Rule.ID, path)
+	}
+	return fmt.Sprintf("Code scanning alert: %s", a.Rule.ID)
+}
+
+// closedAt return when the alert has been dismissed or fixed, if it has
+func (a codeScanningAlert) closedAt() (time.Time, bool) {
+	switch {
+	case a.State == "dismissed" && a.DismissedAt != nil:
+		return *a.DismissedAt, true
+	case a.State == "fixed" && a.FixedAt != nil:
+		return *a.FixedAt, true
+	default:
+		return time.Time{}, false
+	}
+}
+
+// listCodeScanningAlerts list all the code scanning alerts of a repository
+func listCodeScanningAlerts(ctx context.Context, baseURL, token, owner, project string) ([]codeScanningAlert, error) {
+	var alerts []codeScanningAlert
+
+	for page := 1; ; page++ {
+		var result []codeScanningAlert
+		url := fmt.Sprintf("%s/repos/%s/%s/code-scanning/alerts?per_page=%d&page=%d",
+			baseURL, owner, project, codeScanningPageSize, page)
+		err := restRequest(ctx, token, restAccept, http.MethodGet, url, nil, &result)
+		if err != nil {
+			return nil, err
+		}
+
+		alerts = append(alerts, result...)
+
+		if len(result) < codeScanningPageSize {
+			break
+		}
+	}
+
+	return alerts, nil
+}
+
+// importCodeScanningAlerts create a bug for each open or dismissed code
+// scanning alert, and keep the status of the bugs in sync with the alerts.
+func (gi *githubImporter) importCodeScanningAlerts(ctx context.Context, repo *cache.RepoCache) error {
+	alerts, err := listCodeScanningAlerts(ctx, baseURLOf(gi.conf), gi.token.Value, gi.conf[keyOwner], gi.conf[keyProject])
+	if err != nil {
+		return err
+	}
+
+	for _, alert := range alerts {
+		if err := gi.ensureCodeScanningAlert(repo, alert); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ensureCodeScanningAlert create the bug tracking an alert if needed, and
+// close or reopen it to match the state of the alert
+func (gi *githubImporter) ensureCodeScanningAlert(repo *cache.RepoCache, alert codeScanningAlert) error {
+	closedAt, closed := alert.closedAt()
+
+	return gi.ensureAlert(repo, trackedAlert{
+		metaKey:   metaKeyCodeScanningAlert,
+		metaValue: alert.HTMLURL,
+		closed:    closed,
+		closedAt:  closedAt,
+		updatedAt: alert.UpdatedAt,
+		create: func() (*cache.BugCache, error) {
+			// fixed alerts are only used to close the existing bugs
+			if alert.State == "fixed" {
+				return nil, nil
+			}
+			return gi.createCodeScanningBug(repo, alert)
+		},
+	})
+}
+
+// createCodeScanningBug create the bug tracking an alert
+func (gi *githubImporter) createCodeScanningBug(repo *cache.RepoCache, alert codeScanningAlert) (*cache.BugCache, error) {
+	// the API doesn't give a human author
+	author, err := gi.getGhost(repo)
+	if err != nil {
+		return nil, err
+	}
+
+	message := alert.Rule.Description + "\n"
+	if alert.Tool.Name != "" {
+		message += fmt.Sprintf("\nTool: %s", alert.Tool.Name)
+	}
+	message += fmt.Sprintf("\nAlert: %s", alert.HTMLURL)
+
+	cleanText, err := text.Cleanup(message)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata := map[string]string{
+		core.MetaKeyOrigin:       codeScanningOrigin,
+		metaKeyCodeScanningAlert: alert.HTMLURL,
+	}
+	if location := alert.location(); location != "" {
+		metadata[metaKeyCodeScanningLocation] = location
+	}
+
+	b, err := repo.NewBugWithID(codeScanningOrigin, alert.HTMLURL, cache.BugCreateArgs{
+		Author:   author,
+		UnixTime: alert.CreatedAt.Unix(),
+		Title:    alert.title(),
+		Message:  cleanText,
+		Metadata: metadata,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	labels := []string{labelSecurity, labelCodeScanning}
+	if alert.Rule.Severity != "" {
+		labels = append(labels, labelPriorityPrefix+alert.Rule.Severity)
+	}
+
+	_, _, err = b.ChangeLabelsRaw(author, alert.CreatedAt.Unix(), labels, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	gi.out <- core.NewImportBug(b.Id())
+	return b, nil
+}
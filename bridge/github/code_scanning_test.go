@@ -0,0 +1,65 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCodeScanningAlert(t *testing.T) {
+	var alert codeScanningAlert
+	err := json.Unmarshal([]byte(`{
+		"number": 42,
+		"state": "dismissed",
+		"dismissed_at": "2020-03-01T10:00:00Z",
+		"rule": {"id": "go/sql-injection", "severity": "error", "description": "Database query built from user-controlled sources"},
+		"most_recent_instance": {"location": {"path": "db/query.go", "start_line": 12}}
+	}`), &alert)
+	require.NoError(t, err)
+
+	require.Equal(t, "Code scanning alert: go/sql-injection in db/query.go", alert.title())
+	require.Equal(t, "db/query.go:12", alert.location())
+
+	closedAt, closed := alert.closedAt()
+	require.True(t, closed)
+	require.Equal(t, time.Date(2020, 3, 1, 10, 0, 0, 0, time.UTC), closedAt)
+
+	alert.State = "open"
+	_, closed = alert.closedAt()
+	require.False(t, closed)
+
+	alert.MostRecentInstance.Location.Path = ""
+	require.Equal(t, "Code scanning alert: go/sql-injection", alert.title())
+	require.Equal(t, "", alert.location())
+}
+
+func TestListCodeScanningAlerts(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/repos/a/b/code-scanning/alerts", func(w http.ResponseWriter, r *http.Request) {
+		var alerts []map[string]interface{}
+		if r.URL.Query().Get("page") == "1" {
+			for i := 0; i < codeScanningPageSize; i++ {
+				alerts = append(alerts, map[string]interface{}{"number": i})
+			}
+		} else {
+			alerts = append(alerts, map[string]interface{}{
+				"number":   codeScanningPageSize,
+				"html_url": fmt.Sprintf("https://github.com/a/b/security/code-scanning/%d", codeScanningPageSize),
+			})
+		}
+		_ = json.NewEncoder(w).Encode(alerts)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	alerts, err := listCodeScanningAlerts(context.Background(), server.URL, "token", "a", "b")
+	require.NoError(t, err)
+	require.Len(t, alerts, codeScanningPageSize+1)
+	require.Equal(t, "https://github.com/a/b/security/code-scanning/100", alerts[codeScanningPageSize].HTMLURL)
+}
@@ -3,12 +3,14 @@ package github
 import (
 	"bufio"
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"math/rand"
 	"net/http"
+	"net/url"
 	"os"
 	"regexp"
 	"sort"
@@ -37,6 +39,9 @@ const (
 	keyOwner    = "owner"
 	keyProject  = "project"
 
+	// base URL of the API v3, to support Github Enterprise Server
+	keyGithubBaseURL = "base-url"
+
 	defaultTimeout = 60 * time.Second
 )
 
@@ -45,10 +50,6 @@ var (
 )
 
 func (g *Github) Configure(repo *cache.RepoCache, params core.BridgeParams) (core.Configuration, error) {
-	if params.BaseURL != "" {
-		fmt.Println("warning: --base-url is ineffective for a Github bridge")
-	}
-
 	conf := make(core.Configuration)
 	var err error
 
@@ -57,6 +58,20 @@ func (g *Github) Configure(repo *cache.RepoCache, params core.BridgeParams) (cor
 		return nil, fmt.Errorf("you must provide a project URL or Owner/Name to configure this bridge with a token")
 	}
 
+	// getting the API base URL, which also tell the host of the project
+	baseURL := params.BaseURL
+	switch {
+	case baseURL != "":
+	case params.URL == "" && params.CredPrefix == "" && params.TokenRaw == "" && !params.NonInteractive:
+		baseURL, err = promptBaseURL()
+		if err != nil {
+			return nil, err
+		}
+	default:
+		baseURL = githubV3Url
+	}
+	baseURL = strings.TrimSuffix(baseURL, "/")
+
 	var owner string
 	var project string
 
@@ -68,20 +83,22 @@ func (g *Github) Configure(repo *cache.RepoCache, params core.BridgeParams) (cor
 		project = params.Project
 	case params.URL != "":
 		// try to parse params URL and extract owner and project
-		owner, project, err = splitURL(params.URL)
+		_, owner, project, err = parseProjectURL(params.URL, baseURL)
 		if err != nil {
 			return nil, err
 		}
+	case params.NonInteractive:
+		return nil, core.ErrMissingParam("url or owner/project")
 	default:
 		// terminal prompt
-		owner, project, err = promptURL(repo)
+		owner, project, err = promptURL(repo, baseURL)
 		if err != nil {
 			return nil, err
 		}
 	}
 
 	// validate project owner
-	ok, err := validateUsername(owner)
+	ok, err := validateUsername(baseURL, owner)
 	if err != nil {
 		return nil, err
 	}
@@ -113,8 +130,10 @@ func (g *Github) Configure(repo *cache.RepoCache, params core.BridgeParams) (cor
 		}
 	case params.TokenRaw != "":
 		cred = auth.NewToken(userId, params.TokenRaw, target)
+	case params.NonInteractive:
+		return nil, core.ErrMissingParam("token or credential")
 	default:
-		cred, err = promptTokenOptions(repo, userId, owner, project)
+		cred, err = promptTokenOptions(repo, baseURL, userId, owner, project)
 		if err != nil {
 			return nil, err
 		}
@@ -126,7 +145,7 @@ func (g *Github) Configure(repo *cache.RepoCache, params core.BridgeParams) (cor
 	}
 
 	// verify access to the repository with token
-	ok, err = validateProject(owner, project, token)
+	ok, err = validateProject(baseURL, owner, project, token)
 	if err != nil {
 		return nil, err
 	}
@@ -134,9 +153,99 @@ func (g *Github) Configure(repo *cache.RepoCache, params core.BridgeParams) (cor
 		return nil, fmt.Errorf("project doesn't exist or authentication token has an incorrect scope")
 	}
 
+	// the keys only help to verify the authorship of the operations, failing
+	// to fetch them doesn't prevent the configuration
+	if user != nil {
+		keys, err := fetchUserKeys(context.Background(), baseURL, token)
+		if err == nil {
+			err = user.AddKeys(keys...)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
 	conf[core.ConfigKeyTarget] = target
 	conf[keyOwner] = owner
 	conf[keyProject] = project
+	conf[keyGithubBaseURL] = baseURL
+
+	if params.ImportProjectBoard {
+		projectID, err := selectProjectBoard(baseURL, token, owner, project, params.NonInteractive)
+		if err != nil {
+			return nil, err
+		}
+		conf[keyProjectID] = projectID
+	}
+
+	if params.ProjectV2 != 0 {
+		client := buildClient(baseURL, token, nil, 0)
+		projectID, fieldID, options, err := fetchProjectV2Status(context.Background(), client, owner, params.ProjectV2)
+		if err != nil {
+			return nil, err
+		}
+
+		options, err = mapProjectStatuses(options, params.ProjectStatusMap, params.NonInteractive)
+		if err != nil {
+			return nil, err
+		}
+
+		statusMap, err := json.Marshal(options)
+		if err != nil {
+			return nil, err
+		}
+
+		conf[keyProjectV2ID] = projectID
+		conf[keyProjectStatusField] = fieldID
+		conf[keyProjectStatusMap] = string(statusMap)
+	}
+
+	if params.ImportIssueTypes {
+		client := buildClient(baseURL, token, nil, 0)
+		types, err := fetchIssueTypes(context.Background(), client, owner, project)
+		if err != nil {
+			return nil, err
+		}
+		if len(types) == 0 {
+			return nil, fmt.Errorf("the issue types are not enabled on %s/%s", owner, project)
+		}
+
+		names := make([]string, len(types))
+		for i, t := range types {
+			names[i] = t.Name
+		}
+		fmt.Printf("available issue types: %s\n", strings.Join(names, ", "))
+
+		raw, err := json.Marshal(types)
+		if err != nil {
+			return nil, err
+		}
+		conf[keyIssueTypes] = string(raw)
+	}
+
+	if params.ImportWorkflowFailures {
+		conf[keyImportWorkflowFailures] = "true"
+	}
+
+	if params.ImportCodeScanning {
+		conf[keyImportCodeScanning] = "true"
+	}
+
+	if params.ImportDependabot {
+		conf[keyImportDependabot] = "true"
+	}
+
+	if params.ImportSecretScanning {
+		conf[keyImportSecretScanning] = "true"
+	}
+
+	if params.EMUSlug != "" {
+		conf[keyEMUSlug] = params.EMUSlug
+	}
+
+	if params.EmailDomain != "" {
+		conf[keyEmailDomain] = params.EmailDomain
+	}
 
 	err = g.ValidateConfig(conf)
 	if err != nil {
@@ -169,15 +278,63 @@ func (*Github) ValidateConfig(conf core.Configuration) error {
 		return fmt.Errorf("missing %s key", keyProject)
 	}
 
+	if domain, ok := conf[keyEmailDomain]; ok && conf[keyEMUSlug] == "" {
+		return fmt.Errorf("%s %s requires %s to be set", keyEmailDomain, domain, keyEMUSlug)
+	}
+
+	if _, ok := conf[keyProjectV2ID]; ok {
+		if conf[keyProjectStatusField] == "" {
+			return fmt.Errorf("%s requires %s to be set", keyProjectV2ID, keyProjectStatusField)
+		}
+		if _, err := parseProjectStatusMap(conf[keyProjectStatusMap]); err != nil {
+			return err
+		}
+	}
+
+	if raw, ok := conf[keyIssueTypes]; ok {
+		if _, err := parseIssueTypes(raw); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
-func requestToken(note, username, password string, scope string) (*http.Response, error) {
-	return requestTokenWith2FA(note, username, password, "", scope)
+// baseURLOf return the API v3 base URL of a configuration. Configurations
+// created before the support of Github Enterprise Server don't have one.
+func baseURLOf(conf core.Configuration) string {
+	if baseURL, ok := conf[keyGithubBaseURL]; ok && baseURL != "" {
+		return baseURL
+	}
+	return githubV3Url
 }
 
-func requestTokenWith2FA(note, username, password, otpCode string, scope string) (*http.Response, error) {
-	url := fmt.Sprintf("%s/authorizations", githubV3Url)
+// projectHost return the host of the repositories served by an API v3 base
+// URL, that is github.com or the host of a Github Enterprise Server
+func projectHost(baseURL string) string {
+	u, err := url.Parse(baseURL)
+	if err != nil || u.Hostname() == "api.github.com" {
+		return "github.com"
+	}
+	return u.Hostname()
+}
+
+// graphqlURL return the API v4 URL matching an API v3 base URL
+func graphqlURL(baseURL string) string {
+	baseURL = strings.TrimSuffix(baseURL, "/")
+	if strings.HasSuffix(baseURL, "/api/v3") {
+		// Github Enterprise Server
+		return strings.TrimSuffix(baseURL, "/v3") + "/graphql"
+	}
+	return baseURL + "/graphql"
+}
+
+func requestToken(baseURL, note, username, password string, scope string) (*http.Response, error) {
+	return requestTokenWith2FA(baseURL, note, username, password, "", scope)
+}
+
+func requestTokenWith2FA(baseURL, note, username, password, otpCode string, scope string) (*http.Response, error) {
+	url := fmt.Sprintf("%s/authorizations", baseURL)
 	params := struct {
 		Scopes      []string `json:"scopes"`
 		Note        string   `json:"note"`
@@ -205,9 +362,7 @@ func requestTokenWith2FA(note, username, password, otpCode string, scope string)
 		req.Header.Set("X-GitHub-OTP", otpCode)
 	}
 
-	client := &http.Client{
-		Timeout: defaultTimeout,
-	}
+	client := core.NewHTTPClient(defaultTimeout)
 
 	return client.Do(req)
 }
@@ -242,7 +397,7 @@ func randomFingerprint() string {
 	return string(b)
 }
 
-func promptTokenOptions(repo repository.RepoConfig, userId entity.Id, owner, project string) (auth.Credential, error) {
+func promptTokenOptions(repo repository.RepoConfig, baseURL string, userId entity.Id, owner, project string) (auth.Credential, error) {
 	for {
 		creds, err := auth.List(repo, auth.WithUserId(userId), auth.WithTarget(target))
 		if err != nil {
@@ -293,7 +448,7 @@ func promptTokenOptions(repo repository.RepoConfig, userId entity.Id, owner, pro
 			}
 			return auth.NewToken(userId, value, target), nil
 		case 2:
-			value, err := loginAndRequestToken(owner, project)
+			value, err := loginAndRequestToken(baseURL, owner, project)
 			if err != nil {
 				return nil, err
 			}
@@ -313,6 +468,8 @@ func promptToken() (string, error) {
 	fmt.Println("  - 'public_repo': to be able to read public repositories")
 	fmt.Println("Private:")
 	fmt.Println("  - 'repo'       : to be able to read private repositories")
+	fmt.Println("Optional:")
+	fmt.Println("  - 'security_events': to import the code scanning, Dependabot and secret scanning alerts")
 	fmt.Println()
 
 	re, err := regexp.Compile(`^[a-zA-Z0-9]{40}`)
@@ -337,7 +494,7 @@ func promptToken() (string, error) {
 	}
 }
 
-func loginAndRequestToken(owner, project string) (string, error) {
+func loginAndRequestToken(baseURL, owner, project string) (string, error) {
 	fmt.Println("git-bug will now generate an access token in your Github profile. Your credential are not stored and are only used to generate the token. The token is stored in the global git config.")
 	fmt.Println()
 	fmt.Println("The access scope depend on the type of repository.")
@@ -353,7 +510,7 @@ func loginAndRequestToken(owner, project string) (string, error) {
 		return "", err
 	}
 
-	username, err := promptUsername()
+	username, err := promptUsername(baseURL)
 	if err != nil {
 		return "", err
 	}
@@ -377,7 +534,7 @@ func loginAndRequestToken(owner, project string) (string, error) {
 
 	note := fmt.Sprintf("git-bug - %s/%s", owner, project)
 
-	resp, err := requestToken(note, username, password, scope)
+	resp, err := requestToken(baseURL, note, username, password, scope)
 	if err != nil {
 		return "", err
 	}
@@ -392,7 +549,7 @@ func loginAndRequestToken(owner, project string) (string, error) {
 			return "", err
 		}
 
-		resp, err = requestTokenWith2FA(note, username, password, otpCode, scope)
+		resp, err = requestTokenWith2FA(baseURL, note, username, password, otpCode, scope)
 		if err != nil {
 			return "", err
 		}
@@ -408,7 +565,7 @@ func loginAndRequestToken(owner, project string) (string, error) {
 	return "", fmt.Errorf("error creating token %v: %v", resp.StatusCode, string(b))
 }
 
-func promptUsername() (string, error) {
+func promptUsername(baseURL string) (string, error) {
 	for {
 		fmt.Print("username: ")
 
@@ -419,7 +576,7 @@ func promptUsername() (string, error) {
 
 		line = strings.TrimSpace(line)
 
-		ok, err := validateUsername(line)
+		ok, err := validateUsername(baseURL, line)
 		if err != nil {
 			return "", err
 		}
@@ -431,14 +588,14 @@ func promptUsername() (string, error) {
 	}
 }
 
-func promptURL(repo repository.RepoCommon) (string, string, error) {
+func promptURL(repo repository.RepoCommon, baseURL string) (owner string, project string, err error) {
 	// remote suggestions
 	remotes, err := repo.GetRemotes()
 	if err != nil {
 		return "", "", err
 	}
 
-	validRemotes := getValidGithubRemoteURLs(remotes)
+	validRemotes := getValidGithubRemoteURLs(remotes, baseURL)
 	if len(validRemotes) > 0 {
 		for {
 			fmt.Println("\nDetected projects:")
@@ -470,8 +627,8 @@ func promptURL(repo repository.RepoCommon) (string, string, error) {
 			}
 
 			// get owner and project with index
-			owner, project, _ := splitURL(validRemotes[index-1])
-			return owner, project, nil
+			_, owner, project, err := parseProjectURL(validRemotes[index-1], baseURL)
+			return owner, project, err
 		}
 	}
 
@@ -491,7 +648,7 @@ func promptURL(repo repository.RepoCommon) (string, string, error) {
 		}
 
 		// get owner and project from url
-		owner, project, err := splitURL(line)
+		_, owner, project, err := parseProjectURL(line, baseURL)
 		if err != nil {
 			fmt.Println(err)
 			continue
@@ -501,34 +658,75 @@ func promptURL(repo repository.RepoCommon) (string, string, error) {
 	}
 }
 
+func promptBaseURL() (string, error) {
+	fmt.Println("For Github Enterprise Server, the API is usually at https://<host>/api/v3")
+
+	for {
+		fmt.Printf("Github API base URL [%s]: ", githubV3Url)
+
+		line, err := bufio.NewReader(os.Stdin).ReadString('\n')
+		if err != nil {
+			return "", err
+		}
+
+		line = strings.TrimSpace(line)
+		if line == "" {
+			return githubV3Url, nil
+		}
+
+		if !strings.HasPrefix(line, "http://") && !strings.HasPrefix(line, "https://") {
+			fmt.Println("invalid URL")
+			continue
+		}
+
+		return line, nil
+	}
+}
+
 // splitURL extract the owner and project from a github repository URL. It will remove the
 // '.git' extension from the URL before parsing it.
 // Note that Github removes the '.git' extension from projects names at their creation
 func splitURL(url string) (owner string, project string, err error) {
+	_, owner, project, err = parseProjectURL(url, githubV3Url)
+	return
+}
+
+// parseProjectURL extract the host, owner and project from a repository URL,
+// like splitURL. Only the repositories of github.com, or of the Github
+// Enterprise Server serving the given API v3 base URL, are accepted.
+func parseProjectURL(url string, baseURL string) (host string, owner string, project string, err error) {
 	cleanURL := strings.TrimSuffix(url, ".git")
 
-	re, err := regexp.Compile(`github\.com[/:]([a-zA-Z0-9\-_]+)/([a-zA-Z0-9\-_.]+)`)
+	re, err := regexp.Compile(`^(?:[a-z+]+://)?(?:[^@/]+@)?([a-zA-Z0-9\-.]+\.[a-zA-Z]+)(?::[0-9]+)?[/:]([a-zA-Z0-9\-_]+)/([a-zA-Z0-9\-_.]+)`)
 	if err != nil {
 		panic("regexp compile:" + err.Error())
 	}
 
 	res := re.FindStringSubmatch(cleanURL)
 	if res == nil {
-		return "", "", ErrBadProjectURL
+		return "", "", "", ErrBadProjectURL
+	}
+
+	host = strings.ToLower(res[1])
+	if host == "www.github.com" {
+		host = "github.com"
+	}
+	if host != "github.com" && host != projectHost(baseURL) {
+		return "", "", "", ErrBadProjectURL
 	}
 
-	owner = res[1]
-	project = res[2]
+	owner = res[2]
+	project = res[3]
 	return
 }
 
-func getValidGithubRemoteURLs(remotes map[string]string) []string {
+func getValidGithubRemoteURLs(remotes map[string]string, baseURL string) []string {
 	urls := make([]string, 0, len(remotes))
 	for _, url := range remotes {
 		// split url can work again with shortURL
-		owner, project, err := splitURL(url)
+		host, owner, project, err := parseProjectURL(url, baseURL)
 		if err == nil {
-			shortURL := fmt.Sprintf("%s/%s/%s", "github.com", owner, project)
+			shortURL := fmt.Sprintf("%s/%s/%s", host, owner, project)
 			urls = append(urls, shortURL)
 		}
 	}
@@ -538,12 +736,10 @@ func getValidGithubRemoteURLs(remotes map[string]string) []string {
 	return urls
 }
 
-func validateUsername(username string) (bool, error) {
-	url := fmt.Sprintf("%s/users/%s", githubV3Url, username)
+func validateUsername(baseURL, username string) (bool, error) {
+	url := fmt.Sprintf("%s/users/%s", baseURL, username)
 
-	client := &http.Client{
-		Timeout: defaultTimeout,
-	}
+	client := core.NewHTTPClient(defaultTimeout)
 
 	resp, err := client.Get(url)
 	if err != nil {
@@ -558,8 +754,8 @@ func validateUsername(username string) (bool, error) {
 	return resp.StatusCode == http.StatusOK, nil
 }
 
-func validateProject(owner, project string, token *auth.Token) (bool, error) {
-	url := fmt.Sprintf("%s/repos/%s/%s", githubV3Url, owner, project)
+func validateProject(baseURL, owner, project string, token *auth.Token) (bool, error) {
+	url := fmt.Sprintf("%s/repos/%s/%s", baseURL, owner, project)
 
 	req, err := http.NewRequest("GET", url, nil)
 	if err != nil {
@@ -569,9 +765,7 @@ func validateProject(owner, project string, token *auth.Token) (bool, error) {
 	// need the token for private repositories
 	req.Header.Set("Authorization", fmt.Sprintf("token %s", token.Value))
 
-	client := &http.Client{
-		Timeout: defaultTimeout,
-	}
+	client := core.NewHTTPClient(defaultTimeout)
 
 	resp, err := client.Do(req)
 	if err != nil {
@@ -586,6 +780,38 @@ func validateProject(owner, project string, token *auth.Token) (bool, error) {
 	return resp.StatusCode == http.StatusOK, nil
 }
 
+// fetchUserKeys read the public SSH keys of the user owning the token
+func fetchUserKeys(ctx context.Context, baseURL string, token *auth.Token) ([]identity.Key, error) {
+	var user struct {
+		Login string `json:"login"`
+	}
+	err := restRequest(ctx, token.Value, restAccept, http.MethodGet, baseURL+"/user", nil, &user)
+	if err != nil {
+		return nil, err
+	}
+
+	var userKeys []struct {
+		Key string `json:"key"`
+	}
+	url := fmt.Sprintf("%s/users/%s/keys", baseURL, user.Login)
+	err = restRequest(ctx, token.Value, restAccept, http.MethodGet, url, nil, &userKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	var keys []identity.Key
+	for _, userKey := range userKeys {
+		key, err := identity.NewSSHKey(userKey.Key)
+		if err != nil {
+			// not a key format we know about
+			continue
+		}
+		keys = append(keys, key)
+	}
+
+	return keys, nil
+}
+
 func promptPassword() (string, error) {
 	termState, err := terminal.GetState(int(syscall.Stdin))
 	if err != nil {
@@ -669,3 +895,45 @@ func promptProjectVisibility() (bool, error) {
 		return index == 1, nil
 	}
 }
+
+// selectProjectBoard return the id of the classic project whose board is
+// synchronized. The only project of the repository is selected automatically,
+// otherwise the user is prompted to choose one.
+func selectProjectBoard(baseURL string, token *auth.Token, owner, project string, nonInteractive bool) (string, error) {
+	projects, err := listRepoProjects(context.Background(), baseURL, token.Value, owner, project)
+	if err != nil {
+		return "", err
+	}
+
+	switch {
+	case len(projects) == 0:
+		return "", fmt.Errorf("the repository doesn't have any project board")
+	case len(projects) == 1:
+		return strconv.FormatInt(projects[0].ID, 10), nil
+	case nonInteractive:
+		return "", core.ErrMissingParam("project board")
+	}
+
+	for {
+		for i, p := range projects {
+			fmt.Printf("[%d]: %s\n", i+1, p.Name)
+		}
+		fmt.Print("project board: ")
+
+		line, err := bufio.NewReader(os.Stdin).ReadString('\n')
+		fmt.Println()
+		if err != nil {
+			return "", err
+		}
+
+		line = strings.TrimSpace(line)
+
+		index, err := strconv.Atoi(line)
+		if err != nil || index < 1 || index > len(projects) {
+			fmt.Println("invalid input")
+			continue
+		}
+
+		return strconv.FormatInt(projects[index-1].ID, 10), nil
+	}
+}
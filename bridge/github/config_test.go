@@ -100,6 +100,32 @@ func TestSplitURL(t *testing.T) {
 	}
 }
 
+func TestParseProjectURL(t *testing.T) {
+	enterprise := "https://github.corp.com/api/v3"
+
+	host, owner, project, err := parseProjectURL("git@github.corp.com:MichaelMure/git-bug.git", enterprise)
+	assert.NoError(t, err)
+	assert.Equal(t, "github.corp.com", host)
+	assert.Equal(t, "MichaelMure", owner)
+	assert.Equal(t, "git-bug", project)
+
+	host, _, _, err = parseProjectURL("https://github.com/MichaelMure/git-bug", enterprise)
+	assert.NoError(t, err)
+	assert.Equal(t, "github.com", host)
+
+	// only the configured Github Enterprise Server is accepted
+	_, _, _, err = parseProjectURL("https://github.corp.com/MichaelMure/git-bug", githubV3Url)
+	assert.Equal(t, ErrBadProjectURL, err)
+	_, _, _, err = parseProjectURL("https://github.other.com/MichaelMure/git-bug", enterprise)
+	assert.Equal(t, ErrBadProjectURL, err)
+
+	assert.Equal(t, "github.com", projectHost(githubV3Url))
+	assert.Equal(t, "github.corp.com", projectHost(enterprise))
+
+	assert.Equal(t, "https://api.github.com/graphql", graphqlURL(githubV3Url))
+	assert.Equal(t, "https://github.corp.com/api/graphql", graphqlURL("https://github.corp.com/api/v3/"))
+}
+
 func TestValidateUsername(t *testing.T) {
 	if env := os.Getenv("TRAVIS"); env == "true" {
 		t.Skip("Travis environment: avoiding non authenticated requests")
@@ -138,7 +164,7 @@ func TestValidateUsername(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			ok, _ := validateUsername(tt.args.username)
+			ok, _ := validateUsername(githubV3Url, tt.args.username)
 			assert.Equal(t, tt.want, ok)
 		})
 	}
@@ -208,7 +234,7 @@ func TestValidateProject(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			ok, _ := validateProject(tt.args.owner, tt.args.project, tt.args.token)
+			ok, _ := validateProject(githubV3Url, tt.args.owner, tt.args.project, tt.args.token)
 			assert.Equal(t, tt.want, ok)
 		})
 	}
@@ -0,0 +1,217 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/MichaelMure/git-bug/bridge/core"
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/util/text"
+)
+
+const (
+	// enable the import of the Dependabot alerts
+	keyImportDependabot = "import-dependabot"
+
+	// origin of the bugs created from Dependabot alerts. As it differs from
+	// the target, those bugs are not exported as Github issues.
+	dependabotOrigin = "github-dependabot"
+
+	// URL of the alert a bug has been created from
+	metaKeyDependabotAlert = "github-dependabot-alert"
+	// CVE identifier of the vulnerability of the alert, if any
+	metaKeyDependabotCVE = "github-dependabot-cve"
+	// GHSA identifier of the security advisory of the alert
+	metaKeyDependabotGHSA = "github-dependabot-ghsa"
+
+	// label set on the bugs created from Dependabot alerts, along with
+	// labelSecurity
+	labelDependabot = "dependabot"
+
+	dependabotPageSize = 100
+)
+
+type dependabotAlert struct {
+	Number          int        `json:"number"`
+	State           string     `json:"state"`
+	HTMLURL         string     `json:"html_url"`
+	CreatedAt       time.Time  `json:"created_at"`
+	UpdatedAt       time.Time  `json:"updated_at"`
+	DismissedAt     *time.Time `json:"dismissed_at"`
+	FixedAt         *time.Time `json:"fixed_at"`
+	AutoDismissedAt *time.Time `json:"auto_dismissed_at"`
+	Dependency      struct {
+		Package struct {
+			Ecosystem string `json:"ecosystem"`
+			Name      string `json:"name"`
+		} `json:"package"`
+		ManifestPath string `json:"manifest_path"`
+	} `json:"dependency"`
+	SecurityAdvisory struct {
+		GHSAID      string `json:"ghsa_id"`
+		CVEID       string `json:"cve_id"`
+		Summary     string `json:"summary"`
+		Description string `json:"description"`
+		Severity    string `json:"severity"`
+	} `json:"security_advisory"`
+	SecurityVulnerability struct {
+		VulnerableVersionRange string `json:"vulnerable_version_range"`
+		FirstPatchedVersion    *struct {
+			Identifier string `json:"identifier"`
+		} `json:"first_patched_version"`
+	} `json:"security_vulnerability"`
+}
+
+// title build the title of the bug tracking an alert
+func (a dependabotAlert) title() string {
+	return fmt.Sprintf("Dependabot alert in %s: %s",
+		a.Dependency.Package.Name, a.SecurityAdvisory.Summary)
+}
+
+// closedAt return when the alert has been dismissed, automatically or not,
+// or fixed, if it has
+func (a dependabotAlert) closedAt() (time.Time, bool) {
+	switch {
+	case a.State == "dismissed" && a.DismissedAt != nil:
+		return *a.DismissedAt, true
+	case a.State == "auto_dismissed" && a.AutoDismissedAt != nil:
+		return *a.AutoDismissedAt, true
+	case a.State == "fixed" && a.FixedAt != nil:
+		return *a.FixedAt, true
+	default:
+		return time.Time{}, false
+	}
+}
+
+// message build the description of the bug tracking an alert
+func (a dependabotAlert) message() string {
+	message := a.SecurityAdvisory.Description + "\n"
+	message += fmt.Sprintf("\nPackage: %s (%s)", a.Dependency.Package.Name, a.Dependency.Package.Ecosystem)
+	if a.Dependency.ManifestPath != "" {
+		message += fmt.Sprintf("\nManifest: %s", a.Dependency.ManifestPath)
+	}
+	if a.SecurityVulnerability.VulnerableVersionRange != "" {
+		message += fmt.Sprintf("\nVulnerable versions: %s", a.SecurityVulnerability.VulnerableVersionRange)
+	}
+	if patched := a.SecurityVulnerability.FirstPatchedVersion; patched != nil {
+		message += fmt.Sprintf("\nPatched version: %s", patched.Identifier)
+	}
+	if a.SecurityAdvisory.CVEID != "" {
+		message += fmt.Sprintf("\nCVE: %s", a.SecurityAdvisory.CVEID)
+	}
+	message += fmt.Sprintf("\nAlert: %s", a.HTMLURL)
+	return message
+}
+
+// listDependabotAlerts list all the Dependabot alerts of a repository
+func listDependabotAlerts(ctx context.Context, baseURL, token, owner, project string) ([]dependabotAlert, error) {
+	var alerts []dependabotAlert
+
+	for page := 1; ; page++ {
+		var result []dependabotAlert
+		url := fmt.Sprintf("%s/repos/%s/%s/dependabot/alerts?per_page=%d&page=%d",
+			baseURL, owner, project, dependabotPageSize, page)
+		err := restRequest(ctx, token, restAccept, http.MethodGet, url, nil, &result)
+		if err != nil {
+			return nil, err
+		}
+
+		alerts = append(alerts, result...)
+
+		if len(result) < dependabotPageSize {
+			break
+		}
+	}
+
+	return alerts, nil
+}
+
+// importDependabotAlerts create a bug for each open or automatically
+// dismissed Dependabot alert, and keep the status of the bugs in sync with
+// the alerts.
+func (gi *githubImporter) importDependabotAlerts(ctx context.Context, repo *cache.RepoCache) error {
+	alerts, err := listDependabotAlerts(ctx, baseURLOf(gi.conf), gi.token.Value, gi.conf[keyOwner], gi.conf[keyProject])
+	if err != nil {
+		return err
+	}
+
+	for _, alert := range alerts {
+		if err := gi.ensureDependabotAlert(repo, alert); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ensureDependabotAlert create the bug tracking an alert if needed, and
+// close or reopen it to match the state of the alert
+func (gi *githubImporter) ensureDependabotAlert(repo *cache.RepoCache, alert dependabotAlert) error {
+	closedAt, closed := alert.closedAt()
+
+	return gi.ensureAlert(repo, trackedAlert{
+		metaKey:   metaKeyDependabotAlert,
+		metaValue: alert.HTMLURL,
+		closed:    closed,
+		closedAt:  closedAt,
+		updatedAt: alert.UpdatedAt,
+		create: func() (*cache.BugCache, error) {
+			// dismissed and fixed alerts are only used to close the existing bugs
+			if alert.State != "open" && alert.State != "auto_dismissed" {
+				return nil, nil
+			}
+			return gi.createDependabotBug(repo, alert)
+		},
+	})
+}
+
+// createDependabotBug create the bug tracking an alert
+func (gi *githubImporter) createDependabotBug(repo *cache.RepoCache, alert dependabotAlert) (*cache.BugCache, error) {
+	// the API doesn't give a human author
+	author, err := gi.getGhost(repo)
+	if err != nil {
+		return nil, err
+	}
+
+	cleanText, err := text.Cleanup(alert.message())
+	if err != nil {
+		return nil, err
+	}
+
+	metadata := map[string]string{
+		core.MetaKeyOrigin:     dependabotOrigin,
+		metaKeyDependabotAlert: alert.HTMLURL,
+	}
+	if alert.SecurityAdvisory.CVEID != "" {
+		metadata[metaKeyDependabotCVE] = alert.SecurityAdvisory.CVEID
+	}
+	if alert.SecurityAdvisory.GHSAID != "" {
+		metadata[metaKeyDependabotGHSA] = alert.SecurityAdvisory.GHSAID
+	}
+
+	b, err := repo.NewBugWithID(dependabotOrigin, alert.HTMLURL, cache.BugCreateArgs{
+		Author:   author,
+		UnixTime: alert.CreatedAt.Unix(),
+		Title:    alert.title(),
+		Message:  cleanText,
+		Metadata: metadata,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	labels := []string{labelSecurity, labelDependabot}
+	if alert.SecurityAdvisory.Severity != "" {
+		labels = append(labels, labelPriorityPrefix+alert.SecurityAdvisory.Severity)
+	}
+
+	_, _, err = b.ChangeLabelsRaw(author, alert.CreatedAt.Unix(), labels, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	gi.out <- core.NewImportBug(b.Id())
+	return b, nil
+}
@@ -0,0 +1,60 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDependabotAlert(t *testing.T) {
+	var alert dependabotAlert
+	err := json.Unmarshal([]byte(`{
+		"number": 3,
+		"state": "auto_dismissed",
+		"html_url": "https://github.com/a/b/security/dependabot/3",
+		"auto_dismissed_at": "2020-03-01T10:00:00Z",
+		"dependency": {"package": {"ecosystem": "npm", "name": "lodash"}, "manifest_path": "package-lock.json"},
+		"security_advisory": {"ghsa_id": "GHSA-p6mc-m468-83gw", "cve_id": "CVE-2020-8203", "summary": "Prototype Pollution in lodash", "severity": "high"},
+		"security_vulnerability": {"vulnerable_version_range": "< 4.17.19", "first_patched_version": {"identifier": "4.17.19"}}
+	}`), &alert)
+	require.NoError(t, err)
+
+	require.Equal(t, "Dependabot alert in lodash: Prototype Pollution in lodash", alert.title())
+	require.Contains(t, alert.message(), "Patched version: 4.17.19")
+	require.Contains(t, alert.message(), "CVE: CVE-2020-8203")
+
+	closedAt, closed := alert.closedAt()
+	require.True(t, closed)
+	require.Equal(t, time.Date(2020, 3, 1, 10, 0, 0, 0, time.UTC), closedAt)
+
+	alert.State = "open"
+	_, closed = alert.closedAt()
+	require.False(t, closed)
+}
+
+func TestListDependabotAlerts(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/repos/a/b/dependabot/alerts", func(w http.ResponseWriter, r *http.Request) {
+		var alerts []map[string]interface{}
+		if r.URL.Query().Get("page") == "1" {
+			for i := 0; i < dependabotPageSize; i++ {
+				alerts = append(alerts, map[string]interface{}{"number": i})
+			}
+		} else {
+			alerts = append(alerts, map[string]interface{}{"number": dependabotPageSize, "state": "open"})
+		}
+		_ = json.NewEncoder(w).Encode(alerts)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	alerts, err := listDependabotAlerts(context.Background(), server.URL, "token", "a", "b")
+	require.NoError(t, err)
+	require.Len(t, alerts, dependabotPageSize+1)
+	require.Equal(t, "open", alerts[dependabotPageSize].State)
+}
@@ -0,0 +1,56 @@
+package github
+
+import (
+	"strings"
+)
+
+const (
+	// slug of the enterprise, for the Enterprise Managed Users. Their login
+	// are suffixed with "_<slug>", which is stripped from the identities.
+	keyEMUSlug = "emu-slug"
+	// domain of the corporate emails of the Enterprise Managed Users, used
+	// instead of their Github noreply email
+	keyEmailDomain = "email-domain"
+
+	noreplyEmailDomain = "@users.noreply.github.com"
+)
+
+// emuLogin return the login of an Enterprise Managed User without the
+// enterprise suffix, or false if the login is not the one of a managed user
+// of this enterprise
+func emuLogin(login, slug string) (string, bool) {
+	if slug == "" {
+		return login, false
+	}
+
+	suffix := "_" + slug
+	if !strings.HasSuffix(login, suffix) || len(login) == len(suffix) {
+		return login, false
+	}
+
+	return strings.TrimSuffix(login, suffix), true
+}
+
+// emuEmail return the corporate email of an Enterprise Managed User, in
+// place of an empty or noreply Github email. Other emails are kept as is.
+func emuEmail(login, email, domain string) string {
+	if domain == "" {
+		return email
+	}
+	if email != "" && !strings.HasSuffix(email, noreplyEmailDomain) {
+		return email
+	}
+
+	return login + "@" + strings.TrimPrefix(domain, "@")
+}
+
+// identityLoginAndEmail return the login and email to record for a Github
+// user, taking into account the Enterprise Managed Users settings
+func (gi *githubImporter) identityLoginAndEmail(login, email string) (string, string) {
+	stripped, ok := emuLogin(login, gi.conf[keyEMUSlug])
+	if !ok {
+		return login, email
+	}
+
+	return stripped, emuEmail(stripped, email, gi.conf[keyEmailDomain])
+}
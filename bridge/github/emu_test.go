@@ -0,0 +1,49 @@
+package github
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/bridge/core"
+)
+
+func TestEMULogin(t *testing.T) {
+	login, ok := emuLogin("alice_corp", "corp")
+	require.True(t, ok)
+	require.Equal(t, "alice", login)
+
+	login, ok = emuLogin("alice", "corp")
+	require.False(t, ok)
+	require.Equal(t, "alice", login)
+
+	login, ok = emuLogin("_corp", "corp")
+	require.False(t, ok)
+	require.Equal(t, "_corp", login)
+
+	_, ok = emuLogin("alice_corp", "")
+	require.False(t, ok)
+}
+
+func TestEMUEmail(t *testing.T) {
+	require.Equal(t, "alice@corp.com", emuEmail("alice", "123+alice_corp@users.noreply.github.com", "corp.com"))
+	require.Equal(t, "alice@corp.com", emuEmail("alice", "", "@corp.com"))
+	require.Equal(t, "alice@other.com", emuEmail("alice", "alice@other.com", "corp.com"))
+	require.Equal(t, "", emuEmail("alice", "", ""))
+}
+
+func TestIdentityLoginAndEmail(t *testing.T) {
+	gi := &githubImporter{conf: core.Configuration{
+		keyEMUSlug:     "corp",
+		keyEmailDomain: "corp.com",
+	}}
+
+	login, email := gi.identityLoginAndEmail("alice_corp", "")
+	require.Equal(t, "alice", login)
+	require.Equal(t, "alice@corp.com", email)
+
+	// not a managed user of this enterprise
+	login, email = gi.identityLoginAndEmail("bob", "")
+	require.Equal(t, "bob", login)
+	require.Equal(t, "", email)
+}
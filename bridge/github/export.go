@@ -49,6 +49,17 @@ type githubExporter struct {
 
 	// cache labels used to speed up exporting labels events
 	cachedLabels map[string]string
+
+	// the project board whose cards are moved on column label changes, if configured
+	board *projectBoard
+
+	// the items of the project whose Status field follow the status of the
+	// bugs, indexed by issueKey, if configured
+	projectItems map[string]projectItem
+
+	// rate limit state of each client
+	rateLimitThreshold int
+	limiters           []*rateLimiter
 }
 
 // Init .
@@ -58,6 +69,12 @@ func (ge *githubExporter) Init(repo *cache.RepoCache, conf core.Configuration) e
 	ge.cachedOperationIDs = make(map[entity.Id]string)
 	ge.cachedLabels = make(map[string]string)
 
+	threshold, err := rateLimitThreshold(conf)
+	if err != nil {
+		return err
+	}
+	ge.rateLimitThreshold = threshold
+
 	user, err := repo.GetUserIdentity()
 	if err != nil {
 		return err
@@ -88,6 +105,15 @@ func (ge *githubExporter) Init(repo *cache.RepoCache, conf core.Configuration) e
 	return nil
 }
 
+// Stats return the statistics of the exports done so far
+func (ge *githubExporter) Stats() core.SyncStats {
+	var stats core.SyncStats
+	for _, limiter := range ge.limiters {
+		stats = stats.Add(limiter.Stats())
+	}
+	return stats
+}
+
 func (ge *githubExporter) cacheAllClient(repo repository.RepoConfig) error {
 	creds, err := auth.List(repo, auth.WithTarget(target), auth.WithKind(auth.KindToken))
 	if err != nil {
@@ -96,7 +122,9 @@ func (ge *githubExporter) cacheAllClient(repo repository.RepoConfig) error {
 
 	for _, cred := range creds {
 		if _, ok := ge.identityClient[cred.UserId()]; !ok {
-			client := buildClient(creds[0].(*auth.Token))
+			limiter := newRateLimiter(ge.rateLimitThreshold)
+			ge.limiters = append(ge.limiters, limiter)
+			client := buildClient(baseURLOf(ge.conf), creds[0].(*auth.Token), limiter, core.Timeout(ge.conf))
 			ge.identityClient[cred.UserId()] = client
 		}
 	}
@@ -122,6 +150,7 @@ func (ge *githubExporter) ExportAll(ctx context.Context, repo *cache.RepoCache,
 	// get repository node id
 	ge.repositoryID, err = getRepositoryNodeID(
 		ctx,
+		baseURLOf(ge.conf),
 		ge.defaultToken,
 		ge.conf[keyOwner],
 		ge.conf[keyProject],
@@ -136,6 +165,20 @@ func (ge *githubExporter) ExportAll(ctx context.Context, repo *cache.RepoCache,
 		return nil, err
 	}
 
+	if projectID := ge.conf[keyProjectID]; projectID != "" {
+		ge.board, err = fetchProjectBoard(ctx, baseURLOf(ge.conf), ge.defaultToken.Value, projectID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if projectID := ge.conf[keyProjectV2ID]; projectID != "" {
+		ge.projectItems, err = fetchProjectItems(ctx, ge.defaultClient, projectID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	go func() {
 		defer close(out)
 
@@ -144,12 +187,12 @@ func (ge *githubExporter) ExportAll(ctx context.Context, repo *cache.RepoCache,
 			allIdentitiesIds = append(allIdentitiesIds, id)
 		}
 
-		allBugsIds := repo.AllBugsIds()
+		it := repo.QueryBugsIter(core.ExportQuery(ge.conf))
 
-		for _, id := range allBugsIds {
-			b, err := repo.ResolveBug(id)
-			if err != nil {
-				out <- core.NewExportError(errors.Wrap(err, "can't load bug"), id)
+		for it.Next() {
+			b := it.Value()
+			if b == nil {
+				out <- core.NewExportError(it.Err(), it.Excerpt().Id)
 				return
 			}
 
@@ -171,7 +214,7 @@ func (ge *githubExporter) ExportAll(ctx context.Context, repo *cache.RepoCache,
 
 				if snapshot.HasAnyActor(allIdentitiesIds...) {
 					// try to export the bug and it associated events
-					ge.exportBug(ctx, b, since, out)
+					ge.exportBug(ctx, repo, b, since, out)
 				}
 			}
 		}
@@ -181,7 +224,7 @@ func (ge *githubExporter) ExportAll(ctx context.Context, repo *cache.RepoCache,
 }
 
 // exportBug publish bugs and related events
-func (ge *githubExporter) exportBug(ctx context.Context, b *cache.BugCache, since time.Time, out chan<- core.ExportResult) {
+func (ge *githubExporter) exportBug(ctx context.Context, repo *cache.RepoCache, b *cache.BugCache, since time.Time, out chan<- core.ExportResult) {
 	snapshot := b.Snapshot()
 	var bugUpdated bool
 
@@ -196,6 +239,12 @@ func (ge *githubExporter) exportBug(ctx context.Context, b *cache.BugCache, sinc
 	createOp := snapshot.Operations[0].(*bug.CreateOperation)
 	author := snapshot.Author
 
+	// skip bug imported from another bug tracker
+	if source := snapshot.Source(); source != nil && source.Target != target {
+		out <- core.NewExportNothing(b.Id(), fmt.Sprintf("issue imported from: %s", source.Target))
+		return
+	}
+
 	// skip bug if origin is not allowed
 	origin, ok := snapshot.GetCreateMetadata(core.MetaKeyOrigin)
 	if ok && origin != target {
@@ -214,7 +263,7 @@ func (ge *githubExporter) exportBug(ctx context.Context, b *cache.BugCache, sinc
 		}
 
 		// extract owner and project
-		owner, project, err := splitURL(githubURL)
+		_, owner, project, err := parseProjectURL(githubURL, baseURLOf(ge.conf))
 		if err != nil {
 			err := fmt.Errorf("bad project url: %v", err)
 			out <- core.NewExportError(err, b.Id())
@@ -250,6 +299,13 @@ func (ge *githubExporter) exportBug(ctx context.Context, b *cache.BugCache, sinc
 
 		out <- core.NewExportBug(b.Id())
 
+		if raw := ge.conf[keyIssueTypes]; raw != "" {
+			if err := exportIssueType(ctx, client, snapshot, id, raw); err != nil {
+				err := errors.Wrap(err, "setting issue type")
+				out <- core.NewExportError(err, b.Id())
+			}
+		}
+
 		// mark bug creation operation as exported
 		if err := markOperationAsExported(b, createOp.Id(), id, url); err != nil {
 			err := errors.Wrap(err, "marking operation as exported")
@@ -273,8 +329,24 @@ func (ge *githubExporter) exportBug(ctx context.Context, b *cache.BugCache, sinc
 	ge.cachedOperationIDs[createOp.Id()] = bugGithubID
 
 	for _, op := range snapshot.Operations[1:] {
-		// ignore SetMetadata operations
-		if _, ok := op.(*bug.SetMetadataOperation); ok {
+		// ignore SetMetadata and StripMetadata operations
+		switch op.(type) {
+		case *bug.SetMetadataOperation, *bug.StripMetadataOperation, *bug.EditAuthorOperation:
+			continue
+		}
+
+		// Github issues don't have a due date
+		if _, ok := op.(*bug.SetDueDateOperation); ok {
+			continue
+		}
+
+		// the commits referencing the bug are already known to Github
+		if _, ok := op.(*bug.CrossRefOperation); ok {
+			continue
+		}
+
+		// NoOp only carry metadata for other bridges
+		if _, ok := op.(*bug.NoOpOperation); ok {
 			continue
 		}
 
@@ -352,11 +424,29 @@ func (ge *githubExporter) exportBug(ctx context.Context, b *cache.BugCache, sinc
 				return
 			}
 
+			if ge.projectItems != nil {
+				if err := ge.updateProjectStatus(ctx, client, bugGithubURL, op.Status); err != nil {
+					err := errors.Wrap(err, "editing project status")
+					out <- core.NewExportError(err, b.Id())
+					return
+				}
+			}
+
 			out <- core.NewExportStatusChange(op.Id())
 
 			id = bugGithubID
 			url = bugGithubURL
 
+		case *bug.PinOperation:
+			if err := updateGithubIssuePinned(ctx, client, bugGithubID, op.Pinned); err != nil {
+				err := errors.Wrap(err, "updating pinned state")
+				out <- core.NewExportError(err, b.Id())
+				return
+			}
+
+			id = bugGithubID
+			url = bugGithubURL
+
 		case *bug.SetTitleOperation:
 			if err := updateGithubIssueTitle(ctx, client, bugGithubID, op.Title); err != nil {
 				err := errors.Wrap(err, "editing title")
@@ -369,18 +459,66 @@ func (ge *githubExporter) exportBug(ctx context.Context, b *cache.BugCache, sinc
 			id = bugGithubID
 			url = bugGithubURL
 
-		case *bug.LabelChangeOperation:
-			if err := ge.updateGithubIssueLabels(ctx, client, bugGithubID, op.Added, op.Removed); err != nil {
-				err := errors.Wrap(err, "updating labels")
+		case *bug.LabelChangeOperation, *bug.SetLabelsOperation:
+			// the label changes of a whole set of labels are the ones computed
+			// for its timeline item
+			item, err := snapshot.SearchTimelineItem(op.Id())
+			if err != nil {
 				out <- core.NewExportError(err, b.Id())
 				return
 			}
+			labelChange := item.(*bug.LabelChangeTimelineItem)
+
+			added, removed, column := ge.splitColumnLabels(labelChange.Added, labelChange.Removed)
+
+			if len(added) > 0 || len(removed) > 0 {
+				if err := ge.updateGithubIssueLabels(ctx, client, bugGithubID, added, removed); err != nil {
+					err := errors.Wrap(err, "updating labels")
+					out <- core.NewExportError(err, b.Id())
+					return
+				}
+			}
+
+			if column != nil {
+				if err := ge.moveBoardCard(ctx, bugGithubURL, *column); err != nil {
+					err := errors.Wrap(err, "moving project card")
+					out <- core.NewExportError(err, b.Id())
+					return
+				}
+			}
 
 			out <- core.NewExportLabelChange(op.Id())
 
 			id = bugGithubID
 			url = bugGithubURL
 
+		case *bug.LinkOperation:
+			// the relationship is created from the child side only
+			if op.Direction != bug.ChildOf {
+				continue
+			}
+
+			parentGithubID, err := githubIDOf(repo, op.Target)
+			if err != nil {
+				err := errors.Wrap(err, "resolving parent issue")
+				out <- core.NewExportError(err, b.Id())
+				return
+			}
+
+			// the parent is not on Github (yet), nothing we can do
+			if parentGithubID == "" {
+				continue
+			}
+
+			if err := addGithubSubIssue(ctx, client, parentGithubID, bugGithubID); err != nil {
+				err := errors.Wrap(err, "adding sub-issue")
+				out <- core.NewExportError(err, b.Id())
+				return
+			}
+
+			id = bugGithubID
+			url = bugGithubURL
+
 		default:
 			panic("unhandled operation type case")
 		}
@@ -407,10 +545,26 @@ func (ge *githubExporter) exportBug(ctx context.Context, b *cache.BugCache, sinc
 	}
 }
 
+// githubIDOf return the Github node id of an exported or imported bug, or
+// an empty string if the bug is not on Github
+func githubIDOf(repo *cache.RepoCache, id entity.Id) (string, error) {
+	if !repo.BugExists(id) {
+		return "", nil
+	}
+
+	b, err := repo.ResolveBug(id)
+	if err != nil {
+		return "", err
+	}
+
+	githubID, _ := b.Snapshot().GetCreateMetadata(metaKeyGithubId)
+	return githubID, nil
+}
+
 // getRepositoryNodeID request github api v3 to get repository node id
-func getRepositoryNodeID(ctx context.Context, token *auth.Token, owner, project string) (string, error) {
-	url := fmt.Sprintf("%s/repos/%s/%s", githubV3Url, owner, project)
-	client := &http.Client{}
+func getRepositoryNodeID(ctx context.Context, baseURL string, token *auth.Token, owner, project string) (string, error) {
+	url := fmt.Sprintf("%s/repos/%s/%s", baseURL, owner, project)
+	client := core.NewHTTPClient(0)
 
 	req, err := http.NewRequest("GET", url, nil)
 	if err != nil {
@@ -509,8 +663,8 @@ func (ge *githubExporter) getLabelID(gc *githubv4.Client, label string) (string,
 // NOTE: since createLabel mutation is still in preview mode we use github api v3 to create labels
 // see https://developer.github.com/v4/mutation/createlabel/ and https://developer.github.com/v4/previews/#labels-preview
 func (ge *githubExporter) createGithubLabel(ctx context.Context, label, color string) (string, error) {
-	url := fmt.Sprintf("%s/repos/%s/%s/labels", githubV3Url, ge.conf[keyOwner], ge.conf[keyProject])
-	client := &http.Client{}
+	url := fmt.Sprintf("%s/repos/%s/%s/labels", baseURLOf(ge.conf), ge.conf[keyOwner], ge.conf[keyProject])
+	client := core.NewHTTPClient(0)
 
 	params := struct {
 		Name        string `json:"name"`
@@ -752,6 +906,20 @@ func updateGithubIssueTitle(ctx context.Context, gc *githubv4.Client, id, title
 	return nil
 }
 
+// addGithubSubIssue make the issue subIssueID a sub-issue of the issue parentID
+func addGithubSubIssue(ctx context.Context, gc *githubv4.Client, parentID, subIssueID string) error {
+	m := &addSubIssueMutation{}
+	input := AddSubIssueInput{
+		IssueID:    parentID,
+		SubIssueID: subIssueID,
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	return gc.Mutate(ctx, m, input, nil)
+}
+
 // update github issue labels
 func (ge *githubExporter) updateGithubIssueLabels(ctx context.Context, gc *githubv4.Client, labelableID string, added, removed []bug.Label) error {
 	reqCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
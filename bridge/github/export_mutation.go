@@ -1,5 +1,7 @@
 package github
 
+import "github.com/shurcooL/githubv4"
+
 type createIssueMutation struct {
 	CreateIssue struct {
 		Issue struct {
@@ -38,6 +40,24 @@ type updateIssueCommentMutation struct {
 	} `graphql:"updateIssueComment(input:$input)"`
 }
 
+type addSubIssueMutation struct {
+	AddSubIssue struct {
+		Issue struct {
+			ID string `graphql:"id"`
+		}
+	} `graphql:"addSubIssue(input:$input)"`
+}
+
+// AddSubIssueInput is an autogenerated input type of AddSubIssue.
+// It's not part of our vendored githubv4 yet. The type name is used as is
+// in the query, hence the exported name.
+type AddSubIssueInput struct {
+	// The id of the parent issue. (Required.)
+	IssueID githubv4.ID `json:"issueId"`
+	// The id of the sub-issue. (Required.)
+	SubIssueID githubv4.ID `json:"subIssueId"`
+}
+
 type removeLabelsFromLabelableMutation struct {
 	AddLabels struct {
 		Labelable struct {
@@ -3,6 +3,8 @@ package github
 
 import (
 	"context"
+	"net/http"
+	"time"
 
 	"github.com/shurcooL/githubv4"
 	"golang.org/x/oauth2"
@@ -17,6 +19,10 @@ func (*Github) Target() string {
 	return target
 }
 
+func (*Github) Capabilities() core.BridgeCapabilities {
+	return core.CapImport | core.CapExport | core.CapMigration
+}
+
 func (*Github) NewImporter() core.Importer {
 	return &githubImporter{}
 }
@@ -25,11 +31,44 @@ func (*Github) NewExporter() core.Exporter {
 	return &githubExporter{}
 }
 
-func buildClient(token *auth.Token) *githubv4.Client {
+// buildClient create a GraphQL client for the given API. If limiter is not
+// nil, it is used to respect the rate limit of the API.
+func buildClient(baseURL string, token *auth.Token, limiter *rateLimiter, timeout time.Duration) *githubv4.Client {
 	src := oauth2.StaticTokenSource(
 		&oauth2.Token{AccessToken: token.Value},
 	)
-	httpClient := oauth2.NewClient(context.TODO(), src)
+	// the base client goes through the configured proxy
+	ctx := context.WithValue(context.TODO(), oauth2.HTTPClient, core.NewHTTPClient(timeout))
+	httpClient := oauth2.NewClient(ctx, src)
+
+	// opt-in for the preview features we use
+	httpClient.Transport = &featuresTransport{
+		features: "sub_issues",
+		base:     httpClient.Transport,
+	}
+
+	if limiter != nil {
+		httpClient.Transport = &rateLimitTransport{
+			limiter: limiter,
+			base:    httpClient.Transport,
+		}
+	}
+
+	if baseURL == githubV3Url {
+		return githubv4.NewClient(httpClient)
+	}
+	return githubv4.NewEnterpriseClient(graphqlURL(baseURL), httpClient)
+}
+
+// featuresTransport add the header enabling the given GraphQL preview features
+type featuresTransport struct {
+	features string
+	base     http.RoundTripper
+}
 
-	return githubv4.NewClient(httpClient)
+func (t *featuresTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// RoundTrip should not modify the request
+	req2 := req.Clone(req.Context())
+	req2.Header.Set("GraphQL-Features", t.features)
+	return t.base.RoundTrip(req2)
 }
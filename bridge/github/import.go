@@ -20,6 +20,7 @@ const (
 	metaKeyGithubId    = "github-id"
 	metaKeyGithubUrl   = "github-url"
 	metaKeyGithubLogin = "github-login"
+	metaKeyGithubEmail = "github-email"
 )
 
 // githubImporter implement the Importer interface
@@ -29,9 +30,24 @@ type githubImporter struct {
 	// default user client
 	client *githubv4.Client
 
+	// rate limit state of the client
+	limiter *rateLimiter
+
 	// iterator
 	iterator *iterator
 
+	// the project board whose columns are imported as labels, if configured
+	board *projectBoard
+
+	// token of the default user
+	token *auth.Token
+
+	// if not empty, only the issue with this url is imported
+	onlyIssue string
+
+	// if not nil, only the issues matching the filter are imported
+	filter core.ImportFilter
+
 	// send only channel
 	out chan<- core.ImportResult
 }
@@ -61,11 +77,31 @@ func (gi *githubImporter) Init(repo *cache.RepoCache, conf core.Configuration) e
 		return ErrMissingIdentityToken
 	}
 
-	gi.client = buildClient(creds[0].(*auth.Token))
+	return gi.initClient(creds[0].(*auth.Token))
+}
+
+// initClient build the client of the importer, authenticated with the token
+func (gi *githubImporter) initClient(token *auth.Token) error {
+	threshold, err := rateLimitThreshold(gi.conf)
+	if err != nil {
+		return err
+	}
+
+	gi.limiter = newRateLimiter(threshold)
+	gi.token = token
+	gi.client = buildClient(baseURLOf(gi.conf), gi.token, gi.limiter, core.Timeout(gi.conf))
 
 	return nil
 }
 
+// Stats return the statistics of the imports done so far
+func (gi *githubImporter) Stats() core.SyncStats {
+	if gi.limiter == nil {
+		return core.SyncStats{}
+	}
+	return gi.limiter.Stats()
+}
+
 // ImportAll iterate over all the configured repository issues and ensure the creation of the
 // missing issues / timeline items / edits / label events ...
 func (gi *githubImporter) ImportAll(ctx context.Context, repo *cache.RepoCache, since time.Time) (<-chan core.ImportResult, error) {
@@ -76,9 +112,47 @@ func (gi *githubImporter) ImportAll(ctx context.Context, repo *cache.RepoCache,
 	go func() {
 		defer close(gi.out)
 
+		if projectID := gi.conf[keyProjectID]; projectID != "" {
+			board, err := fetchProjectBoard(ctx, baseURLOf(gi.conf), gi.token.Value, projectID)
+			if err != nil {
+				err = fmt.Errorf("project board: %v", err)
+				out <- core.NewImportError(err, "")
+				return
+			}
+			gi.board = board
+		}
+
+		if core.UseMigrationAPI(gi.conf) && gi.onlyIssue == "" {
+			exportedAt, err := gi.importMigration(ctx, repo)
+			if err != nil {
+				// the regular import still import everything
+				reason := fmt.Sprintf("migration API failed, falling back to the regular import: %v", err)
+				out <- core.NewImportNothing("", reason)
+			} else if exportedAt.After(since) {
+				// only the issues updated during or after the export are left
+				gi.iterator = NewIterator(ctx, gi.client, 10, gi.conf[keyOwner], gi.conf[keyProject], exportedAt)
+			}
+		}
+
+		// the total is only known once the first issue is queried, and is
+		// meaningless when importing a single issue
+		totalSent := gi.onlyIssue != ""
+
 		// Loop over all matching issues
 		for gi.iterator.NextIssue() {
+			if !totalSent {
+				out <- core.NewImportTotal(gi.iterator.TotalIssues())
+				totalSent = true
+			}
+
 			issue := gi.iterator.IssueValue()
+			if gi.onlyIssue != "" && issue.Url.String() != gi.onlyIssue {
+				continue
+			}
+			if !gi.shouldImport(issue) {
+				continue
+			}
+
 			// create issue
 			b, err := gi.ensureIssue(repo, issue)
 			if err != nil {
@@ -98,9 +172,25 @@ func (gi *githubImporter) ImportAll(ctx context.Context, repo *cache.RepoCache,
 				}
 			}
 
+			err = gi.ensureSubIssues(repo, b, issue)
+			if err != nil {
+				err = fmt.Errorf("sub-issue link creation: %v", err)
+				out <- core.NewImportError(err, "")
+				return
+			}
+
+			if gi.board != nil {
+				err = gi.ensureBoardColumn(repo, b, issue)
+				if err != nil {
+					err = fmt.Errorf("project board column: %v", err)
+					out <- core.NewImportError(err, "")
+					return
+				}
+			}
+
 			if !b.NeedCommit() {
 				out <- core.NewImportNothing(b.Id(), "no imported operation")
-			} else if err := b.Commit(); err != nil {
+			} else if err := b.CommitOverrideSizeLimit(); err != nil {
 				// commit bug state
 				err = fmt.Errorf("bug commit: %v", err)
 				out <- core.NewImportError(err, "")
@@ -110,6 +200,60 @@ func (gi *githubImporter) ImportAll(ctx context.Context, repo *cache.RepoCache,
 
 		if err := gi.iterator.Error(); err != nil {
 			gi.out <- core.NewImportError(err, "")
+			return
+		}
+
+		// the issue type is not part of the main query, the feature not being
+		// available on all Github instances
+		if gi.conf[keyIssueTypes] != "" {
+			if err := gi.ensureIssueTypes(ctx, repo, since); err != nil {
+				err = fmt.Errorf("issue types: %v", err)
+				out <- core.NewImportError(err, "")
+			}
+		}
+
+		if gi.conf[keyProjectV2ID] != "" {
+			if err := gi.ensureProjectStatuses(ctx, repo); err != nil {
+				err = fmt.Errorf("project status: %v", err)
+				out <- core.NewImportError(err, "")
+			}
+		}
+
+		// pinning an issue doesn't change its update time, so this is
+		// checked outside of the iteration over the updated issues
+		if gi.onlyIssue == "" {
+			if err := gi.ensurePinnedIssues(ctx, repo); err != nil {
+				err = fmt.Errorf("pinned issues: %v", err)
+				out <- core.NewImportError(err, "")
+			}
+		}
+
+		if gi.conf[keyImportWorkflowFailures] == "true" {
+			if err := gi.importWorkflowFailures(ctx, repo, since); err != nil {
+				err = fmt.Errorf("workflow failures: %v", err)
+				out <- core.NewImportError(err, "")
+			}
+		}
+
+		if gi.conf[keyImportCodeScanning] == "true" {
+			if err := gi.importCodeScanningAlerts(ctx, repo); err != nil {
+				err = fmt.Errorf("code scanning alerts: %v", err)
+				out <- core.NewImportError(err, "")
+			}
+		}
+
+		if gi.conf[keyImportDependabot] == "true" {
+			if err := gi.importDependabotAlerts(ctx, repo); err != nil {
+				err = fmt.Errorf("dependabot alerts: %v", err)
+				out <- core.NewImportError(err, "")
+			}
+		}
+
+		if gi.conf[keyImportSecretScanning] == "true" {
+			if err := gi.importSecretScanningAlerts(ctx, repo); err != nil {
+				err = fmt.Errorf("secret scanning alerts: %v", err)
+				out <- core.NewImportError(err, "")
+			}
 		}
 	}()
 
@@ -123,8 +267,14 @@ func (gi *githubImporter) ensureIssue(repo *cache.RepoCache, issue issueTimeline
 		return nil, err
 	}
 
-	// resolve bug
-	b, err := repo.ResolveBugCreateMetadata(metaKeyGithubUrl, issue.Url.String())
+	// resolve bug, the issues imported before the deterministic ids being
+	// found with their metadata
+	var b *cache.BugCache
+	if repo.BugExists(issueBugId(issue)) {
+		b, err = repo.ResolveBug(issueBugId(issue))
+	} else {
+		b, err = repo.ResolveBugCreateMetadata(metaKeyGithubUrl, issue.Url.String())
+	}
 	if err != nil && err != bug.ErrBugNotExist {
 		return nil, err
 	}
@@ -144,17 +294,18 @@ func (gi *githubImporter) ensureIssue(repo *cache.RepoCache, issue issueTimeline
 			}
 
 			// create bug
-			b, _, err = repo.NewBugRaw(
-				author,
-				issue.CreatedAt.Unix(),
-				issue.Title,
-				cleanText,
-				nil,
-				map[string]string{
+			b, err = repo.NewBugWithID(target, issue.Url.String(), cache.BugCreateArgs{
+				Author:   author,
+				UnixTime: issue.CreatedAt.Unix(),
+				Title:    issue.Title,
+				Message:  cleanText,
+				Source:   issueSource(issue),
+				Metadata: map[string]string{
 					core.MetaKeyOrigin: target,
 					metaKeyGithubId:    parseId(issue.Id),
 					metaKeyGithubUrl:   issue.Url.String(),
-				})
+				},
+			})
 			if err != nil {
 				return nil, err
 			}
@@ -178,18 +329,18 @@ func (gi *githubImporter) ensureIssue(repo *cache.RepoCache, issue issueTimeline
 			// if the bug doesn't exist
 			if b == nil {
 				// we create the bug as soon as we have a legit first edition
-				b, _, err = repo.NewBugRaw(
-					author,
-					issue.CreatedAt.Unix(),
-					issue.Title,
-					cleanText,
-					nil,
-					map[string]string{
+				b, err = repo.NewBugWithID(target, issue.Url.String(), cache.BugCreateArgs{
+					Author:   author,
+					UnixTime: issue.CreatedAt.Unix(),
+					Title:    issue.Title,
+					Message:  cleanText,
+					Source:   issueSource(issue),
+					Metadata: map[string]string{
 						core.MetaKeyOrigin: target,
 						metaKeyGithubId:    parseId(issue.Id),
 						metaKeyGithubUrl:   issue.Url.String(),
 					},
-				)
+				})
 
 				if err != nil {
 					return nil, err
@@ -212,6 +363,16 @@ func (gi *githubImporter) ensureIssue(repo *cache.RepoCache, issue issueTimeline
 		}
 	}
 
+	// the fields of an issue form, as in the current body
+	unixTime := issue.CreatedAt.Unix()
+	if len(issueEdits) > 0 {
+		unixTime = issueEdits[len(issueEdits)-1].CreatedAt.Unix()
+	}
+	err = gi.ensureFormFields(b, author, unixTime, string(issue.Body))
+	if err != nil {
+		return nil, err
+	}
+
 	return b, nil
 }
 
@@ -511,6 +672,72 @@ func (gi *githubImporter) ensureCommentEdit(repo *cache.RepoCache, b *cache.BugC
 	return nil
 }
 
+// ensureSubIssues link the bug with its parent issue and its sub-issues, if
+// they have already been imported. As the link is made on both sides, the
+// relationship is complete whichever issue is imported last. The other bugs
+// are committed right away.
+func (gi *githubImporter) ensureSubIssues(repo *cache.RepoCache, b *cache.BugCache, issue issueTimeline) error {
+	author, err := gi.ensurePerson(repo, issue.Author)
+	if err != nil {
+		return err
+	}
+
+	unixTime := issue.CreatedAt.Unix()
+
+	if issue.Parent != nil {
+		parent, err := repo.ResolveBugCreateMetadata(metaKeyGithubId, parseId(issue.Parent.Id))
+		if err == nil {
+			err = gi.ensureLink(parent, b, parseId(issue.Id), author, unixTime)
+		}
+		if err == nil {
+			err = parent.CommitAsNeeded()
+		}
+		if err != nil && err != bug.ErrBugNotExist {
+			return err
+		}
+	}
+
+	for _, subIssue := range issue.SubIssues.Nodes {
+		child, err := repo.ResolveBugCreateMetadata(metaKeyGithubId, parseId(subIssue.Id))
+		if err == nil {
+			err = gi.ensureLink(b, child, parseId(subIssue.Id), author, unixTime)
+		}
+		if err == nil {
+			err = child.CommitAsNeeded()
+		}
+		if err != nil && err != bug.ErrBugNotExist {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ensureLink create the missing link operations between a parent and a child
+// bug. As the relationship has no id of its own on Github, the operations are
+// tagged with the id of the child issue, so that they are not exported back.
+func (gi *githubImporter) ensureLink(parent, child *cache.BugCache, childGithubId string, author *cache.IdentityCache, unixTime int64) error {
+	metadata := map[string]string{
+		metaKeyGithubId: childGithubId,
+	}
+
+	if !parent.Snapshot().HasLink(bug.ParentOf, child.Id()) {
+		_, err := parent.AddLinkRaw(author, unixTime, bug.ParentOf, child.Id(), metadata)
+		if err != nil {
+			return err
+		}
+	}
+
+	if !child.Snapshot().HasLink(bug.ChildOf, parent.Id()) {
+		_, err := child.AddLinkRaw(author, unixTime, bug.ChildOf, parent.Id(), metadata)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // ensurePerson create a bug.Person from the Github data
 func (gi *githubImporter) ensurePerson(repo *cache.RepoCache, actor *actor) (*cache.IdentityCache, error) {
 	// When a user has been deleted, Github return a null actor, while displaying a profile named "ghost"
@@ -549,14 +776,22 @@ func (gi *githubImporter) ensurePerson(repo *cache.RepoCache, actor *actor) (*ca
 	case "Bot":
 	}
 
+	metadata := map[string]string{
+		// the metadata keep the real login, to match the actor on the next import
+		metaKeyGithubLogin: string(actor.Login),
+	}
+	if email != "" {
+		metadata[metaKeyGithubEmail] = email
+	}
+
+	login, email := gi.identityLoginAndEmail(string(actor.Login), email)
+
 	i, err = repo.NewIdentityRaw(
 		name,
 		email,
-		string(actor.Login),
+		login,
 		string(actor.AvatarUrl),
-		map[string]string{
-			metaKeyGithubLogin: string(actor.Login),
-		},
+		metadata,
 	)
 
 	if err != nil {
@@ -607,6 +842,21 @@ func (gi *githubImporter) getGhost(repo *cache.RepoCache) (*cache.IdentityCache,
 	)
 }
 
+// issueSource describe the Github issue as the source of an imported bug
+func issueSource(issue issueTimeline) *bug.BugSource {
+	return &bug.BugSource{
+		Target:    target,
+		RemoteID:  parseId(issue.Id),
+		RemoteURL: issue.Url.String(),
+	}
+}
+
+// issueBugId return the deterministic id of the bug imported from a Github
+// issue
+func issueBugId(issue issueTimeline) entity.Id {
+	return entity.NewDeterministicId(target, issue.Url.String())
+}
+
 // parseId convert the unusable githubv4.ID (an interface{}) into a string
 func parseId(id githubv4.ID) string {
 	return fmt.Sprintf("%v", id)
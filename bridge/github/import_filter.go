@@ -0,0 +1,62 @@
+package github
+
+import (
+	"time"
+
+	"github.com/MichaelMure/git-bug/bridge/core"
+)
+
+var _ core.FilteredImporter = &githubImporter{}
+
+// SetImportFilter restrict the import to the issues matching the filter
+func (gi *githubImporter) SetImportFilter(filter core.ImportFilter) {
+	gi.filter = filter
+}
+
+// shouldImport return true if the issue match the import filter, if any
+func (gi *githubImporter) shouldImport(issue issueTimeline) bool {
+	return gi.filter == nil || gi.filter.ShouldImport(filterIssue{issue})
+}
+
+// filterIssue expose a Github issue to the import filters. The custom fields
+// are the fields of the issues created with an issue form, by title.
+type filterIssue struct {
+	issue issueTimeline
+}
+
+func (i filterIssue) Raw() interface{} {
+	return i.issue
+}
+
+func (i filterIssue) IssueLabels() []string {
+	var labels []string
+	for _, label := range i.issue.Labels.Nodes {
+		labels = append(labels, string(label.Name))
+	}
+	return labels
+}
+
+func (i filterIssue) IssueAssignees() []string {
+	var logins []string
+	for _, assignee := range i.issue.Assignees.Nodes {
+		logins = append(logins, string(assignee.Login))
+	}
+	return logins
+}
+
+func (i filterIssue) IssueCreatedAt() time.Time {
+	return i.issue.CreatedAt.Time
+}
+
+func (i filterIssue) IssueCustomField(name string) (string, bool) {
+	fields, ok := parseIssueForm(string(i.issue.Body))
+	if !ok {
+		return "", false
+	}
+	for _, field := range fields {
+		if field.title == name {
+			return field.value, true
+		}
+	}
+	return "", false
+}
@@ -102,6 +102,18 @@ type issueTimeline struct {
 	Body  githubv4.String
 	Url   githubv4.URI
 
+	// for the import filters
+	Labels struct {
+		Nodes []struct {
+			Name githubv4.String
+		}
+	} `graphql:"labels(first: 100)"`
+	Assignees struct {
+		Nodes []struct {
+			Login githubv4.String
+		}
+	} `graphql:"assignees(first: 100)"`
+
 	TimelineItems struct {
 		Edges []struct {
 			Cursor githubv4.String
@@ -114,6 +126,16 @@ type issueTimeline struct {
 		Nodes    []userContentEdit
 		PageInfo pageInfo
 	} `graphql:"userContentEdits(last: $issueEditLast, before: $issueEditBefore)"`
+
+	// sub-issues relationships, see https://docs.github.com/en/issues/tracking-your-work-with-issues/using-issues/adding-sub-issues
+	Parent *struct {
+		Id githubv4.ID
+	}
+	SubIssues struct {
+		Nodes []struct {
+			Id githubv4.ID
+		}
+	} `graphql:"subIssues(first: 100)"`
 }
 
 type issueEdit struct {
@@ -126,8 +148,9 @@ type issueEdit struct {
 type issueTimelineQuery struct {
 	Repository struct {
 		Issues struct {
-			Nodes    []issueTimeline
-			PageInfo pageInfo
+			Nodes      []issueTimeline
+			PageInfo   pageInfo
+			TotalCount githubv4.Int
 		} `graphql:"issues(first: $issueFirst, after: $issueAfter, orderBy: {field: CREATED_AT, direction: ASC}, filterBy: {since: $issueSince})"`
 	} `graphql:"repository(owner: $owner, name: $name)"`
 }
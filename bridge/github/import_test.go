@@ -212,3 +212,35 @@ func Test_Importer(t *testing.T) {
 		})
 	}
 }
+
+func TestEnsureLink(t *testing.T) {
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	backend, err := cache.NewRepoCache(repo)
+	require.NoError(t, err)
+	defer backend.Close()
+
+	author, err := backend.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+
+	parent, _, err := backend.NewBugRaw(author, time.Now().Unix(), "parent", "message", nil, nil)
+	require.NoError(t, err)
+	child, _, err := backend.NewBugRaw(author, time.Now().Unix(), "child", "message", nil, nil)
+	require.NoError(t, err)
+
+	gi := &githubImporter{}
+	require.NoError(t, gi.ensureLink(parent, child, "CHILD_ID", author, time.Now().Unix()))
+	// already linked
+	require.NoError(t, gi.ensureLink(parent, child, "CHILD_ID", author, time.Now().Unix()))
+
+	// the links are known to Github, they must not be exported back
+	for _, b := range []*cache.BugCache{parent, child} {
+		ops := b.Snapshot().Operations
+		require.Len(t, ops, 2)
+		require.IsType(t, &bug.LinkOperation{}, ops[1])
+		value, ok := ops[1].GetMetadata(metaKeyGithubId)
+		require.True(t, ok)
+		require.Equal(t, "CHILD_ID", value)
+	}
+}
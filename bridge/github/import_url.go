@@ -0,0 +1,92 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/MichaelMure/git-bug/bridge/core"
+	"github.com/MichaelMure/git-bug/bridge/core/auth"
+	"github.com/MichaelMure/git-bug/cache"
+)
+
+// parseIssueURL split the URL of a Github issue, like
+// https://github.com/<owner>/<project>/issues/<number>
+func parseIssueURL(rawURL string) (owner string, project string, number int, err error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", "", 0, err
+	}
+
+	parts := strings.Split(strings.Trim(u.Path, "/"), "/")
+	if u.Host != "github.com" || len(parts) != 4 || parts[2] != "issues" {
+		return "", "", 0, fmt.Errorf("invalid Github issue url %s", rawURL)
+	}
+
+	number, err = strconv.Atoi(parts[3])
+	if err != nil {
+		return "", "", 0, fmt.Errorf("invalid Github issue url %s", rawURL)
+	}
+
+	return parts[0], parts[1], number, nil
+}
+
+// ImportBugFromURL import a single issue from its URL, without a configured
+// bridge. The bug is updated if it was already imported.
+func (*Github) ImportBugFromURL(ctx context.Context, repo *cache.RepoCache, rawURL string, cred auth.Credential) (*cache.BugCache, error) {
+	token, ok := cred.(*auth.Token)
+	if !ok {
+		return nil, fmt.Errorf("a token is required to import from Github")
+	}
+
+	owner, project, number, err := parseIssueURL(rawURL)
+	if err != nil {
+		return nil, err
+	}
+
+	var issue struct {
+		HTMLURL     string    `json:"html_url"`
+		UpdatedAt   time.Time `json:"updated_at"`
+		PullRequest *struct{} `json:"pull_request"`
+	}
+	u := fmt.Sprintf("%s/repos/%s/%s/issues/%d", githubV3Url, owner, project, number)
+	err = restRequest(ctx, token.Value, restAccept, http.MethodGet, u, nil, &issue)
+	if err != nil {
+		return nil, err
+	}
+	if issue.PullRequest != nil {
+		return nil, fmt.Errorf("%s is a pull request, not an issue", rawURL)
+	}
+
+	gi := &githubImporter{
+		conf: core.Configuration{
+			core.ConfigKeyTarget: target,
+			keyOwner:             owner,
+			keyProject:           project,
+		},
+		onlyIssue: issue.HTMLURL,
+	}
+
+	err = gi.initClient(token)
+	if err != nil {
+		return nil, err
+	}
+
+	// there is no way to query a single issue with the iterator, but only
+	// the issues updated since the last update of this one are listed
+	results, err := gi.ImportAll(ctx, repo, issue.UpdatedAt.Add(-time.Second))
+	if err != nil {
+		return nil, err
+	}
+
+	err = core.WaitImport(results)
+	if err != nil {
+		return nil, err
+	}
+
+	return repo.ResolveBugCreateMetadata(metaKeyGithubUrl, issue.HTMLURL)
+}
@@ -0,0 +1,25 @@
+package github
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseIssueURL(t *testing.T) {
+	owner, project, number, err := parseIssueURL("https://github.com/MichaelMure/git-bug/issues/42")
+	require.NoError(t, err)
+	require.Equal(t, "MichaelMure", owner)
+	require.Equal(t, "git-bug", project)
+	require.Equal(t, 42, number)
+
+	for _, invalid := range []string{
+		"https://github.com/MichaelMure/git-bug",
+		"https://github.com/MichaelMure/git-bug/pull/42",
+		"https://github.com/MichaelMure/git-bug/issues/abc",
+		"https://gitlab.com/MichaelMure/git-bug/issues/42",
+	} {
+		_, _, _, err := parseIssueURL(invalid)
+		require.Error(t, err, invalid)
+	}
+}
@@ -0,0 +1,112 @@
+package github
+
+import (
+	"strings"
+
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/cache"
+)
+
+// MetaKeyFormFieldPrefix is the prefix of the metadata keys holding the
+// fields of an issue created with an issue form, followed by the title of the
+// field. Like the other values changing over time, the fields are recorded on
+// a NoOp operation each time they change, the current value being the last
+// one (see bug.Snapshot.LastMetadata).
+const MetaKeyFormFieldPrefix = "github:form:"
+
+// formNoResponse is what Github render for an optional field left empty
+const formNoResponse = "_No response_"
+
+// formField is a field of an issue form, as rendered in the issue body
+type formField struct {
+	title string
+	value string
+}
+
+// parseIssueForm parse the body of an issue created with an issue form. Each
+// field is rendered as a "### <title>" header followed by the value. The
+// second returned value is false if the body doesn't look like a form output.
+func parseIssueForm(body string) ([]formField, bool) {
+	body = strings.TrimSpace(strings.Replace(body, "\r\n", "\n", -1))
+
+	// the form output always start with the header of the first field
+	if !strings.HasPrefix(body, "### ") {
+		return nil, false
+	}
+
+	var fields []formField
+	var value []string
+	inCode := false
+
+	flush := func() {
+		if len(fields) == 0 {
+			return
+		}
+		v := strings.TrimSpace(strings.Join(value, "\n"))
+		if v == formNoResponse {
+			v = ""
+		}
+		fields[len(fields)-1].value = v
+		value = nil
+	}
+
+	for _, line := range strings.Split(body, "\n") {
+		if strings.HasPrefix(strings.TrimSpace(line), "```") {
+			inCode = !inCode
+		}
+
+		if !inCode && strings.HasPrefix(line, "### ") {
+			flush()
+			fields = append(fields, formField{title: strings.TrimSpace(line[4:])})
+			continue
+		}
+
+		value = append(value, line)
+	}
+	flush()
+
+	return fields, true
+}
+
+// FormFields return the current fields of a bug imported from an issue
+// created with an issue form, indexed by title
+func FormFields(snap *bug.Snapshot) map[string]string {
+	result := make(map[string]string)
+
+	// the last value of each field wins
+	for _, op := range snap.Operations {
+		for key, value := range op.AllMetadata() {
+			if strings.HasPrefix(key, MetaKeyFormFieldPrefix) {
+				result[strings.TrimPrefix(key, MetaKeyFormFieldPrefix)] = value
+			}
+		}
+	}
+
+	return result
+}
+
+// ensureFormFields record the fields of an issue form that changed since the
+// last import
+func (gi *githubImporter) ensureFormFields(b *cache.BugCache, author *cache.IdentityCache, unixTime int64, body string) error {
+	fields, ok := parseIssueForm(body)
+	if !ok {
+		return nil
+	}
+
+	current := FormFields(b.Snapshot())
+
+	metadata := make(map[string]string)
+	for _, field := range fields {
+		if value, ok := current[field.title]; ok && value == field.value {
+			continue
+		}
+		metadata[MetaKeyFormFieldPrefix+field.title] = field.value
+	}
+
+	if len(metadata) == 0 {
+		return nil
+	}
+
+	_, err := b.OpNoOpRaw(author, unixTime, metadata)
+	return err
+}
@@ -0,0 +1,68 @@
+package github
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+const formBody = "### Version\r\n\r\n1.2.3\r\n\r\n" +
+	"### What happened?\r\n\r\nIt crashed:\r\n```\r\n### not a field\r\n```\r\n\r\n" +
+	"### Relevant logs\r\n\r\n_No response_\r\n\r\n" +
+	"### Code of Conduct\r\n\r\n- [X] I agree to follow this project's Code of Conduct\r\n"
+
+func TestParseIssueForm(t *testing.T) {
+	fields, ok := parseIssueForm(formBody)
+	require.True(t, ok)
+	require.Equal(t, []formField{
+		{title: "Version", value: "1.2.3"},
+		{title: "What happened?", value: "It crashed:\n```\n### not a field\n```"},
+		{title: "Relevant logs", value: ""},
+		{title: "Code of Conduct", value: "- [X] I agree to follow this project's Code of Conduct"},
+	}, fields)
+
+	_, ok = parseIssueForm("A regular issue\n\n### with a header")
+	require.False(t, ok)
+}
+
+func TestEnsureFormFields(t *testing.T) {
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	backend, err := cache.NewRepoCache(repo)
+	require.NoError(t, err)
+	defer backend.Close()
+
+	author, err := backend.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+
+	b, _, err := backend.NewBugRaw(author, time.Now().Unix(), "title", formBody, nil, nil)
+	require.NoError(t, err)
+
+	gi := &githubImporter{}
+
+	err = gi.ensureFormFields(b, author, time.Now().Unix(), formBody)
+	require.NoError(t, err)
+	require.Len(t, b.Snapshot().Operations, 2)
+	require.Equal(t, "1.2.3", FormFields(b.Snapshot())["Version"])
+
+	// nothing changed, nothing recorded
+	err = gi.ensureFormFields(b, author, time.Now().Unix(), formBody)
+	require.NoError(t, err)
+	require.Len(t, b.Snapshot().Operations, 2)
+
+	// only the changed field is recorded
+	edited := "### Version\n\n1.2.4\n\n### Relevant logs\n\n_No response_\n"
+	err = gi.ensureFormFields(b, author, time.Now().Unix(), edited)
+	require.NoError(t, err)
+
+	snap := b.Snapshot()
+	require.Len(t, snap.Operations, 3)
+	require.Len(t, snap.Operations[2].AllMetadata(), 1)
+	require.Equal(t, "1.2.4", FormFields(snap)["Version"])
+	require.Equal(t, "", FormFields(snap)["Relevant logs"])
+}
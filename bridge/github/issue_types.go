@@ -0,0 +1,274 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/shurcooL/githubv4"
+
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/cache"
+)
+
+const (
+	// MetaKeyIssueType is the metadata holding the issue type (bug, task,
+	// feature ...) of a bug. As it can change, it's recorded on NoOp
+	// operations and the current value is the last one.
+	MetaKeyIssueType = "github:issue-type"
+
+	// the issue types of the repository, as a JSON list, when their
+	// synchronization is enabled
+	keyIssueTypes = "issue-types"
+
+	issueTypesPageSize = 100
+)
+
+// issueType is a type of issue available in a repository
+type issueType struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+func parseIssueTypes(raw string) ([]issueType, error) {
+	var types []issueType
+	if err := json.Unmarshal([]byte(raw), &types); err != nil {
+		return nil, fmt.Errorf("invalid %s: %v", keyIssueTypes, err)
+	}
+	return types, nil
+}
+
+// IssueTypeFilter return a Filter that match the bugs of the given issue
+// type, ignoring the case
+func IssueTypeFilter(issueType string) cache.Filter {
+	return func(repoCache *cache.RepoCache, excerpt *cache.BugExcerpt) bool {
+		b, err := repoCache.ResolveBug(excerpt.Id)
+		if err != nil {
+			return false
+		}
+		current, ok := b.Snapshot().LastMetadata(MetaKeyIssueType)
+		return ok && strings.EqualFold(current, issueType)
+	}
+}
+
+// exportedIssueType return the issue type to set on the Github issue of a
+// bug: the recorded one, or the first label matching the name of a type
+func exportedIssueType(snap *bug.Snapshot, types []issueType) (issueType, bool) {
+	if name, ok := snap.LastMetadata(MetaKeyIssueType); ok {
+		for _, t := range types {
+			if t.Name == name {
+				return t, true
+			}
+		}
+	}
+
+	for _, label := range snap.Labels {
+		for _, t := range types {
+			if strings.EqualFold(t.Name, label.String()) {
+				return t, true
+			}
+		}
+	}
+
+	return issueType{}, false
+}
+
+type issueTypesQuery struct {
+	Repository struct {
+		IssueTypes struct {
+			Nodes []struct {
+				Id   githubv4.ID
+				Name githubv4.String
+			}
+		} `graphql:"issueTypes(first: $first)"`
+	} `graphql:"repository(owner: $owner, name: $name)"`
+}
+
+// fetchIssueTypes query the issue types available in a repository
+func fetchIssueTypes(ctx context.Context, gc *githubv4.Client, owner, project string) ([]issueType, error) {
+	var q issueTypesQuery
+
+	variables := map[string]interface{}{
+		"owner": githubv4.String(owner),
+		"name":  githubv4.String(project),
+		"first": githubv4.Int(issueTypesPageSize),
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	if err := gc.Query(ctx, &q, variables); err != nil {
+		return nil, err
+	}
+
+	types := make([]issueType, len(q.Repository.IssueTypes.Nodes))
+	for i, node := range q.Repository.IssueTypes.Nodes {
+		types[i] = issueType{ID: parseId(node.Id), Name: string(node.Name)}
+	}
+
+	return types, nil
+}
+
+// issueTypeNode is an issue and its type, if any
+type issueTypeNode struct {
+	Id        githubv4.ID
+	Url       githubv4.URI
+	UpdatedAt githubv4.DateTime
+	IssueType *struct {
+		Name githubv4.String
+	}
+}
+
+type issuesTypeQuery struct {
+	Repository struct {
+		Issues struct {
+			Nodes    []issueTypeNode
+			PageInfo pageInfo
+		} `graphql:"issues(first: $first, after: $after, filterBy: $filterBy)"`
+	} `graphql:"repository(owner: $owner, name: $name)"`
+}
+
+// fetchIssuesType query the type of the issues updated since the given time
+func fetchIssuesType(ctx context.Context, gc *githubv4.Client, owner, project string, since time.Time) ([]issueTypeNode, error) {
+	var nodes []issueTypeNode
+
+	filter := githubv4.IssueFilters{}
+	if !since.IsZero() {
+		filter.Since = &githubv4.DateTime{Time: since}
+	}
+
+	variables := map[string]interface{}{
+		"owner":    githubv4.String(owner),
+		"name":     githubv4.String(project),
+		"first":    githubv4.Int(issueTypesPageSize),
+		"after":    (*githubv4.String)(nil),
+		"filterBy": filter,
+	}
+
+	for {
+		var q issuesTypeQuery
+
+		reqCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
+		err := gc.Query(reqCtx, &q, variables)
+		cancel()
+		if err != nil {
+			return nil, err
+		}
+
+		nodes = append(nodes, q.Repository.Issues.Nodes...)
+
+		if !q.Repository.Issues.PageInfo.HasNextPage {
+			return nodes, nil
+		}
+		variables["after"] = githubv4.NewString(q.Repository.Issues.PageInfo.EndCursor)
+	}
+}
+
+// ensureIssueTypes record the type of the issues updated since the given
+// time, when it changed
+func (gi *githubImporter) ensureIssueTypes(ctx context.Context, repo *cache.RepoCache, since time.Time) error {
+	nodes, err := fetchIssuesType(ctx, gi.client, gi.conf[keyOwner], gi.conf[keyProject], since)
+	if err != nil {
+		return err
+	}
+
+	return gi.applyIssueTypes(repo, nodes)
+}
+
+func (gi *githubImporter) applyIssueTypes(repo *cache.RepoCache, nodes []issueTypeNode) error {
+	for _, node := range nodes {
+		issueURL := node.Url.String()
+		if gi.onlyIssue != "" && issueURL != gi.onlyIssue {
+			continue
+		}
+
+		b, err := repo.ResolveBugCreateMetadata(metaKeyGithubUrl, issueURL)
+		if err == bug.ErrBugNotExist {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		var name string
+		if node.IssueType != nil {
+			name = string(node.IssueType.Name)
+		}
+
+		current, _ := b.Snapshot().LastMetadata(MetaKeyIssueType)
+		if current == name {
+			continue
+		}
+
+		// the API doesn't tell who changed the type
+		author, err := gi.getGhost(repo)
+		if err != nil {
+			return err
+		}
+
+		// the github id mark the operation as already existing in github
+		_, err = b.OpNoOpRaw(author, node.UpdatedAt.Unix(), map[string]string{
+			metaKeyGithubId:  parseId(node.Id),
+			MetaKeyIssueType: name,
+		})
+		if err != nil {
+			return err
+		}
+
+		if err := b.CommitAsNeeded(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type updateIssueIssueTypeMutation struct {
+	UpdateIssueIssueType struct {
+		Issue struct {
+			ID string `graphql:"id"`
+		}
+	} `graphql:"updateIssueIssueType(input:$input)"`
+}
+
+// UpdateIssueIssueTypeInput is an autogenerated input type of
+// UpdateIssueIssueType.
+// It's not part of our vendored githubv4 yet. The type name is used as is
+// in the query, hence the exported name.
+type UpdateIssueIssueTypeInput struct {
+	// The ID of the issue to update. (Required.)
+	IssueID githubv4.ID `json:"issueId"`
+	// The ID of the issue type to set, or null to remove it. (Required.)
+	IssueTypeID *githubv4.ID `json:"issueTypeId"`
+}
+
+// updateGithubIssueType set the type of an issue
+func updateGithubIssueType(ctx context.Context, gc *githubv4.Client, issueID string, typeID string) error {
+	m := &updateIssueIssueTypeMutation{}
+	input := UpdateIssueIssueTypeInput{
+		IssueID:     issueID,
+		IssueTypeID: githubv4.NewID(typeID),
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	return gc.Mutate(ctx, m, input, nil)
+}
+
+// exportIssueType set the type of a newly created issue, if one match the bug
+func exportIssueType(ctx context.Context, gc *githubv4.Client, snap *bug.Snapshot, issueID string, rawTypes string) error {
+	types, err := parseIssueTypes(rawTypes)
+	if err != nil {
+		return err
+	}
+
+	t, ok := exportedIssueType(snap, types)
+	if !ok {
+		return nil
+	}
+
+	return updateGithubIssueType(ctx, gc, issueID, t.ID)
+}
@@ -0,0 +1,141 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/shurcooL/githubv4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestIssueTypes(t *testing.T) {
+	var mutations []string
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+
+		var req struct {
+			Query     string
+			Variables map[string]interface{}
+		}
+		require.NoError(t, json.Unmarshal(body, &req))
+
+		switch {
+		case strings.Contains(req.Query, "issueTypes("):
+			fmt.Fprint(w, `{"data": {"repository": {"issueTypes": {"nodes": [
+				{"id": "IT_1", "name": "Bug"},
+				{"id": "IT_2", "name": "Feature"}
+			]}}}}`)
+
+		case strings.Contains(req.Query, "issues("):
+			require.Equal(t, map[string]interface{}{"since": "2020-01-01T00:00:00Z"}, req.Variables["filterBy"])
+			fmt.Fprint(w, `{"data": {"repository": {"issues": {
+				"nodes": [
+					{"id": "I_1", "url": "https://github.com/rene/project/issues/1", "updatedAt": "2020-01-02T10:00:00Z", "issueType": {"name": "Bug"}},
+					{"id": "I_2", "url": "https://github.com/rene/project/issues/2", "updatedAt": "2020-01-02T10:00:00Z", "issueType": null},
+					{"id": "I_3", "url": "https://github.com/rene/project/issues/3", "updatedAt": "2020-01-02T10:00:00Z", "issueType": {"name": "Feature"}}
+				],
+				"pageInfo": {"hasNextPage": false}
+			}}}}`)
+
+		case strings.Contains(req.Query, "updateIssueIssueType"):
+			mutations = append(mutations, string(body))
+			fmt.Fprint(w, `{"data": {"updateIssueIssueType": {"issue": {"id": "I_4"}}}}`)
+
+		default:
+			t.Fatalf("unexpected query %s", req.Query)
+		}
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client := githubv4.NewEnterpriseClient(server.URL+"/graphql", server.Client())
+
+	types, err := fetchIssueTypes(context.Background(), client, "rene", "project")
+	require.NoError(t, err)
+	require.Equal(t, []issueType{{ID: "IT_1", Name: "Bug"}, {ID: "IT_2", Name: "Feature"}}, types)
+
+	since := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	nodes, err := fetchIssuesType(context.Background(), client, "rene", "project", since)
+	require.NoError(t, err)
+	require.Len(t, nodes, 3)
+
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	backend, err := cache.NewRepoCache(repo)
+	require.NoError(t, err)
+	defer backend.Close()
+
+	_, err = backend.NewIdentityRaw("Ghost", "", "ghost", "", map[string]string{
+		metaKeyGithubLogin: "ghost",
+	})
+	require.NoError(t, err)
+
+	author, err := backend.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+
+	newIssue := func(url string) *cache.BugCache {
+		b, _, err := backend.NewBugRaw(author, 1000, "title", "message", nil, map[string]string{
+			metaKeyGithubUrl: url,
+		})
+		require.NoError(t, err)
+		return b
+	}
+
+	typed := newIssue("https://github.com/rene/project/issues/1")
+	untyped := newIssue("https://github.com/rene/project/issues/2")
+
+	gi := &githubImporter{}
+
+	require.NoError(t, gi.applyIssueTypes(backend, nodes))
+	// already in sync
+	require.NoError(t, gi.applyIssueTypes(backend, nodes))
+
+	require.Len(t, typed.Snapshot().Operations, 2)
+	issueType, ok := typed.Snapshot().LastMetadata(MetaKeyIssueType)
+	require.True(t, ok)
+	require.Equal(t, "Bug", issueType)
+	require.Len(t, untyped.Snapshot().Operations, 1)
+
+	filter := IssueTypeFilter("bug")
+	for _, id := range backend.AllBugsIds() {
+		excerpt, err := backend.ResolveBugExcerpt(id)
+		require.NoError(t, err)
+		require.Equal(t, id == typed.Id(), filter(backend, excerpt))
+	}
+
+	// a local bug get the type matching one of its labels
+	local, _, err := backend.NewBugRaw(author, 1000, "title", "message", nil, nil)
+	require.NoError(t, err)
+	_, _, err = local.ChangeLabelsRaw(author, 1001, []string{"feature"}, nil, nil)
+	require.NoError(t, err)
+
+	t2, ok := exportedIssueType(local.Snapshot(), types)
+	require.True(t, ok)
+	require.Equal(t, "IT_2", t2.ID)
+
+	_, ok = exportedIssueType(untyped.Snapshot(), types)
+	require.False(t, ok)
+
+	raw, err := json.Marshal(types)
+	require.NoError(t, err)
+	err = exportIssueType(context.Background(), client, local.Snapshot(), "I_4", string(raw))
+	require.NoError(t, err)
+	require.Len(t, mutations, 1)
+	require.Contains(t, mutations[0], `"issueId":"I_4"`)
+	require.Contains(t, mutations[0], `"issueTypeId":"IT_2"`)
+}
@@ -210,6 +210,12 @@ func (i *iterator) IssueValue() issueTimeline {
 	return issues.Nodes[0]
 }
 
+// TotalIssues return the number of issues matched by the query, once the
+// first one has been queried
+func (i *iterator) TotalIssues() int {
+	return int(i.timeline.query.Repository.Issues.TotalCount)
+}
+
 // NextTimelineItem return true if there is a next timeline item and increments the index by one.
 // It is used iterates over all the timeline items. Extra queries are made if it is necessary.
 func (i *iterator) NextTimelineItem() bool {
@@ -0,0 +1,584 @@
+package github
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/MichaelMure/git-bug/bridge/core"
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/util/text"
+)
+
+const migrationPageSize = 100
+
+// delay between two checks of the state of a migration, a variable to be
+// shortened in the tests
+var migrationPollInterval = 5 * time.Second
+
+// migration is a bulk export of a repository, as returned by the migration API
+type migration struct {
+	ID        int64     `json:"id"`
+	URL       string    `json:"url"`
+	State     string    `json:"state"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// the content of a migration archive, a JSON array per kind of record split
+// in numbered files like issues_000001.json
+
+type migrationUser struct {
+	URL       string `json:"url"`
+	Login     string `json:"login"`
+	Name      string `json:"name"`
+	AvatarURL string `json:"avatar_url"`
+	Emails    []struct {
+		Address string `json:"address"`
+		Primary bool   `json:"primary"`
+	} `json:"emails"`
+}
+
+type migrationIssue struct {
+	URL       string    `json:"url"`
+	User      string    `json:"user"`
+	Title     string    `json:"title"`
+	Body      string    `json:"body"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type migrationComment struct {
+	URL       string    `json:"url"`
+	Issue     string    `json:"issue"`
+	User      string    `json:"user"`
+	Body      string    `json:"body"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type migrationEvent struct {
+	URL       string    `json:"url"`
+	Issue     string    `json:"issue"`
+	Actor     string    `json:"actor"`
+	Event     string    `json:"event"`
+	Label     string    `json:"label"`
+	LabelName string    `json:"label_name"`
+	TitleIs   string    `json:"title_is"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// labelName return the name of the label of a labeled or unlabeled event,
+// given either directly or as the last part of the label url
+func (e migrationEvent) labelName() string {
+	if e.LabelName != "" {
+		return e.LabelName
+	}
+	name, err := url.PathUnescape(path.Base(e.Label))
+	if err != nil {
+		return path.Base(e.Label)
+	}
+	return name
+}
+
+type migrationArchive struct {
+	users  map[string]migrationUser
+	issues []migrationIssue
+	// comments and events, by issue url
+	comments map[string][]migrationComment
+	events   map[string][]migrationEvent
+}
+
+// migrationItem is a comment or an event of an issue
+type migrationItem struct {
+	comment *migrationComment
+	event   *migrationEvent
+}
+
+func (i migrationItem) createdAt() time.Time {
+	if i.comment != nil {
+		return i.comment.CreatedAt
+	}
+	return i.event.CreatedAt
+}
+
+// timeline return the comments and events of an issue, oldest first
+func (a *migrationArchive) timeline(issueURL string) []migrationItem {
+	var items []migrationItem
+	for i := range a.comments[issueURL] {
+		items = append(items, migrationItem{comment: &a.comments[issueURL][i]})
+	}
+	for i := range a.events[issueURL] {
+		items = append(items, migrationItem{event: &a.events[issueURL][i]})
+	}
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].createdAt().Before(items[j].createdAt())
+	})
+	return items
+}
+
+// parseMigrationArchive read the users, issues, comments and events of a
+// migration archive, a tar.gz of JSON files
+func parseMigrationArchive(r io.Reader) (*migrationArchive, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+	defer gz.Close()
+
+	archive := &migrationArchive{
+		users:    make(map[string]migrationUser),
+		comments: make(map[string][]migrationComment),
+		events:   make(map[string][]migrationEvent),
+	}
+
+	tr := tar.NewReader(gz)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		name := path.Base(header.Name)
+		if !strings.HasSuffix(name, ".json") {
+			continue
+		}
+
+		decoder := json.NewDecoder(tr)
+
+		switch {
+		case strings.HasPrefix(name, "users_"):
+			var users []migrationUser
+			if err := decoder.Decode(&users); err != nil {
+				return nil, fmt.Errorf("%s: %v", name, err)
+			}
+			for _, user := range users {
+				archive.users[user.URL] = user
+			}
+
+		case strings.HasPrefix(name, "issues_"):
+			var issues []migrationIssue
+			if err := decoder.Decode(&issues); err != nil {
+				return nil, fmt.Errorf("%s: %v", name, err)
+			}
+			archive.issues = append(archive.issues, issues...)
+
+		case strings.HasPrefix(name, "issue_comments_"):
+			var comments []migrationComment
+			if err := decoder.Decode(&comments); err != nil {
+				return nil, fmt.Errorf("%s: %v", name, err)
+			}
+			for _, comment := range comments {
+				// the comments of the pull requests don't have an issue
+				if comment.Issue != "" {
+					archive.comments[comment.Issue] = append(archive.comments[comment.Issue], comment)
+				}
+			}
+
+		case strings.HasPrefix(name, "issue_events_"):
+			var events []migrationEvent
+			if err := decoder.Decode(&events); err != nil {
+				return nil, fmt.Errorf("%s: %v", name, err)
+			}
+			for _, event := range events {
+				if event.Issue != "" {
+					archive.events[event.Issue] = append(archive.events[event.Issue], event)
+				}
+			}
+		}
+	}
+
+	sort.SliceStable(archive.issues, func(i, j int) bool {
+		return archive.issues[i].CreatedAt.Before(archive.issues[j].CreatedAt)
+	})
+
+	return archive, nil
+}
+
+// startMigration start the export of the issues of a repository. The
+// migrations of an organization repository go through the organization,
+// the other ones through the authenticated user.
+func startMigration(ctx context.Context, baseURL, token, owner, project string) (*migration, error) {
+	var user struct {
+		Type string `json:"type"`
+	}
+	err := restRequest(ctx, token, restAccept, http.MethodGet,
+		fmt.Sprintf("%s/users/%s", baseURL, owner), nil, &user)
+	if err != nil {
+		return nil, err
+	}
+
+	migrationsURL := fmt.Sprintf("%s/user/migrations", baseURL)
+	if user.Type == "Organization" {
+		migrationsURL = fmt.Sprintf("%s/orgs/%s/migrations", baseURL, owner)
+	}
+
+	params := map[string]interface{}{
+		"repositories":           []string{owner + "/" + project},
+		"exclude_attachments":    true,
+		"exclude_releases":       true,
+		"exclude_git_data":       true,
+		"exclude_owner_projects": true,
+	}
+
+	var m migration
+	err = restRequest(ctx, token, restAccept, http.MethodPost, migrationsURL, params, &m)
+	if err != nil {
+		return nil, err
+	}
+
+	return &m, nil
+}
+
+// waitMigration wait for the export of a migration to be done
+func waitMigration(ctx context.Context, token string, m *migration) error {
+	for m.State != "exported" {
+		if m.State == "failed" {
+			return fmt.Errorf("migration %d failed", m.ID)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(migrationPollInterval):
+		}
+
+		err := restRequest(ctx, token, restAccept, http.MethodGet, m.URL, nil, m)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// downloadMigrationArchive download and parse the archive of an exported
+// migration. The archive can be large, so the download is only bounded by
+// the context.
+func downloadMigrationArchive(ctx context.Context, token string, m *migration) (*migrationArchive, error) {
+	req, err := http.NewRequest(http.MethodGet, m.URL+"/archive", nil)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+
+	req.Header.Set("Authorization", fmt.Sprintf("token %s", token))
+	req.Header.Set("Accept", restAccept)
+
+	// the archive is served from a redirection, without the authorization
+	resp, err := core.NewHTTPClient(0).Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("request %s: response status %v", req.URL, resp.StatusCode)
+	}
+
+	return parseMigrationArchive(resp.Body)
+}
+
+// fetchMigrationNodeIds fetch the Github node id of the issues and comments,
+// by url, and of the issue events, by the "event-<id>" anchor of their url.
+// The archive doesn't have them, but the other imports and the export
+// identify the issues and their timeline by node id.
+func fetchMigrationNodeIds(ctx context.Context, baseURL, token, owner, project string) (map[string]string, error) {
+	nodeIds := make(map[string]string)
+
+	lists := []struct {
+		path string
+		key  func(id int64, htmlURL string) string
+	}{
+		{"issues?state=all&", func(_ int64, htmlURL string) string { return htmlURL }},
+		{"issues/comments?", func(_ int64, htmlURL string) string { return htmlURL }},
+		{"issues/events?", func(id int64, _ string) string { return fmt.Sprintf("event-%d", id) }},
+	}
+
+	for _, list := range lists {
+		for page := 1; ; page++ {
+			var nodes []struct {
+				ID      int64  `json:"id"`
+				NodeID  string `json:"node_id"`
+				HTMLURL string `json:"html_url"`
+			}
+			url := fmt.Sprintf("%s/repos/%s/%s/%sper_page=%d&page=%d",
+				baseURL, owner, project, list.path, migrationPageSize, page)
+			err := restRequest(ctx, token, restAccept, http.MethodGet, url, nil, &nodes)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, node := range nodes {
+				nodeIds[list.key(node.ID, node.HTMLURL)] = node.NodeID
+			}
+
+			if len(nodes) < migrationPageSize {
+				break
+			}
+		}
+	}
+
+	return nodeIds, nil
+}
+
+// importMigration import the issues of the repository from a migration
+// archive, which is much faster than the regular import for a first import
+// of a large repository. The issues already imported are left to the
+// regular import. It return the time of the export, the issues updated
+// since then still having to be imported.
+func (gi *githubImporter) importMigration(ctx context.Context, repo *cache.RepoCache) (time.Time, error) {
+	if gi.filter != nil {
+		return time.Time{}, fmt.Errorf("the import filters are not supported")
+	}
+
+	baseURL := baseURLOf(gi.conf)
+	owner, project := gi.conf[keyOwner], gi.conf[keyProject]
+
+	m, err := startMigration(ctx, baseURL, gi.token.Value, owner, project)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	err = waitMigration(ctx, gi.token.Value, m)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	archive, err := downloadMigrationArchive(ctx, gi.token.Value, m)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	nodeIds, err := fetchMigrationNodeIds(ctx, baseURL, gi.token.Value, owner, project)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	for _, issue := range archive.issues {
+		err := gi.ensureMigrationIssue(repo, archive, nodeIds, issue)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("issue %s: %v", issue.URL, err)
+		}
+	}
+
+	return m.CreatedAt, nil
+}
+
+// ensureMigrationIssue create the bug of an issue of a migration archive,
+// with its comments and events, if it wasn't imported already
+func (gi *githubImporter) ensureMigrationIssue(repo *cache.RepoCache, archive *migrationArchive, nodeIds map[string]string, issue migrationIssue) error {
+	id := entity.NewDeterministicId(target, issue.URL)
+	if repo.BugExists(id) {
+		return nil
+	}
+	_, err := repo.ResolveBugCreateMetadata(metaKeyGithubUrl, issue.URL)
+	if err == nil {
+		return nil
+	}
+	if err != bug.ErrBugNotExist {
+		return err
+	}
+
+	author, err := gi.ensureMigrationPerson(repo, archive, issue.User)
+	if err != nil {
+		return err
+	}
+
+	cleanText, err := text.Cleanup(issue.Body)
+	if err != nil {
+		return err
+	}
+
+	b, err := repo.NewBugWithID(target, issue.URL, cache.BugCreateArgs{
+		Author:   author,
+		UnixTime: issue.CreatedAt.Unix(),
+		Title:    issue.Title,
+		Message:  cleanText,
+		Source: &bug.BugSource{
+			Target:    target,
+			RemoteID:  nodeIds[issue.URL],
+			RemoteURL: issue.URL,
+		},
+		Metadata: migrationMetadata(nodeIds, issue.URL, issue.URL, map[string]string{
+			core.MetaKeyOrigin: target,
+		}),
+	})
+	if err != nil {
+		return err
+	}
+
+	gi.out <- core.NewImportBug(b.Id())
+
+	for _, item := range archive.timeline(issue.URL) {
+		if item.comment != nil {
+			err = gi.ensureMigrationComment(repo, b, archive, nodeIds, *item.comment)
+		} else {
+			err = gi.ensureMigrationEvent(repo, b, archive, nodeIds, *item.event)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return b.CommitOverrideSizeLimit()
+}
+
+func (gi *githubImporter) ensureMigrationComment(repo *cache.RepoCache, b *cache.BugCache, archive *migrationArchive, nodeIds map[string]string, comment migrationComment) error {
+	author, err := gi.ensureMigrationPerson(repo, archive, comment.User)
+	if err != nil {
+		return err
+	}
+
+	cleanText, err := text.Cleanup(comment.Body)
+	if err != nil {
+		return err
+	}
+
+	op, err := b.AddCommentRaw(
+		author,
+		comment.CreatedAt.Unix(),
+		cleanText,
+		nil,
+		migrationMetadata(nodeIds, comment.URL, comment.URL, nil),
+	)
+	if err != nil {
+		return err
+	}
+
+	gi.out <- core.NewImportComment(op.Id())
+	return nil
+}
+
+func (gi *githubImporter) ensureMigrationEvent(repo *cache.RepoCache, b *cache.BugCache, archive *migrationArchive, nodeIds map[string]string, event migrationEvent) error {
+	switch event.Event {
+	case "closed", "reopened", "labeled", "unlabeled", "renamed":
+	default:
+		// the other events don't have an equivalent operation
+		return nil
+	}
+
+	author, err := gi.ensureMigrationPerson(repo, archive, event.Actor)
+	if err != nil {
+		return err
+	}
+
+	unixTime := event.CreatedAt.Unix()
+
+	// the node ids of the events are indexed by the anchor of their url
+	var anchor string
+	if i := strings.LastIndexByte(event.URL, '#'); i >= 0 {
+		anchor = event.URL[i+1:]
+	}
+	metadata := migrationMetadata(nodeIds, anchor, event.URL, nil)
+
+	switch event.Event {
+	case "closed":
+		op, err := b.CloseRaw(author, unixTime, metadata)
+		if err != nil {
+			return err
+		}
+		gi.out <- core.NewImportStatusChange(op.Id())
+
+	case "reopened":
+		op, err := b.OpenRaw(author, unixTime, metadata)
+		if err != nil {
+			return err
+		}
+		gi.out <- core.NewImportStatusChange(op.Id())
+
+	case "labeled":
+		op, err := b.ForceChangeLabelsRaw(author, unixTime, []string{event.labelName()}, nil, metadata)
+		if err != nil {
+			return err
+		}
+		gi.out <- core.NewImportLabelChange(op.Id())
+
+	case "unlabeled":
+		op, err := b.ForceChangeLabelsRaw(author, unixTime, nil, []string{event.labelName()}, metadata)
+		if err != nil {
+			return err
+		}
+		gi.out <- core.NewImportLabelChange(op.Id())
+
+	case "renamed":
+		op, err := b.SetTitleRaw(author, unixTime, event.TitleIs, metadata)
+		if err != nil {
+			return err
+		}
+		gi.out <- core.NewImportTitleEdition(op.Id())
+	}
+
+	return nil
+}
+
+// migrationMetadata build the metadata of an operation imported from a
+// migration archive, with the node id found for the given key if any
+func migrationMetadata(nodeIds map[string]string, key string, url string, metadata map[string]string) map[string]string {
+	if metadata == nil {
+		metadata = make(map[string]string)
+	}
+	metadata[metaKeyGithubUrl] = url
+	if nodeId, ok := nodeIds[key]; ok {
+		metadata[metaKeyGithubId] = nodeId
+	}
+	return metadata
+}
+
+// ensureMigrationPerson create the identity of a user of a migration archive,
+// given by the url of its profile
+func (gi *githubImporter) ensureMigrationPerson(repo *cache.RepoCache, archive *migrationArchive, userURL string) (*cache.IdentityCache, error) {
+	// the deleted users don't have a profile anymore
+	if userURL == "" {
+		return gi.getGhost(repo)
+	}
+
+	user, ok := archive.users[userURL]
+	if !ok {
+		user.Login = path.Base(userURL)
+	}
+
+	i, err := repo.ResolveIdentityImmutableMetadata(metaKeyGithubLogin, user.Login)
+	if err == nil {
+		return i, nil
+	}
+	if entity.IsErrMultipleMatch(err) {
+		return nil, err
+	}
+
+	var email string
+	for _, e := range user.Emails {
+		if email == "" || e.Primary {
+			email = e.Address
+		}
+	}
+
+	metadata := map[string]string{
+		// the metadata keep the real login, to match the user on the next import
+		metaKeyGithubLogin: user.Login,
+	}
+	if email != "" {
+		metadata[metaKeyGithubEmail] = email
+	}
+
+	login, email := gi.identityLoginAndEmail(user.Login, email)
+
+	i, err = repo.NewIdentityRaw(user.Name, email, login, user.AvatarURL, metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	gi.out <- core.NewImportIdentity(i.Id())
+	return i, nil
+}
@@ -0,0 +1,156 @@
+package github
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/bridge/core"
+	"github.com/MichaelMure/git-bug/bridge/core/auth"
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+// buildMigrationArchive build a migration archive with the given JSON files
+func buildMigrationArchive(t *testing.T, files map[string]interface{}) []byte {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+
+	for name, content := range files {
+		data, err := json.Marshal(content)
+		require.NoError(t, err)
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: "archive/" + name, Mode: 0644, Size: int64(len(data))}))
+		_, err = tw.Write(data)
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, tw.Close())
+	require.NoError(t, gz.Close())
+	return buf.Bytes()
+}
+
+func TestImportMigration(t *testing.T) {
+	migrationPollInterval = time.Millisecond
+
+	issueURL := "https://github.com/a/b/issues/1"
+	commentURL := issueURL + "#issuecomment-10"
+	created := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	archive := buildMigrationArchive(t, map[string]interface{}{
+		"users_000001.json": []map[string]interface{}{
+			{"url": "https://github.com/rene", "login": "rene", "name": "René Descartes",
+				"emails": []map[string]interface{}{{"address": "rene@descartes.fr", "primary": true}}},
+		},
+		"issues_000001.json": []map[string]interface{}{
+			{"url": issueURL, "user": "https://github.com/rene", "title": "first", "body": "message", "created_at": created},
+		},
+		"issue_comments_000001.json": []map[string]interface{}{
+			{"url": commentURL, "issue": issueURL, "user": "https://github.com/rene", "body": "comment", "created_at": created.Add(time.Minute)},
+			{"url": "https://github.com/a/b/pull/2#issuecomment-11", "pull_request": "https://github.com/a/b/pull/2", "body": "ignored"},
+		},
+		"issue_events_000001.json": []map[string]interface{}{
+			{"url": issueURL + "#event-20", "issue": issueURL, "actor": "https://github.com/rene", "event": "labeled",
+				"label": "https://github.com/a/b/labels/needs%20review", "created_at": created.Add(2 * time.Minute)},
+			{"url": issueURL + "#event-21", "issue": issueURL, "actor": "https://github.com/rene", "event": "closed",
+				"created_at": created.Add(3 * time.Minute)},
+			{"url": issueURL + "#event-22", "issue": issueURL, "actor": "https://github.com/rene", "event": "subscribed",
+				"created_at": created.Add(4 * time.Minute)},
+		},
+	})
+
+	var server *httptest.Server
+	polled := 0
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/users/a", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"type": "Organization"}`))
+	})
+	mux.HandleFunc("/orgs/a/migrations", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		var params struct {
+			Repositories []string `json:"repositories"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&params))
+		require.Equal(t, []string{"a/b"}, params.Repositories)
+		_ = json.NewEncoder(w).Encode(migration{ID: 79, URL: server.URL + "/orgs/a/migrations/79", State: "pending", CreatedAt: created.Add(time.Hour)})
+	})
+	mux.HandleFunc("/orgs/a/migrations/79", func(w http.ResponseWriter, r *http.Request) {
+		polled++
+		_ = json.NewEncoder(w).Encode(migration{ID: 79, URL: server.URL + "/orgs/a/migrations/79", State: "exported", CreatedAt: created.Add(time.Hour)})
+	})
+	mux.HandleFunc("/orgs/a/migrations/79/archive", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/storage/archive.tar.gz", http.StatusFound)
+	})
+	mux.HandleFunc("/storage/archive.tar.gz", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(archive)
+	})
+	mux.HandleFunc("/repos/a/b/issues", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "all", r.URL.Query().Get("state"))
+		_, _ = w.Write([]byte(`[{"id": 1, "node_id": "ISSUE_1", "html_url": "` + issueURL + `"}]`))
+	})
+	mux.HandleFunc("/repos/a/b/issues/comments", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`[{"id": 10, "node_id": "COMMENT_10", "html_url": "` + commentURL + `"}]`))
+	})
+	mux.HandleFunc("/repos/a/b/issues/events", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`[{"id": 20, "node_id": "EVENT_20"}, {"id": 21, "node_id": "EVENT_21"}]`))
+	})
+
+	server = httptest.NewServer(mux)
+	defer server.Close()
+
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	backend, err := cache.NewRepoCache(repo)
+	require.NoError(t, err)
+	defer backend.Close()
+
+	gi := &githubImporter{
+		conf: core.Configuration{
+			keyOwner:         "a",
+			keyProject:       "b",
+			keyGithubBaseURL: server.URL,
+		},
+		token: auth.NewToken(entity.UnsetId, "token", target),
+		out:   make(chan core.ImportResult, 100),
+	}
+
+	exportedAt, err := gi.importMigration(context.Background(), backend)
+	require.NoError(t, err)
+	require.Equal(t, created.Add(time.Hour), exportedAt.UTC())
+	require.Equal(t, 1, polled)
+
+	b, err := backend.ResolveBug(entity.NewDeterministicId(target, issueURL))
+	require.NoError(t, err)
+
+	snap := b.Snapshot()
+	require.Equal(t, "first", snap.Title)
+	require.Equal(t, bug.ClosedStatus, snap.Status)
+	require.Equal(t, []bug.Label{"needs review"}, snap.Labels)
+	require.Len(t, snap.Comments, 2)
+	require.Equal(t, "comment", snap.Comments[1].Message)
+	require.Equal(t, "René Descartes", snap.Author.Name())
+
+	require.Len(t, snap.Operations, 4)
+	for i, nodeId := range []string{"ISSUE_1", "COMMENT_10", "EVENT_20", "EVENT_21"} {
+		value, ok := snap.Operations[i].GetMetadata(metaKeyGithubId)
+		require.True(t, ok)
+		require.Equal(t, nodeId, value)
+	}
+
+	// the issues already imported are left to the regular import
+	_, err = gi.importMigration(context.Background(), backend)
+	require.NoError(t, err)
+	require.Len(t, b.Snapshot().Operations, 4)
+}
@@ -0,0 +1,169 @@
+package github
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/shurcooL/githubv4"
+
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/cache"
+)
+
+// Github allow to pin at most 3 issues on a repository
+const maxPinnedIssues = 3
+
+type pinnedIssuesQuery struct {
+	Repository struct {
+		Url          githubv4.URI
+		PinnedIssues struct {
+			Nodes []struct {
+				Issue struct {
+					Id githubv4.ID
+				}
+			}
+		} `graphql:"pinnedIssues(first: $first)"`
+	} `graphql:"repository(owner: $owner, name: $name)"`
+}
+
+type pinIssueMutation struct {
+	PinIssue struct {
+		Issue struct {
+			ID string `graphql:"id"`
+		}
+	} `graphql:"pinIssue(input:$input)"`
+}
+
+type unpinIssueMutation struct {
+	UnpinIssue struct {
+		Issue struct {
+			ID string `graphql:"id"`
+		}
+	} `graphql:"unpinIssue(input:$input)"`
+}
+
+// PinIssueInput is an autogenerated input type of PinIssue.
+// It's not part of our vendored githubv4 yet. The type name is used as is
+// in the query, hence the exported name.
+type PinIssueInput struct {
+	// The ID of the issue to be pinned. (Required.)
+	IssueID githubv4.ID `json:"issueId"`
+}
+
+// UnpinIssueInput is an autogenerated input type of UnpinIssue.
+// It's not part of our vendored githubv4 yet. The type name is used as is
+// in the query, hence the exported name.
+type UnpinIssueInput struct {
+	// The ID of the issue to be unpinned. (Required.)
+	IssueID githubv4.ID `json:"issueId"`
+}
+
+// pinSynced return true if the pinned state of the bug is the one of Github,
+// that is if the last pin operation was imported or exported. A local change
+// not exported yet must not be overwritten by the import.
+func pinSynced(snap *bug.Snapshot) bool {
+	for i := len(snap.Operations) - 1; i >= 0; i-- {
+		if op, ok := snap.Operations[i].(*bug.PinOperation); ok {
+			_, synced := op.GetMetadata(metaKeyGithubId)
+			return synced
+		}
+	}
+	// never pinned
+	return true
+}
+
+// ensurePinnedIssues query the issues pinned on the repository and update
+// the pinned state of the matching bugs
+func (gi *githubImporter) ensurePinnedIssues(ctx context.Context, repo *cache.RepoCache) error {
+	var q pinnedIssuesQuery
+
+	variables := map[string]interface{}{
+		"owner": githubv4.String(gi.conf[keyOwner]),
+		"name":  githubv4.String(gi.conf[keyProject]),
+		"first": githubv4.Int(maxPinnedIssues),
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	if err := gi.client.Query(ctx, &q, variables); err != nil {
+		return err
+	}
+
+	pinned := make([]string, 0, len(q.Repository.PinnedIssues.Nodes))
+	for _, node := range q.Repository.PinnedIssues.Nodes {
+		pinned = append(pinned, parseId(node.Issue.Id))
+	}
+
+	return gi.applyPinnedIssues(repo, q.Repository.Url.String(), pinned)
+}
+
+// applyPinnedIssues pin the bugs of the given Github ids and unpin the other
+// bugs imported from the repository at repoURL
+func (gi *githubImporter) applyPinnedIssues(repo *cache.RepoCache, repoURL string, pinned []string) error {
+	isPinned := make(map[string]bool, len(pinned))
+	for _, id := range pinned {
+		isPinned[id] = true
+	}
+
+	issuesPrefix := strings.TrimSuffix(repoURL, "/") + "/issues/"
+
+	for _, id := range repo.AllBugsIds() {
+		excerpt, err := repo.ResolveBugExcerpt(id)
+		if err != nil {
+			return err
+		}
+
+		if !strings.HasPrefix(excerpt.CreateMetadata[metaKeyGithubUrl], issuesPrefix) {
+			continue
+		}
+
+		githubID := excerpt.CreateMetadata[metaKeyGithubId]
+		if excerpt.Pinned == isPinned[githubID] {
+			continue
+		}
+
+		b, err := repo.ResolveBug(id)
+		if err != nil {
+			return err
+		}
+
+		if !pinSynced(b.Snapshot()) {
+			continue
+		}
+
+		// the API doesn't tell who pinned the issue
+		author, err := gi.getGhost(repo)
+		if err != nil {
+			return err
+		}
+
+		_, err = b.PinRaw(author, time.Now().Unix(), isPinned[githubID], map[string]string{
+			metaKeyGithubId: githubID,
+		})
+		if err != nil {
+			return err
+		}
+
+		if err := b.CommitAsNeeded(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// updateGithubIssuePinned pin or unpin an issue on its repository
+func updateGithubIssuePinned(ctx context.Context, gc *githubv4.Client, id string, pinned bool) error {
+	ctx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	if pinned {
+		m := &pinIssueMutation{}
+		return gc.Mutate(ctx, m, PinIssueInput{IssueID: id}, nil)
+	}
+
+	m := &unpinIssueMutation{}
+	return gc.Mutate(ctx, m, UnpinIssueInput{IssueID: id}, nil)
+}
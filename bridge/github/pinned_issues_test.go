@@ -0,0 +1,73 @@
+package github
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestApplyPinnedIssues(t *testing.T) {
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	backend, err := cache.NewRepoCache(repo)
+	require.NoError(t, err)
+	defer backend.Close()
+
+	_, err = backend.NewIdentityRaw("Ghost", "", "ghost", "", map[string]string{
+		metaKeyGithubLogin: "ghost",
+	})
+	require.NoError(t, err)
+
+	author, err := backend.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+
+	newIssue := func(id, url string) *cache.BugCache {
+		b, _, err := backend.NewBugRaw(author, 1000, "title", "message", nil, map[string]string{
+			metaKeyGithubId:  id,
+			metaKeyGithubUrl: url,
+		})
+		require.NoError(t, err)
+		return b
+	}
+
+	first := newIssue("I_1", "https://github.com/rene/project/issues/1")
+	second := newIssue("I_2", "https://github.com/rene/project/issues/2")
+	other := newIssue("I_3", "https://github.com/rene/other/issues/1")
+	local := newIssue("I_4", "https://github.com/rene/project/issues/4")
+
+	// pinned locally, not exported yet
+	_, err = local.PinRaw(author, 1100, true, nil)
+	require.NoError(t, err)
+	_, err = other.PinRaw(author, 1100, true, map[string]string{metaKeyGithubId: "I_3"})
+	require.NoError(t, err)
+
+	gi := &githubImporter{}
+
+	err = gi.applyPinnedIssues(backend, "https://github.com/rene/project", []string{"I_1", "I_2"})
+	require.NoError(t, err)
+
+	require.True(t, first.Snapshot().Pinned)
+	require.True(t, second.Snapshot().Pinned)
+	require.True(t, local.Snapshot().Pinned)
+	// another repository
+	require.True(t, other.Snapshot().Pinned)
+	require.True(t, pinSynced(first.Snapshot()))
+	require.False(t, pinSynced(local.Snapshot()))
+
+	err = gi.applyPinnedIssues(backend, "https://github.com/rene/project", []string{"I_2"})
+	require.NoError(t, err)
+
+	require.False(t, first.Snapshot().Pinned)
+	require.True(t, second.Snapshot().Pinned)
+	require.True(t, local.Snapshot().Pinned)
+
+	// nothing changed, nothing recorded
+	ops := len(second.Snapshot().Operations)
+	err = gi.applyPinnedIssues(backend, "https://github.com/rene/project", []string{"I_2"})
+	require.NoError(t, err)
+	require.Len(t, second.Snapshot().Operations, ops)
+}
@@ -0,0 +1,310 @@
+package github
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/MichaelMure/git-bug/bridge/core"
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/cache"
+)
+
+const (
+	// id of the classic project whose board columns are synchronized as labels
+	keyProjectID = "project-id"
+
+	// prefix of the labels representing the board column of an issue
+	labelColumnPrefix = "column:"
+
+	// the classic projects API is still a preview feature
+	projectsPreviewAccept = "application/vnd.github.inertia-preview+json"
+
+	boardPageSize = 100
+)
+
+type boardProject struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+}
+
+type boardColumn struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+}
+
+type boardCard struct {
+	ID         int64     `json:"id"`
+	NodeID     string    `json:"node_id"`
+	ContentURL string    `json:"content_url"`
+	UpdatedAt  time.Time `json:"updated_at"`
+
+	// not part of the API response, filled when listing the column cards
+	ColumnID int64 `json:"-"`
+}
+
+// projectBoard is the state of the columns of a classic project, and of the
+// cards they hold.
+type projectBoard struct {
+	columns []boardColumn
+	// cards indexed by issueKey
+	cards map[string]boardCard
+}
+
+// columnLabel return the label representing the given board column
+func columnLabel(column string) string {
+	return labelColumnPrefix + column
+}
+
+// issueKey return a "owner/project/issues/number" key identifying an issue
+// from either its HTML or API URL, or "" if the URL is not the one of an issue.
+func issueKey(url string) string {
+	parts := strings.Split(strings.TrimSuffix(url, "/"), "/")
+	if len(parts) < 4 || parts[len(parts)-2] != "issues" {
+		return ""
+	}
+	return strings.Join(parts[len(parts)-4:], "/")
+}
+
+// columnLabelChanges compute the label changes needed for the labels of a bug
+// to reflect that its card is in the given column.
+func columnLabelChanges(labels []bug.Label, column string) (added, removed []string) {
+	want := columnLabel(column)
+	found := false
+
+	for _, label := range labels {
+		switch {
+		case string(label) == want:
+			found = true
+		case strings.HasPrefix(string(label), labelColumnPrefix):
+			removed = append(removed, string(label))
+		}
+	}
+
+	if !found {
+		added = append(added, want)
+	}
+
+	return added, removed
+}
+
+// cardOf return the card of the issue with the given URL, if any
+func (pb *projectBoard) cardOf(issueURL string) (boardCard, bool) {
+	card, ok := pb.cards[issueKey(issueURL)]
+	return card, ok
+}
+
+func (pb *projectBoard) columnName(id int64) string {
+	for _, column := range pb.columns {
+		if column.ID == id {
+			return column.Name
+		}
+	}
+	return ""
+}
+
+// columnOfLabel return the board column represented by the given label, if
+// it matches a known column.
+func (pb *projectBoard) columnOfLabel(label bug.Label) (boardColumn, bool) {
+	if !strings.HasPrefix(string(label), labelColumnPrefix) {
+		return boardColumn{}, false
+	}
+	name := strings.TrimPrefix(string(label), labelColumnPrefix)
+	for _, column := range pb.columns {
+		if column.Name == name {
+			return column, true
+		}
+	}
+	return boardColumn{}, false
+}
+
+// fetchProjectBoard read the columns and cards of a classic project
+func fetchProjectBoard(ctx context.Context, baseURL, token, projectID string) (*projectBoard, error) {
+	board := &projectBoard{
+		cards: make(map[string]boardCard),
+	}
+
+	url := fmt.Sprintf("%s/projects/%s/columns?per_page=%d", baseURL, projectID, boardPageSize)
+	err := boardRequest(ctx, token, http.MethodGet, url, nil, &board.columns)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, column := range board.columns {
+		for page := 1; ; page++ {
+			var cards []boardCard
+			url := fmt.Sprintf("%s/projects/columns/%d/cards?per_page=%d&page=%d",
+				baseURL, column.ID, boardPageSize, page)
+			err := boardRequest(ctx, token, http.MethodGet, url, nil, &cards)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, card := range cards {
+				// notes don't reference an issue
+				key := issueKey(card.ContentURL)
+				if key == "" {
+					continue
+				}
+				card.ColumnID = column.ID
+				board.cards[key] = card
+			}
+
+			if len(cards) < boardPageSize {
+				break
+			}
+		}
+	}
+
+	return board, nil
+}
+
+// listRepoProjects list the classic projects of a repository
+func listRepoProjects(ctx context.Context, baseURL, token, owner, project string) ([]boardProject, error) {
+	var projects []boardProject
+	url := fmt.Sprintf("%s/repos/%s/%s/projects?per_page=%d", baseURL, owner, project, boardPageSize)
+	err := boardRequest(ctx, token, http.MethodGet, url, nil, &projects)
+	return projects, err
+}
+
+// moveCard move a card at the top of the given column
+func moveCard(ctx context.Context, baseURL, token string, cardID, columnID int64) error {
+	url := fmt.Sprintf("%s/projects/columns/cards/%d/moves", baseURL, cardID)
+	params := struct {
+		Position string `json:"position"`
+		ColumnID int64  `json:"column_id"`
+	}{
+		Position: "top",
+		ColumnID: columnID,
+	}
+	return boardRequest(ctx, token, http.MethodPost, url, params, nil)
+}
+
+// boardRequest do a request on the classic projects API, encoding params as
+// the JSON body if not nil and decoding the response in result if not nil.
+func boardRequest(ctx context.Context, token, method, url string, params interface{}, result interface{}) error {
+	return restRequest(ctx, token, projectsPreviewAccept, method, url, params, result)
+}
+
+// restRequest do a request on the REST API with the given media type,
+// encoding params as the JSON body if not nil and decoding the response in
+// result if not nil.
+func restRequest(ctx context.Context, token, accept, method, url string, params interface{}, result interface{}) error {
+	var body io.Reader
+	if params != nil {
+		data, err := json.Marshal(params)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewBuffer(data)
+	}
+
+	req, err := http.NewRequest(method, url, body)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+	req = req.WithContext(ctx)
+
+	req.Header.Set("Authorization", fmt.Sprintf("token %s", token))
+	req.Header.Set("Accept", accept)
+
+	resp, err := core.NewHTTPClient(0).Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("request %s: response status %v", url, resp.StatusCode)
+	}
+
+	if result == nil {
+		return nil
+	}
+
+	return json.NewDecoder(resp.Body).Decode(result)
+}
+
+// ensureBoardColumn record the board column of the issue card as a label
+func (gi *githubImporter) ensureBoardColumn(repo *cache.RepoCache, b *cache.BugCache, issue issueTimeline) error {
+	card, ok := gi.board.cardOf(issue.Url.String())
+	if !ok {
+		return nil
+	}
+
+	added, removed := columnLabelChanges(b.Snapshot().Labels, gi.board.columnName(card.ColumnID))
+	if len(added) == 0 && len(removed) == 0 {
+		return nil
+	}
+
+	// the API doesn't tell who moved the card
+	author, err := gi.getGhost(repo)
+	if err != nil {
+		return err
+	}
+
+	_, op, err := b.ChangeLabelsRaw(author, card.UpdatedAt.Unix(), added, removed, map[string]string{
+		metaKeyGithubId: card.NodeID,
+	})
+	if err != nil {
+		return err
+	}
+
+	gi.out <- core.NewImportLabelChange(op.Id())
+	return nil
+}
+
+// splitColumnLabels separate the labels matching a known board column from
+// the regular labels. The column is the one of the last added column label,
+// or nil if there is none or no board is configured.
+func (ge *githubExporter) splitColumnLabels(added, removed []bug.Label) ([]bug.Label, []bug.Label, *boardColumn) {
+	if ge.board == nil {
+		return added, removed, nil
+	}
+
+	var column *boardColumn
+	var regularAdded, regularRemoved []bug.Label
+
+	for _, label := range added {
+		if c, ok := ge.board.columnOfLabel(label); ok {
+			column = &c
+			continue
+		}
+		regularAdded = append(regularAdded, label)
+	}
+
+	for _, label := range removed {
+		if _, ok := ge.board.columnOfLabel(label); ok {
+			continue
+		}
+		regularRemoved = append(regularRemoved, label)
+	}
+
+	return regularAdded, regularRemoved, column
+}
+
+// moveBoardCard move the card of the issue to the given column. Issues without
+// a card on the board are left alone.
+func (ge *githubExporter) moveBoardCard(ctx context.Context, issueURL string, column boardColumn) error {
+	card, ok := ge.board.cardOf(issueURL)
+	if !ok || card.ColumnID == column.ID {
+		return nil
+	}
+
+	err := moveCard(ctx, baseURLOf(ge.conf), ge.defaultToken.Value, card.ID, column.ID)
+	if err != nil {
+		return err
+	}
+
+	card.ColumnID = column.ID
+	ge.board.cards[issueKey(issueURL)] = card
+	return nil
+}
@@ -0,0 +1,70 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/bug"
+)
+
+func TestIssueKey(t *testing.T) {
+	require.Equal(t, "MichaelMure/git-bug/issues/12", issueKey("https://github.com/MichaelMure/git-bug/issues/12"))
+	require.Equal(t, "MichaelMure/git-bug/issues/12", issueKey("https://api.github.com/repos/MichaelMure/git-bug/issues/12"))
+	require.Equal(t, "", issueKey("https://github.com/MichaelMure/git-bug/pull/12"))
+	require.Equal(t, "", issueKey(""))
+}
+
+func TestColumnLabelChanges(t *testing.T) {
+	added, removed := columnLabelChanges([]bug.Label{"bug", "column:To Do"}, "In Progress")
+	require.Equal(t, []string{"column:In Progress"}, added)
+	require.Equal(t, []string{"column:To Do"}, removed)
+
+	added, removed = columnLabelChanges([]bug.Label{"bug", "column:Done"}, "Done")
+	require.Empty(t, added)
+	require.Empty(t, removed)
+}
+
+func TestFetchProjectBoard(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/projects/1/columns", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, projectsPreviewAccept, r.Header.Get("Accept"))
+		_ = json.NewEncoder(w).Encode([]boardColumn{{ID: 10, Name: "To Do"}, {ID: 11, Name: "Done"}})
+	})
+	mux.HandleFunc("/projects/columns/10/cards", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode([]map[string]interface{}{
+			{"id": 100, "content_url": "https://api.github.com/repos/a/b/issues/1"},
+			// a note, without content
+			{"id": 101},
+		})
+	})
+	mux.HandleFunc("/projects/columns/11/cards", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode([]map[string]interface{}{
+			{"id": 102, "content_url": "https://api.github.com/repos/a/b/issues/2"},
+		})
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	board, err := fetchProjectBoard(context.Background(), server.URL, "token", "1")
+	require.NoError(t, err)
+
+	card, ok := board.cardOf("https://github.com/a/b/issues/2")
+	require.True(t, ok)
+	require.Equal(t, int64(102), card.ID)
+	require.Equal(t, "Done", board.columnName(card.ColumnID))
+
+	_, ok = board.cardOf("https://github.com/a/b/issues/3")
+	require.False(t, ok)
+
+	column, ok := board.columnOfLabel("column:To Do")
+	require.True(t, ok)
+	require.Equal(t, int64(10), column.ID)
+
+	_, ok = board.columnOfLabel("column:Unknown")
+	require.False(t, ok)
+}
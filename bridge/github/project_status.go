@@ -0,0 +1,419 @@
+package github
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/shurcooL/githubv4"
+
+	"github.com/MichaelMure/git-bug/bridge/core"
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/cache"
+)
+
+const (
+	// node id of the project (v2) whose Status field is synchronized with
+	// the status of the bugs
+	keyProjectV2ID = "project-v2-id"
+	// node id of the Status field of the project
+	keyProjectStatusField = "project-status-field"
+	// JSON list of the options of the Status field, with their bug status
+	keyProjectStatusMap = "project-status-map"
+
+	projectItemsPageSize = 100
+)
+
+// projectStatusOption is an option of the Status field of a project, mapped
+// to a bug status
+type projectStatusOption struct {
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	Status string `json:"status"`
+}
+
+// parseProjectStatusMap read the options stored in the configuration
+func parseProjectStatusMap(raw string) ([]projectStatusOption, error) {
+	var options []projectStatusOption
+	if err := json.Unmarshal([]byte(raw), &options); err != nil {
+		return nil, fmt.Errorf("invalid %s: %v", keyProjectStatusMap, err)
+	}
+
+	for _, option := range options {
+		if _, err := bug.StatusFromString(option.Status); err != nil {
+			return nil, fmt.Errorf("invalid %s: option %s: %v", keyProjectStatusMap, option.Name, err)
+		}
+	}
+
+	return options, nil
+}
+
+// statusOfOption return the bug status mapped to the option with the given id
+func statusOfOption(options []projectStatusOption, optionID string) (bug.Status, bool) {
+	for _, option := range options {
+		if option.ID == optionID {
+			status, err := bug.StatusFromString(option.Status)
+			return status, err == nil
+		}
+	}
+	return 0, false
+}
+
+// optionOfStatus return the first option mapped to the given bug status
+func optionOfStatus(options []projectStatusOption, status bug.Status) (projectStatusOption, bool) {
+	for _, option := range options {
+		if s, err := bug.StatusFromString(option.Status); err == nil && s == status {
+			return option, true
+		}
+	}
+	return projectStatusOption{}, false
+}
+
+// defaultOptionStatus guess the bug status of an option from its name
+func defaultOptionStatus(name string) bug.Status {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "done", "closed", "completed":
+		return bug.ClosedStatus
+	default:
+		return bug.OpenStatus
+	}
+}
+
+// mapProjectStatuses set the bug status of each option, from the given
+// "<option>=<open|closed>" mappings, by asking the user or by guessing from
+// the name of the option.
+func mapProjectStatuses(options []projectStatusOption, mappings []string, nonInteractive bool) ([]projectStatusOption, error) {
+	given := make(map[string]string, len(mappings))
+	for _, mapping := range mappings {
+		split := strings.SplitN(mapping, "=", 2)
+		if len(split) != 2 {
+			return nil, fmt.Errorf("invalid project status mapping \"%s\", expected <option>=<open|closed>", mapping)
+		}
+		status, err := bug.StatusFromString(strings.TrimSpace(split[1]))
+		if err != nil {
+			return nil, fmt.Errorf("invalid project status mapping \"%s\": %v", mapping, err)
+		}
+		given[strings.TrimSpace(split[0])] = status.String()
+	}
+
+	result := make([]projectStatusOption, len(options))
+	for i, option := range options {
+		status, ok := given[option.Name]
+		delete(given, option.Name)
+
+		switch {
+		case ok:
+		case nonInteractive:
+			status = defaultOptionStatus(option.Name).String()
+		default:
+			s, err := promptOptionStatus(option.Name)
+			if err != nil {
+				return nil, err
+			}
+			status = s.String()
+		}
+
+		option.Status = status
+		result[i] = option
+	}
+
+	for name := range given {
+		return nil, fmt.Errorf("unknown project status option \"%s\"", name)
+	}
+
+	return result, nil
+}
+
+func promptOptionStatus(name string) (bug.Status, error) {
+	def := defaultOptionStatus(name)
+
+	for {
+		fmt.Printf("bug status for the project status \"%s\" [%s]: ", name, def)
+
+		line, err := bufio.NewReader(os.Stdin).ReadString('\n')
+		if err != nil {
+			return 0, err
+		}
+
+		line = strings.TrimSpace(line)
+		if line == "" {
+			return def, nil
+		}
+
+		status, err := bug.StatusFromString(line)
+		if err != nil {
+			fmt.Println("invalid input, expected open or closed")
+			continue
+		}
+
+		return status, nil
+	}
+}
+
+type projectV2StatusQuery struct {
+	RepositoryOwner struct {
+		ProjectV2Owner struct {
+			ProjectV2 *struct {
+				Id    githubv4.ID
+				Field struct {
+					SingleSelect struct {
+						Id      githubv4.ID
+						Options []struct {
+							Id   githubv4.String
+							Name githubv4.String
+						}
+					} `graphql:"... on ProjectV2SingleSelectField"`
+				} `graphql:"field(name: \"Status\")"`
+			} `graphql:"projectV2(number: $number)"`
+		} `graphql:"... on ProjectV2Owner"`
+	} `graphql:"repositoryOwner(login: $owner)"`
+}
+
+// fetchProjectV2Status query the id of a project of the owner, and the id
+// and options of its Status field
+func fetchProjectV2Status(ctx context.Context, gc *githubv4.Client, owner string, number int) (string, string, []projectStatusOption, error) {
+	var q projectV2StatusQuery
+
+	variables := map[string]interface{}{
+		"owner":  githubv4.String(owner),
+		"number": githubv4.Int(number),
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	if err := gc.Query(ctx, &q, variables); err != nil {
+		return "", "", nil, err
+	}
+
+	project := q.RepositoryOwner.ProjectV2Owner.ProjectV2
+	if project == nil {
+		return "", "", nil, fmt.Errorf("%s has no project number %d", owner, number)
+	}
+
+	field := project.Field.SingleSelect
+	if len(field.Options) == 0 {
+		return "", "", nil, fmt.Errorf("the project number %d has no Status field", number)
+	}
+
+	options := make([]projectStatusOption, len(field.Options))
+	for i, option := range field.Options {
+		options[i] = projectStatusOption{ID: string(option.Id), Name: string(option.Name)}
+	}
+
+	return parseId(project.Id), parseId(field.Id), options, nil
+}
+
+// projectItem is an issue in a project, with its value of the Status field
+type projectItem struct {
+	Id      githubv4.ID
+	Content struct {
+		Issue struct {
+			Url githubv4.URI
+		} `graphql:"... on Issue"`
+	}
+	FieldValueByName struct {
+		SingleSelect struct {
+			OptionId  githubv4.String
+			UpdatedAt githubv4.DateTime
+		} `graphql:"... on ProjectV2ItemFieldSingleSelectValue"`
+	} `graphql:"fieldValueByName(name: \"Status\")"`
+}
+
+type projectItemsQuery struct {
+	Node struct {
+		ProjectV2 struct {
+			Items struct {
+				Nodes    []projectItem
+				PageInfo pageInfo
+			} `graphql:"items(first: $first, after: $after)"`
+		} `graphql:"... on ProjectV2"`
+	} `graphql:"node(id: $projectId)"`
+}
+
+// fetchProjectItems query the issues of a project, indexed by issueKey
+func fetchProjectItems(ctx context.Context, gc *githubv4.Client, projectID string) (map[string]projectItem, error) {
+	items := make(map[string]projectItem)
+
+	variables := map[string]interface{}{
+		"projectId": githubv4.ID(projectID),
+		"first":     githubv4.Int(projectItemsPageSize),
+		"after":     (*githubv4.String)(nil),
+	}
+
+	for {
+		var q projectItemsQuery
+
+		reqCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
+		err := gc.Query(reqCtx, &q, variables)
+		cancel()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range q.Node.ProjectV2.Items.Nodes {
+			// draft issues and pull requests are not synchronized
+			if item.Content.Issue.Url.URL == nil {
+				continue
+			}
+			key := issueKey(item.Content.Issue.Url.String())
+			if key == "" {
+				continue
+			}
+			items[key] = item
+		}
+
+		if !q.Node.ProjectV2.Items.PageInfo.HasNextPage {
+			return items, nil
+		}
+		variables["after"] = githubv4.NewString(q.Node.ProjectV2.Items.PageInfo.EndCursor)
+	}
+}
+
+// ensureProjectStatuses set the status of the bugs from the Status field of
+// their project item. Changing the field doesn't change the update time of
+// the issue, so all the items of the project are checked.
+func (gi *githubImporter) ensureProjectStatuses(ctx context.Context, repo *cache.RepoCache) error {
+	options, err := parseProjectStatusMap(gi.conf[keyProjectStatusMap])
+	if err != nil {
+		return err
+	}
+
+	items, err := fetchProjectItems(ctx, gi.client, gi.conf[keyProjectV2ID])
+	if err != nil {
+		return err
+	}
+
+	return gi.applyProjectStatuses(repo, options, items)
+}
+
+func (gi *githubImporter) applyProjectStatuses(repo *cache.RepoCache, options []projectStatusOption, items map[string]projectItem) error {
+	for _, item := range items {
+		issueURL := item.Content.Issue.Url.String()
+		if gi.onlyIssue != "" && issueURL != gi.onlyIssue {
+			continue
+		}
+
+		value := item.FieldValueByName.SingleSelect
+		status, ok := statusOfOption(options, string(value.OptionId))
+		if !ok {
+			continue
+		}
+
+		b, err := repo.ResolveBugCreateMetadata(metaKeyGithubUrl, issueURL)
+		if err == bug.ErrBugNotExist {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if b.Snapshot().Status == status {
+			continue
+		}
+
+		// the API doesn't tell who changed the field
+		author, err := gi.getGhost(repo)
+		if err != nil {
+			return err
+		}
+
+		metadata := map[string]string{metaKeyGithubId: parseId(item.Id)}
+
+		var op *bug.SetStatusOperation
+		if status == bug.ClosedStatus {
+			op, err = b.CloseRaw(author, value.UpdatedAt.Unix(), metadata)
+		} else {
+			op, err = b.OpenRaw(author, value.UpdatedAt.Unix(), metadata)
+		}
+		if err != nil {
+			return err
+		}
+
+		gi.out <- core.NewImportStatusChange(op.Id())
+
+		if err := b.CommitAsNeeded(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type updateProjectV2ItemFieldValueMutation struct {
+	UpdateProjectV2ItemFieldValue struct {
+		ProjectV2Item struct {
+			Id githubv4.ID
+		}
+	} `graphql:"updateProjectV2ItemFieldValue(input:$input)"`
+}
+
+// UpdateProjectV2ItemFieldValueInput is an autogenerated input type of
+// UpdateProjectV2ItemFieldValue.
+// It's not part of our vendored githubv4 yet. The type name is used as is
+// in the query, hence the exported name.
+type UpdateProjectV2ItemFieldValueInput struct {
+	// The ID of the Project. (Required.)
+	ProjectID githubv4.ID `json:"projectId"`
+	// The ID of the item to be updated. (Required.)
+	ItemID githubv4.ID `json:"itemId"`
+	// The ID of the field to be updated. (Required.)
+	FieldID githubv4.ID `json:"fieldId"`
+	// The value which will be set on the field. (Required.)
+	Value ProjectV2FieldValue `json:"value"`
+}
+
+// ProjectV2FieldValue is the value of a field of a project item, only the
+// single select fields being supported here
+type ProjectV2FieldValue struct {
+	// The id of the single select option to set on the field.
+	SingleSelectOptionID githubv4.String `json:"singleSelectOptionId"`
+}
+
+// updateProjectStatus set the Status field of the project item of the issue
+// to the first option mapped to the given status. Issues not in the project,
+// or whose option is already mapped to the status, are left alone.
+func (ge *githubExporter) updateProjectStatus(ctx context.Context, gc *githubv4.Client, issueURL string, status bug.Status) error {
+	item, ok := ge.projectItems[issueKey(issueURL)]
+	if !ok {
+		return nil
+	}
+
+	options, err := parseProjectStatusMap(ge.conf[keyProjectStatusMap])
+	if err != nil {
+		return err
+	}
+
+	if current, ok := statusOfOption(options, string(item.FieldValueByName.SingleSelect.OptionId)); ok && current == status {
+		return nil
+	}
+
+	option, ok := optionOfStatus(options, status)
+	if !ok {
+		return nil
+	}
+
+	m := &updateProjectV2ItemFieldValueMutation{}
+	input := UpdateProjectV2ItemFieldValueInput{
+		ProjectID: ge.conf[keyProjectV2ID],
+		ItemID:    item.Id,
+		FieldID:   ge.conf[keyProjectStatusField],
+		Value: ProjectV2FieldValue{
+			SingleSelectOptionID: githubv4.String(option.ID),
+		},
+	}
+
+	reqCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	if err := gc.Mutate(reqCtx, m, input, nil); err != nil {
+		return err
+	}
+
+	item.FieldValueByName.SingleSelect.OptionId = githubv4.String(option.ID)
+	ge.projectItems[issueKey(issueURL)] = item
+	return nil
+}
@@ -0,0 +1,129 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/shurcooL/githubv4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/bridge/core"
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestMapProjectStatuses(t *testing.T) {
+	options := []projectStatusOption{
+		{ID: "o1", Name: "Todo"},
+		{ID: "o2", Name: "Won't fix"},
+		{ID: "o3", Name: "Done"},
+	}
+
+	mapped, err := mapProjectStatuses(options, []string{"Won't fix=closed"}, true)
+	require.NoError(t, err)
+	require.Equal(t, []projectStatusOption{
+		{ID: "o1", Name: "Todo", Status: "open"},
+		{ID: "o2", Name: "Won't fix", Status: "closed"},
+		{ID: "o3", Name: "Done", Status: "closed"},
+	}, mapped)
+
+	_, err = mapProjectStatuses(options, []string{"Unknown=closed"}, true)
+	require.Error(t, err)
+	_, err = mapProjectStatuses(options, []string{"Todo=maybe"}, true)
+	require.Error(t, err)
+	_, err = mapProjectStatuses(options, []string{"Todo"}, true)
+	require.Error(t, err)
+
+	status, ok := statusOfOption(mapped, "o2")
+	require.True(t, ok)
+	require.Equal(t, bug.ClosedStatus, status)
+	_, ok = statusOfOption(mapped, "unknown")
+	require.False(t, ok)
+
+	option, ok := optionOfStatus(mapped, bug.ClosedStatus)
+	require.True(t, ok)
+	require.Equal(t, "o2", option.ID)
+
+	_, err = parseProjectStatusMap(`[{"id": "o1", "name": "Todo", "status": "pending"}]`)
+	require.Error(t, err)
+}
+
+func TestProjectStatuses(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data": {"node": {"items": {
+			"nodes": [
+				{"id": "PVTI_1", "content": {"url": "https://github.com/rene/project/issues/1"},
+				 "fieldValueByName": {"optionId": "o3", "updatedAt": "2020-01-01T10:00:00Z"}},
+				{"id": "PVTI_2", "content": {"url": "https://github.com/rene/project/issues/2"},
+				 "fieldValueByName": {"optionId": "o1", "updatedAt": "2020-01-01T10:00:00Z"}},
+				{"id": "PVTI_3", "content": {},
+				 "fieldValueByName": {"optionId": "o3", "updatedAt": "2020-01-01T10:00:00Z"}}
+			],
+			"pageInfo": {"hasNextPage": false}
+		}}}}`)
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client := githubv4.NewEnterpriseClient(server.URL+"/graphql", server.Client())
+
+	items, err := fetchProjectItems(context.Background(), client, "PVT_1")
+	require.NoError(t, err)
+	require.Len(t, items, 2)
+
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	backend, err := cache.NewRepoCache(repo)
+	require.NoError(t, err)
+	defer backend.Close()
+
+	_, err = backend.NewIdentityRaw("Ghost", "", "ghost", "", map[string]string{
+		metaKeyGithubLogin: "ghost",
+	})
+	require.NoError(t, err)
+
+	author, err := backend.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+
+	newIssue := func(url string) *cache.BugCache {
+		b, _, err := backend.NewBugRaw(author, 1000, "title", "message", nil, map[string]string{
+			metaKeyGithubUrl: url,
+		})
+		require.NoError(t, err)
+		return b
+	}
+
+	done := newIssue("https://github.com/rene/project/issues/1")
+	todo := newIssue("https://github.com/rene/project/issues/2")
+
+	options := []projectStatusOption{
+		{ID: "o1", Name: "Todo", Status: "open"},
+		{ID: "o3", Name: "Done", Status: "closed"},
+	}
+
+	gi := &githubImporter{out: make(chan core.ImportResult, 10)}
+
+	err = gi.applyProjectStatuses(backend, options, items)
+	require.NoError(t, err)
+
+	require.Equal(t, bug.ClosedStatus, done.Snapshot().Status)
+	require.Equal(t, bug.OpenStatus, todo.Snapshot().Status)
+	require.Len(t, todo.Snapshot().Operations, 1)
+
+	last := done.Snapshot().Operations[1]
+	githubID, ok := last.GetMetadata(metaKeyGithubId)
+	require.True(t, ok)
+	require.Equal(t, "PVTI_1", githubID)
+
+	// already in sync
+	err = gi.applyProjectStatuses(backend, options, items)
+	require.NoError(t, err)
+	require.Len(t, done.Snapshot().Operations, 2)
+}
@@ -0,0 +1,159 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/MichaelMure/git-bug/bridge/core"
+)
+
+const (
+	// keyRateLimitThreshold is the configuration key to change the number of
+	// remaining API calls under which the bridge wait for the rate limit reset
+	keyRateLimitThreshold = "rate-limit-threshold"
+
+	defaultRateLimitThreshold = 100
+
+	// debugEnv enable the logging of the rate limit state of each response
+	debugEnv = "GIT_BUG_DEBUG"
+)
+
+// rateLimiter track the rate limit of the Github API, as reported in the
+// X-RateLimit-Remaining and X-RateLimit-Reset headers of each response, and
+// pause the requests until the reset when the remaining quota drop under
+// the threshold.
+type rateLimiter struct {
+	threshold int
+	debug     bool
+
+	mu        sync.Mutex
+	known     bool
+	remaining int
+	reset     time.Time
+	stats     core.SyncStats
+
+	// for testing
+	now   func() time.Time
+	sleep func(ctx context.Context, d time.Duration) error
+}
+
+func newRateLimiter(threshold int) *rateLimiter {
+	return &rateLimiter{
+		threshold: threshold,
+		debug:     os.Getenv(debugEnv) == "1",
+		now:       time.Now,
+		sleep:     sleepContext,
+	}
+}
+
+// rateLimitThreshold read the threshold from the configuration
+func rateLimitThreshold(conf core.Configuration) (int, error) {
+	raw, ok := conf[keyRateLimitThreshold]
+	if !ok || raw == "" {
+		return defaultRateLimitThreshold, nil
+	}
+
+	threshold, err := strconv.Atoi(raw)
+	if err != nil || threshold < 0 {
+		return 0, fmt.Errorf("invalid %s value: %s", keyRateLimitThreshold, raw)
+	}
+
+	return threshold, nil
+}
+
+// Stats return the rate limit statistics gathered so far
+func (rl *rateLimiter) Stats() core.SyncStats {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+	return rl.stats
+}
+
+// wait block until the rate limit reset if the remaining quota is too low
+func (rl *rateLimiter) wait(ctx context.Context) error {
+	rl.mu.Lock()
+
+	if !rl.known || rl.remaining >= rl.threshold {
+		rl.mu.Unlock()
+		return nil
+	}
+
+	d := rl.reset.Sub(rl.now())
+	// the quota is refreshed at the reset, until the next response tell otherwise
+	rl.known = false
+
+	if d <= 0 {
+		rl.mu.Unlock()
+		return nil
+	}
+
+	rl.stats.RateLimitHits++
+	rl.stats.RateLimitWaitDuration += d
+	rl.mu.Unlock()
+
+	if rl.debug {
+		fmt.Fprintf(os.Stderr, "github: rate limit reached, waiting %s\n", d.Round(time.Second))
+	}
+
+	return rl.sleep(ctx, d)
+}
+
+// update record the rate limit state given in the headers of a response
+func (rl *rateLimiter) update(header http.Header) {
+	remaining, err := strconv.Atoi(header.Get("X-RateLimit-Remaining"))
+	if err != nil {
+		return
+	}
+	reset, err := strconv.ParseInt(header.Get("X-RateLimit-Reset"), 10, 64)
+	if err != nil {
+		return
+	}
+
+	rl.mu.Lock()
+	rl.known = true
+	rl.remaining = remaining
+	rl.reset = time.Unix(reset, 0)
+	rl.mu.Unlock()
+
+	if rl.debug {
+		fmt.Fprintf(os.Stderr, "github: %d API calls remaining, reset at %s\n",
+			remaining, time.Unix(reset, 0).Format(time.RFC3339))
+	}
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// rateLimitTransport apply a rateLimiter to each request
+type rateLimitTransport struct {
+	limiter *rateLimiter
+	base    http.RoundTripper
+}
+
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.limiter.wait(req.Context()); err != nil {
+		return nil, err
+	}
+
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	t.limiter.update(resp.Header)
+
+	return resp, nil
+}
@@ -0,0 +1,70 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/bridge/core"
+)
+
+func TestRateLimiter(t *testing.T) {
+	now := time.Unix(1580000000, 0)
+
+	var slept []time.Duration
+	rl := newRateLimiter(100)
+	rl.now = func() time.Time { return now }
+	rl.sleep = func(ctx context.Context, d time.Duration) error {
+		slept = append(slept, d)
+		return nil
+	}
+
+	header := func(remaining int, reset time.Time) http.Header {
+		h := make(http.Header)
+		h.Set("X-RateLimit-Remaining", strconv.Itoa(remaining))
+		h.Set("X-RateLimit-Reset", strconv.FormatInt(reset.Unix(), 10))
+		return h
+	}
+
+	// unknown state, no wait
+	require.NoError(t, rl.wait(context.Background()))
+
+	rl.update(header(4000, now.Add(time.Hour)))
+	require.NoError(t, rl.wait(context.Background()))
+	require.Empty(t, slept)
+
+	rl.update(header(50, now.Add(10*time.Minute)))
+	require.NoError(t, rl.wait(context.Background()))
+	require.Equal(t, []time.Duration{10 * time.Minute}, slept)
+
+	// the quota is considered refreshed until the next response
+	require.NoError(t, rl.wait(context.Background()))
+	require.Len(t, slept, 1)
+
+	// a reset in the past doesn't wait
+	rl.update(header(10, now.Add(-time.Minute)))
+	require.NoError(t, rl.wait(context.Background()))
+	require.Len(t, slept, 1)
+
+	require.Equal(t, core.SyncStats{
+		RateLimitHits:         1,
+		RateLimitWaitDuration: 10 * time.Minute,
+	}, rl.Stats())
+}
+
+func TestRateLimitThreshold(t *testing.T) {
+	threshold, err := rateLimitThreshold(core.Configuration{})
+	require.NoError(t, err)
+	require.Equal(t, defaultRateLimitThreshold, threshold)
+
+	threshold, err = rateLimitThreshold(core.Configuration{keyRateLimitThreshold: "500"})
+	require.NoError(t, err)
+	require.Equal(t, 500, threshold)
+
+	_, err = rateLimitThreshold(core.Configuration{keyRateLimitThreshold: "nope"})
+	require.Error(t, err)
+}
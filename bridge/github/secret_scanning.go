@@ -0,0 +1,229 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/MichaelMure/git-bug/bridge/core"
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/util/text"
+)
+
+const (
+	// enable the import of the secret scanning alerts
+	keyImportSecretScanning = "import-secret-scanning"
+
+	// origin of the bugs created from secret scanning alerts. As it differs
+	// from the target, those bugs are not exported as Github issues.
+	secretScanningOrigin = "github-secret-scanning"
+
+	// URL of the alert a bug has been created from
+	metaKeySecretScanningAlert = "github-secret-scanning-alert"
+	// comma separated locations where the secret has been found, as
+	// "path:line"
+	metaKeySecretScanningLocations = "github-secret-scanning-locations"
+
+	// label set on the bugs created from secret scanning alerts, along with
+	// labelSecurity and the type of the secret
+	labelSecretScanning = "secret-scanning"
+
+	secretScanningPageSize = 100
+)
+
+type secretScanningAlert struct {
+	Number                int        `json:"number"`
+	State                 string     `json:"state"`
+	HTMLURL               string     `json:"html_url"`
+	CreatedAt             time.Time  `json:"created_at"`
+	UpdatedAt             time.Time  `json:"updated_at"`
+	ResolvedAt            *time.Time `json:"resolved_at"`
+	Resolution            string     `json:"resolution"`
+	SecretType            string     `json:"secret_type"`
+	SecretTypeDisplayName string     `json:"secret_type_display_name"`
+}
+
+type secretScanningLocation struct {
+	Type    string `json:"type"`
+	Details struct {
+		Path      string `json:"path"`
+		StartLine int    `json:"start_line"`
+	} `json:"details"`
+}
+
+// title build the title of the bug tracking an alert
+func (a secretScanningAlert) title() string {
+	name := a.SecretTypeDisplayName
+	if name == "" {
+		name = a.SecretType
+	}
+	return fmt.Sprintf("Secret scanning alert: %s", name)
+}
+
+// closedAt return when the alert has been resolved, if it has
+func (a secretScanningAlert) closedAt() (time.Time, bool) {
+	if a.State == "resolved" && a.ResolvedAt != nil {
+		return *a.ResolvedAt, true
+	}
+	return time.Time{}, false
+}
+
+// formatSecretLocations format the locations of a secret in the files of the
+// repository as "path:line", ignoring the other kinds of location
+func formatSecretLocations(locations []secretScanningLocation) string {
+	var formatted []string
+	for _, loc := range locations {
+		if loc.Type != "commit" || loc.Details.Path == "" {
+			continue
+		}
+		formatted = append(formatted, loc.Details.Path+":"+strconv.Itoa(loc.Details.StartLine))
+	}
+	return strings.Join(formatted, ",")
+}
+
+// listSecretScanningAlerts list all the secret scanning alerts of a repository
+func listSecretScanningAlerts(ctx context.Context, baseURL, token, owner, project string) ([]secretScanningAlert, error) {
+	var alerts []secretScanningAlert
+
+	for page := 1; ; page++ {
+		var result []secretScanningAlert
+		url := fmt.Sprintf("%s/repos/%s/%s/secret-scanning/alerts?per_page=%d&page=%d",
+			baseURL, owner, project, secretScanningPageSize, page)
+		err := restRequest(ctx, token, restAccept, http.MethodGet, url, nil, &result)
+		if err != nil {
+			return nil, err
+		}
+
+		alerts = append(alerts, result...)
+
+		if len(result) < secretScanningPageSize {
+			break
+		}
+	}
+
+	return alerts, nil
+}
+
+// listSecretScanningLocations list the places where the secret of an alert
+// has been found
+func listSecretScanningLocations(ctx context.Context, baseURL, token, owner, project string, number int) ([]secretScanningLocation, error) {
+	var locations []secretScanningLocation
+
+	for page := 1; ; page++ {
+		var result []secretScanningLocation
+		url := fmt.Sprintf("%s/repos/%s/%s/secret-scanning/alerts/%d/locations?per_page=%d&page=%d",
+			baseURL, owner, project, number, secretScanningPageSize, page)
+		err := restRequest(ctx, token, restAccept, http.MethodGet, url, nil, &result)
+		if err != nil {
+			return nil, err
+		}
+
+		locations = append(locations, result...)
+
+		if len(result) < secretScanningPageSize {
+			break
+		}
+	}
+
+	return locations, nil
+}
+
+// importSecretScanningAlerts create a bug for each open secret scanning
+// alert, and keep the status of the bugs in sync with the alerts.
+func (gi *githubImporter) importSecretScanningAlerts(ctx context.Context, repo *cache.RepoCache) error {
+	alerts, err := listSecretScanningAlerts(ctx, baseURLOf(gi.conf), gi.token.Value, gi.conf[keyOwner], gi.conf[keyProject])
+	if err != nil {
+		return err
+	}
+
+	for _, alert := range alerts {
+		if err := gi.ensureSecretScanningAlert(ctx, repo, alert); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ensureSecretScanningAlert create the bug tracking an alert if needed, and
+// close or reopen it to match the state of the alert
+func (gi *githubImporter) ensureSecretScanningAlert(ctx context.Context, repo *cache.RepoCache, alert secretScanningAlert) error {
+	closedAt, closed := alert.closedAt()
+
+	return gi.ensureAlert(repo, trackedAlert{
+		metaKey:   metaKeySecretScanningAlert,
+		metaValue: alert.HTMLURL,
+		closed:    closed,
+		closedAt:  closedAt,
+		updatedAt: alert.UpdatedAt,
+		create: func() (*cache.BugCache, error) {
+			// resolved alerts are only used to close the existing bugs
+			if closed {
+				return nil, nil
+			}
+			return gi.createSecretScanningBug(ctx, repo, alert)
+		},
+	})
+}
+
+// createSecretScanningBug create the bug tracking an alert
+func (gi *githubImporter) createSecretScanningBug(ctx context.Context, repo *cache.RepoCache, alert secretScanningAlert) (*cache.BugCache, error) {
+	locations, err := listSecretScanningLocations(ctx, baseURLOf(gi.conf), gi.token.Value, gi.conf[keyOwner], gi.conf[keyProject], alert.Number)
+	if err != nil {
+		return nil, err
+	}
+
+	// the API doesn't give a human author
+	author, err := gi.getGhost(repo)
+	if err != nil {
+		return nil, err
+	}
+
+	message := fmt.Sprintf("A secret of type %s has been committed in the repository.\n", alert.SecretType)
+	for _, loc := range locations {
+		if loc.Type == "commit" && loc.Details.Path != "" {
+			message += fmt.Sprintf("\n- %s:%d", loc.Details.Path, loc.Details.StartLine)
+		}
+	}
+	message += fmt.Sprintf("\n\nAlert: %s", alert.HTMLURL)
+
+	cleanText, err := text.Cleanup(message)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata := map[string]string{
+		core.MetaKeyOrigin:         secretScanningOrigin,
+		metaKeySecretScanningAlert: alert.HTMLURL,
+	}
+	if formatted := formatSecretLocations(locations); formatted != "" {
+		metadata[metaKeySecretScanningLocations] = formatted
+	}
+
+	b, err := repo.NewBugWithID(secretScanningOrigin, alert.HTMLURL, cache.BugCreateArgs{
+		Author:   author,
+		UnixTime: alert.CreatedAt.Unix(),
+		Title:    alert.title(),
+		Message:  cleanText,
+		Metadata: metadata,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	labels := []string{labelSecurity, labelSecretScanning}
+	if alert.SecretType != "" {
+		labels = append(labels, alert.SecretType)
+	}
+
+	_, _, err = b.ChangeLabelsRaw(author, alert.CreatedAt.Unix(), labels, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	gi.out <- core.NewImportBug(b.Id())
+	return b, nil
+}
@@ -0,0 +1,56 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecretScanningAlert(t *testing.T) {
+	var alert secretScanningAlert
+	err := json.Unmarshal([]byte(`{
+		"number": 3,
+		"state": "resolved",
+		"resolution": "revoked",
+		"resolved_at": "2020-03-01T10:00:00Z",
+		"secret_type": "github_personal_access_token",
+		"secret_type_display_name": "GitHub Personal Access Token"
+	}`), &alert)
+	require.NoError(t, err)
+
+	require.Equal(t, "Secret scanning alert: GitHub Personal Access Token", alert.title())
+
+	closedAt, closed := alert.closedAt()
+	require.True(t, closed)
+	require.Equal(t, time.Date(2020, 3, 1, 10, 0, 0, 0, time.UTC), closedAt)
+
+	alert.State = "open"
+	_, closed = alert.closedAt()
+	require.False(t, closed)
+
+	alert.SecretTypeDisplayName = ""
+	require.Equal(t, "Secret scanning alert: github_personal_access_token", alert.title())
+}
+
+func TestListSecretScanningLocations(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/repos/a/b/secret-scanning/alerts/3/locations", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`[
+			{"type": "commit", "details": {"path": "config/prod.env", "start_line": 4}},
+			{"type": "issue_body", "details": {}},
+			{"type": "commit", "details": {"path": "README.md", "start_line": 12}}
+		]`))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	locations, err := listSecretScanningLocations(context.Background(), server.URL, "token", "a", "b", 3)
+	require.NoError(t, err)
+	require.Len(t, locations, 3)
+	require.Equal(t, "config/prod.env:4,README.md:12", formatSecretLocations(locations))
+}
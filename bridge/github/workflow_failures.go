@@ -0,0 +1,299 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/MichaelMure/git-bug/bridge/core"
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/util/text"
+)
+
+const (
+	// enable the import of the failures of the Github Actions workflows
+	keyImportWorkflowFailures = "import-workflow-failures"
+
+	// origin of the bugs created from workflow failures. As it differs from the
+	// target, those bugs are not exported as Github issues.
+	workflowFailureOrigin = "github-actions"
+
+	// identify the failure a bug has been created from, see workflowFailureKey
+	metaKeyWorkflowFailure = "github-workflow-failure"
+	// name of the workflow a bug has been created from
+	metaKeyWorkflow = "github-workflow"
+
+	// label set on the bugs created from workflow failures
+	labelCIFailure = "ci-failure"
+
+	restAccept = "application/vnd.github.v3+json"
+
+	workflowRunsPageSize = 100
+	maxWorkflowTitleLen  = 100
+)
+
+type workflowRun struct {
+	ID           int64     `json:"id"`
+	NodeID       string    `json:"node_id"`
+	Name         string    `json:"name"`
+	Conclusion   string    `json:"conclusion"`
+	CheckSuiteID int64     `json:"check_suite_id"`
+	HTMLURL      string    `json:"html_url"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+type checkRun struct {
+	ID     int64 `json:"id"`
+	Output struct {
+		AnnotationsCount int `json:"annotations_count"`
+	} `json:"output"`
+}
+
+type checkAnnotation struct {
+	Path            string `json:"path"`
+	StartLine       int    `json:"start_line"`
+	AnnotationLevel string `json:"annotation_level"`
+	Title           string `json:"title"`
+	Message         string `json:"message"`
+}
+
+// workflowFailureKey identify a failure by its workflow and message, so that
+// the same failure happening again in later runs is tracked by the same bug
+func workflowFailureKey(workflow string, annotation checkAnnotation) string {
+	return entity.NewDeterministicId(metaKeyWorkflowFailure, workflow+"\n"+annotation.Message).String()
+}
+
+// workflowFailureTitle build the title of the bug tracking a failure
+func workflowFailureTitle(workflow string, annotation checkAnnotation) string {
+	summary := annotation.Title
+	if summary == "" {
+		summary = annotation.Message
+	}
+	summary = strings.TrimSpace(strings.SplitN(summary, "\n", 2)[0])
+
+	title := fmt.Sprintf("CI failure in %s: %s", workflow, summary)
+	if runes := []rune(title); len(runes) > maxWorkflowTitleLen {
+		title = string(runes[:maxWorkflowTitleLen-3]) + "..."
+	}
+	return title
+}
+
+// listWorkflowRuns list the completed workflow runs updated after the since
+// date, oldest first
+func listWorkflowRuns(ctx context.Context, baseURL, token, owner, project string, since time.Time) ([]workflowRun, error) {
+	var runs []workflowRun
+
+	for page := 1; ; page++ {
+		var result struct {
+			WorkflowRuns []workflowRun `json:"workflow_runs"`
+		}
+		url := fmt.Sprintf("%s/repos/%s/%s/actions/runs?status=completed&per_page=%d&page=%d",
+			baseURL, owner, project, workflowRunsPageSize, page)
+		err := restRequest(ctx, token, restAccept, http.MethodGet, url, nil, &result)
+		if err != nil {
+			return nil, err
+		}
+
+		// the runs are listed most recent first
+		done := len(result.WorkflowRuns) < workflowRunsPageSize
+		for _, run := range result.WorkflowRuns {
+			if run.UpdatedAt.Before(since) {
+				done = true
+				break
+			}
+			runs = append(runs, run)
+		}
+
+		if done {
+			break
+		}
+	}
+
+	sort.SliceStable(runs, func(i, j int) bool {
+		return runs[i].UpdatedAt.Before(runs[j].UpdatedAt)
+	})
+
+	return runs, nil
+}
+
+// listFailureAnnotations list the failure level annotations of the check runs
+// of a workflow run
+func listFailureAnnotations(ctx context.Context, baseURL, token, owner, project string, run workflowRun) ([]checkAnnotation, error) {
+	var checkRuns struct {
+		CheckRuns []checkRun `json:"check_runs"`
+	}
+	url := fmt.Sprintf("%s/repos/%s/%s/check-suites/%d/check-runs?per_page=%d",
+		baseURL, owner, project, run.CheckSuiteID, workflowRunsPageSize)
+	err := restRequest(ctx, token, restAccept, http.MethodGet, url, nil, &checkRuns)
+	if err != nil {
+		return nil, err
+	}
+
+	var failures []checkAnnotation
+	for _, checkRun := range checkRuns.CheckRuns {
+		if checkRun.Output.AnnotationsCount == 0 {
+			continue
+		}
+
+		var annotations []checkAnnotation
+		url := fmt.Sprintf("%s/repos/%s/%s/check-runs/%d/annotations?per_page=%d",
+			baseURL, owner, project, checkRun.ID, workflowRunsPageSize)
+		err := restRequest(ctx, token, restAccept, http.MethodGet, url, nil, &annotations)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, annotation := range annotations {
+			if annotation.AnnotationLevel == "failure" {
+				failures = append(failures, annotation)
+			}
+		}
+	}
+
+	return failures, nil
+}
+
+// importWorkflowFailures create or reopen a bug for each failure annotation
+// of the workflow runs, and close the bugs of a workflow when it succeed.
+func (gi *githubImporter) importWorkflowFailures(ctx context.Context, repo *cache.RepoCache, since time.Time) error {
+	baseURL := baseURLOf(gi.conf)
+	owner, project := gi.conf[keyOwner], gi.conf[keyProject]
+
+	runs, err := listWorkflowRuns(ctx, baseURL, gi.token.Value, owner, project, since)
+	if err != nil {
+		return err
+	}
+
+	for _, run := range runs {
+		switch run.Conclusion {
+		case "failure":
+			annotations, err := listFailureAnnotations(ctx, baseURL, gi.token.Value, owner, project, run)
+			if err != nil {
+				return err
+			}
+			for _, annotation := range annotations {
+				if err := gi.ensureWorkflowFailure(repo, run, annotation); err != nil {
+					return err
+				}
+			}
+
+		case "success":
+			if err := gi.resolveWorkflowFailures(repo, run); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// ensureWorkflowFailure create the bug tracking a failure, or reopen it if
+// the failure happen again after the bug has been closed
+func (gi *githubImporter) ensureWorkflowFailure(repo *cache.RepoCache, run workflowRun, annotation checkAnnotation) error {
+	key := workflowFailureKey(run.Name, annotation)
+
+	// the failures are resolved by a successful run, see resolveWorkflowFailures
+	return gi.ensureAlert(repo, trackedAlert{
+		metaKey:   metaKeyWorkflowFailure,
+		metaValue: key,
+		updatedAt: run.UpdatedAt,
+		statusMetadata: map[string]string{
+			metaKeyGithubId: run.NodeID,
+		},
+		create: func() (*cache.BugCache, error) {
+			return gi.createWorkflowFailureBug(repo, run, annotation, key)
+		},
+	})
+}
+
+// createWorkflowFailureBug create the bug tracking a failure
+func (gi *githubImporter) createWorkflowFailureBug(repo *cache.RepoCache, run workflowRun, annotation checkAnnotation, key string) (*cache.BugCache, error) {
+	// the API doesn't give a human author
+	author, err := gi.getGhost(repo)
+	if err != nil {
+		return nil, err
+	}
+
+	message := annotation.Message + "\n"
+	if annotation.Path != "" {
+		message += fmt.Sprintf("\nFile: %s:%d", annotation.Path, annotation.StartLine)
+	}
+	message += fmt.Sprintf("\nRun: %s", run.HTMLURL)
+
+	cleanText, err := text.Cleanup(message)
+	if err != nil {
+		return nil, err
+	}
+
+	// the same inputs as workflowFailureKey, so that the key is the bug id
+	b, err := repo.NewBugWithID(metaKeyWorkflowFailure, run.Name+"\n"+annotation.Message, cache.BugCreateArgs{
+		Author:   author,
+		UnixTime: run.UpdatedAt.Unix(),
+		Title:    workflowFailureTitle(run.Name, annotation),
+		Message:  cleanText,
+		Metadata: map[string]string{
+			core.MetaKeyOrigin:     workflowFailureOrigin,
+			metaKeyWorkflowFailure: key,
+			metaKeyWorkflow:        run.Name,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	_, _, err = b.ChangeLabelsRaw(author, run.UpdatedAt.Unix(), []string{labelCIFailure}, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	gi.out <- core.NewImportBug(b.Id())
+	return b, nil
+}
+
+// resolveWorkflowFailures close the open bugs created from the failures of
+// the workflow of a successful run
+func (gi *githubImporter) resolveWorkflowFailures(repo *cache.RepoCache, run workflowRun) error {
+	for _, id := range repo.AllBugsIds() {
+		excerpt, err := repo.ResolveBugExcerpt(id)
+		if err != nil {
+			return err
+		}
+
+		if excerpt.Status != bug.OpenStatus || excerpt.CreateMetadata[metaKeyWorkflow] != run.Name {
+			continue
+		}
+
+		b, err := repo.ResolveBug(id)
+		if err != nil {
+			return err
+		}
+
+		if !run.UpdatedAt.After(lastStatusChange(b.Snapshot())) {
+			continue
+		}
+
+		author, err := gi.getGhost(repo)
+		if err != nil {
+			return err
+		}
+
+		op, err := b.CloseRaw(author, run.UpdatedAt.Unix(), map[string]string{
+			metaKeyGithubId: run.NodeID,
+		})
+		if err != nil {
+			return err
+		}
+
+		gi.out <- core.NewImportStatusChange(op.Id())
+		if err := b.CommitAsNeeded(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
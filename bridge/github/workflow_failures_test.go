@@ -0,0 +1,77 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkflowFailureKey(t *testing.T) {
+	a := checkAnnotation{Message: "test failed", Path: "a.go", StartLine: 12}
+	b := checkAnnotation{Message: "test failed", Path: "b.go", StartLine: 3}
+
+	// the same failure in the same workflow is the same bug
+	require.Equal(t, workflowFailureKey("CI", a), workflowFailureKey("CI", b))
+	require.NotEqual(t, workflowFailureKey("CI", a), workflowFailureKey("Release", a))
+}
+
+func TestWorkflowFailureTitle(t *testing.T) {
+	require.Equal(t, "CI failure in CI: test failed",
+		workflowFailureTitle("CI", checkAnnotation{Message: "test failed\nmore details"}))
+	require.Equal(t, "CI failure in CI: TestFoo",
+		workflowFailureTitle("CI", checkAnnotation{Title: "TestFoo", Message: "test failed"}))
+
+	long := workflowFailureTitle("CI", checkAnnotation{Message: strings.Repeat("a", 200)})
+	require.Len(t, []rune(long), maxWorkflowTitleLen)
+}
+
+func TestListWorkflowFailures(t *testing.T) {
+	now := time.Now().UTC().Truncate(time.Second)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/repos/a/b/actions/runs", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "completed", r.URL.Query().Get("status"))
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"workflow_runs": []workflowRun{
+				{ID: 3, Name: "CI", Conclusion: "success", UpdatedAt: now},
+				{ID: 2, Name: "CI", Conclusion: "failure", CheckSuiteID: 20, UpdatedAt: now.Add(-time.Hour)},
+				{ID: 1, Name: "CI", Conclusion: "failure", UpdatedAt: now.Add(-48 * time.Hour)},
+			},
+		})
+	})
+	mux.HandleFunc("/repos/a/b/check-suites/20/check-runs", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"check_runs": []map[string]interface{}{
+				{"id": 200, "output": map[string]interface{}{"annotations_count": 2}},
+				{"id": 201, "output": map[string]interface{}{"annotations_count": 0}},
+			},
+		})
+	})
+	mux.HandleFunc("/repos/a/b/check-runs/200/annotations", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode([]checkAnnotation{
+			{AnnotationLevel: "warning", Message: "deprecated"},
+			{AnnotationLevel: "failure", Message: "test failed"},
+		})
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	ctx := context.Background()
+
+	runs, err := listWorkflowRuns(ctx, server.URL, "token", "a", "b", now.Add(-24*time.Hour))
+	require.NoError(t, err)
+	require.Len(t, runs, 2)
+	// oldest first
+	require.Equal(t, int64(2), runs[0].ID)
+	require.Equal(t, int64(3), runs[1].ID)
+
+	annotations, err := listFailureAnnotations(ctx, server.URL, "token", "a", "b", runs[0])
+	require.NoError(t, err)
+	require.Equal(t, []checkAnnotation{{AnnotationLevel: "failure", Message: "test failed"}}, annotations)
+}
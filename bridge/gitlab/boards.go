@@ -0,0 +1,67 @@
+package gitlab
+
+import (
+	"context"
+	"sort"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/MichaelMure/git-bug/cache"
+)
+
+// listBoards query the issue boards of the project
+func (gi *gitlabImporter) listBoards(ctx context.Context) ([]*gitlab.IssueBoard, error) {
+	ctx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	var boards []*gitlab.IssueBoard
+	err := retryableRequest(func() (resp *gitlab.Response, err error) {
+		boards, resp, err = gi.client.Boards.ListIssueBoards(gi.conf[keyProjectID], &gitlab.ListIssueBoardsOptions{}, gitlab.WithContext(ctx))
+		return resp, err
+	}, maxRetries(gi.conf))
+
+	return boards, err
+}
+
+// importBoards store the issue boards of the project, with the label of
+// each of their lists as columns. The Open and Closed lists of Gitlab are
+// not backed by a label, so they are not included.
+func (gi *gitlabImporter) importBoards(ctx context.Context, repo *cache.RepoCache) error {
+	boards, err := gi.listBoards(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, b := range boards {
+		err := repo.StoreBoard(boardFromGitlab(b))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// boardFromGitlab convert an issue board, ordering its columns by position
+func boardFromGitlab(b *gitlab.IssueBoard) cache.Board {
+	lists := make([]*gitlab.BoardList, 0, len(b.Lists))
+	for _, list := range b.Lists {
+		if list.Label != nil {
+			lists = append(lists, list)
+		}
+	}
+
+	sort.Slice(lists, func(i, j int) bool {
+		return lists[i].Position < lists[j].Position
+	})
+
+	board := cache.Board{
+		Name:    b.Name,
+		Columns: make([]string, len(lists)),
+	}
+	for i, list := range lists {
+		board.Columns[i] = list.Label.Name
+	}
+
+	return board
+}
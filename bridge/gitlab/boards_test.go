@@ -0,0 +1,60 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/MichaelMure/git-bug/bridge/core"
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestImportBoards(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v4/projects/123/boards", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{
+			"id": 1,
+			"name": "Development",
+			"lists": [
+				{"id": 3, "label": {"name": "review"}, "position": 2},
+				{"id": 2, "label": {"name": "doing"}, "position": 1},
+				{"id": 1, "label": {"name": "todo"}, "position": 0},
+				{"id": 4, "position": 3}
+			]
+		}]`)
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client := gitlab.NewClient(server.Client(), "token")
+	require.NoError(t, client.SetBaseURL(server.URL))
+
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	backend, err := cache.NewRepoCache(repo)
+	require.NoError(t, err)
+	defer backend.Close()
+
+	gi := &gitlabImporter{
+		conf:   core.Configuration{keyProjectID: "123"},
+		client: client,
+	}
+
+	err = gi.importBoards(context.Background(), backend)
+	require.NoError(t, err)
+
+	boards, err := backend.Boards()
+	require.NoError(t, err)
+	require.Equal(t, []cache.Board{{
+		Name:    "Development",
+		Columns: []string{"todo", "doing", "review"},
+	}}, boards)
+}
@@ -53,6 +53,8 @@ func (g *Gitlab) Configure(repo *cache.RepoCache, params core.BridgeParams) (cor
 	switch {
 	case params.URL != "":
 		url = params.URL
+	case params.NonInteractive:
+		return nil, core.ErrMissingParam("url")
 	default:
 		// terminal prompt
 		url, err = promptURL(repo)
@@ -89,6 +91,8 @@ func (g *Gitlab) Configure(repo *cache.RepoCache, params core.BridgeParams) (cor
 		}
 	case params.TokenRaw != "":
 		cred = auth.NewToken(userId, params.TokenRaw, target)
+	case params.NonInteractive:
+		return nil, core.ErrMissingParam("token or credential")
 	default:
 		cred, err = promptTokenOptions(repo, userId)
 		if err != nil {
@@ -102,15 +106,61 @@ func (g *Gitlab) Configure(repo *cache.RepoCache, params core.BridgeParams) (cor
 	}
 
 	// validate project url and get its ID
-	id, err := validateProjectURL(params.BaseURL, url, token)
+	project, err := validateProjectURL(params.BaseURL, url, token)
 	if err != nil {
 		return nil, errors.Wrap(err, "project validation")
 	}
 
+	// the keys only help to verify the authorship of the operations, failing
+	// to fetch them doesn't prevent the configuration
+	if user != nil {
+		keys, err := fetchUserKeys(params.BaseURL, token)
+		if err == nil {
+			err = user.AddKeys(keys...)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
 	conf[core.ConfigKeyTarget] = target
-	conf[keyProjectID] = strconv.Itoa(id)
+	conf[keyProjectID] = strconv.Itoa(project.ID)
 	conf[keyGitlabBaseUrl] = params.BaseURL
 
+	// Epics only exist at the group level, so only offer to import them
+	// if the project belong to a group, and only in interactive mode.
+	interactive := params.CredPrefix == "" && params.TokenRaw == "" && !params.NonInteractive
+	if interactive && project.Namespace != nil && project.Namespace.Kind == "group" {
+		importEpics, err := promptImportEpics()
+		if err != nil {
+			return nil, err
+		}
+		if importEpics {
+			conf[keyGroupPath] = project.Namespace.FullPath
+			conf[keyImportEpics] = "true"
+		}
+	}
+
+	if params.ImportIterations {
+		conf[keyImportIterations] = "true"
+	}
+
+	if params.ImportMRComments {
+		conf[keyImportMRComments] = "true"
+	}
+
+	if params.ImportBoards {
+		conf[keyImportBoards] = "true"
+	}
+
+	if params.ImportPipelineStatus {
+		conf[keyImportPipelineStatus] = "true"
+	}
+
+	if params.MaxRetries >= 0 && params.MaxRetries != defaultMaxRetries {
+		conf[keyMaxRetries] = strconv.Itoa(params.MaxRetries)
+	}
+
 	err = g.ValidateConfig(conf)
 	if err != nil {
 		return nil, err
@@ -138,6 +188,16 @@ func (g *Gitlab) ValidateConfig(conf core.Configuration) error {
 		return fmt.Errorf("missing %s key", keyProjectID)
 	}
 
+	if conf[keyImportEpics] == "true" && conf[keyGroupPath] == "" {
+		return fmt.Errorf("missing %s key", keyGroupPath)
+	}
+
+	if v, ok := conf[keyMaxRetries]; ok {
+		if n, err := strconv.Atoi(v); err != nil || n < 0 {
+			return fmt.Errorf("invalid %s key: %s", keyMaxRetries, v)
+		}
+	}
+
 	return nil
 }
 
@@ -232,6 +292,26 @@ func promptToken() (string, error) {
 	}
 }
 
+func promptImportEpics() (bool, error) {
+	for {
+		fmt.Print("Import the Epics of the group? [y/N]: ")
+
+		line, err := bufio.NewReader(os.Stdin).ReadString('\n')
+		if err != nil {
+			return false, err
+		}
+
+		switch strings.ToLower(strings.TrimSpace(line)) {
+		case "y", "yes":
+			return true, nil
+		case "", "n", "no":
+			return false, nil
+		default:
+			fmt.Println("invalid input")
+		}
+	}
+}
+
 func promptURL(repo repository.RepoCommon) (string, error) {
 	// remote suggestions
 	remotes, err := repo.GetRemotes()
@@ -318,21 +398,51 @@ func getValidGitlabRemoteURLs(remotes map[string]string) []string {
 	return urls
 }
 
-func validateProjectURL(baseURL, url string, token *auth.Token) (int, error) {
+// fetchUserKeys read the public SSH keys of the user owning the token
+func fetchUserKeys(baseURL string, token *auth.Token) ([]identity.Key, error) {
+	client, err := buildClient(baseURL, token, defaultTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	user, _, err := client.Users.CurrentUser()
+	if err != nil {
+		return nil, err
+	}
+
+	userKeys, _, err := client.Users.ListSSHKeysForUser(user.ID, &gitlab.ListSSHKeysForUserOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	var keys []identity.Key
+	for _, userKey := range userKeys {
+		key, err := identity.NewSSHKey(userKey.Key)
+		if err != nil {
+			// not a key format we know about
+			continue
+		}
+		keys = append(keys, key)
+	}
+
+	return keys, nil
+}
+
+func validateProjectURL(baseURL, url string, token *auth.Token) (*gitlab.Project, error) {
 	projectPath, err := getProjectPath(url)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 
-	client, err := buildClient(baseURL, token)
+	client, err := buildClient(baseURL, token, defaultTimeout)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 
 	project, _, err := client.Projects.GetProject(projectPath, &gitlab.GetProjectOptions{})
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 
-	return project.ID, nil
+	return project, nil
 }
@@ -0,0 +1,214 @@
+package gitlab
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/MichaelMure/git-bug/bridge/core"
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/util/git"
+)
+
+// designsQuery list the designs of an issue. Only the designs of the latest
+// version of the collection are returned, deleted ones excluded.
+const designsQuery = `query($fullPath: ID!, $iid: String!) {
+  project(fullPath: $fullPath) {
+    issue(iid: $iid) {
+      designCollection {
+        designs {
+          nodes {
+            id
+            filename
+            image
+            versions {
+              nodes {
+                id
+                createdAt
+              }
+            }
+          }
+        }
+      }
+    }
+  }
+}`
+
+// design is a file attached to an issue with the Design Management feature
+type design struct {
+	ID       string `json:"id"`
+	Filename string `json:"filename"`
+	Image    string `json:"image"`
+	Versions struct {
+		Nodes []designVersion `json:"nodes"`
+	} `json:"versions"`
+}
+
+// designVersion is a version of a design, each upload of the design file
+// creating a new one
+type designVersion struct {
+	ID        string    `json:"id"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// latestVersion return the most recent version of a design, or false if the
+// design has none
+func (d *design) latestVersion() (designVersion, bool) {
+	var latest designVersion
+	found := false
+
+	for _, version := range d.Versions.Nodes {
+		if !found || version.CreatedAt.After(latest.CreatedAt) {
+			latest = version
+			found = true
+		}
+	}
+
+	return latest, found
+}
+
+// designsEnabled return true if the project can hold designs. Design
+// Management store the files with Git LFS, so it's not available without it.
+func designsEnabled(project *gitlab.Project) bool {
+	return project != nil && project.LFSEnabled
+}
+
+// listDesigns query the designs of an issue. They are not available through
+// the REST API, so this use the GraphQL API.
+func (gi *gitlabImporter) listDesigns(ctx context.Context, project *gitlab.Project, issue *gitlab.Issue) ([]*design, error) {
+	ctx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	body, err := json.Marshal(map[string]interface{}{
+		"query": designsQuery,
+		"variables": map[string]string{
+			"fullPath": project.PathWithNamespace,
+			"iid":      strconv.Itoa(issue.IID),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	u := strings.TrimSuffix(gi.conf[keyGitlabBaseUrl], "/") + "/api/graphql"
+	req, err := http.NewRequest("POST", u, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Authorization", "Bearer "+gi.token.Value)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := core.NewHTTPClient(0).Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("querying the designs: unexpected status %s", resp.Status)
+	}
+
+	var result struct {
+		Data struct {
+			Project *struct {
+				Issue *struct {
+					DesignCollection *struct {
+						Designs struct {
+							Nodes []*design `json:"nodes"`
+						} `json:"designs"`
+					} `json:"designCollection"`
+				} `json:"issue"`
+			} `json:"project"`
+		} `json:"data"`
+		Errors []struct {
+			Message string `json:"message"`
+		} `json:"errors"`
+	}
+
+	err = json.NewDecoder(resp.Body).Decode(&result)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(result.Errors) > 0 {
+		return nil, fmt.Errorf("querying the designs: %s", result.Errors[0].Message)
+	}
+
+	p := result.Data.Project
+	if p == nil || p.Issue == nil || p.Issue.DesignCollection == nil {
+		return nil, nil
+	}
+
+	return p.Issue.DesignCollection.Designs.Nodes, nil
+}
+
+// ensureDesigns import the latest version of the designs of an issue as
+// comments with the design file attached. A new version of a design is
+// imported as a new comment.
+func (gi *gitlabImporter) ensureDesigns(ctx context.Context, repo *cache.RepoCache, b *cache.BugCache, project *gitlab.Project, issue *gitlab.Issue) error {
+	if !designsEnabled(project) {
+		return nil
+	}
+
+	designs, err := gi.listDesigns(ctx, project, issue)
+	if err != nil {
+		return err
+	}
+
+	if len(designs) == 0 {
+		return nil
+	}
+
+	// the API doesn't tell who uploaded the design
+	author, err := gi.ensurePerson(repo, issue.Author.ID)
+	if err != nil {
+		return err
+	}
+
+	for _, d := range designs {
+		version, ok := d.latestVersion()
+		if !ok {
+			continue
+		}
+
+		_, err := b.ResolveOperationWithMetadata(metaKeyGitlabDesignVersion, version.ID)
+		if err == nil {
+			continue
+		}
+		if err != cache.ErrNoMatchingOp {
+			return err
+		}
+
+		hash, err := gi.downloadFile(ctx, repo, d.Image)
+		if err != nil {
+			return err
+		}
+
+		unixTime := issue.UpdatedAt.Unix()
+		if !version.CreatedAt.IsZero() {
+			unixTime = version.CreatedAt.Unix()
+		}
+
+		message := fmt.Sprintf("Design: %s", d.Filename)
+
+		op, err := b.AddCommentRaw(author, unixTime, message, []git.Hash{hash}, map[string]string{
+			metaKeyGitlabDesignId:      d.ID,
+			metaKeyGitlabDesignVersion: version.ID,
+		})
+		if err != nil {
+			return err
+		}
+
+		gi.out <- core.NewImportComment(op.Id())
+	}
+
+	return nil
+}
@@ -0,0 +1,32 @@
+package gitlab
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/xanzy/go-gitlab"
+)
+
+func TestDesignLatestVersion(t *testing.T) {
+	d := &design{}
+	_, ok := d.latestVersion()
+	require.False(t, ok)
+
+	now := time.Now()
+	d.Versions.Nodes = []designVersion{
+		{ID: "v2", CreatedAt: now.Add(-time.Hour)},
+		{ID: "v3", CreatedAt: now},
+		{ID: "v1", CreatedAt: now.Add(-2 * time.Hour)},
+	}
+
+	version, ok := d.latestVersion()
+	require.True(t, ok)
+	require.Equal(t, "v3", version.ID)
+}
+
+func TestDesignsEnabled(t *testing.T) {
+	require.False(t, designsEnabled(nil))
+	require.False(t, designsEnabled(&gitlab.Project{LFSEnabled: false}))
+	require.True(t, designsEnabled(&gitlab.Project{LFSEnabled: true}))
+}
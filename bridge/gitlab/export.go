@@ -3,6 +3,7 @@ package gitlab
 import (
 	"context"
 	"fmt"
+	"net/url"
 	"strconv"
 	"time"
 
@@ -31,9 +32,18 @@ type gitlabExporter struct {
 	// gitlab repository ID
 	repositoryID string
 
+	// number of retries of the API calls failing with a transient error
+	maxRetries int
+
 	// cache identifiers used to speed up exporting operations
 	// cleared for each bug
 	cachedOperationIDs map[string]string
+
+	// metadata of the local labels, by name
+	labelMetadata map[string]cache.LabelMetadata
+
+	// labels existing in the project, loaded on the first label change
+	gitlabLabels map[string]bool
 }
 
 // Init .
@@ -44,6 +54,7 @@ func (ge *gitlabExporter) Init(repo *cache.RepoCache, conf core.Configuration) e
 
 	// get repository node id
 	ge.repositoryID = ge.conf[keyProjectID]
+	ge.maxRetries = maxRetries(ge.conf)
 
 	// preload all clients
 	err := ge.cacheAllClient(repo)
@@ -51,6 +62,15 @@ func (ge *gitlabExporter) Init(repo *cache.RepoCache, conf core.Configuration) e
 		return err
 	}
 
+	allMeta, err := repo.AllLabelMetadata()
+	if err != nil {
+		return err
+	}
+	ge.labelMetadata = make(map[string]cache.LabelMetadata, len(allMeta))
+	for _, meta := range allMeta {
+		ge.labelMetadata[meta.Name] = meta
+	}
+
 	return nil
 }
 
@@ -62,7 +82,7 @@ func (ge *gitlabExporter) cacheAllClient(repo repository.RepoConfig) error {
 
 	for _, cred := range creds {
 		if _, ok := ge.identityClient[cred.UserId()]; !ok {
-			client, err := buildClient(ge.conf[keyGitlabBaseUrl], creds[0].(*auth.Token))
+			client, err := buildClient(ge.conf[keyGitlabBaseUrl], creds[0].(*auth.Token), core.Timeout(ge.conf))
 			if err != nil {
 				return err
 			}
@@ -96,16 +116,16 @@ func (ge *gitlabExporter) ExportAll(ctx context.Context, repo *cache.RepoCache,
 			allIdentitiesIds = append(allIdentitiesIds, id)
 		}
 
-		allBugsIds := repo.AllBugsIds()
+		it := repo.QueryBugsIter(core.ExportQuery(ge.conf))
 
-		for _, id := range allBugsIds {
+		for it.Next() {
 			select {
 			case <-ctx.Done():
 				return
 			default:
-				b, err := repo.ResolveBug(id)
-				if err != nil {
-					out <- core.NewExportError(err, id)
+				b := it.Value()
+				if b == nil {
+					out <- core.NewExportError(it.Err(), it.Excerpt().Id)
 					return
 				}
 
@@ -144,6 +164,12 @@ func (ge *gitlabExporter) exportBug(ctx context.Context, b *cache.BugCache, sinc
 	// if a user try to export a bug that is not already exported to Gitlab (or imported
 	// from Gitlab) and we do not have the token of the bug author, there is nothing we can do.
 
+	// skip bug imported from another bug tracker
+	if source := snapshot.Source(); source != nil && source.Target != target {
+		out <- core.NewExportNothing(b.Id(), fmt.Sprintf("issue imported from: %s", source.Target))
+		return
+	}
+
 	// skip bug if origin is not allowed
 	origin, ok := snapshot.GetCreateMetadata(core.MetaKeyOrigin)
 	if ok && origin != target {
@@ -151,6 +177,12 @@ func (ge *gitlabExporter) exportBug(ctx context.Context, b *cache.BugCache, sinc
 		return
 	}
 
+	// the epics are only imported
+	if isEpic(snapshot) {
+		out <- core.NewExportNothing(b.Id(), "skipping epic")
+		return
+	}
+
 	// first operation is always createOp
 	createOp := snapshot.Operations[0].(*bug.CreateOperation)
 	author := snapshot.Author
@@ -194,7 +226,8 @@ func (ge *gitlabExporter) exportBug(ctx context.Context, b *cache.BugCache, sinc
 		}
 
 		// create bug
-		_, id, url, err := createGitlabIssue(ctx, client, ge.repositoryID, createOp.Title, createOp.Message)
+		issueType, _ := snapshot.GetCreateMetadata(metaKeyGitlabIssueType)
+		_, id, url, err := createGitlabIssue(ctx, client, ge.repositoryID, createOp.Title, createOp.Message, issueType)
 		if err != nil {
 			err := errors.Wrap(err, "exporting gitlab issue")
 			out <- core.NewExportError(err, b.Id())
@@ -204,6 +237,15 @@ func (ge *gitlabExporter) exportBug(ctx context.Context, b *cache.BugCache, sinc
 		idString := strconv.Itoa(id)
 		out <- core.NewExportBug(b.Id())
 
+		if title, ok := snapshot.GetCreateMetadata(metaKeyGitlabIteration); ok {
+			err := ge.exportIteration(ctx, client, id, title)
+			if err != nil {
+				err := errors.Wrap(err, "exporting iteration")
+				out <- core.NewExportError(err, b.Id())
+				return
+			}
+		}
+
 		_, err = b.SetMetadata(
 			createOp.Id(),
 			map[string]string{
@@ -237,11 +279,33 @@ func (ge *gitlabExporter) exportBug(ctx context.Context, b *cache.BugCache, sinc
 
 	labelSet := make(map[string]struct{})
 	for _, op := range snapshot.Operations[1:] {
-		// ignore SetMetadata operations
-		if _, ok := op.(*bug.SetMetadataOperation); ok {
+		// ignore SetMetadata and StripMetadata operations
+		switch op.(type) {
+		case *bug.SetMetadataOperation, *bug.StripMetadataOperation, *bug.EditAuthorOperation:
+			continue
+		}
+
+		// links between bugs, due dates, pins and cross references are not
+		// exported by this bridge
+		switch op.(type) {
+		case *bug.LinkOperation, *bug.SetDueDateOperation, *bug.PinOperation, *bug.CrossRefOperation:
 			continue
 		}
 
+		// NoOp are only exported when carrying a health status, a lock or a
+		// weight. The weight events come from gitlab.
+		if op, ok := op.(*bug.NoOpOperation); ok {
+			_, hasStatus := op.GetMetadata(MetaKeyHealthStatus)
+			_, hasLock := op.GetMetadata(cache.MetaKeyLocked)
+			_, hasWeight := op.GetMetadata(MetaKeyWeight)
+			if !hasStatus && !hasLock && !hasWeight {
+				continue
+			}
+			if _, ok := op.GetMetadata(metaKeyGitlabWeightEvent); ok {
+				continue
+			}
+		}
+
 		// ignore operations already existing in gitlab (due to import or export)
 		// cache the ID of already exported or imported issues and events from Gitlab
 		if id, ok := op.GetMetadata(metaKeyGitlabId); ok {
@@ -281,7 +345,7 @@ func (ge *gitlabExporter) exportBug(ctx context.Context, b *cache.BugCache, sinc
 			if targetId == bugCreationId {
 
 				// case bug creation operation: we need to edit the Gitlab issue
-				if err := updateGitlabIssueBody(ctx, client, ge.repositoryID, bugGitlabID, op.Message); err != nil {
+				if err := updateGitlabIssueBody(ctx, client, ge.maxRetries, ge.repositoryID, bugGitlabID, op.Message); err != nil {
 					err := errors.Wrap(err, "editing issue")
 					out <- core.NewExportError(err, b.Id())
 					return
@@ -305,7 +369,7 @@ func (ge *gitlabExporter) exportBug(ctx context.Context, b *cache.BugCache, sinc
 					return
 				}
 
-				if err := editCommentGitlabIssue(ctx, client, ge.repositoryID, bugGitlabID, commentIDint, op.Message); err != nil {
+				if err := editCommentGitlabIssue(ctx, client, ge.maxRetries, ge.repositoryID, bugGitlabID, commentIDint, op.Message); err != nil {
 					err := errors.Wrap(err, "editing comment")
 					out <- core.NewExportError(err, b.Id())
 					return
@@ -316,7 +380,7 @@ func (ge *gitlabExporter) exportBug(ctx context.Context, b *cache.BugCache, sinc
 			}
 
 		case *bug.SetStatusOperation:
-			if err := updateGitlabIssueStatus(ctx, client, ge.repositoryID, bugGitlabID, op.Status); err != nil {
+			if err := updateGitlabIssueStatus(ctx, client, ge.maxRetries, ge.repositoryID, bugGitlabID, op.Status); err != nil {
 				err := errors.Wrap(err, "editing status")
 				out <- core.NewExportError(err, b.Id())
 				return
@@ -326,7 +390,7 @@ func (ge *gitlabExporter) exportBug(ctx context.Context, b *cache.BugCache, sinc
 			id = bugGitlabID
 
 		case *bug.SetTitleOperation:
-			if err := updateGitlabIssueTitle(ctx, client, ge.repositoryID, bugGitlabID, op.Title); err != nil {
+			if err := updateGitlabIssueTitle(ctx, client, ge.maxRetries, ge.repositoryID, bugGitlabID, op.Title); err != nil {
 				err := errors.Wrap(err, "editing title")
 				out <- core.NewExportError(err, b.Id())
 				return
@@ -352,7 +416,13 @@ func (ge *gitlabExporter) exportBug(ctx context.Context, b *cache.BugCache, sinc
 				labels = append(labels, key)
 			}
 
-			if err := updateGitlabIssueLabels(ctx, client, ge.repositoryID, bugGitlabID, labels); err != nil {
+			if err := ge.ensureGitlabLabels(ctx, client, op.Added); err != nil {
+				err := errors.Wrap(err, "creating labels")
+				out <- core.NewExportError(err, b.Id())
+				return
+			}
+
+			if err := updateGitlabIssueLabels(ctx, client, ge.maxRetries, ge.repositoryID, bugGitlabID, labels); err != nil {
 				err := errors.Wrap(err, "updating labels")
 				out <- core.NewExportError(err, b.Id())
 				return
@@ -360,6 +430,56 @@ func (ge *gitlabExporter) exportBug(ctx context.Context, b *cache.BugCache, sinc
 
 			out <- core.NewExportLabelChange(op.Id())
 			id = bugGitlabID
+
+		case *bug.SetLabelsOperation:
+			labelSet = make(map[string]struct{})
+			labels := make([]string, len(op.Labels))
+			for i, label := range op.Labels {
+				labelSet[label.String()] = struct{}{}
+				labels[i] = label.String()
+			}
+
+			if err := ge.ensureGitlabLabels(ctx, client, op.Labels); err != nil {
+				err := errors.Wrap(err, "creating labels")
+				out <- core.NewExportError(err, b.Id())
+				return
+			}
+
+			if err := updateGitlabIssueLabels(ctx, client, ge.maxRetries, ge.repositoryID, bugGitlabID, labels); err != nil {
+				err := errors.Wrap(err, "updating labels")
+				out <- core.NewExportError(err, b.Id())
+				return
+			}
+
+			out <- core.NewExportLabelChange(op.Id())
+			id = bugGitlabID
+
+		case *bug.NoOpOperation:
+			if status, ok := op.GetMetadata(MetaKeyHealthStatus); ok {
+				if err := updateGitlabIssueHealthStatus(ctx, client, ge.maxRetries, ge.repositoryID, bugGitlabID, status); err != nil {
+					err := errors.Wrap(err, "updating health status")
+					out <- core.NewExportError(err, b.Id())
+					return
+				}
+			}
+
+			if locked, ok := op.GetMetadata(cache.MetaKeyLocked); ok {
+				if err := updateGitlabIssueLocked(ctx, client, ge.maxRetries, ge.repositoryID, bugGitlabID, locked == "true"); err != nil {
+					err := errors.Wrap(err, "updating locked discussion")
+					out <- core.NewExportError(err, b.Id())
+					return
+				}
+			}
+
+			if weight, ok := op.GetMetadata(MetaKeyWeight); ok {
+				if err := updateGitlabIssueWeight(ctx, client, ge.maxRetries, ge.repositoryID, bugGitlabID, weight); err != nil {
+					err := errors.Wrap(err, "updating weight")
+					out <- core.NewExportError(err, b.Id())
+					return
+				}
+			}
+
+			id = bugGitlabID
 		default:
 			panic("unhandled operation type case")
 		}
@@ -399,18 +519,57 @@ func markOperationAsExported(b *cache.BugCache, target entity.Id, gitlabID, gitl
 	return err
 }
 
-// create a gitlab. issue and return it ID
-func createGitlabIssue(ctx context.Context, gc *gitlab.Client, repositoryID, title, body string) (int, int, string, error) {
+// exportIteration assign the issue to the iteration with the given title,
+// if it exist in Gitlab
+func (ge *gitlabExporter) exportIteration(ctx context.Context, gc *gitlab.Client, issueID int, title string) error {
+	it, err := findIteration(ctx, gc, ge.maxRetries, ge.repositoryID, title)
+	if err != nil {
+		return err
+	}
+	if it == nil {
+		return nil
+	}
+	return setGitlabIssueIteration(ctx, gc, ge.repositoryID, issueID, it)
+}
+
+// create a gitlab. issue and return it ID. As a server error can happen
+// after the issue is created, this is not retried to avoid duplicates.
+func createGitlabIssue(ctx context.Context, gc *gitlab.Client, repositoryID, title, body, issueType string) (int, int, string, error) {
 	ctx, cancel := context.WithTimeout(ctx, defaultTimeout)
 	defer cancel()
-	issue, _, err := gc.Issues.CreateIssue(
-		repositoryID,
-		&gitlab.CreateIssueOptions{
-			Title:       &title,
-			Description: &body,
-		},
-		gitlab.WithContext(ctx),
-	)
+
+	opt := &gitlab.CreateIssueOptions{
+		Title:       &title,
+		Description: &body,
+	}
+
+	if !isIssueType(issueType) {
+		issue, _, err := gc.Issues.CreateIssue(repositoryID, opt, gitlab.WithContext(ctx))
+		if err != nil {
+			return 0, 0, "", err
+		}
+
+		return issue.ID, issue.IID, issue.WebURL, nil
+	}
+
+	// the issue type is not covered by the gitlab client so the request
+	// is built manually
+	typedOpt := struct {
+		*gitlab.CreateIssueOptions
+		IssueType *string `json:"issue_type,omitempty"`
+	}{
+		CreateIssueOptions: opt,
+		IssueType:          &issueType,
+	}
+
+	u := fmt.Sprintf("projects/%s/issues", url.PathEscape(repositoryID))
+	req, err := gc.NewRequest("POST", u, typedOpt, []gitlab.OptionFunc{gitlab.WithContext(ctx)})
+	if err != nil {
+		return 0, 0, "", err
+	}
+
+	issue := new(gitlab.Issue)
+	_, err = gc.Do(req, issue)
 	if err != nil {
 		return 0, 0, "", err
 	}
@@ -418,7 +577,8 @@ func createGitlabIssue(ctx context.Context, gc *gitlab.Client, repositoryID, tit
 	return issue.ID, issue.IID, issue.WebURL, nil
 }
 
-// add a comment to an issue and return it ID
+// add a comment to an issue and return it ID. As a server error can happen
+// after the comment is created, this is not retried to avoid duplicates.
 func addCommentGitlabIssue(ctx context.Context, gc *gitlab.Client, repositoryID string, issueID int, body string) (int, error) {
 	ctx, cancel := context.WithTimeout(ctx, defaultTimeout)
 	defer cancel()
@@ -436,21 +596,22 @@ func addCommentGitlabIssue(ctx context.Context, gc *gitlab.Client, repositoryID
 	return note.ID, nil
 }
 
-func editCommentGitlabIssue(ctx context.Context, gc *gitlab.Client, repositoryID string, issueID, noteID int, body string) error {
+func editCommentGitlabIssue(ctx context.Context, gc *gitlab.Client, maxRetries int, repositoryID string, issueID, noteID int, body string) error {
 	ctx, cancel := context.WithTimeout(ctx, defaultTimeout)
 	defer cancel()
-	_, _, err := gc.Notes.UpdateIssueNote(
-		repositoryID, issueID, noteID,
-		&gitlab.UpdateIssueNoteOptions{
-			Body: &body,
-		},
-		gitlab.WithContext(ctx),
-	)
-
-	return err
+	return retryableRequest(func() (*gitlab.Response, error) {
+		_, resp, err := gc.Notes.UpdateIssueNote(
+			repositoryID, issueID, noteID,
+			&gitlab.UpdateIssueNoteOptions{
+				Body: &body,
+			},
+			gitlab.WithContext(ctx),
+		)
+		return resp, err
+	}, maxRetries)
 }
 
-func updateGitlabIssueStatus(ctx context.Context, gc *gitlab.Client, repositoryID string, issueID int, status bug.Status) error {
+func updateGitlabIssueStatus(ctx context.Context, gc *gitlab.Client, maxRetries int, repositoryID string, issueID int, status bug.Status) error {
 	var state string
 
 	switch status {
@@ -464,57 +625,61 @@ func updateGitlabIssueStatus(ctx context.Context, gc *gitlab.Client, repositoryI
 
 	ctx, cancel := context.WithTimeout(ctx, defaultTimeout)
 	defer cancel()
-	_, _, err := gc.Issues.UpdateIssue(
-		repositoryID, issueID,
-		&gitlab.UpdateIssueOptions{
-			StateEvent: &state,
-		},
-		gitlab.WithContext(ctx),
-	)
-
-	return err
+	return retryableRequest(func() (*gitlab.Response, error) {
+		_, resp, err := gc.Issues.UpdateIssue(
+			repositoryID, issueID,
+			&gitlab.UpdateIssueOptions{
+				StateEvent: &state,
+			},
+			gitlab.WithContext(ctx),
+		)
+		return resp, err
+	}, maxRetries)
 }
 
-func updateGitlabIssueBody(ctx context.Context, gc *gitlab.Client, repositoryID string, issueID int, body string) error {
+func updateGitlabIssueBody(ctx context.Context, gc *gitlab.Client, maxRetries int, repositoryID string, issueID int, body string) error {
 	ctx, cancel := context.WithTimeout(ctx, defaultTimeout)
 	defer cancel()
-	_, _, err := gc.Issues.UpdateIssue(
-		repositoryID, issueID,
-		&gitlab.UpdateIssueOptions{
-			Description: &body,
-		},
-		gitlab.WithContext(ctx),
-	)
-
-	return err
+	return retryableRequest(func() (*gitlab.Response, error) {
+		_, resp, err := gc.Issues.UpdateIssue(
+			repositoryID, issueID,
+			&gitlab.UpdateIssueOptions{
+				Description: &body,
+			},
+			gitlab.WithContext(ctx),
+		)
+		return resp, err
+	}, maxRetries)
 }
 
-func updateGitlabIssueTitle(ctx context.Context, gc *gitlab.Client, repositoryID string, issueID int, title string) error {
+func updateGitlabIssueTitle(ctx context.Context, gc *gitlab.Client, maxRetries int, repositoryID string, issueID int, title string) error {
 	ctx, cancel := context.WithTimeout(ctx, defaultTimeout)
 	defer cancel()
-	_, _, err := gc.Issues.UpdateIssue(
-		repositoryID, issueID,
-		&gitlab.UpdateIssueOptions{
-			Title: &title,
-		},
-		gitlab.WithContext(ctx),
-	)
-
-	return err
+	return retryableRequest(func() (*gitlab.Response, error) {
+		_, resp, err := gc.Issues.UpdateIssue(
+			repositoryID, issueID,
+			&gitlab.UpdateIssueOptions{
+				Title: &title,
+			},
+			gitlab.WithContext(ctx),
+		)
+		return resp, err
+	}, maxRetries)
 }
 
 // update gitlab. issue labels
-func updateGitlabIssueLabels(ctx context.Context, gc *gitlab.Client, repositoryID string, issueID int, labels []string) error {
+func updateGitlabIssueLabels(ctx context.Context, gc *gitlab.Client, maxRetries int, repositoryID string, issueID int, labels []string) error {
 	ctx, cancel := context.WithTimeout(ctx, defaultTimeout)
 	defer cancel()
 	gitlabLabels := gitlab.Labels(labels)
-	_, _, err := gc.Issues.UpdateIssue(
-		repositoryID, issueID,
-		&gitlab.UpdateIssueOptions{
-			Labels: &gitlabLabels,
-		},
-		gitlab.WithContext(ctx),
-	)
-
-	return err
+	return retryableRequest(func() (*gitlab.Response, error) {
+		_, resp, err := gc.Issues.UpdateIssue(
+			repositoryID, issueID,
+			&gitlab.UpdateIssueOptions{
+				Labels: &gitlabLabels,
+			},
+			gitlab.WithContext(ctx),
+		)
+		return resp, err
+	}, maxRetries)
 }
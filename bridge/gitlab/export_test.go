@@ -282,7 +282,7 @@ func generateRepoName() string {
 
 // create repository need a token with scope 'repo'
 func createRepository(ctx context.Context, name string, token *auth.Token) (int, error) {
-	client, err := buildClient("https://gitlab.com/", token)
+	client, err := buildClient("https://gitlab.com/", token, defaultTimeout)
 	if err != nil {
 		return 0, err
 	}
@@ -302,7 +302,7 @@ func createRepository(ctx context.Context, name string, token *auth.Token) (int,
 
 // delete repository need a token with scope 'delete_repo'
 func deleteRepository(ctx context.Context, project int, token *auth.Token) error {
-	client, err := buildClient("https://gitlab.com/", token)
+	client, err := buildClient("https://gitlab.com/", token, defaultTimeout)
 	if err != nil {
 		return err
 	}
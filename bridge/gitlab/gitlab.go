@@ -1,7 +1,6 @@
 package gitlab
 
 import (
-	"net/http"
 	"time"
 
 	"github.com/xanzy/go-gitlab"
@@ -16,11 +15,53 @@ const (
 	metaKeyGitlabId      = "gitlab-id"
 	metaKeyGitlabUrl     = "gitlab-url"
 	metaKeyGitlabLogin   = "gitlab-login"
+	metaKeyGitlabEmail   = "gitlab-email"
 	metaKeyGitlabProject = "gitlab-project-id"
 	metaKeyGitlabBaseUrl = "gitlab-base-url"
 
+	// the epics have their own ids and belong to a group rather than a
+	// project, they don't share the keys of the issues
+	metaKeyGitlabEpicId    = "gitlab-epic-id"
+	metaKeyGitlabEpicGroup = "gitlab-epic-group"
+
+	metaKeyGitlabEpicStartDate = "epic:start-date"
+	metaKeyGitlabEpicDueDate   = "epic:due-date"
+
+	metaKeyGitlabIssueType     = "gitlab:issue-type"
+	metaKeyGitlabMetricImageId = "gitlab:metric-image-id"
+
+	metaKeyGitlabDesignId      = "gitlab:design-id"
+	metaKeyGitlabDesignVersion = "gitlab:design-version"
+
+	metaKeyGitlabIteration          = "gitlab:iteration"
+	metaKeyGitlabIterationStartDate = "gitlab:iteration-start-date"
+	metaKeyGitlabIterationDueDate   = "gitlab:iteration-due-date"
+
+	// the comments imported from the merge requests related to an issue
+	// are tagged with the id of the merge request note
+	metaKeyGitlabMRComment = "gitlab:mr-comment"
+	metaKeyGitlabMRUrl     = "gitlab:mr-url"
+
+	// the operations created from the quick actions of a comment are tagged
+	// with the id of the note
+	metaKeyGitlabQuickAction = "gitlab:quick-action"
+
+	// the system notes without an equivalent operation are kept as NoOp,
+	// with their body
+	metaKeyGitlabSystemNote = "gitlab:system-note"
+
 	keyProjectID     = "project-id"
 	keyGitlabBaseUrl = "base-url"
+	keyGroupPath     = "group-path"
+	keyImportEpics   = "import-epics"
+
+	keyImportIterations = "import-iterations"
+	keyImportMRComments = "import-mr-comments"
+	keyImportBoards     = "import-boards"
+
+	keyImportPipelineStatus = "import-pipeline-status"
+
+	epicLabel = "epic"
 
 	defaultBaseURL = "https://gitlab.com/"
 	defaultTimeout = 60 * time.Second
@@ -32,6 +73,10 @@ func (*Gitlab) Target() string {
 	return target
 }
 
+func (*Gitlab) Capabilities() core.BridgeCapabilities {
+	return core.CapImport | core.CapExport
+}
+
 func (*Gitlab) NewImporter() core.Importer {
 	return &gitlabImporter{}
 }
@@ -40,10 +85,8 @@ func (*Gitlab) NewExporter() core.Exporter {
 	return &gitlabExporter{}
 }
 
-func buildClient(baseURL string, token *auth.Token) (*gitlab.Client, error) {
-	httpClient := &http.Client{
-		Timeout: defaultTimeout,
-	}
+func buildClient(baseURL string, token *auth.Token, timeout time.Duration) (*gitlab.Client, error) {
+	httpClient := core.NewHTTPClient(timeout)
 
 	gitlabClient := gitlab.NewClient(httpClient, token.Value)
 	err := gitlabClient.SetBaseURL(baseURL)
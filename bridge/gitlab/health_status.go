@@ -0,0 +1,78 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/MichaelMure/git-bug/cache"
+)
+
+// MetaKeyHealthStatus is the metadata key holding the health status of an
+// issue. As the status change over time, it is carried by NoOp operations and
+// the current value is the one of the most recent operation, see
+// bug.Snapshot.LastMetadata. An empty value means no health status.
+const MetaKeyHealthStatus = "gitlab:health-status"
+
+// HealthStatuses are the health statuses supported by Gitlab
+var HealthStatuses = []string{"on_track", "needs_attention", "at_risk"}
+
+// IsHealthStatus return true if the given value is a Gitlab health status
+func IsHealthStatus(status string) bool {
+	for _, s := range HealthStatuses {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
+
+// ensureHealthStatus record the health status of the issue if it changed
+func (gi *gitlabImporter) ensureHealthStatus(repo *cache.RepoCache, b *cache.BugCache, issue *gitlab.Issue, details *issueDetails) error {
+	status := ""
+	if details.HealthStatus != nil {
+		status = *details.HealthStatus
+	}
+
+	current, ok := b.Snapshot().LastMetadata(MetaKeyHealthStatus)
+	if current == status && (ok || status == "") {
+		return nil
+	}
+
+	author, err := gi.ensurePerson(repo, issue.Author.ID)
+	if err != nil {
+		return err
+	}
+
+	// the gitlab id mark the operation as already existing in gitlab
+	_, err = b.OpNoOpRaw(author, issue.UpdatedAt.Unix(), map[string]string{
+		MetaKeyHealthStatus: status,
+		metaKeyGitlabId:     parseID(issue.IID),
+	})
+	return err
+}
+
+// updateGitlabIssueHealthStatus set the health status of an issue. This field
+// is not covered by the gitlab client, so the request is built manually.
+func updateGitlabIssueHealthStatus(ctx context.Context, gc *gitlab.Client, maxRetries int, repositoryID string, issueID int, status string) error {
+	ctx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	opt := struct {
+		HealthStatus *string `json:"health_status"`
+	}{}
+	if status != "" {
+		opt.HealthStatus = &status
+	}
+
+	u := fmt.Sprintf("projects/%s/issues/%d", url.PathEscape(repositoryID), issueID)
+	return retryableRequest(func() (*gitlab.Response, error) {
+		req, err := gc.NewRequest("PUT", u, opt, []gitlab.OptionFunc{gitlab.WithContext(ctx)})
+		if err != nil {
+			return nil, err
+		}
+		return gc.Do(req, nil)
+	}, maxRetries)
+}
@@ -3,6 +3,7 @@ package gitlab
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strconv"
 	"time"
 
@@ -24,9 +25,24 @@ type gitlabImporter struct {
 	// default user client
 	client *gitlab.Client
 
+	// token of the default user, to download the files
+	token *auth.Token
+
 	// iterator
 	iterator *iterator
 
+	// if not empty, only the issues with these iids are imported
+	onlyIssues []int
+
+	// if not nil, only the issues matching the filter are imported
+	filter core.ImportFilter
+
+	// name of the labels of the project, by id
+	labelNames map[int]string
+
+	// issue templates of the project, sorted by name
+	templates []issueTemplate
+
 	// send only channel
 	out chan<- core.ImportResult
 }
@@ -56,7 +72,8 @@ func (gi *gitlabImporter) Init(repo *cache.RepoCache, conf core.Configuration) e
 		return ErrMissingIdentityToken
 	}
 
-	gi.client, err = buildClient(conf[keyGitlabBaseUrl], creds[0].(*auth.Token))
+	gi.token = creds[0].(*auth.Token)
+	gi.client, err = buildClient(conf[keyGitlabBaseUrl], gi.token, core.Timeout(conf))
 	if err != nil {
 		return err
 	}
@@ -67,16 +84,47 @@ func (gi *gitlabImporter) Init(repo *cache.RepoCache, conf core.Configuration) e
 // ImportAll iterate over all the configured repository issues (notes) and ensure the creation
 // of the missing issues / comments / label events / title changes ...
 func (gi *gitlabImporter) ImportAll(ctx context.Context, repo *cache.RepoCache, since time.Time) (<-chan core.ImportResult, error) {
-	gi.iterator = NewIterator(ctx, gi.client, 10, gi.conf[keyProjectID], since)
+	gi.iterator = NewIterator(ctx, gi.client, 10, maxRetries(gi.conf), gi.conf[keyProjectID], since)
+	gi.iterator.iids = gi.onlyIssues
 	out := make(chan core.ImportResult)
 	gi.out = out
 
 	go func() {
 		defer close(gi.out)
 
+		var project *gitlab.Project
+		err := retryableRequest(func() (resp *gitlab.Response, err error) {
+			project, resp, err = gi.client.Projects.GetProject(gi.conf[keyProjectID], &gitlab.GetProjectOptions{}, gitlab.WithContext(ctx))
+			return resp, err
+		}, maxRetries(gi.conf))
+		if err != nil {
+			out <- core.NewImportError(fmt.Errorf("project: %v", err), "")
+			return
+		}
+
+		if err := gi.importLabelMetadata(ctx, repo); err != nil {
+			out <- core.NewImportError(fmt.Errorf("label metadata: %v", err), "")
+		}
+
+		gi.templates, err = gi.listIssueTemplates(ctx)
+		if err != nil {
+			out <- core.NewImportError(fmt.Errorf("issue templates: %v", err), "")
+		}
+
+		// the total is only known once the first page is queried
+		totalSent := false
+
 		// Loop over all matching issues
 		for gi.iterator.NextIssue() {
+			if !totalSent {
+				out <- core.NewImportTotal(gi.iterator.TotalIssues())
+				totalSent = true
+			}
+
 			issue := gi.iterator.IssueValue()
+			if !gi.shouldImport(issue) {
+				continue
+			}
 
 			// create issue
 			b, err := gi.ensureIssue(repo, issue)
@@ -86,6 +134,69 @@ func (gi *gitlabImporter) ImportAll(ctx context.Context, repo *cache.RepoCache,
 				return
 			}
 
+			if err := gi.ensureDueDate(repo, b, issue); err != nil {
+				err := fmt.Errorf("due date: %v", err)
+				out <- core.NewImportError(err, b.Id())
+				return
+			}
+
+			details, err := gi.getIssueDetails(ctx, issue)
+			if err != nil {
+				err := fmt.Errorf("issue details: %v", err)
+				out <- core.NewImportError(err, b.Id())
+				return
+			}
+
+			if err := gi.ensureIssueType(ctx, repo, b, issue, details); err != nil {
+				err := fmt.Errorf("issue type: %v", err)
+				out <- core.NewImportError(err, b.Id())
+				return
+			}
+
+			if err := gi.ensureDesigns(ctx, repo, b, project, issue); err != nil {
+				err := fmt.Errorf("designs: %v", err)
+				out <- core.NewImportError(err, b.Id())
+				return
+			}
+
+			if err := gi.ensureHealthStatus(repo, b, issue, details); err != nil {
+				err := fmt.Errorf("health status: %v", err)
+				out <- core.NewImportError(err, b.Id())
+				return
+			}
+
+			if err := gi.ensureWeightEvents(ctx, repo, b, issue); err != nil {
+				err := fmt.Errorf("weight events: %v", err)
+				out <- core.NewImportError(err, b.Id())
+				return
+			}
+
+			if err := gi.ensureWeight(repo, b, issue); err != nil {
+				err := fmt.Errorf("weight: %v", err)
+				out <- core.NewImportError(err, b.Id())
+				return
+			}
+
+			if err := gi.ensureTemplate(repo, b, issue); err != nil {
+				err := fmt.Errorf("template: %v", err)
+				out <- core.NewImportError(err, b.Id())
+				return
+			}
+
+			if err := gi.ensureLocked(repo, b, issue); err != nil {
+				err := fmt.Errorf("locked: %v", err)
+				out <- core.NewImportError(err, b.Id())
+				return
+			}
+
+			if gi.conf[keyImportIterations] == "true" {
+				if err := gi.ensureIteration(repo, b, issue, details.Iteration); err != nil {
+					err := fmt.Errorf("iteration: %v", err)
+					out <- core.NewImportError(err, b.Id())
+					return
+				}
+			}
+
 			// Loop over all notes
 			for gi.iterator.NextNote() {
 				note := gi.iterator.NoteValue()
@@ -96,19 +207,36 @@ func (gi *gitlabImporter) ImportAll(ctx context.Context, repo *cache.RepoCache,
 				}
 			}
 
-			// Loop over all label events
-			for gi.iterator.NextLabelEvent() {
-				labelEvent := gi.iterator.LabelEventValue()
-				if err := gi.ensureLabelEvent(repo, b, labelEvent); err != nil {
-					err := fmt.Errorf("label event creation: %v", err)
-					out <- core.NewImportError(err, entity.Id(strconv.Itoa(labelEvent.ID)))
+			if gi.conf[keyImportMRComments] == "true" {
+				if err := gi.ensureMergeRequestComments(ctx, repo, b, issue); err != nil {
+					err := fmt.Errorf("merge request comments: %v", err)
+					out <- core.NewImportError(err, b.Id())
+					return
+				}
+			}
+
+			if gi.conf[keyImportPipelineStatus] == "true" {
+				if err := gi.ensurePipelineStatus(ctx, repo, b, issue); err != nil {
+					err := fmt.Errorf("pipeline status: %v", err)
+					out <- core.NewImportError(err, b.Id())
 					return
 				}
 			}
 
+			// Loop over all label events
+			var labelEvents []*gitlab.LabelEvent
+			for gi.iterator.NextLabelEvent() {
+				labelEvents = append(labelEvents, gi.iterator.LabelEventValue())
+			}
+			if err := gi.ensureLabelEvents(repo, b, labelEvents); err != nil {
+				err := fmt.Errorf("label event creation: %v", err)
+				out <- core.NewImportError(err, b.Id())
+				return
+			}
+
 			if !b.NeedCommit() {
 				out <- core.NewImportNothing(b.Id(), "no imported operation")
-			} else if err := b.Commit(); err != nil {
+			} else if err := b.CommitOverrideSizeLimit(); err != nil {
 				// commit bug state
 				err := fmt.Errorf("bug commit: %v", err)
 				out <- core.NewImportError(err, "")
@@ -118,6 +246,18 @@ func (gi *gitlabImporter) ImportAll(ctx context.Context, repo *cache.RepoCache,
 
 		if err := gi.iterator.Error(); err != nil {
 			out <- core.NewImportError(err, "")
+			return
+		}
+
+		if gi.conf[keyImportBoards] == "true" {
+			if err := gi.importBoards(ctx, repo); err != nil {
+				out <- core.NewImportError(fmt.Errorf("boards: %v", err), "")
+			}
+		}
+
+		// Epics are imported last so that their children already exist
+		if gi.conf[keyImportEpics] == "true" {
+			gi.importEpics(ctx, repo)
 		}
 	}()
 
@@ -131,7 +271,13 @@ func (gi *gitlabImporter) ensureIssue(repo *cache.RepoCache, issue *gitlab.Issue
 		return nil, err
 	}
 
-	// resolve bug
+	// resolve bug, the issues imported before the deterministic ids being
+	// found with their metadata
+	id := entity.NewDeterministicId(target, issue.WebURL)
+	if repo.BugExists(id) {
+		return repo.ResolveBug(id)
+	}
+
 	b, err := repo.ResolveBugCreateMetadata(metaKeyGitlabUrl, issue.WebURL)
 	if err == nil {
 		return b, nil
@@ -147,20 +293,24 @@ func (gi *gitlabImporter) ensureIssue(repo *cache.RepoCache, issue *gitlab.Issue
 	}
 
 	// create bug
-	b, _, err = repo.NewBugRaw(
-		author,
-		issue.CreatedAt.Unix(),
-		issue.Title,
-		cleanText,
-		nil,
-		map[string]string{
+	b, err = repo.NewBugWithID(target, issue.WebURL, cache.BugCreateArgs{
+		Author:   author,
+		UnixTime: issue.CreatedAt.Unix(),
+		Title:    issue.Title,
+		Message:  cleanText,
+		Source: &bug.BugSource{
+			Target:    target,
+			RemoteID:  parseID(issue.IID),
+			RemoteURL: issue.WebURL,
+		},
+		Metadata: map[string]string{
 			core.MetaKeyOrigin:   target,
 			metaKeyGitlabId:      parseID(issue.IID),
 			metaKeyGitlabUrl:     issue.WebURL,
 			metaKeyGitlabProject: gi.conf[keyProjectID],
 			metaKeyGitlabBaseUrl: gi.conf[keyGitlabBaseUrl],
 		},
-	)
+	})
 
 	if err != nil {
 		return nil, err
@@ -172,6 +322,32 @@ func (gi *gitlabImporter) ensureIssue(repo *cache.RepoCache, issue *gitlab.Issue
 	return b, nil
 }
 
+// ensureDueDate synchronize the due date of the issue. As Gitlab doesn't
+// expose when and by whom the due date was changed, the change is attributed
+// to the issue author at the last update time of the issue.
+func (gi *gitlabImporter) ensureDueDate(repo *cache.RepoCache, b *cache.BugCache, issue *gitlab.Issue) error {
+	var due time.Time
+	if issue.DueDate != nil {
+		due = time.Time(*issue.DueDate)
+	}
+
+	current := b.Snapshot().DueDate
+	if current == nil && due.IsZero() {
+		return nil
+	}
+	if current != nil && current.Equal(due) {
+		return nil
+	}
+
+	author, err := gi.ensurePerson(repo, issue.Author.ID)
+	if err != nil {
+		return err
+	}
+
+	_, err = b.SetDueDateRaw(author, issue.UpdatedAt.Unix(), due, nil)
+	return err
+}
+
 func (gi *gitlabImporter) ensureNote(repo *cache.RepoCache, b *cache.BugCache, note *gitlab.Note) error {
 	gitlabID := parseID(note.ID)
 
@@ -250,6 +426,9 @@ func (gi *gitlabImporter) ensureNote(repo *cache.RepoCache, b *cache.BugCache, n
 		}
 
 	case NOTE_COMMENT:
+		// the quick actions are executed rather than kept as text
+		actions, body := parseQuickActions(body)
+
 		cleanText, err := text.Cleanup(body)
 		if err != nil {
 			return err
@@ -257,11 +436,22 @@ func (gi *gitlabImporter) ensureNote(repo *cache.RepoCache, b *cache.BugCache, n
 
 		// if we didn't import the comment
 		if errResolve == cache.ErrNoMatchingOp {
+			// a comment made only of quick actions is still recorded, to
+			// not execute them again on the next import
+			if cleanText == "" {
+				_, err := b.OpNoOpRaw(author, note.CreatedAt.Unix(), map[string]string{
+					metaKeyGitlabId: gitlabID,
+				})
+				if err != nil {
+					return err
+				}
+				return gi.applyQuickActions(b, author, note, actions)
+			}
 
 			// add comment operation
-			op, err := b.AddCommentRaw(
+			op, err := b.AddCommentRawAt(
 				author,
-				note.CreatedAt.Unix(),
+				*note.CreatedAt,
 				cleanText,
 				nil,
 				map[string]string{
@@ -271,7 +461,13 @@ func (gi *gitlabImporter) ensureNote(repo *cache.RepoCache, b *cache.BugCache, n
 			if err != nil {
 				return err
 			}
+
 			gi.out <- core.NewImportComment(op.Id())
+			return gi.applyQuickActions(b, author, note, actions)
+		}
+
+		// a comment made only of quick actions has nothing to update
+		if cleanText == "" {
 			return nil
 		}
 
@@ -322,6 +518,54 @@ func (gi *gitlabImporter) ensureNote(repo *cache.RepoCache, b *cache.BugCache, n
 
 		gi.out <- core.NewImportTitleEdition(op.Id())
 
+	case NOTE_LABELS_CHANGED:
+		if errResolve == nil {
+			return nil
+		}
+
+		added, removed, ok := parseLabelNote(body, gi.labelNames)
+		if !ok {
+			return gi.ensureSystemNote(b, author, note)
+		}
+
+		op, err := b.ForceChangeLabelsRaw(
+			author,
+			note.CreatedAt.Unix(),
+			added,
+			removed,
+			map[string]string{
+				metaKeyGitlabId: gitlabID,
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		gi.out <- core.NewImportLabelChange(op.Id())
+
+	case NOTE_MENTIONED_IN_ISSUE,
+		NOTE_MENTIONED_IN_MERGE_REQUEST,
+		NOTE_MENTIONED_IN_COMMIT:
+		if errResolve == nil {
+			return nil
+		}
+
+		issue := gi.iterator.IssueValue()
+		url, ok := crossRefURL(noteType, note.Body, gi.conf[keyGitlabBaseUrl], issue.WebURL)
+		if !ok {
+			return gi.ensureSystemNote(b, author, note)
+		}
+
+		_, err := b.CrossRefURLRaw(
+			author,
+			note.CreatedAt.Unix(),
+			url,
+			map[string]string{
+				metaKeyGitlabId: gitlabID,
+			},
+		)
+		return err
+
 	case NOTE_UNKNOWN,
 		NOTE_ASSIGNED,
 		NOTE_UNASSIGNED,
@@ -330,11 +574,15 @@ func (gi *gitlabImporter) ensureNote(repo *cache.RepoCache, b *cache.BugCache, n
 		NOTE_CHANGED_DUEDATE,
 		NOTE_REMOVED_DUEDATE,
 		NOTE_LOCKED,
-		NOTE_UNLOCKED,
-		NOTE_MENTIONED_IN_ISSUE,
-		NOTE_MENTIONED_IN_MERGE_REQUEST:
+		NOTE_UNLOCKED:
 
-		return nil
+		// the due date and the lock are imported from the issue, the other
+		// events are kept as they are
+		if errResolve == nil {
+			return nil
+		}
+
+		return gi.ensureSystemNote(b, author, note)
 
 	default:
 		panic("unhandled note type")
@@ -343,6 +591,94 @@ func (gi *gitlabImporter) ensureNote(repo *cache.RepoCache, b *cache.BugCache, n
 	return nil
 }
 
+// metaKeyGitlabLabelEvents tag the operation condensing the label events of
+// an issue with the id of the last of them
+const metaKeyGitlabLabelEvents = "gitlab:label-events"
+
+// ensureLabelEvents import the label events of an issue. When no label change
+// has been imported from Gitlab yet, the label history is condensed in a
+// single operation setting the resulting labels, attributed to the author of
+// the last event. The next imports add the newer events one by one.
+func (gi *gitlabImporter) ensureLabelEvents(repo *cache.RepoCache, b *cache.BugCache, labelEvents []*gitlab.LabelEvent) error {
+	sort.Slice(labelEvents, func(i, j int) bool {
+		return labelEvents[i].ID < labelEvents[j].ID
+	})
+
+	// the events up to the last condensed one are already imported
+	lastCondensed := 0
+	imported := false
+	for _, op := range b.Snapshot().Operations {
+		if value, ok := op.GetMetadata(metaKeyGitlabLabelEvents); ok {
+			if id, err := strconv.Atoi(value); err == nil && id > lastCondensed {
+				lastCondensed = id
+			}
+			imported = true
+		}
+		if _, ok := op.(*bug.LabelChangeOperation); ok {
+			if _, ok := op.GetMetadata(metaKeyGitlabId); ok {
+				imported = true
+			}
+		}
+	}
+
+	var newEvents []*gitlab.LabelEvent
+	for _, labelEvent := range labelEvents {
+		if labelEvent.ID > lastCondensed {
+			newEvents = append(newEvents, labelEvent)
+		}
+	}
+
+	if imported || len(newEvents) <= 1 {
+		for _, labelEvent := range newEvents {
+			if err := gi.ensureLabelEvent(repo, b, labelEvent); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	labels := append([]bug.Label{}, b.Snapshot().Labels...)
+	for _, labelEvent := range newEvents {
+		label := bug.Label(labelEvent.Label.Name)
+		switch labelEvent.Action {
+		case "add":
+			if !hasLabel(labels, label) {
+				labels = append(labels, label)
+			}
+		case "remove":
+			var remaining []bug.Label
+			for _, l := range labels {
+				if l != label {
+					remaining = append(remaining, l)
+				}
+			}
+			labels = remaining
+		default:
+			return fmt.Errorf("unexpected label event action")
+		}
+	}
+
+	last := newEvents[len(newEvents)-1]
+
+	author, err := gi.ensurePerson(repo, last.User.ID)
+	if err != nil {
+		return err
+	}
+
+	// the gitlab id mark the operation as already existing in gitlab
+	op, err := b.SetLabelsRaw(author, last.CreatedAt.Unix(), labels, map[string]string{
+		metaKeyGitlabId:          parseID(last.ID),
+		metaKeyGitlabLabelEvents: parseID(last.ID),
+	})
+	if err != nil {
+		return err
+	}
+
+	gi.out <- core.NewImportLabelChange(op.Id())
+
+	return nil
+}
+
 func (gi *gitlabImporter) ensureLabelEvent(repo *cache.RepoCache, b *cache.BugCache, labelEvent *gitlab.LabelEvent) error {
 	_, err := b.ResolveOperationWithMetadata(metaKeyGitlabId, parseID(labelEvent.ID))
 	if err != cache.ErrNoMatchingOp {
@@ -395,21 +731,34 @@ func (gi *gitlabImporter) ensurePerson(repo *cache.RepoCache, id int) (*cache.Id
 		return nil, err
 	}
 
-	user, _, err := gi.client.Users.GetUser(id)
+	var user *gitlab.User
+	err = retryableRequest(func() (resp *gitlab.Response, err error) {
+		user, resp, err = gi.client.Users.GetUser(id)
+		return resp, err
+	}, maxRetries(gi.conf))
 	if err != nil {
 		return nil, err
 	}
 
+	metadata := map[string]string{
+		// because Gitlab
+		metaKeyGitlabId:    strconv.Itoa(id),
+		metaKeyGitlabLogin: user.Username,
+	}
+
+	// the private email is only visible to the admins
+	if user.Email != "" {
+		metadata[metaKeyGitlabEmail] = user.Email
+	} else if user.PublicEmail != "" {
+		metadata[metaKeyGitlabEmail] = user.PublicEmail
+	}
+
 	i, err = repo.NewIdentityRaw(
 		user.Name,
 		user.PublicEmail,
 		user.Username,
 		user.AvatarURL,
-		map[string]string{
-			// because Gitlab
-			metaKeyGitlabId:    strconv.Itoa(id),
-			metaKeyGitlabLogin: user.Username,
-		},
+		metadata,
 	)
 	if err != nil {
 		return nil, err
@@ -422,3 +771,12 @@ func (gi *gitlabImporter) ensurePerson(repo *cache.RepoCache, id int) (*cache.Id
 func parseID(id int) string {
 	return fmt.Sprintf("%d", id)
 }
+
+func hasLabel(labels []bug.Label, label bug.Label) bool {
+	for _, l := range labels {
+		if l == label {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,288 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/MichaelMure/git-bug/bridge/core"
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/util/text"
+)
+
+// importEpics import the Epics of the configured group as bugs labeled
+// "epic", and link them with their children issues.
+func (gi *gitlabImporter) importEpics(ctx context.Context, repo *cache.RepoCache) {
+	group := gi.conf[keyGroupPath]
+
+	for page := 1; ; page++ {
+		if ctx.Err() != nil {
+			return
+		}
+
+		epics, err := gi.listEpics(ctx, group, page)
+		if err != nil {
+			gi.out <- core.NewImportError(fmt.Errorf("epics listing: %v", err), "")
+			return
+		}
+
+		if len(epics) == 0 {
+			return
+		}
+
+		for _, epic := range epics {
+			b, err := gi.ensureEpic(repo, epic)
+			if err != nil {
+				err := fmt.Errorf("epic creation: %v", err)
+				gi.out <- core.NewImportError(err, "")
+				return
+			}
+
+			err = gi.ensureEpicChildren(ctx, repo, b, epic)
+			if err != nil {
+				err := fmt.Errorf("epic children link: %v", err)
+				gi.out <- core.NewImportError(err, entity.Id(parseID(epic.IID)))
+				return
+			}
+
+			if !b.NeedCommit() {
+				gi.out <- core.NewImportNothing(b.Id(), "no imported operation")
+			} else if err := b.CommitOverrideSizeLimit(); err != nil {
+				err := fmt.Errorf("bug commit: %v", err)
+				gi.out <- core.NewImportError(err, "")
+				return
+			}
+		}
+	}
+}
+
+func (gi *gitlabImporter) listEpics(ctx context.Context, group string, page int) ([]*gitlab.Epic, error) {
+	ctx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	var epics []*gitlab.Epic
+	err := retryableRequest(func() (resp *gitlab.Response, err error) {
+		epics, resp, err = gi.client.Epics.ListGroupEpics(
+			group,
+			&gitlab.ListGroupEpicsOptions{
+				ListOptions: gitlab.ListOptions{
+					Page:    page,
+					PerPage: 10,
+				},
+				Sort: gitlab.String("asc"),
+			},
+			gitlab.WithContext(ctx),
+		)
+		return resp, err
+	}, maxRetries(gi.conf))
+
+	return epics, err
+}
+
+// listEpicIssues query the issues assigned to an epic. This endpoint is not
+// covered by the gitlab client so the request is built manually.
+func (gi *gitlabImporter) listEpicIssues(ctx context.Context, group string, epicIID int, page int) ([]*gitlab.Issue, error) {
+	ctx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	u := fmt.Sprintf("groups/%s/epics/%d/issues", url.PathEscape(group), epicIID)
+	opt := &gitlab.ListOptions{
+		Page:    page,
+		PerPage: 10,
+	}
+
+	var issues []*gitlab.Issue
+	err := retryableRequest(func() (*gitlab.Response, error) {
+		req, err := gi.client.NewRequest("GET", u, opt, []gitlab.OptionFunc{gitlab.WithContext(ctx)})
+		if err != nil {
+			return nil, err
+		}
+		return gi.client.Do(req, &issues)
+	}, maxRetries(gi.conf))
+	if err != nil {
+		return nil, err
+	}
+
+	return issues, nil
+}
+
+func (gi *gitlabImporter) ensureEpic(repo *cache.RepoCache, epic *gitlab.Epic) (*cache.BugCache, error) {
+	author, err := gi.ensurePerson(repo, epic.Author.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	epicUrl := epicURL(gi.conf[keyGitlabBaseUrl], gi.conf[keyGroupPath], epic.IID)
+	dates := epicDates(epic)
+
+	b, err := repo.ResolveBugCreateMetadata(metaKeyGitlabUrl, epicUrl)
+	if err == nil {
+		return b, gi.ensureEpicDates(b, author, epic, dates)
+	}
+	if err != bug.ErrBugNotExist {
+		return nil, err
+	}
+
+	cleanText, err := text.Cleanup(epic.Description)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata := map[string]string{
+		core.MetaKeyOrigin:     target,
+		metaKeyGitlabEpicId:    parseID(epic.IID),
+		metaKeyGitlabEpicGroup: gi.conf[keyGroupPath],
+		metaKeyGitlabUrl:       epicUrl,
+		metaKeyGitlabBaseUrl:   gi.conf[keyGitlabBaseUrl],
+	}
+	for key, value := range dates {
+		metadata[key] = value
+	}
+
+	b, err = repo.NewBugWithID(target, epicUrl, cache.BugCreateArgs{
+		Author:   author,
+		UnixTime: epic.CreatedAt.Unix(),
+		Title:    epic.Title,
+		Message:  cleanText,
+		Source: &bug.BugSource{
+			Target:    target,
+			RemoteID:  parseID(epic.IID),
+			RemoteURL: epicUrl,
+		},
+		Metadata: metadata,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = b.ForceChangeLabelsRaw(author, epic.CreatedAt.Unix(), []string{epicLabel}, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if epic.State == "closed" {
+		_, err = b.CloseRaw(author, epic.UpdatedAt.Unix(), nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	gi.out <- core.NewImportBug(b.Id())
+
+	return b, nil
+}
+
+// ensureEpicDates update the start and due dates stored on the create
+// operation of an already imported epic, if they changed.
+func (gi *gitlabImporter) ensureEpicDates(b *cache.BugCache, author *cache.IdentityCache, epic *gitlab.Epic, dates map[string]string) error {
+	createOp := b.Snapshot().Operations[0]
+
+	changed := make(map[string]string)
+	for key, value := range dates {
+		if current, ok := createOp.GetMetadata(key); !ok || current != value {
+			changed[key] = value
+		}
+	}
+
+	if len(changed) == 0 {
+		return nil
+	}
+
+	_, err := b.SetMetadataRaw(author, epic.UpdatedAt.Unix(), createOp.Id(), changed)
+	return err
+}
+
+// ensureEpicChildren link the epic with its children issues that have
+// already been imported.
+func (gi *gitlabImporter) ensureEpicChildren(ctx context.Context, repo *cache.RepoCache, b *cache.BugCache, epic *gitlab.Epic) error {
+	author, err := gi.ensurePerson(repo, epic.Author.ID)
+	if err != nil {
+		return err
+	}
+
+	unixTime := epic.CreatedAt.Unix()
+
+	for page := 1; ; page++ {
+		issues, err := gi.listEpicIssues(ctx, gi.conf[keyGroupPath], epic.IID, page)
+		if err != nil {
+			return err
+		}
+
+		if len(issues) == 0 {
+			return nil
+		}
+
+		for _, issue := range issues {
+			child, err := repo.ResolveBugCreateMetadata(metaKeyGitlabUrl, issue.WebURL)
+			if err == bug.ErrBugNotExist {
+				// the issue belong to another project of the group
+				continue
+			}
+			if err != nil {
+				return err
+			}
+
+			err = ensureLink(b, child, author, unixTime)
+			if err != nil {
+				return err
+			}
+
+			err = child.CommitAsNeeded()
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// ensureLink create the missing link operations between a parent and a child bug
+func ensureLink(parent, child *cache.BugCache, author *cache.IdentityCache, unixTime int64) error {
+	if !parent.Snapshot().HasLink(bug.ParentOf, child.Id()) {
+		_, err := parent.AddLinkRaw(author, unixTime, bug.ParentOf, child.Id(), nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	if !child.Snapshot().HasLink(bug.ChildOf, parent.Id()) {
+		_, err := child.AddLinkRaw(author, unixTime, bug.ChildOf, parent.Id(), nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// isEpic tell if a bug has been imported from an epic. The epics imported
+// before having their own metadata are recognized by their URL.
+func isEpic(snapshot *bug.Snapshot) bool {
+	if _, ok := snapshot.GetCreateMetadata(metaKeyGitlabEpicId); ok {
+		return true
+	}
+	u, ok := snapshot.GetCreateMetadata(metaKeyGitlabUrl)
+	return ok && strings.Contains(u, "/-/epics/")
+}
+
+// epicURL build the web URL of an epic, as the API doesn't return it
+func epicURL(baseURL string, group string, iid int) string {
+	return fmt.Sprintf("%s/groups/%s/-/epics/%d", strings.TrimSuffix(baseURL, "/"), group, iid)
+}
+
+// epicDates return the start and due dates of an epic, formatted as ISO 8601
+func epicDates(epic *gitlab.Epic) map[string]string {
+	dates := make(map[string]string)
+	if epic.StartDate != nil {
+		dates[metaKeyGitlabEpicStartDate] = time.Time(*epic.StartDate).Format("2006-01-02")
+	}
+	if epic.DueDate != nil {
+		dates[metaKeyGitlabEpicDueDate] = time.Time(*epic.DueDate).Format("2006-01-02")
+	}
+	return dates
+}
@@ -0,0 +1,54 @@
+package gitlab
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/identity"
+)
+
+func TestEpicURL(t *testing.T) {
+	assert.Equal(t, "https://gitlab.com/groups/foo/bar/-/epics/12", epicURL("https://gitlab.com/", "foo/bar", 12))
+	assert.Equal(t, "https://gitlab.com/groups/foo/-/epics/1", epicURL("https://gitlab.com", "foo", 1))
+}
+
+func TestEpicDates(t *testing.T) {
+	start := gitlab.ISOTime(time.Date(2020, 2, 3, 0, 0, 0, 0, time.UTC))
+
+	dates := epicDates(&gitlab.Epic{StartDate: &start})
+	assert.Equal(t, map[string]string{metaKeyGitlabEpicStartDate: "2020-02-03"}, dates)
+
+	dates = epicDates(&gitlab.Epic{})
+	assert.Empty(t, dates)
+}
+
+func TestIsEpic(t *testing.T) {
+	rene := identity.NewBare("René Descartes", "rene@descartes.fr")
+
+	snapshot := func(metadata map[string]string) *bug.Snapshot {
+		createOp := bug.NewCreateOp(rene, time.Now().Unix(), "title", "message", nil)
+		for key, value := range metadata {
+			createOp.SetMetadata(key, value)
+		}
+		return &bug.Snapshot{Operations: []bug.Operation{createOp}}
+	}
+
+	assert.True(t, isEpic(snapshot(map[string]string{
+		metaKeyGitlabEpicId:    "1",
+		metaKeyGitlabEpicGroup: "foo",
+	})))
+	// imported before having dedicated metadata
+	assert.True(t, isEpic(snapshot(map[string]string{
+		metaKeyGitlabId:  "1",
+		metaKeyGitlabUrl: "https://gitlab.com/groups/foo/-/epics/1",
+	})))
+	assert.False(t, isEpic(snapshot(map[string]string{
+		metaKeyGitlabId:  "1",
+		metaKeyGitlabUrl: "https://gitlab.com/foo/bar/-/issues/1",
+	})))
+	assert.False(t, isEpic(snapshot(nil)))
+}
@@ -0,0 +1,73 @@
+package gitlab
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/MichaelMure/git-bug/bridge/core"
+)
+
+var _ core.FilteredImporter = &gitlabImporter{}
+
+// SetImportFilter restrict the import to the issues matching the filter
+func (gi *gitlabImporter) SetImportFilter(filter core.ImportFilter) {
+	gi.filter = filter
+}
+
+// shouldImport return true if the issue match the import filter, if any
+func (gi *gitlabImporter) shouldImport(issue *gitlab.Issue) bool {
+	return gi.filter == nil || gi.filter.ShouldImport(filterIssue{issue})
+}
+
+// filterIssue expose a Gitlab issue to the import filters. The custom fields
+// are "weight", "milestone", "state" and "confidential".
+type filterIssue struct {
+	issue *gitlab.Issue
+}
+
+func (i filterIssue) Raw() interface{} {
+	return i.issue
+}
+
+func (i filterIssue) IssueLabels() []string {
+	return i.issue.Labels
+}
+
+func (i filterIssue) IssueAssignees() []string {
+	var logins []string
+	for _, assignee := range i.issue.Assignees {
+		logins = append(logins, assignee.Username)
+	}
+	// the older Gitlab have a single assignee
+	if len(logins) == 0 && i.issue.Assignee != nil {
+		logins = append(logins, i.issue.Assignee.Username)
+	}
+	return logins
+}
+
+func (i filterIssue) IssueCreatedAt() time.Time {
+	if i.issue.CreatedAt == nil {
+		return time.Time{}
+	}
+	return *i.issue.CreatedAt
+}
+
+func (i filterIssue) IssueCustomField(name string) (string, bool) {
+	switch name {
+	case "weight":
+		return strconv.Itoa(i.issue.Weight), true
+	case "milestone":
+		if i.issue.Milestone == nil {
+			return "", false
+		}
+		return i.issue.Milestone.Title, true
+	case "state":
+		return i.issue.State, true
+	case "confidential":
+		return strconv.FormatBool(i.issue.Confidential), true
+	default:
+		return "", false
+	}
+}
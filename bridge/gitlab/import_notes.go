@@ -25,6 +25,8 @@ const (
 	NOTE_REMOVED_MILESTONE
 	NOTE_MENTIONED_IN_ISSUE
 	NOTE_MENTIONED_IN_MERGE_REQUEST
+	NOTE_MENTIONED_IN_COMMIT
+	NOTE_LABELS_CHANGED
 	NOTE_UNKNOWN
 )
 
@@ -60,6 +62,10 @@ func (nt NoteType) String() string {
 		return "note mentioned in issue"
 	case NOTE_MENTIONED_IN_MERGE_REQUEST:
 		return "note mentioned in merge request"
+	case NOTE_MENTIONED_IN_COMMIT:
+		return "note mentioned in commit"
+	case NOTE_LABELS_CHANGED:
+		return "note labels changed"
 	case NOTE_UNKNOWN:
 		return "note unknown"
 	default:
@@ -132,6 +138,14 @@ func GetNoteType(n *gitlab.Note) (NoteType, string) {
 		return NOTE_MENTIONED_IN_MERGE_REQUEST, ""
 	}
 
+	if strings.HasPrefix(n.Body, "mentioned in commit") || strings.HasPrefix(n.Body, "referenced in commit") {
+		return NOTE_MENTIONED_IN_COMMIT, ""
+	}
+
+	if strings.HasPrefix(n.Body, "added ~") || strings.HasPrefix(n.Body, "removed ~") {
+		return NOTE_LABELS_CHANGED, n.Body
+	}
+
 	return NOTE_UNKNOWN, ""
 }
 
@@ -9,6 +9,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/xanzy/go-gitlab"
 
 	"github.com/MichaelMure/git-bug/bridge/core"
 	"github.com/MichaelMure/git-bug/bridge/core/auth"
@@ -167,3 +168,68 @@ func TestImport(t *testing.T) {
 		})
 	}
 }
+
+func TestEnsureLabelEvents(t *testing.T) {
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	backend, err := cache.NewRepoCache(repo)
+	require.NoError(t, err)
+	defer backend.Close()
+
+	author, err := backend.NewIdentityRaw("René Descartes", "rene@descartes.fr", "rene", "", map[string]string{
+		metaKeyGitlabId: "7",
+	})
+	require.NoError(t, err)
+
+	b, _, err := backend.NewBugRaw(author, time.Now().Unix(), "title", "message", nil, nil)
+	require.NoError(t, err)
+
+	gi := &gitlabImporter{out: make(chan core.ImportResult, 10)}
+
+	created := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	event := func(id int, action string, label string) *gitlab.LabelEvent {
+		e := &gitlab.LabelEvent{ID: id, Action: action, CreatedAt: &created}
+		e.User.ID = 7
+		e.Label.Name = label
+		return e
+	}
+
+	// the history is condensed on the first import
+	err = gi.ensureLabelEvents(backend, b, []*gitlab.LabelEvent{
+		event(12, "remove", "bug"),
+		event(10, "add", "bug"),
+		event(11, "add", "core"),
+	})
+	require.NoError(t, err)
+
+	snap := b.Snapshot()
+	require.Equal(t, []bug.Label{"core"}, snap.Labels)
+	require.Len(t, snap.Operations, 2)
+	op, ok := snap.Operations[1].(*bug.SetLabelsOperation)
+	require.True(t, ok)
+	value, ok := op.GetMetadata(metaKeyGitlabLabelEvents)
+	require.True(t, ok)
+	require.Equal(t, "12", value)
+
+	// the next events are imported one by one
+	err = gi.ensureLabelEvents(backend, b, []*gitlab.LabelEvent{
+		event(10, "add", "bug"),
+		event(11, "add", "core"),
+		event(12, "remove", "bug"),
+		event(13, "add", "urgent"),
+		event(14, "remove", "core"),
+	})
+	require.NoError(t, err)
+
+	snap = b.Snapshot()
+	require.Equal(t, []bug.Label{"urgent"}, snap.Labels)
+	require.Len(t, snap.Operations, 4)
+	require.IsType(t, &bug.LabelChangeOperation{}, snap.Operations[2])
+	require.IsType(t, &bug.LabelChangeOperation{}, snap.Operations[3])
+
+	// already imported
+	err = gi.ensureLabelEvents(backend, b, []*gitlab.LabelEvent{event(14, "remove", "core")})
+	require.NoError(t, err)
+	require.Len(t, b.Snapshot().Operations, 4)
+}
@@ -0,0 +1,95 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/MichaelMure/git-bug/bridge/core"
+	"github.com/MichaelMure/git-bug/bridge/core/auth"
+	"github.com/MichaelMure/git-bug/cache"
+)
+
+// parseIssueURL split the URL of a Gitlab issue into the base URL of the
+// instance, the path of the project and the iid of the issue. Both the
+// ".../-/issues/<iid>" and the older ".../issues/<iid>" forms are accepted.
+func parseIssueURL(rawURL string) (baseURL string, projectPath string, iid int, err error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", "", 0, err
+	}
+
+	path := strings.Trim(u.Path, "/")
+	index := strings.LastIndex(path, "/issues/")
+	if u.Host == "" || index < 0 {
+		return "", "", 0, fmt.Errorf("invalid Gitlab issue url %s", rawURL)
+	}
+
+	iid, err = strconv.Atoi(path[index+len("/issues/"):])
+	if err != nil {
+		return "", "", 0, fmt.Errorf("invalid Gitlab issue url %s", rawURL)
+	}
+
+	projectPath = strings.TrimSuffix(path[:index], "/-")
+	if projectPath == "" {
+		return "", "", 0, fmt.Errorf("invalid Gitlab issue url %s", rawURL)
+	}
+
+	return fmt.Sprintf("%s://%s/", u.Scheme, u.Host), projectPath, iid, nil
+}
+
+// ImportBugFromURL import a single issue from its URL, without a configured
+// bridge. The bug is updated if it was already imported.
+func (*Gitlab) ImportBugFromURL(ctx context.Context, repo *cache.RepoCache, rawURL string, cred auth.Credential) (*cache.BugCache, error) {
+	token, ok := cred.(*auth.Token)
+	if !ok {
+		return nil, fmt.Errorf("a token is required to import from Gitlab")
+	}
+
+	baseURL, projectPath, iid, err := parseIssueURL(rawURL)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := buildClient(baseURL, token, core.DefaultTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	var issue *gitlab.Issue
+	err = retryableRequest(func() (resp *gitlab.Response, err error) {
+		issue, resp, err = client.Issues.GetIssue(projectPath, iid, gitlab.WithContext(ctx))
+		return resp, err
+	}, defaultMaxRetries)
+	if err != nil {
+		return nil, err
+	}
+
+	gi := &gitlabImporter{
+		conf: core.Configuration{
+			core.ConfigKeyTarget: target,
+			keyProjectID:         strconv.Itoa(issue.ProjectID),
+			keyGitlabBaseUrl:     baseURL,
+		},
+		client:     client,
+		token:      token,
+		onlyIssues: []int{iid},
+	}
+
+	results, err := gi.ImportAll(ctx, repo, time.Time{})
+	if err != nil {
+		return nil, err
+	}
+
+	err = core.WaitImport(results)
+	if err != nil {
+		return nil, err
+	}
+
+	return repo.ResolveBugCreateMetadata(metaKeyGitlabUrl, issue.WebURL)
+}
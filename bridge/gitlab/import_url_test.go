@@ -0,0 +1,37 @@
+package gitlab
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseIssueURL(t *testing.T) {
+	tests := []struct {
+		url         string
+		baseURL     string
+		projectPath string
+		iid         int
+	}{
+		{"https://gitlab.com/group/project/-/issues/12", "https://gitlab.com/", "group/project", 12},
+		{"https://gitlab.example.com/group/sub/project/issues/3", "https://gitlab.example.com/", "group/sub/project", 3},
+	}
+
+	for _, tt := range tests {
+		baseURL, projectPath, iid, err := parseIssueURL(tt.url)
+		require.NoError(t, err)
+		require.Equal(t, tt.baseURL, baseURL)
+		require.Equal(t, tt.projectPath, projectPath)
+		require.Equal(t, tt.iid, iid)
+	}
+
+	for _, invalid := range []string{
+		"https://gitlab.com/group/project",
+		"https://gitlab.com/group/project/-/issues/abc",
+		"https://gitlab.com/issues/12",
+		"/group/project/-/issues/12",
+	} {
+		_, _, _, err := parseIssueURL(invalid)
+		require.Error(t, err, invalid)
+	}
+}
@@ -0,0 +1,254 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/MichaelMure/git-bug/bridge/core"
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/util/git"
+)
+
+// defaultIssueType is the type of the regular Gitlab issues
+const defaultIssueType = "issue"
+
+// issueTypes are the structured issue types of Gitlab, other than the
+// default one. An imported issue with one of these types get the matching
+// label.
+var issueTypes = []string{"incident", "test_case", "task", "objective", "key_result"}
+
+func isIssueType(issueType string) bool {
+	for _, t := range issueTypes {
+		if t == issueType {
+			return true
+		}
+	}
+	return false
+}
+
+// issueDetails hold the fields of an issue not decoded by the gitlab client
+type issueDetails struct {
+	IssueType    string     `json:"issue_type"`
+	Iteration    *iteration `json:"iteration"`
+	HealthStatus *string    `json:"health_status"`
+}
+
+// iteration is a timebox (sprint) an issue can be assigned to
+type iteration struct {
+	ID        int             `json:"id"`
+	Title     string          `json:"title"`
+	StartDate *gitlab.ISOTime `json:"start_date"`
+	DueDate   *gitlab.ISOTime `json:"due_date"`
+}
+
+// metricImage is an image attached to an incident
+type metricImage struct {
+	ID        int        `json:"id"`
+	CreatedAt *time.Time `json:"created_at"`
+	Filename  string     `json:"filename"`
+	FilePath  string     `json:"file_path"`
+	URL       string     `json:"url"`
+}
+
+// getIssueDetails query the fields of an issue not covered by the gitlab
+// client, so the request is built manually.
+func (gi *gitlabImporter) getIssueDetails(ctx context.Context, issue *gitlab.Issue) (*issueDetails, error) {
+	ctx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	u := fmt.Sprintf("projects/%s/issues/%d", url.PathEscape(gi.conf[keyProjectID]), issue.IID)
+
+	var details issueDetails
+	err := retryableRequest(func() (*gitlab.Response, error) {
+		req, err := gi.client.NewRequest("GET", u, nil, []gitlab.OptionFunc{gitlab.WithContext(ctx)})
+		if err != nil {
+			return nil, err
+		}
+		return gi.client.Do(req, &details)
+	}, maxRetries(gi.conf))
+	if err != nil {
+		return nil, err
+	}
+
+	if details.IssueType == "" {
+		details.IssueType = defaultIssueType
+	}
+
+	return &details, nil
+}
+
+// listMetricImages query the metric images of an incident
+func (gi *gitlabImporter) listMetricImages(ctx context.Context, issue *gitlab.Issue) ([]*metricImage, error) {
+	ctx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	u := fmt.Sprintf("projects/%s/issues/%d/metric_images", url.PathEscape(gi.conf[keyProjectID]), issue.IID)
+
+	var images []*metricImage
+	err := retryableRequest(func() (*gitlab.Response, error) {
+		req, err := gi.client.NewRequest("GET", u, nil, []gitlab.OptionFunc{gitlab.WithContext(ctx)})
+		if err != nil {
+			return nil, err
+		}
+		return gi.client.Do(req, &images)
+	}, maxRetries(gi.conf))
+	if err != nil {
+		return nil, err
+	}
+
+	return images, nil
+}
+
+// ensureIssueType record the type of the issue on the create operation and
+// synchronize the matching label. Incidents also get their metric images
+// imported as comments with attached files.
+func (gi *gitlabImporter) ensureIssueType(ctx context.Context, repo *cache.RepoCache, b *cache.BugCache, issue *gitlab.Issue, details *issueDetails) error {
+	issueType := details.IssueType
+
+	author, err := gi.ensurePerson(repo, issue.Author.ID)
+	if err != nil {
+		return err
+	}
+
+	snap := b.Snapshot()
+	createOp := snap.Operations[0]
+
+	// the metadata are immutable, so this only record the type at import time,
+	// the label reflect the current type
+	if _, ok := createOp.GetMetadata(metaKeyGitlabIssueType); !ok {
+		_, err = b.SetMetadataRaw(author, issue.UpdatedAt.Unix(), createOp.Id(), map[string]string{
+			metaKeyGitlabIssueType: issueType,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	added, removed := issueTypeLabelChanges(snap.Labels, issueType)
+	if len(added) > 0 || len(removed) > 0 {
+		_, err = b.ForceChangeLabelsRaw(author, issue.UpdatedAt.Unix(), added, removed, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	if issueType == "incident" {
+		return gi.ensureMetricImages(ctx, repo, b, issue)
+	}
+
+	return nil
+}
+
+// issueTypeLabelChanges compute the label changes needed for a bug to carry
+// only the label of the given issue type
+func issueTypeLabelChanges(current []bug.Label, issueType string) (added []string, removed []string) {
+	hasLabel := false
+
+	for _, label := range current {
+		switch {
+		case string(label) == issueType:
+			hasLabel = true
+		case isIssueType(string(label)):
+			removed = append(removed, string(label))
+		}
+	}
+
+	if !hasLabel && isIssueType(issueType) {
+		added = append(added, issueType)
+	}
+
+	return added, removed
+}
+
+func (gi *gitlabImporter) ensureMetricImages(ctx context.Context, repo *cache.RepoCache, b *cache.BugCache, issue *gitlab.Issue) error {
+	images, err := gi.listMetricImages(ctx, issue)
+	if err != nil {
+		return err
+	}
+
+	author, err := gi.ensurePerson(repo, issue.Author.ID)
+	if err != nil {
+		return err
+	}
+
+	for _, image := range images {
+		id := parseID(image.ID)
+
+		_, err := b.ResolveOperationWithMetadata(metaKeyGitlabMetricImageId, id)
+		if err == nil {
+			continue
+		}
+		if err != cache.ErrNoMatchingOp {
+			return err
+		}
+
+		hash, err := gi.downloadFile(ctx, repo, image.FilePath)
+		if err != nil {
+			return err
+		}
+
+		unixTime := issue.UpdatedAt.Unix()
+		if image.CreatedAt != nil {
+			unixTime = image.CreatedAt.Unix()
+		}
+
+		message := fmt.Sprintf("Metric image: %s", image.Filename)
+		if image.URL != "" {
+			message += "\n\n" + image.URL
+		}
+
+		op, err := b.AddCommentRaw(author, unixTime, message, []git.Hash{hash}, map[string]string{
+			metaKeyGitlabMetricImageId: id,
+		})
+		if err != nil {
+			return err
+		}
+
+		gi.out <- core.NewImportComment(op.Id())
+	}
+
+	return nil
+}
+
+// downloadFile fetch the content of a file uploaded on Gitlab and store it
+// in the repository. The path can be relative to the Gitlab instance.
+func (gi *gitlabImporter) downloadFile(ctx context.Context, repo *cache.RepoCache, fileURL string) (git.Hash, error) {
+	ctx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	if !strings.HasPrefix(fileURL, "http") {
+		fileURL = strings.TrimSuffix(gi.conf[keyGitlabBaseUrl], "/") + "/" + strings.TrimPrefix(fileURL, "/")
+	}
+
+	req, err := http.NewRequest("GET", fileURL, nil)
+	if err != nil {
+		return "", err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("PRIVATE-TOKEN", gi.token.Value)
+
+	resp, err := core.NewHTTPClient(0).Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("downloading %s: unexpected status %s", fileURL, resp.Status)
+	}
+
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+
+	return repo.StoreData(data)
+}
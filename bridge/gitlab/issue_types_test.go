@@ -0,0 +1,31 @@
+package gitlab
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/bug"
+)
+
+func TestIssueTypeLabelChanges(t *testing.T) {
+	added, removed := issueTypeLabelChanges([]bug.Label{"bug"}, defaultIssueType)
+	require.Empty(t, added)
+	require.Empty(t, removed)
+
+	added, removed = issueTypeLabelChanges([]bug.Label{"bug"}, "incident")
+	require.Equal(t, []string{"incident"}, added)
+	require.Empty(t, removed)
+
+	added, removed = issueTypeLabelChanges([]bug.Label{"incident", "bug"}, "incident")
+	require.Empty(t, added)
+	require.Empty(t, removed)
+
+	added, removed = issueTypeLabelChanges([]bug.Label{"incident", "bug"}, "task")
+	require.Equal(t, []string{"task"}, added)
+	require.Equal(t, []string{"incident"}, removed)
+
+	added, removed = issueTypeLabelChanges([]bug.Label{"task"}, defaultIssueType)
+	require.Empty(t, added)
+	require.Equal(t, []string{"task"}, removed)
+}
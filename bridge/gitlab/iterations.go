@@ -0,0 +1,93 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/MichaelMure/git-bug/cache"
+)
+
+// ensureIteration record the iteration (sprint) an issue is assigned to on
+// the create operation of the bug. As the metadata are immutable, only the
+// first known iteration is recorded.
+func (gi *gitlabImporter) ensureIteration(repo *cache.RepoCache, b *cache.BugCache, issue *gitlab.Issue, it *iteration) error {
+	if it == nil {
+		return nil
+	}
+
+	createOp := b.Snapshot().Operations[0]
+	if _, ok := createOp.GetMetadata(metaKeyGitlabIteration); ok {
+		return nil
+	}
+
+	author, err := gi.ensurePerson(repo, issue.Author.ID)
+	if err != nil {
+		return err
+	}
+
+	_, err = b.SetMetadataRaw(author, issue.UpdatedAt.Unix(), createOp.Id(), iterationMetadata(it))
+	return err
+}
+
+// iterationMetadata return the metadata describing an iteration, with the
+// dates formatted as ISO 8601
+func iterationMetadata(it *iteration) map[string]string {
+	metadata := map[string]string{
+		metaKeyGitlabIteration: it.Title,
+	}
+	if it.StartDate != nil {
+		metadata[metaKeyGitlabIterationStartDate] = time.Time(*it.StartDate).Format("2006-01-02")
+	}
+	if it.DueDate != nil {
+		metadata[metaKeyGitlabIterationDueDate] = time.Time(*it.DueDate).Format("2006-01-02")
+	}
+	return metadata
+}
+
+// findIteration look for an iteration with the given title, available for
+// the project. It returns nil if there is none.
+func findIteration(ctx context.Context, gc *gitlab.Client, maxRetries int, repositoryID string, title string) (*iteration, error) {
+	ctx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	u := fmt.Sprintf("projects/%s/iterations", url.PathEscape(repositoryID))
+	opt := struct {
+		Search           string `url:"search"`
+		IncludeAncestors bool   `url:"include_ancestors"`
+	}{
+		Search:           title,
+		IncludeAncestors: true,
+	}
+
+	var iterations []*iteration
+	err := retryableRequest(func() (*gitlab.Response, error) {
+		req, err := gc.NewRequest("GET", u, opt, []gitlab.OptionFunc{gitlab.WithContext(ctx)})
+		if err != nil {
+			return nil, err
+		}
+		return gc.Do(req, &iterations)
+	}, maxRetries)
+	if err != nil {
+		return nil, err
+	}
+
+	// the search is fuzzy
+	for _, it := range iterations {
+		if it.Title == title {
+			return it, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// setGitlabIssueIteration assign an issue to an iteration. The REST API
+// doesn't allow to set it directly, so this is done with a quick action.
+func setGitlabIssueIteration(ctx context.Context, gc *gitlab.Client, repositoryID string, issueID int, it *iteration) error {
+	_, err := addCommentGitlabIssue(ctx, gc, repositoryID, issueID, fmt.Sprintf("/iteration *iteration:%d", it.ID))
+	return err
+}
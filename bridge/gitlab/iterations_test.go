@@ -0,0 +1,28 @@
+package gitlab
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/xanzy/go-gitlab"
+)
+
+func TestIterationMetadata(t *testing.T) {
+	start := gitlab.ISOTime(time.Date(2020, 3, 2, 0, 0, 0, 0, time.UTC))
+	due := gitlab.ISOTime(time.Date(2020, 3, 16, 0, 0, 0, 0, time.UTC))
+
+	metadata := iterationMetadata(&iteration{
+		Title:     "Sprint 12",
+		StartDate: &start,
+		DueDate:   &due,
+	})
+	require.Equal(t, map[string]string{
+		metaKeyGitlabIteration:          "Sprint 12",
+		metaKeyGitlabIterationStartDate: "2020-03-02",
+		metaKeyGitlabIterationDueDate:   "2020-03-16",
+	}, metadata)
+
+	metadata = iterationMetadata(&iteration{Title: "Sprint 13"})
+	require.Equal(t, map[string]string{metaKeyGitlabIteration: "Sprint 13"}, metadata)
+}
@@ -51,15 +51,24 @@ type iterator struct {
 	// project id
 	project string
 
+	// if not empty, only the issues with these iids are queried
+	iids []int
+
 	// number of issues and notes to query at once
 	capacity int
 
+	// number of retries of the API calls failing with a transient error
+	maxRetries int
+
 	// shared context
 	ctx context.Context
 
 	// sticky error
 	err error
 
+	// number of issues matching the query, as reported by the first page
+	total int
+
 	// issues iterator
 	issue *issueIterator
 
@@ -71,13 +80,14 @@ type iterator struct {
 }
 
 // NewIterator create a new iterator
-func NewIterator(ctx context.Context, client *gitlab.Client, capacity int, projectID string, since time.Time) *iterator {
+func NewIterator(ctx context.Context, client *gitlab.Client, capacity int, maxRetries int, projectID string, since time.Time) *iterator {
 	return &iterator{
-		gc:       client,
-		project:  projectID,
-		since:    since,
-		capacity: capacity,
-		ctx:      ctx,
+		gc:         client,
+		project:    projectID,
+		since:      since,
+		capacity:   capacity,
+		maxRetries: maxRetries,
+		ctx:        ctx,
 		issue: &issueIterator{
 			index: -1,
 			page:  1,
@@ -101,25 +111,38 @@ func (i *iterator) getNextIssues() bool {
 	ctx, cancel := context.WithTimeout(i.ctx, defaultTimeout)
 	defer cancel()
 
-	issues, _, err := i.gc.Issues.ListProjectIssues(
-		i.project,
-		&gitlab.ListProjectIssuesOptions{
-			ListOptions: gitlab.ListOptions{
-				Page:    i.issue.page,
-				PerPage: i.capacity,
+	var issues []*gitlab.Issue
+	var total int
+	err := retryableRequest(func() (resp *gitlab.Response, err error) {
+		issues, resp, err = i.gc.Issues.ListProjectIssues(
+			i.project,
+			&gitlab.ListProjectIssuesOptions{
+				ListOptions: gitlab.ListOptions{
+					Page:    i.issue.page,
+					PerPage: i.capacity,
+				},
+				IIDs:         i.iids,
+				Scope:        gitlab.String("all"),
+				UpdatedAfter: &i.since,
+				Sort:         gitlab.String("asc"),
 			},
-			Scope:        gitlab.String("all"),
-			UpdatedAfter: &i.since,
-			Sort:         gitlab.String("asc"),
-		},
-		gitlab.WithContext(ctx),
-	)
+			gitlab.WithContext(ctx),
+		)
+		if resp != nil {
+			total = resp.TotalItems
+		}
+		return resp, err
+	}, i.maxRetries)
 
 	if err != nil {
 		i.err = err
 		return false
 	}
 
+	if i.issue.page == 1 {
+		i.total = total
+	}
+
 	// if repository doesn't have any issues
 	if len(issues) == 0 {
 		return false
@@ -157,6 +180,12 @@ func (i *iterator) NextIssue() bool {
 	return i.getNextIssues()
 }
 
+// TotalIssues return the number of issues matching the query, once the first
+// page has been queried
+func (i *iterator) TotalIssues() int {
+	return i.total
+}
+
 func (i *iterator) IssueValue() *gitlab.Issue {
 	return i.issue.cache[i.issue.index]
 }
@@ -165,19 +194,23 @@ func (i *iterator) getNextNotes() bool {
 	ctx, cancel := context.WithTimeout(i.ctx, defaultTimeout)
 	defer cancel()
 
-	notes, _, err := i.gc.Notes.ListIssueNotes(
-		i.project,
-		i.IssueValue().IID,
-		&gitlab.ListIssueNotesOptions{
-			ListOptions: gitlab.ListOptions{
-				Page:    i.note.page,
-				PerPage: i.capacity,
+	var notes []*gitlab.Note
+	err := retryableRequest(func() (resp *gitlab.Response, err error) {
+		notes, resp, err = i.gc.Notes.ListIssueNotes(
+			i.project,
+			i.IssueValue().IID,
+			&gitlab.ListIssueNotesOptions{
+				ListOptions: gitlab.ListOptions{
+					Page:    i.note.page,
+					PerPage: i.capacity,
+				},
+				Sort:    gitlab.String("asc"),
+				OrderBy: gitlab.String("created_at"),
 			},
-			Sort:    gitlab.String("asc"),
-			OrderBy: gitlab.String("created_at"),
-		},
-		gitlab.WithContext(ctx),
-	)
+			gitlab.WithContext(ctx),
+		)
+		return resp, err
+	}, i.maxRetries)
 
 	if err != nil {
 		i.err = err
@@ -232,17 +265,21 @@ func (i *iterator) getLabelEvents() bool {
 	page := 1
 	hasNextPage := true
 	for hasNextPage {
-		labelEvents, _, err := i.gc.ResourceLabelEvents.ListIssueLabelEvents(
-			i.project,
-			i.IssueValue().IID,
-			&gitlab.ListLabelEventsOptions{
-				ListOptions: gitlab.ListOptions{
-					Page:    page,
-					PerPage: i.capacity,
+		var labelEvents []*gitlab.LabelEvent
+		err := retryableRequest(func() (resp *gitlab.Response, err error) {
+			labelEvents, resp, err = i.gc.ResourceLabelEvents.ListIssueLabelEvents(
+				i.project,
+				i.IssueValue().IID,
+				&gitlab.ListLabelEventsOptions{
+					ListOptions: gitlab.ListOptions{
+						Page:    page,
+						PerPage: i.capacity,
+					},
 				},
-			},
-			gitlab.WithContext(ctx),
-		)
+				gitlab.WithContext(ctx),
+			)
+			return resp, err
+		}, i.maxRetries)
 		if err != nil {
 			i.err = err
 			return false
@@ -0,0 +1,157 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/cache"
+)
+
+// gitlabLabel is a label of a project, as listed by the labels API. The
+// gitlab client can't tell apart a label without priority, so this is
+// decoded manually.
+type gitlabLabel struct {
+	ID             int    `json:"id"`
+	Name           string `json:"name"`
+	Color          string `json:"color"`
+	Description    string `json:"description"`
+	Priority       *int   `json:"priority"`
+	IsProjectLabel bool   `json:"is_project_label"`
+}
+
+// metadata convert the label into the metadata stored by git-bug
+func (l *gitlabLabel) metadata() cache.LabelMetadata {
+	meta := cache.LabelMetadata{
+		Name:         l.Name,
+		Description:  l.Description,
+		Priority:     l.Priority,
+		ProjectLabel: l.IsProjectLabel,
+	}
+
+	// gitlab also accept the CSS color names, which are not kept
+	if color, err := bug.ParseLabelColor(l.Color); err == nil {
+		meta.Color = color.Hex()
+	}
+
+	return meta
+}
+
+// listGitlabLabels list the labels of a project, including the ones
+// inherited from its groups
+func listGitlabLabels(ctx context.Context, gc *gitlab.Client, maxRetries int, repositoryID string) ([]*gitlabLabel, error) {
+	ctx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	u := fmt.Sprintf("projects/%s/labels", url.PathEscape(repositoryID))
+
+	var labels []*gitlabLabel
+	page := 1
+
+	for page != 0 {
+		opt := &gitlab.ListLabelsOptions{PerPage: 100, Page: page}
+
+		var pageLabels []*gitlabLabel
+		var resp *gitlab.Response
+		err := retryableRequest(func() (*gitlab.Response, error) {
+			req, err := gc.NewRequest("GET", u, opt, []gitlab.OptionFunc{gitlab.WithContext(ctx)})
+			if err != nil {
+				return nil, err
+			}
+			resp, err = gc.Do(req, &pageLabels)
+			return resp, err
+		}, maxRetries)
+		if err != nil {
+			return nil, err
+		}
+
+		labels = append(labels, pageLabels...)
+		page = resp.NextPage
+	}
+
+	return labels, nil
+}
+
+// importLabelMetadata store the color, description and priority of the
+// labels of the project
+func (gi *gitlabImporter) importLabelMetadata(ctx context.Context, repo *cache.RepoCache) error {
+	labels, err := listGitlabLabels(ctx, gi.client, maxRetries(gi.conf), gi.conf[keyProjectID])
+	if err != nil {
+		return err
+	}
+
+	// the label system notes refer to the labels by id
+	gi.labelNames = make(map[int]string, len(labels))
+
+	for _, label := range labels {
+		gi.labelNames[label.ID] = label.Name
+
+		if err := repo.StoreLabelMetadata(label.metadata()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ensureGitlabLabels create the labels missing in the project, with the
+// color and description of their metadata if any. Otherwise gitlab would
+// create them with a default color when updating the labels of an issue.
+func (ge *gitlabExporter) ensureGitlabLabels(ctx context.Context, gc *gitlab.Client, labels []bug.Label) error {
+	if ge.gitlabLabels == nil {
+		existing, err := listGitlabLabels(ctx, gc, ge.maxRetries, ge.repositoryID)
+		if err != nil {
+			return err
+		}
+
+		ge.gitlabLabels = make(map[string]bool, len(existing))
+		for _, label := range existing {
+			ge.gitlabLabels[label.Name] = true
+		}
+	}
+
+	for _, label := range labels {
+		if ge.gitlabLabels[label.String()] {
+			continue
+		}
+
+		name := label.String()
+		color := label.Color().Hex()
+		var description *string
+
+		if meta, ok := ge.labelMetadata[name]; ok {
+			if meta.Color != "" {
+				color = meta.Color
+			}
+			if meta.Description != "" {
+				description = &meta.Description
+			}
+		}
+
+		err := createGitlabLabel(ctx, gc, ge.maxRetries, ge.repositoryID, &gitlab.CreateLabelOptions{
+			Name:        &name,
+			Color:       &color,
+			Description: description,
+		})
+		if err != nil {
+			return err
+		}
+
+		ge.gitlabLabels[name] = true
+	}
+
+	return nil
+}
+
+func createGitlabLabel(ctx context.Context, gc *gitlab.Client, maxRetries int, repositoryID string, opt *gitlab.CreateLabelOptions) error {
+	ctx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	return retryableRequest(func() (*gitlab.Response, error) {
+		_, resp, err := gc.Labels.CreateLabel(repositoryID, opt, gitlab.WithContext(ctx))
+		return resp, err
+	}, maxRetries)
+}
@@ -0,0 +1,105 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/MichaelMure/git-bug/bridge/core"
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestLabelMetadata(t *testing.T) {
+	var created []map[string]string
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v4/projects/123/labels", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "POST" {
+			var body map[string]string
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			created = append(created, body)
+			fmt.Fprint(w, `{}`)
+			return
+		}
+
+		switch r.URL.Query().Get("page") {
+		case "1":
+			w.Header().Set("X-Next-Page", "2")
+			fmt.Fprint(w, `[
+				{"name": "bug", "color": "#FF0000", "description": "Something is broken", "priority": 0, "is_project_label": true}
+			]`)
+		case "2":
+			fmt.Fprint(w, `[
+				{"name": "feature", "color": "blue", "description": "", "priority": null, "is_project_label": false}
+			]`)
+		}
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client := gitlab.NewClient(server.Client(), "token")
+	require.NoError(t, client.SetBaseURL(server.URL))
+
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	backend, err := cache.NewRepoCache(repo)
+	require.NoError(t, err)
+	defer backend.Close()
+
+	gi := &gitlabImporter{
+		conf:   core.Configuration{keyProjectID: "123"},
+		client: client,
+	}
+
+	err = gi.importLabelMetadata(context.Background(), backend)
+	require.NoError(t, err)
+
+	meta, ok, err := backend.ResolveLabelMetadata("bug")
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, "#ff0000", meta.Color)
+	require.Equal(t, "Something is broken", meta.Description)
+	require.Equal(t, 0, *meta.Priority)
+	require.True(t, meta.ProjectLabel)
+	require.Equal(t, bug.LabelColor{R: 255, A: 255}, backend.LabelColor("bug"))
+
+	meta, ok, err = backend.ResolveLabelMetadata("feature")
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, "", meta.Color)
+	require.Nil(t, meta.Priority)
+	require.False(t, meta.ProjectLabel)
+
+	err = backend.StoreLabelMetadata(cache.LabelMetadata{
+		Name:        "scope/ui",
+		Color:       "#00bcd4",
+		Description: "The user interface",
+	})
+	require.NoError(t, err)
+
+	ge := &gitlabExporter{repositoryID: "123"}
+	require.NoError(t, ge.Init(backend, core.Configuration{keyProjectID: "123"}))
+
+	err = ge.ensureGitlabLabels(context.Background(), client, []bug.Label{"bug", "scope/ui", "new"})
+	require.NoError(t, err)
+
+	require.Equal(t, []map[string]string{
+		{"name": "scope/ui", "color": "#00bcd4", "description": "The user interface"},
+		{"name": "new", "color": bug.Label("new").Color().Hex()},
+	}, created)
+
+	// the created labels are remembered
+	err = ge.ensureGitlabLabels(context.Background(), client, []bug.Label{"new"})
+	require.NoError(t, err)
+	require.Len(t, created, 2)
+}
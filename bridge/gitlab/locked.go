@@ -0,0 +1,44 @@
+package gitlab
+
+import (
+	"context"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/MichaelMure/git-bug/cache"
+)
+
+// ensureLocked record the locking of the issue discussion if it changed
+func (gi *gitlabImporter) ensureLocked(repo *cache.RepoCache, b *cache.BugCache, issue *gitlab.Issue) error {
+	if b.IsLocked() == issue.DiscussionLocked {
+		return nil
+	}
+
+	// the API doesn't tell who locked the issue
+	author, err := gi.ensurePerson(repo, issue.Author.ID)
+	if err != nil {
+		return err
+	}
+
+	// the gitlab id mark the operation as already existing in gitlab
+	_, err = b.SetLockedRaw(author, issue.UpdatedAt.Unix(), issue.DiscussionLocked, map[string]string{
+		metaKeyGitlabId: parseID(issue.IID),
+	})
+	return err
+}
+
+// updateGitlabIssueLocked lock or unlock the discussion of an issue
+func updateGitlabIssueLocked(ctx context.Context, gc *gitlab.Client, maxRetries int, repositoryID string, issueID int, locked bool) error {
+	ctx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+	return retryableRequest(func() (*gitlab.Response, error) {
+		_, resp, err := gc.Issues.UpdateIssue(
+			repositoryID, issueID,
+			&gitlab.UpdateIssueOptions{
+				DiscussionLocked: gitlab.Bool(locked),
+			},
+			gitlab.WithContext(ctx),
+		)
+		return resp, err
+	}, maxRetries)
+}
@@ -0,0 +1,152 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/MichaelMure/git-bug/bridge/core"
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/util/text"
+)
+
+// mrNote is a note of a merge request. Unlike gitlab.Note, it has the bot
+// flag of the author.
+type mrNote struct {
+	ID        int        `json:"id"`
+	Body      string     `json:"body"`
+	System    bool       `json:"system"`
+	CreatedAt *time.Time `json:"created_at"`
+	Author    struct {
+		ID  int  `json:"id"`
+		Bot bool `json:"bot"`
+	} `json:"author"`
+}
+
+// listRelatedMergeRequests query the merge requests related to an issue,
+// that is mentioning it or closing it
+func (gi *gitlabImporter) listRelatedMergeRequests(ctx context.Context, issue *gitlab.Issue) ([]*gitlab.MergeRequest, error) {
+	ctx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	var mrs []*gitlab.MergeRequest
+	err := retryableRequest(func() (resp *gitlab.Response, err error) {
+		mrs, resp, err = gi.client.Issues.ListMergeRequestsRelatedToIssue(
+			gi.conf[keyProjectID],
+			issue.IID,
+			&gitlab.ListMergeRequestsRelatedToIssueOptions{PerPage: 100},
+			gitlab.WithContext(ctx),
+		)
+		return resp, err
+	}, maxRetries(gi.conf))
+
+	return mrs, err
+}
+
+// listMergeRequestNotes query a page of the notes of a merge request. The
+// request is built manually to get the bot flag of the authors.
+func (gi *gitlabImporter) listMergeRequestNotes(ctx context.Context, mr *gitlab.MergeRequest, page int) ([]*mrNote, error) {
+	ctx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	u := fmt.Sprintf("projects/%d/merge_requests/%d/notes", mr.ProjectID, mr.IID)
+	opt := &gitlab.ListMergeRequestNotesOptions{
+		ListOptions: gitlab.ListOptions{
+			Page:    page,
+			PerPage: 10,
+		},
+		OrderBy: gitlab.String("created_at"),
+		Sort:    gitlab.String("asc"),
+	}
+
+	var notes []*mrNote
+	err := retryableRequest(func() (*gitlab.Response, error) {
+		req, err := gi.client.NewRequest("GET", u, opt, []gitlab.OptionFunc{gitlab.WithContext(ctx)})
+		if err != nil {
+			return nil, err
+		}
+		return gi.client.Do(req, &notes)
+	}, maxRetries(gi.conf))
+	if err != nil {
+		return nil, err
+	}
+
+	return notes, nil
+}
+
+// ensureMergeRequestComments import the comments of the merge requests
+// related to an issue as comments of the bug. The system notes and the
+// comments of the bots are skipped.
+func (gi *gitlabImporter) ensureMergeRequestComments(ctx context.Context, repo *cache.RepoCache, b *cache.BugCache, issue *gitlab.Issue) error {
+	mrs, err := gi.listRelatedMergeRequests(ctx, issue)
+	if err != nil {
+		return err
+	}
+
+	for _, mr := range mrs {
+		for page := 1; ; page++ {
+			notes, err := gi.listMergeRequestNotes(ctx, mr, page)
+			if err != nil {
+				return err
+			}
+			if len(notes) == 0 {
+				break
+			}
+
+			for _, note := range notes {
+				if note.System || note.Author.Bot {
+					continue
+				}
+
+				err := gi.ensureMergeRequestComment(repo, b, mr, note)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func (gi *gitlabImporter) ensureMergeRequestComment(repo *cache.RepoCache, b *cache.BugCache, mr *gitlab.MergeRequest, note *mrNote) error {
+	noteID := strconv.Itoa(note.ID)
+
+	_, err := b.ResolveOperationWithMetadata(metaKeyGitlabMRComment, noteID)
+	if err == nil {
+		return nil
+	}
+	if err != cache.ErrNoMatchingOp {
+		return err
+	}
+
+	author, err := gi.ensurePerson(repo, note.Author.ID)
+	if err != nil {
+		return err
+	}
+
+	cleanText, err := text.Cleanup(note.Body)
+	if err != nil {
+		return err
+	}
+
+	op, err := b.AddCommentRawAt(
+		author,
+		*note.CreatedAt,
+		cleanText,
+		nil,
+		map[string]string{
+			metaKeyGitlabMRComment: noteID,
+			metaKeyGitlabMRUrl:     mr.WebURL,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	gi.out <- core.NewImportComment(op.Id())
+	return nil
+}
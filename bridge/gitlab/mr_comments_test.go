@@ -0,0 +1,82 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/MichaelMure/git-bug/bridge/core"
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestEnsureMergeRequestComments(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v4/projects/123/issues/1/related_merge_requests", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"id": 50, "iid": 5, "project_id": 123, "web_url": "https://gitlab.com/group/project/-/merge_requests/5"}]`)
+	})
+	mux.HandleFunc("/api/v4/projects/123/merge_requests/5/notes", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page") != "1" {
+			fmt.Fprint(w, `[]`)
+			return
+		}
+		fmt.Fprint(w, `[
+			{"id": 1, "body": "looks good", "system": false, "created_at": "2020-01-01T10:00:00Z", "author": {"id": 7, "bot": false}},
+			{"id": 2, "body": "pipeline passed", "system": false, "created_at": "2020-01-01T11:00:00Z", "author": {"id": 8, "bot": true}},
+			{"id": 3, "body": "approved this merge request", "system": true, "created_at": "2020-01-01T12:00:00Z", "author": {"id": 7, "bot": false}}
+		]`)
+	})
+	mux.HandleFunc("/api/v4/users/7", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": 7, "name": "René Descartes", "username": "rene"}`)
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client := gitlab.NewClient(server.Client(), "token")
+	require.NoError(t, client.SetBaseURL(server.URL))
+
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	backend, err := cache.NewRepoCache(repo)
+	require.NoError(t, err)
+	defer backend.Close()
+
+	author, err := backend.NewIdentity("Blaise Pascal", "blaise@pascal.fr")
+	require.NoError(t, err)
+	b, _, err := backend.NewBugRaw(author, 1000, "title", "message", nil, nil)
+	require.NoError(t, err)
+
+	out := make(chan core.ImportResult, 10)
+	gi := &gitlabImporter{
+		conf:   core.Configuration{keyProjectID: "123"},
+		client: client,
+		out:    out,
+	}
+	issue := &gitlab.Issue{IID: 1}
+
+	err = gi.ensureMergeRequestComments(context.Background(), backend, b, issue)
+	require.NoError(t, err)
+
+	comments := b.Snapshot().Comments
+	require.Len(t, comments, 2)
+	require.Equal(t, "looks good", comments[1].Message)
+	require.Equal(t, "René Descartes", comments[1].Author.Name())
+
+	_, err = b.ResolveOperationWithMetadata(metaKeyGitlabMRComment, "1")
+	require.NoError(t, err)
+	url, ok := b.Snapshot().LastMetadata(metaKeyGitlabMRUrl)
+	require.True(t, ok)
+	require.Equal(t, "https://gitlab.com/group/project/-/merge_requests/5", url)
+
+	// importing again doesn't duplicate the comments
+	err = gi.ensureMergeRequestComments(context.Background(), backend, b, issue)
+	require.NoError(t, err)
+	require.Len(t, b.Snapshot().Comments, 2)
+}
@@ -0,0 +1,132 @@
+package gitlab
+
+import (
+	"context"
+	"time"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/MichaelMure/git-bug/cache"
+)
+
+// MetaKeyPipelineStatus is the metadata key holding the status of the CI
+// pipelines of the merge requests related to an issue. Like the health
+// status, it is carried by NoOp operations and the current value is the one
+// of the most recent operation. An empty value means no pipeline.
+const MetaKeyPipelineStatus = "gitlab:pipeline-status"
+
+// The pipeline statuses, the Gitlab ones being simplified
+const (
+	PipelinePassed  = "passed"
+	PipelineFailed  = "failed"
+	PipelineRunning = "running"
+)
+
+// pipelineStatus simplify the status of a Gitlab pipeline. The pipelines
+// canceled, skipped or waiting for a manual action don't have one.
+func pipelineStatus(status string) string {
+	switch status {
+	case "success":
+		return PipelinePassed
+	case "failed":
+		return PipelineFailed
+	case "created", "waiting_for_resource", "preparing", "pending", "running", "scheduled":
+		return PipelineRunning
+	default:
+		return ""
+	}
+}
+
+// combinePipelineStatuses return the status of a set of pipelines: failed
+// if any failed, else running if any is running, else passed if any passed
+func combinePipelineStatuses(statuses []string) string {
+	result := ""
+	for _, status := range statuses {
+		switch {
+		case status == PipelineFailed:
+			return PipelineFailed
+		case status == PipelineRunning:
+			result = PipelineRunning
+		case status == PipelinePassed && result == "":
+			result = PipelinePassed
+		}
+	}
+	return result
+}
+
+// latestMergeRequestPipeline query the most recent pipeline of a merge
+// request, or nil if there is none
+func (gi *gitlabImporter) latestMergeRequestPipeline(ctx context.Context, mr *gitlab.MergeRequest) (*gitlab.PipelineInfo, error) {
+	ctx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	var pipelines []*gitlab.PipelineInfo
+	err := retryableRequest(func() (resp *gitlab.Response, err error) {
+		pipelines, resp, err = gi.client.MergeRequests.ListMergeRequestPipelines(
+			mr.ProjectID,
+			mr.IID,
+			gitlab.WithContext(ctx),
+		)
+		return resp, err
+	}, maxRetries(gi.conf))
+	if err != nil {
+		return nil, err
+	}
+
+	var latest *gitlab.PipelineInfo
+	for _, p := range pipelines {
+		if latest == nil || p.ID > latest.ID {
+			latest = p
+		}
+	}
+
+	return latest, nil
+}
+
+// ensurePipelineStatus record the status of the latest pipelines of the merge
+// requests related to the issue, if it changed
+func (gi *gitlabImporter) ensurePipelineStatus(ctx context.Context, repo *cache.RepoCache, b *cache.BugCache, issue *gitlab.Issue) error {
+	mrs, err := gi.listRelatedMergeRequests(ctx, issue)
+	if err != nil {
+		return err
+	}
+
+	var statuses []string
+	var updatedAt time.Time
+
+	for _, mr := range mrs {
+		p, err := gi.latestMergeRequestPipeline(ctx, mr)
+		if err != nil {
+			return err
+		}
+		if p == nil {
+			continue
+		}
+
+		statuses = append(statuses, pipelineStatus(p.Status))
+		if p.UpdatedAt != nil && p.UpdatedAt.After(updatedAt) {
+			updatedAt = *p.UpdatedAt
+		}
+	}
+
+	status := combinePipelineStatuses(statuses)
+
+	current, ok := b.Snapshot().LastMetadata(MetaKeyPipelineStatus)
+	if current == status && (ok || status == "") {
+		return nil
+	}
+
+	author, err := gi.ensurePerson(repo, issue.Author.ID)
+	if err != nil {
+		return err
+	}
+
+	if updatedAt.IsZero() {
+		updatedAt = *issue.UpdatedAt
+	}
+
+	_, err = b.OpNoOpRaw(author, updatedAt.Unix(), map[string]string{
+		MetaKeyPipelineStatus: status,
+	})
+	return err
+}
@@ -0,0 +1,95 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/MichaelMure/git-bug/bridge/core"
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestCombinePipelineStatuses(t *testing.T) {
+	require.Equal(t, "", combinePipelineStatuses(nil))
+	require.Equal(t, "", combinePipelineStatuses([]string{""}))
+	require.Equal(t, PipelinePassed, combinePipelineStatuses([]string{"", PipelinePassed}))
+	require.Equal(t, PipelineRunning, combinePipelineStatuses([]string{PipelinePassed, PipelineRunning}))
+	require.Equal(t, PipelineFailed, combinePipelineStatuses([]string{PipelineRunning, PipelineFailed, PipelinePassed}))
+}
+
+func TestEnsurePipelineStatus(t *testing.T) {
+	status := "running"
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v4/projects/123/issues/1/related_merge_requests", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[
+			{"id": 50, "iid": 5, "project_id": 123},
+			{"id": 60, "iid": 6, "project_id": 123}
+		]`)
+	})
+	mux.HandleFunc("/api/v4/projects/123/merge_requests/5/pipelines", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `[
+			{"id": 12, "status": "%s", "updated_at": "2020-01-02T10:00:00Z"},
+			{"id": 11, "status": "failed", "updated_at": "2020-01-01T10:00:00Z"}
+		]`, status)
+	})
+	mux.HandleFunc("/api/v4/projects/123/merge_requests/6/pipelines", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"id": 10, "status": "success", "updated_at": "2020-01-01T09:00:00Z"}]`)
+	})
+	mux.HandleFunc("/api/v4/users/7", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": 7, "name": "René Descartes", "username": "rene"}`)
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client := gitlab.NewClient(server.Client(), "token")
+	require.NoError(t, client.SetBaseURL(server.URL))
+
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	backend, err := cache.NewRepoCache(repo)
+	require.NoError(t, err)
+	defer backend.Close()
+
+	author, err := backend.NewIdentity("Blaise Pascal", "blaise@pascal.fr")
+	require.NoError(t, err)
+	b, _, err := backend.NewBugRaw(author, 1000, "title", "message", nil, nil)
+	require.NoError(t, err)
+
+	gi := &gitlabImporter{
+		conf:   core.Configuration{keyProjectID: "123"},
+		client: client,
+		out:    make(chan core.ImportResult, 10),
+	}
+	issue := &gitlab.Issue{IID: 1, Author: &gitlab.IssueAuthor{ID: 7}}
+
+	// only the latest pipeline of each merge request counts
+	err = gi.ensurePipelineStatus(context.Background(), backend, b, issue)
+	require.NoError(t, err)
+	value, ok := b.Snapshot().LastMetadata(MetaKeyPipelineStatus)
+	require.True(t, ok)
+	require.Equal(t, PipelineRunning, value)
+
+	// unchanged, nothing recorded
+	err = gi.ensurePipelineStatus(context.Background(), backend, b, issue)
+	require.NoError(t, err)
+	require.Len(t, b.Snapshot().Operations, 2)
+
+	status = "failed"
+	err = gi.ensurePipelineStatus(context.Background(), backend, b, issue)
+	require.NoError(t, err)
+	require.Len(t, b.Snapshot().Operations, 3)
+
+	filter := cache.LastMetadataFilter(MetaKeyPipelineStatus, PipelineFailed)
+	excerpt, err := backend.ResolveBugExcerpt(b.Id())
+	require.NoError(t, err)
+	require.True(t, filter(backend, excerpt))
+}
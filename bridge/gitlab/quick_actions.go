@@ -0,0 +1,205 @@
+package gitlab
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/MichaelMure/git-bug/bridge/core"
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/cache"
+)
+
+// quickAction is a command written on its own line in a Gitlab comment, like
+// "/close" or "/label ~bug"
+type quickAction struct {
+	name string
+	// the labels, without the "~", or the title
+	args []string
+}
+
+// quickActionLabelRegexp match a label of a quick action, like ~bug or
+// ~"needs review". The label is the first or the second group.
+var quickActionLabelRegexp = regexp.MustCompile(`~"([^"]+)"|~(\S+)`)
+
+// parseQuickAction parse a single line of a comment, and return false if
+// it's not a quick action with an equivalent in git-bug
+func parseQuickAction(line string) (quickAction, bool) {
+	line = strings.TrimSpace(line)
+	if !strings.HasPrefix(line, "/") {
+		return quickAction{}, false
+	}
+
+	split := strings.SplitN(line[1:], " ", 2)
+	name := split[0]
+	rest := ""
+	if len(split) == 2 {
+		rest = strings.TrimSpace(split[1])
+	}
+
+	switch name {
+	case "close", "reopen":
+		if rest != "" {
+			return quickAction{}, false
+		}
+		return quickAction{name: name}, true
+
+	case "title":
+		if rest == "" {
+			return quickAction{}, false
+		}
+		return quickAction{name: name, args: []string{rest}}, true
+
+	case "label", "unlabel", "relabel":
+		var labels []string
+		for _, match := range quickActionLabelRegexp.FindAllStringSubmatch(rest, -1) {
+			if match[1] != "" {
+				labels = append(labels, match[1])
+			} else {
+				labels = append(labels, match[2])
+			}
+		}
+		// unlabel alone remove all the labels
+		if len(labels) == 0 && name != "unlabel" {
+			return quickAction{}, false
+		}
+		return quickAction{name: name, args: labels}, true
+
+	default:
+		// the other quick actions, like /assign or /milestone, have no
+		// equivalent and are kept as text
+		return quickAction{}, false
+	}
+}
+
+// parseQuickActions extract the quick actions from a comment, and return the
+// comment without them. The lines of the code blocks are never extracted.
+func parseQuickActions(body string) ([]quickAction, string) {
+	var actions []quickAction
+	var kept []string
+	inCode := false
+
+	for _, line := range strings.Split(body, "\n") {
+		if strings.HasPrefix(strings.TrimSpace(line), "```") {
+			inCode = !inCode
+		}
+
+		if !inCode {
+			if action, ok := parseQuickAction(line); ok {
+				actions = append(actions, action)
+				continue
+			}
+		}
+
+		kept = append(kept, line)
+	}
+
+	return actions, strings.TrimSpace(strings.Join(kept, "\n"))
+}
+
+// applyQuickActions create the operations equivalent to the quick actions
+// of a comment. The actions without effect on the bug are ignored.
+func (gi *gitlabImporter) applyQuickActions(b *cache.BugCache, author *cache.IdentityCache, note *gitlab.Note, actions []quickAction) error {
+	unixTime := note.CreatedAt.Unix()
+	metadata := map[string]string{
+		metaKeyGitlabQuickAction: parseID(note.ID),
+	}
+
+	for _, action := range actions {
+		snap := b.Snapshot()
+
+		switch action.name {
+		case "close", "reopen":
+			status := bug.ClosedStatus
+			if action.name == "reopen" {
+				status = bug.OpenStatus
+			}
+			if snap.Status == status {
+				continue
+			}
+
+			var op *bug.SetStatusOperation
+			var err error
+			if status == bug.ClosedStatus {
+				op, err = b.CloseRaw(author, unixTime, metadata)
+			} else {
+				op, err = b.OpenRaw(author, unixTime, metadata)
+			}
+			if err != nil {
+				return err
+			}
+			gi.out <- core.NewImportStatusChange(op.Id())
+
+		case "title":
+			if snap.Title == action.args[0] {
+				continue
+			}
+			op, err := b.SetTitleRaw(author, unixTime, action.args[0], metadata)
+			if err != nil {
+				return err
+			}
+			gi.out <- core.NewImportTitleEdition(op.Id())
+
+		case "label", "unlabel", "relabel":
+			added, removed := quickActionLabelChange(snap.Labels, action)
+			if len(added) == 0 && len(removed) == 0 {
+				continue
+			}
+			_, op, err := b.ChangeLabelsRaw(author, unixTime, added, removed, metadata)
+			if err != nil {
+				return err
+			}
+			gi.out <- core.NewImportLabelChange(op.Id())
+		}
+	}
+
+	return nil
+}
+
+// quickActionLabelChange return the labels added and removed by a label
+// quick action, given the current labels of the bug
+func quickActionLabelChange(current []bug.Label, action quickAction) (added []string, removed []string) {
+	has := make(map[string]bool, len(current))
+	for _, l := range current {
+		has[string(l)] = true
+	}
+
+	wanted := make(map[string]bool, len(action.args))
+	for _, l := range action.args {
+		wanted[l] = true
+	}
+
+	switch action.name {
+	case "label":
+		for _, l := range action.args {
+			if !has[l] {
+				added = append(added, l)
+				has[l] = true
+			}
+		}
+
+	case "unlabel":
+		for _, l := range current {
+			// unlabel alone remove all the labels
+			if len(action.args) == 0 || wanted[string(l)] {
+				removed = append(removed, string(l))
+			}
+		}
+
+	case "relabel":
+		for _, l := range current {
+			if !wanted[string(l)] {
+				removed = append(removed, string(l))
+			}
+		}
+		for _, l := range action.args {
+			if !has[l] {
+				added = append(added, l)
+				has[l] = true
+			}
+		}
+	}
+
+	return added, removed
+}
@@ -0,0 +1,86 @@
+package gitlab
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/MichaelMure/git-bug/bridge/core"
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestParseQuickActions(t *testing.T) {
+	body := "Fixed in the last release.\n" +
+		"/close\n" +
+		"/label ~bug ~\"needs review\"\n" +
+		"/assign @rene\n" +
+		"```\n/reopen\n```\n"
+
+	actions, stripped := parseQuickActions(body)
+
+	require.Equal(t, []quickAction{
+		{name: "close"},
+		{name: "label", args: []string{"bug", "needs review"}},
+	}, actions)
+	require.Equal(t, "Fixed in the last release.\n/assign @rene\n```\n/reopen\n```", stripped)
+
+	actions, stripped = parseQuickActions("just a comment about /close")
+	require.Empty(t, actions)
+	require.Equal(t, "just a comment about /close", stripped)
+}
+
+func TestQuickActionLabelChange(t *testing.T) {
+	current := []bug.Label{"bug", "doing"}
+
+	tests := []struct {
+		action  quickAction
+		added   []string
+		removed []string
+	}{
+		{quickAction{name: "label", args: []string{"bug", "ui"}}, []string{"ui"}, nil},
+		{quickAction{name: "unlabel", args: []string{"doing", "ui"}}, nil, []string{"doing"}},
+		{quickAction{name: "unlabel"}, nil, []string{"bug", "doing"}},
+		{quickAction{name: "relabel", args: []string{"doing", "done"}}, []string{"done"}, []string{"bug"}},
+	}
+
+	for _, tt := range tests {
+		added, removed := quickActionLabelChange(current, tt.action)
+		require.Equal(t, tt.added, added, tt.action.name)
+		require.Equal(t, tt.removed, removed, tt.action.name)
+	}
+}
+
+func TestApplyQuickActions(t *testing.T) {
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	backend, err := cache.NewRepoCache(repo)
+	require.NoError(t, err)
+	defer backend.Close()
+
+	author, err := backend.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+
+	b, _, err := backend.NewBugRaw(author, time.Now().Unix(), "title", "message", nil, nil)
+	require.NoError(t, err)
+
+	gi := &gitlabImporter{out: make(chan core.ImportResult, 10)}
+
+	created := time.Now()
+	note := &gitlab.Note{ID: 42, CreatedAt: &created}
+
+	actions, _ := parseQuickActions("/close\n/close\n/title title\n/label ~bug")
+	err = gi.applyQuickActions(b, author, note, actions)
+	require.NoError(t, err)
+
+	snap := b.Snapshot()
+	require.Equal(t, bug.ClosedStatus, snap.Status)
+	require.Equal(t, []bug.Label{"bug"}, snap.Labels)
+	// the second /close and the unchanged title are ignored
+	require.Len(t, snap.Operations, 3)
+	require.Len(t, gi.out, 2)
+}
@@ -0,0 +1,107 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/MichaelMure/git-bug/cache"
+)
+
+// MetaKeyWeight is the metadata key holding the weight of an issue. Like the
+// health status, it is carried by NoOp operations and the current value is
+// the one of the most recent operation, see bug.Snapshot.LastMetadata. An
+// empty value means no weight.
+const MetaKeyWeight = cache.MetaKeyWeight
+
+// metaKeyGitlabWeightEvent tag the operations imported from a weight event
+// with the id of the event
+const metaKeyGitlabWeightEvent = "gitlab:weight-event"
+
+// weightEvent is a change of the weight of an issue, as recorded by the
+// resource weight events API
+type weightEvent struct {
+	ID        int       `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	Weight    *int      `json:"weight"`
+	User      struct {
+		ID int `json:"id"`
+	} `json:"user"`
+}
+
+// value return the weight as stored in the metadata
+func (e *weightEvent) value() string {
+	if e.Weight == nil {
+		return ""
+	}
+	return strconv.Itoa(*e.Weight)
+}
+
+// listWeightEvents query the history of the weight of an issue. The weight
+// is not available in all the Gitlab editions, in which case there is no
+// event.
+func (gi *gitlabImporter) listWeightEvents(ctx context.Context, issue *gitlab.Issue) ([]*weightEvent, error) {
+	ctx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	u := fmt.Sprintf("projects/%s/issues/%d/resource_weight_events", url.PathEscape(gi.conf[keyProjectID]), issue.IID)
+
+	var events []*weightEvent
+	err := retryableRequest(func() (*gitlab.Response, error) {
+		req, err := gi.client.NewRequest("GET", u, nil, []gitlab.OptionFunc{gitlab.WithContext(ctx)})
+		if err != nil {
+			return nil, err
+		}
+		return gi.client.Do(req, &events)
+	}, maxRetries(gi.conf))
+	if errResp, ok := err.(*gitlab.ErrorResponse); ok && errResp.Response != nil &&
+		errResp.Response.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+// ensureWeightEvents import the history of the weight of an issue, each
+// change being recorded with its author and time
+func (gi *gitlabImporter) ensureWeightEvents(ctx context.Context, repo *cache.RepoCache, b *cache.BugCache, issue *gitlab.Issue) error {
+	events, err := gi.listWeightEvents(ctx, issue)
+	if err != nil {
+		return err
+	}
+
+	for _, event := range events {
+		eventID := strconv.Itoa(event.ID)
+
+		_, err := b.ResolveOperationWithMetadata(metaKeyGitlabWeightEvent, eventID)
+		if err == nil {
+			continue
+		}
+		if err != cache.ErrNoMatchingOp {
+			return err
+		}
+
+		author, err := gi.ensurePerson(repo, event.User.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = b.OpNoOpRaw(author, event.CreatedAt.Unix(), map[string]string{
+			MetaKeyWeight:            event.value(),
+			metaKeyGitlabWeightEvent: eventID,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,80 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/MichaelMure/git-bug/bridge/core"
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestEnsureWeightEvents(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v4/projects/123/issues/1/resource_weight_events", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[
+			{"id": 10, "created_at": "2020-01-01T10:00:00Z", "weight": 3, "user": {"id": 7}},
+			{"id": 11, "created_at": "2020-01-02T10:00:00Z", "weight": 5, "user": {"id": 7}},
+			{"id": 12, "created_at": "2020-01-03T10:00:00Z", "weight": null, "user": {"id": 7}}
+		]`)
+	})
+	mux.HandleFunc("/api/v4/projects/123/issues/2/resource_weight_events", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"message": "404 Not Found"}`, http.StatusNotFound)
+	})
+	mux.HandleFunc("/api/v4/users/7", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": 7, "name": "René Descartes", "username": "rene"}`)
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client := gitlab.NewClient(server.Client(), "token")
+	require.NoError(t, client.SetBaseURL(server.URL))
+
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	backend, err := cache.NewRepoCache(repo)
+	require.NoError(t, err)
+	defer backend.Close()
+
+	author, err := backend.NewIdentity("Blaise Pascal", "blaise@pascal.fr")
+	require.NoError(t, err)
+	b, _, err := backend.NewBugRaw(author, 1000, "title", "message", nil, nil)
+	require.NoError(t, err)
+
+	gi := &gitlabImporter{
+		conf:   core.Configuration{keyProjectID: "123"},
+		client: client,
+		out:    make(chan core.ImportResult, 10),
+	}
+
+	err = gi.ensureWeightEvents(context.Background(), backend, b, &gitlab.Issue{IID: 1})
+	require.NoError(t, err)
+
+	ops := b.Snapshot().Operations
+	require.Len(t, ops, 4)
+	weight, _ := ops[1].GetMetadata(MetaKeyWeight)
+	require.Equal(t, "3", weight)
+	require.Equal(t, "René Descartes", ops[1].GetAuthor().Name())
+
+	// the weight has been removed
+	weight, ok := b.Snapshot().LastMetadata(MetaKeyWeight)
+	require.True(t, ok)
+	require.Equal(t, "", weight)
+
+	// importing again doesn't duplicate the events
+	err = gi.ensureWeightEvents(context.Background(), backend, b, &gitlab.Issue{IID: 1})
+	require.NoError(t, err)
+	require.Len(t, b.Snapshot().Operations, 4)
+
+	// no weight in this edition of Gitlab
+	err = gi.ensureWeightEvents(context.Background(), backend, b, &gitlab.Issue{IID: 2})
+	require.NoError(t, err)
+}
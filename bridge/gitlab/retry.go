@@ -0,0 +1,100 @@
+package gitlab
+
+import (
+	"fmt"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/MichaelMure/git-bug/bridge/core"
+)
+
+const (
+	// keyMaxRetries is the configuration key holding the number of retries
+	// of an API call failing with a transient error
+	keyMaxRetries = "max-retries"
+
+	defaultMaxRetries = 3
+	maxRetryDelay     = 60 * time.Second
+)
+
+// retryBaseDelay is the delay before the first retry, doubled for each
+// following one. It's a variable so that the tests don't have to wait.
+var retryBaseDelay = time.Second
+
+// maxRetries return the number of retries of the API calls of a bridge
+// configuration, defaultMaxRetries if not configured or invalid
+func maxRetries(conf core.Configuration) int {
+	n, err := strconv.Atoi(conf[keyMaxRetries])
+	if err != nil || n < 0 {
+		return defaultMaxRetries
+	}
+	return n
+}
+
+// isTransientStatus return true if a request failing with this HTTP status
+// is worth retrying
+func isTransientStatus(status int) bool {
+	switch status {
+	case http.StatusInternalServerError,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// retryDelay return the delay before the given retry (starting at 0): an
+// exponential backoff capped at maxRetryDelay, with some jitter to not have
+// all the clients retrying at once
+func retryDelay(retry int) time.Duration {
+	delay := maxRetryDelay
+	if retry < 16 {
+		delay = retryBaseDelay << uint(retry)
+	}
+	if delay > maxRetryDelay {
+		delay = maxRetryDelay
+	}
+
+	// up to 25% of jitter
+	jitter := time.Duration(rand.Int63n(int64(delay)/4 + 1))
+	return delay - jitter
+}
+
+// retryableRequest execute a Gitlab API call, and retry it up to maxRetries
+// times if it fails with a transient server error (500, 502, 503 or 504).
+// If the call still fails, the error include the body of the last response.
+func retryableRequest(fn func() (*gitlab.Response, error), maxRetries int) error {
+	for retry := 0; ; retry++ {
+		resp, err := fn()
+		if err == nil {
+			return nil
+		}
+
+		status := 0
+		var body []byte
+		if errResp, ok := err.(*gitlab.ErrorResponse); ok && errResp.Response != nil {
+			status = errResp.Response.StatusCode
+			body = errResp.Body
+		} else if resp != nil && resp.Response != nil {
+			status = resp.StatusCode
+		}
+
+		if !isTransientStatus(status) {
+			return err
+		}
+
+		if retry >= maxRetries {
+			if len(body) > 0 {
+				return fmt.Errorf("%v (after %d retries): %s", err, retry, body)
+			}
+			return fmt.Errorf("%v (after %d retries)", err, retry)
+		}
+
+		time.Sleep(retryDelay(retry))
+	}
+}
@@ -0,0 +1,77 @@
+package gitlab
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/MichaelMure/git-bug/bridge/core"
+)
+
+func TestRetryDelay(t *testing.T) {
+	for retry := 0; retry < 10; retry++ {
+		delay := retryDelay(retry)
+		require.True(t, delay > 0)
+		require.True(t, delay <= maxRetryDelay)
+	}
+
+	require.True(t, retryDelay(0) <= time.Second)
+	require.True(t, retryDelay(2) > 2*time.Second)
+	require.True(t, retryDelay(100) > maxRetryDelay/2)
+}
+
+func TestMaxRetries(t *testing.T) {
+	require.Equal(t, defaultMaxRetries, maxRetries(core.Configuration{}))
+	require.Equal(t, defaultMaxRetries, maxRetries(core.Configuration{keyMaxRetries: "foo"}))
+	require.Equal(t, 0, maxRetries(core.Configuration{keyMaxRetries: "0"}))
+	require.Equal(t, 5, maxRetries(core.Configuration{keyMaxRetries: "5"}))
+}
+
+func TestRetryableRequest(t *testing.T) {
+	retryBaseDelay = time.Millisecond
+	defer func() { retryBaseDelay = time.Second }()
+
+	var calls int
+	var statuses []int
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		status := statuses[calls]
+		calls++
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(`{"message":"oops"}`))
+	}))
+	defer server.Close()
+
+	client := gitlab.NewClient(server.Client(), "token")
+	require.NoError(t, client.SetBaseURL(server.URL))
+
+	get := func() (*gitlab.Response, error) {
+		_, resp, err := client.Users.CurrentUser()
+		return resp, err
+	}
+
+	// transient errors are retried until the success
+	calls = 0
+	statuses = []int{503, 502, 200}
+	require.NoError(t, retryableRequest(get, 3))
+	require.Equal(t, 3, calls)
+
+	// other errors are not retried
+	calls = 0
+	statuses = []int{404}
+	require.Error(t, retryableRequest(get, 3))
+	require.Equal(t, 1, calls)
+
+	// the body of the last response is reported
+	calls = 0
+	statuses = []int{500, 500, 504}
+	err := retryableRequest(get, 2)
+	require.Error(t, err)
+	require.Equal(t, 3, calls)
+	require.Contains(t, err.Error(), "after 2 retries")
+	require.Contains(t, err.Error(), `{"message":"oops"}`)
+}
@@ -0,0 +1,153 @@
+package gitlab
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/MichaelMure/git-bug/cache"
+)
+
+// ensureSystemNote record a system note without an equivalent operation as
+// a NoOp, to keep track of it in the bug history
+func (gi *gitlabImporter) ensureSystemNote(b *cache.BugCache, author *cache.IdentityCache, note *gitlab.Note) error {
+	_, err := b.OpNoOpRaw(author, note.CreatedAt.Unix(), map[string]string{
+		metaKeyGitlabId:         parseID(note.ID),
+		metaKeyGitlabSystemNote: note.Body,
+	})
+	return err
+}
+
+// crossRefURL resolve the target of a cross reference system note, like
+// `mentioned in merge request !12`, `mentioned in issue other/project#3` or
+// `mentioned in commit 1a2b3c4d`, to its URL. A target without a project is
+// in the project of the issue, given by its URL.
+func crossRefURL(noteType NoteType, body string, baseURL string, issueURL string) (string, bool) {
+	var sep byte
+	var kind string
+
+	switch noteType {
+	case NOTE_MENTIONED_IN_ISSUE:
+		sep, kind = '#', "issues"
+		body = strings.TrimPrefix(body, "mentioned in issue")
+	case NOTE_MENTIONED_IN_MERGE_REQUEST:
+		sep, kind = '!', "merge_requests"
+		body = strings.TrimPrefix(body, "mentioned in merge request")
+	case NOTE_MENTIONED_IN_COMMIT:
+		sep, kind = '@', "commit"
+		body = strings.TrimPrefix(body, "mentioned in commit")
+		body = strings.TrimPrefix(body, "referenced in commit")
+	default:
+		return "", false
+	}
+
+	ref := strings.TrimSpace(body)
+	if ref == "" || strings.ContainsAny(ref, " \t\n") {
+		return "", false
+	}
+
+	project := ""
+	if i := strings.LastIndexByte(ref, sep); i >= 0 {
+		project, ref = ref[:i], ref[i+1:]
+	} else if noteType != NOTE_MENTIONED_IN_COMMIT {
+		return "", false
+	}
+
+	switch noteType {
+	case NOTE_MENTIONED_IN_COMMIT:
+		if ref == "" || strings.IndexFunc(ref, func(r rune) bool { return !unicode.Is(unicode.ASCII_Hex_Digit, r) }) >= 0 {
+			return "", false
+		}
+	default:
+		if _, err := strconv.Atoi(ref); err != nil {
+			return "", false
+		}
+	}
+
+	projectURL := projectURLFromIssue(issueURL)
+	if project != "" {
+		projectURL = strings.TrimSuffix(baseURL, "/") + "/" + project
+	}
+	if projectURL == "" {
+		return "", false
+	}
+
+	return projectURL + "/-/" + kind + "/" + ref, true
+}
+
+// projectURLFromIssue return the URL of the project of an issue, given the
+// URL of the issue
+func projectURLFromIssue(issueURL string) string {
+	for _, infix := range []string{"/-/issues/", "/issues/"} {
+		if i := strings.LastIndex(issueURL, infix); i >= 0 {
+			return issueURL[:i]
+		}
+	}
+	return ""
+}
+
+// parseLabelNote parse the labels added and removed by a system note, like
+// `added ~12 ~"needs review" labels and removed ~bug label`. Gitlab refer to
+// the labels by id, which are resolved with the given names. It returns
+// false if a label can't be resolved or if the note doesn't change anything.
+func parseLabelNote(body string, names map[int]string) (added []string, removed []string, ok bool) {
+	var current *[]string
+
+	for body = strings.TrimSpace(body); body != ""; body = strings.TrimLeft(body, " ") {
+		var label string
+
+		switch {
+		case strings.HasPrefix(body, "added "):
+			current = &added
+			body = body[len("added "):]
+			continue
+
+		case strings.HasPrefix(body, "removed "):
+			current = &removed
+			body = body[len("removed "):]
+			continue
+
+		case strings.HasPrefix(body, `~"`):
+			end := strings.IndexByte(body[2:], '"')
+			if end < 0 {
+				return nil, nil, false
+			}
+			label = body[2 : 2+end]
+			body = body[2+end+1:]
+
+		case strings.HasPrefix(body, "~"):
+			end := strings.IndexByte(body, ' ')
+			if end < 0 {
+				end = len(body)
+			}
+			label = body[1:end]
+			body = body[end:]
+
+			if id, err := strconv.Atoi(label); err == nil {
+				name, found := names[id]
+				if !found {
+					return nil, nil, false
+				}
+				label = name
+			}
+
+		default:
+			// "label", "labels", "and" ...
+			end := strings.IndexByte(body, ' ')
+			if end < 0 {
+				end = len(body)
+			}
+			body = body[end:]
+			continue
+		}
+
+		if current == nil || label == "" {
+			return nil, nil, false
+		}
+		*current = append(*current, label)
+	}
+
+	return added, removed, len(added) > 0 || len(removed) > 0
+}
@@ -0,0 +1,142 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/MichaelMure/git-bug/bridge/core"
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestParseLabelNote(t *testing.T) {
+	names := map[int]string{12: "bug", 13: "needs review"}
+
+	tests := []struct {
+		body    string
+		added   []string
+		removed []string
+		ok      bool
+	}{
+		{"added ~12 label", []string{"bug"}, nil, true},
+		{"removed ~13 ~12 labels", nil, []string{"needs review", "bug"}, true},
+		{`added ~"to do" ~ui labels and removed ~12 label`, []string{"to do", "ui"}, []string{"bug"}, true},
+		{"added ~99 label", nil, nil, false},
+		{`added ~"unterminated label`, nil, nil, false},
+		{"closed", nil, nil, false},
+	}
+
+	for _, tt := range tests {
+		added, removed, ok := parseLabelNote(tt.body, names)
+		require.Equal(t, tt.ok, ok, tt.body)
+		if ok {
+			require.Equal(t, tt.added, added, tt.body)
+			require.Equal(t, tt.removed, removed, tt.body)
+		}
+	}
+}
+
+func TestCrossRefURL(t *testing.T) {
+	const base = "https://gitlab.com"
+	const issue = "https://gitlab.com/foo/bar/-/issues/3"
+
+	tests := []struct {
+		body string
+		url  string
+		ok   bool
+	}{
+		{"mentioned in merge request !12", "https://gitlab.com/foo/bar/-/merge_requests/12", true},
+		{"mentioned in merge request other/project!7", "https://gitlab.com/other/project/-/merge_requests/7", true},
+		{"mentioned in issue #5", "https://gitlab.com/foo/bar/-/issues/5", true},
+		{"mentioned in issue group/sub/project#5", "https://gitlab.com/group/sub/project/-/issues/5", true},
+		{"mentioned in commit 1a2b3c4d", "https://gitlab.com/foo/bar/-/commit/1a2b3c4d", true},
+		{"referenced in commit other/project@1a2b3c4d", "https://gitlab.com/other/project/-/commit/1a2b3c4d", true},
+		{"mentioned in issue #abc", "", false},
+		{"mentioned in merge request", "", false},
+		{"mentioned in commit not-a-sha", "", false},
+	}
+
+	for _, tt := range tests {
+		noteType, _ := GetNoteType(&gitlab.Note{Body: tt.body, System: true})
+		url, ok := crossRefURL(noteType, tt.body, base, issue)
+		require.Equal(t, tt.ok, ok, tt.body)
+		require.Equal(t, tt.url, url, tt.body)
+	}
+}
+
+func TestEnsureSystemNotes(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v4/users/7", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": 7, "name": "René Descartes", "username": "rene"}`)
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client := gitlab.NewClient(server.Client(), "token")
+	require.NoError(t, client.SetBaseURL(server.URL))
+
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	backend, err := cache.NewRepoCache(repo)
+	require.NoError(t, err)
+	defer backend.Close()
+
+	author, err := backend.NewIdentity("Blaise Pascal", "blaise@pascal.fr")
+	require.NoError(t, err)
+	b, _, err := backend.NewBugRaw(author, 1000, "title", "message", nil, nil)
+	require.NoError(t, err)
+
+	gi := &gitlabImporter{
+		conf:       core.Configuration{keyProjectID: "123"},
+		client:     client,
+		labelNames: map[int]string{12: "bug"},
+		out:        make(chan core.ImportResult, 10),
+	}
+
+	created := time.Unix(2000, 0)
+	note := func(id int, body string) *gitlab.Note {
+		n := &gitlab.Note{ID: id, Body: body, System: true, CreatedAt: &created}
+		n.Author.ID = 7
+		return n
+	}
+
+	notes := []*gitlab.Note{
+		note(1, "added ~12 ~ui labels"),
+		note(2, "closed"),
+		note(3, "assigned to @rene"),
+		note(4, "removed ~12 label"),
+		note(5, "added ~99 label"),
+	}
+
+	// importing twice doesn't duplicate the operations
+	for i := 0; i < 2; i++ {
+		for _, n := range notes {
+			require.NoError(t, gi.ensureNote(backend, b, n))
+		}
+	}
+
+	snap := b.Snapshot()
+	require.Len(t, snap.Operations, 6)
+	require.Equal(t, bug.ClosedStatus, snap.Status)
+	require.Equal(t, []bug.Label{"ui"}, snap.Labels)
+	require.Len(t, snap.Comments, 1)
+
+	require.IsType(t, &bug.LabelChangeOperation{}, snap.Operations[1])
+	require.IsType(t, &bug.SetStatusOperation{}, snap.Operations[2])
+
+	for i, body := range map[int]string{3: "assigned to @rene", 5: "added ~99 label"} {
+		require.IsType(t, &bug.NoOpOperation{}, snap.Operations[i])
+		value, ok := snap.Operations[i].GetMetadata(metaKeyGitlabSystemNote)
+		require.True(t, ok)
+		require.Equal(t, body, value)
+	}
+}
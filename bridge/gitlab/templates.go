@@ -0,0 +1,187 @@
+package gitlab
+
+import (
+	"context"
+	"net/http"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/MichaelMure/git-bug/cache"
+)
+
+// MetaKeyTemplate is the metadata key holding the name of the issue template
+// an issue has been written with, as detected from its description. It's
+// carried by NoOp operations, the current value being the one of the most
+// recent operation, see bug.Snapshot.LastMetadata.
+const MetaKeyTemplate = "gitlab:template"
+
+// templatesPath is the directory of the issue templates in the repository
+const templatesPath = ".gitlab/issue_templates"
+
+// issueTemplate is an issue template of the project, with the normalized
+// section headers it is recognized by
+type issueTemplate struct {
+	name    string
+	headers []string
+}
+
+// markdownHeaders return the normalized Markdown headers of a text, like
+// "summary" for "## Summary:"
+func markdownHeaders(text string) []string {
+	var headers []string
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+		if !strings.HasPrefix(line, "#") {
+			continue
+		}
+		header := strings.TrimSpace(strings.TrimLeft(line, "#"))
+		header = strings.ToLower(strings.TrimRight(header, ": "))
+		if header != "" {
+			headers = append(headers, header)
+		}
+	}
+	return headers
+}
+
+// detectTemplate return the name of the template a description has most
+// likely been written with: the one with the most of its section headers
+// found in the description, at least half of them.
+func detectTemplate(templates []issueTemplate, description string) (string, bool) {
+	found := make(map[string]bool)
+	for _, header := range markdownHeaders(description) {
+		found[header] = true
+	}
+
+	best, bestMatched := "", 0
+	for _, template := range templates {
+		matched := 0
+		for _, header := range template.headers {
+			if found[header] {
+				matched++
+			}
+		}
+
+		if matched == 0 || 2*matched < len(template.headers) {
+			continue
+		}
+		// the templates are sorted by name, which break the ties
+		if matched > bestMatched {
+			best, bestMatched = template.name, matched
+		}
+	}
+
+	return best, best != ""
+}
+
+// TemplateFilter return a filter matching the bugs imported from an issue
+// written with the given template, ignoring the case
+func TemplateFilter(name string) cache.Filter {
+	return func(repoCache *cache.RepoCache, excerpt *cache.BugExcerpt) bool {
+		b, err := repoCache.ResolveBug(excerpt.Id)
+		if err != nil {
+			return false
+		}
+		current, ok := b.Snapshot().LastMetadata(MetaKeyTemplate)
+		return ok && strings.EqualFold(current, name)
+	}
+}
+
+// listIssueTemplates fetch the issue templates of the project, sorted by
+// name. A project without templates has no template directory.
+func (gi *gitlabImporter) listIssueTemplates(ctx context.Context) ([]issueTemplate, error) {
+	ctx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	var nodes []*gitlab.TreeNode
+	opt := &gitlab.ListTreeOptions{
+		ListOptions: gitlab.ListOptions{PerPage: 100},
+		Path:        gitlab.String(templatesPath),
+	}
+	for {
+		var page []*gitlab.TreeNode
+		var resp *gitlab.Response
+		err := retryableRequest(func() (*gitlab.Response, error) {
+			var err error
+			page, resp, err = gi.client.Repositories.ListTree(gi.conf[keyProjectID], opt, gitlab.WithContext(ctx))
+			return resp, err
+		}, maxRetries(gi.conf))
+		if errResp, ok := err.(*gitlab.ErrorResponse); ok && errResp.Response != nil &&
+			errResp.Response.StatusCode == http.StatusNotFound {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		nodes = append(nodes, page...)
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opt.Page = resp.NextPage
+	}
+
+	var templates []issueTemplate
+	for _, node := range nodes {
+		if node.Type != "blob" || path.Ext(node.Name) != ".md" {
+			continue
+		}
+
+		var content []byte
+		err := retryableRequest(func() (*gitlab.Response, error) {
+			var resp *gitlab.Response
+			var err error
+			content, resp, err = gi.client.RepositoryFiles.GetRawFile(gi.conf[keyProjectID], node.Path,
+				&gitlab.GetRawFileOptions{Ref: gitlab.String("HEAD")}, gitlab.WithContext(ctx))
+			return resp, err
+		}, maxRetries(gi.conf))
+		if err != nil {
+			return nil, err
+		}
+
+		headers := markdownHeaders(string(content))
+		if len(headers) == 0 {
+			continue
+		}
+
+		templates = append(templates, issueTemplate{
+			name:    strings.TrimSuffix(node.Name, ".md"),
+			headers: headers,
+		})
+	}
+
+	sort.Slice(templates, func(i, j int) bool {
+		return templates[i].name < templates[j].name
+	})
+
+	return templates, nil
+}
+
+// ensureTemplate record the template the issue has been written with, if it
+// can be detected and changed since the last import
+func (gi *gitlabImporter) ensureTemplate(repo *cache.RepoCache, b *cache.BugCache, issue *gitlab.Issue) error {
+	name, ok := detectTemplate(gi.templates, issue.Description)
+	if !ok {
+		return nil
+	}
+
+	current, ok := b.Snapshot().LastMetadata(MetaKeyTemplate)
+	if ok && current == name {
+		return nil
+	}
+
+	author, err := gi.ensurePerson(repo, issue.Author.ID)
+	if err != nil {
+		return err
+	}
+
+	// the gitlab id mark the operation as already existing in gitlab
+	_, err = b.OpNoOpRaw(author, issue.UpdatedAt.Unix(), map[string]string{
+		MetaKeyTemplate: name,
+		metaKeyGitlabId: parseID(issue.IID),
+	})
+	return err
+}
@@ -0,0 +1,98 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/MichaelMure/git-bug/bridge/core"
+)
+
+func TestMarkdownHeaders(t *testing.T) {
+	text := "## Summary\n\nsome text\n### Steps to reproduce:\n#\n  # What is the expected *correct* behavior?  \n#nospace"
+	require.Equal(t, []string{
+		"summary",
+		"steps to reproduce",
+		"what is the expected *correct* behavior?",
+		"nospace",
+	}, markdownHeaders(text))
+}
+
+func TestDetectTemplate(t *testing.T) {
+	templates := []issueTemplate{
+		{name: "Bug", headers: []string{"summary", "steps to reproduce", "expected behavior", "actual behavior"}},
+		{name: "Feature", headers: []string{"summary", "proposal"}},
+	}
+
+	tests := []struct {
+		description string
+		template    string
+	}{
+		{"no headers at all", ""},
+		{"## Summary\nit crashes\n## Steps to reproduce\nclick", "Bug"},
+		{"## Summary\nit crashes\n## Steps to reproduce\n## Expected behavior\n## Actual behavior", "Bug"},
+		{"## Summary:\nmore\n## Proposal\nadd a button", "Feature"},
+		// one of four headers of Bug, one of two of Feature
+		{"## Summary\nsomething", "Feature"},
+		{"## Unrelated\n## Other", ""},
+	}
+
+	for _, test := range tests {
+		template, ok := detectTemplate(templates, test.description)
+		require.Equal(t, test.template != "", ok, test.description)
+		require.Equal(t, test.template, template, test.description)
+	}
+}
+
+func TestListIssueTemplates(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v4/projects/123/repository/tree", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, templatesPath, r.URL.Query().Get("path"))
+		fmt.Fprint(w, `[
+			{"name": "Feature.md", "type": "blob", "path": ".gitlab/issue_templates/Feature.md"},
+			{"name": "Bug.md", "type": "blob", "path": ".gitlab/issue_templates/Bug.md"},
+			{"name": "README.txt", "type": "blob", "path": ".gitlab/issue_templates/README.txt"},
+			{"name": "nested", "type": "tree", "path": ".gitlab/issue_templates/nested"}
+		]`)
+	})
+	mux.HandleFunc("/api/v4/projects/123/repository/files/", func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.Contains(r.URL.Path, "Bug.md"):
+			fmt.Fprint(w, "## Summary\n\n## Steps to reproduce\n")
+		case strings.Contains(r.URL.Path, "Feature.md"):
+			fmt.Fprint(w, "### Proposal\n")
+		default:
+			t.Errorf("unexpected file %s", r.URL.Path)
+		}
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client := gitlab.NewClient(server.Client(), "token")
+	require.NoError(t, client.SetBaseURL(server.URL))
+
+	gi := &gitlabImporter{
+		conf:   core.Configuration{keyProjectID: "123"},
+		client: client,
+	}
+
+	templates, err := gi.listIssueTemplates(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []issueTemplate{
+		{name: "Bug", headers: []string{"summary", "steps to reproduce"}},
+		{name: "Feature", headers: []string{"proposal"}},
+	}, templates)
+
+	// a project without templates
+	gi.conf[keyProjectID] = "456"
+	templates, err = gi.listIssueTemplates(context.Background())
+	require.NoError(t, err)
+	require.Empty(t, templates)
+}
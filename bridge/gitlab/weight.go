@@ -0,0 +1,74 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/MichaelMure/git-bug/cache"
+)
+
+// weightValue return the weight of the issue as stored in the metadata. The
+// gitlab client doesn't tell apart a weight of 0 and no weight, so both are
+// recorded as no weight.
+func weightValue(issue *gitlab.Issue) string {
+	if issue.Weight == 0 {
+		return ""
+	}
+	return strconv.Itoa(issue.Weight)
+}
+
+// ensureWeight record the current weight of the issue if it changed. The
+// history of the weight is imported from the weight events when available,
+// this catch up with the editions without them.
+func (gi *gitlabImporter) ensureWeight(repo *cache.RepoCache, b *cache.BugCache, issue *gitlab.Issue) error {
+	weight := weightValue(issue)
+
+	current, _ := b.Snapshot().LastMetadata(MetaKeyWeight)
+	if current == weight {
+		return nil
+	}
+
+	author, err := gi.ensurePerson(repo, issue.Author.ID)
+	if err != nil {
+		return err
+	}
+
+	// the gitlab id mark the operation as already existing in gitlab
+	_, err = b.OpNoOpRaw(author, issue.UpdatedAt.Unix(), map[string]string{
+		MetaKeyWeight:   weight,
+		metaKeyGitlabId: parseID(issue.IID),
+	})
+	return err
+}
+
+// updateGitlabIssueWeight set the weight of an issue. An empty weight remove
+// it, which the gitlab client can't express, so the request is built
+// manually.
+func updateGitlabIssueWeight(ctx context.Context, gc *gitlab.Client, maxRetries int, repositoryID string, issueID int, weight string) error {
+	ctx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	opt := struct {
+		Weight *int `json:"weight"`
+	}{}
+	if weight != "" {
+		value, err := strconv.Atoi(weight)
+		if err != nil {
+			return fmt.Errorf("invalid weight %q", weight)
+		}
+		opt.Weight = &value
+	}
+
+	u := fmt.Sprintf("projects/%s/issues/%d", url.PathEscape(repositoryID), issueID)
+	return retryableRequest(func() (*gitlab.Response, error) {
+		req, err := gc.NewRequest("PUT", u, opt, []gitlab.OptionFunc{gitlab.WithContext(ctx)})
+		if err != nil {
+			return nil, err
+		}
+		return gc.Do(req, nil)
+	}, maxRetries)
+}
@@ -0,0 +1,46 @@
+package gitlab
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/xanzy/go-gitlab"
+)
+
+func TestWeightValue(t *testing.T) {
+	require.Equal(t, "", weightValue(&gitlab.Issue{}))
+	require.Equal(t, "5", weightValue(&gitlab.Issue{Weight: 5}))
+}
+
+func TestUpdateGitlabIssueWeight(t *testing.T) {
+	var bodies []string
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v4/projects/123/issues/1", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "PUT", r.Method)
+		body, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		bodies = append(bodies, string(body))
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client := gitlab.NewClient(server.Client(), "token")
+	require.NoError(t, client.SetBaseURL(server.URL))
+
+	err := updateGitlabIssueWeight(context.Background(), client, 0, "123", 1, "8")
+	require.NoError(t, err)
+
+	err = updateGitlabIssueWeight(context.Background(), client, 0, "123", 1, "")
+	require.NoError(t, err)
+
+	err = updateGitlabIssueWeight(context.Background(), client, 0, "123", 1, "heavy")
+	require.Error(t, err)
+
+	require.Equal(t, []string{`{"weight":8}`, `{"weight":null}`}, bodies)
+}
@@ -0,0 +1,42 @@
+package bridge
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/MichaelMure/git-bug/bridge/core/auth"
+	"github.com/MichaelMure/git-bug/bridge/github"
+	"github.com/MichaelMure/git-bug/bridge/gitlab"
+	"github.com/MichaelMure/git-bug/cache"
+)
+
+// urlImporter is implemented by the bridges able to import a single issue
+// from its URL
+type urlImporter interface {
+	ImportBugFromURL(ctx context.Context, repo *cache.RepoCache, url string, cred auth.Credential) (*cache.BugCache, error)
+}
+
+// ImportBugFromURL import a single issue from its URL, without configuring a
+// bridge. The provider is detected from the URL: github.com for Github, and
+// gitlab.com or the "/-/issues/" path of the self-hosted instances for Gitlab.
+func ImportBugFromURL(ctx context.Context, repo *cache.RepoCache, rawURL string, cred auth.Credential) (*cache.BugCache, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, err
+	}
+
+	var importer urlImporter
+
+	switch {
+	case u.Host == "github.com":
+		importer = &github.Github{}
+	case u.Host == "gitlab.com" || strings.Contains(u.Path, "/-/issues/"):
+		importer = &gitlab.Gitlab{}
+	default:
+		return nil, fmt.Errorf("unsupported issue url %s, only Github and Gitlab are supported", rawURL)
+	}
+
+	return importer.ImportBugFromURL(ctx, repo, rawURL, cred)
+}
@@ -43,6 +43,8 @@ func (l *Launchpad) Configure(repo *cache.RepoCache, params core.BridgeParams) (
 	case params.URL != "":
 		// get project name from url
 		project, err = splitURL(params.URL)
+	case params.NonInteractive:
+		return nil, core.ErrMissingParam("url or project")
 	default:
 		// get project name from terminal prompt
 		project, err = promptProjectName()
@@ -109,9 +111,7 @@ func promptProjectName() (string, error) {
 func validateProject(project string) (bool, error) {
 	url := fmt.Sprintf("%s/%s", apiRoot, project)
 
-	client := &http.Client{
-		Timeout: defaultTimeout,
-	}
+	client := core.NewHTTPClient(defaultTimeout)
 
 	resp, err := client.Get(url)
 	if err != nil {
@@ -50,7 +50,7 @@ func (li *launchpadImporter) ImportAll(ctx context.Context, repo *cache.RepoCach
 	out := make(chan core.ImportResult)
 	lpAPI := new(launchpadAPI)
 
-	err := lpAPI.Init()
+	err := lpAPI.Init(core.Timeout(li.conf))
 	if err != nil {
 		return nil, err
 	}
@@ -61,6 +61,8 @@ func (li *launchpadImporter) ImportAll(ctx context.Context, repo *cache.RepoCach
 	}
 
 	go func() {
+		out <- core.NewImportTotal(len(lpBugs))
+
 		for _, lpBug := range lpBugs {
 			select {
 			case <-ctx.Done():
@@ -81,17 +83,21 @@ func (li *launchpadImporter) ImportAll(ctx context.Context, repo *cache.RepoCach
 
 				if err == bug.ErrBugNotExist {
 					createdAt, _ := time.Parse(time.RFC3339, lpBug.CreatedAt)
-					b, _, err = repo.NewBugRaw(
-						owner,
-						createdAt.Unix(),
-						lpBug.Title,
-						lpBug.Description,
-						nil,
-						map[string]string{
+					b, err = repo.NewBugWithID(target, lpBugID, cache.BugCreateArgs{
+						Author:   owner,
+						UnixTime: createdAt.Unix(),
+						Title:    lpBug.Title,
+						Message:  lpBug.Description,
+						Source: &bug.BugSource{
+							Target:    target,
+							RemoteID:  lpBugID,
+							RemoteURL: lpBug.WebLink,
+						},
+						Metadata: map[string]string{
 							core.MetaKeyOrigin: target,
 							metaKeyLaunchpadID: lpBugID,
 						},
-					)
+					})
 					if err != nil {
 						out <- core.NewImportError(err, entity.Id(lpBugID))
 						return
@@ -149,7 +155,7 @@ func (li *launchpadImporter) ImportAll(ctx context.Context, repo *cache.RepoCach
 
 				if !b.NeedCommit() {
 					out <- core.NewImportNothing(b.Id(), "no imported operation")
-				} else if err := b.Commit(); err != nil {
+				} else if err := b.CommitOverrideSizeLimit(); err != nil {
 					out <- core.NewImportError(err, "")
 					return
 				}
@@ -11,6 +11,10 @@ func (*Launchpad) Target() string {
 	return "launchpad-preview"
 }
 
+func (*Launchpad) Capabilities() core.BridgeCapabilities {
+	return core.CapImport
+}
+
 func (*Launchpad) NewImporter() core.Importer {
 	return &launchpadImporter{}
 }
@@ -19,6 +19,9 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"time"
+
+	"github.com/MichaelMure/git-bug/bridge/core"
 )
 
 const apiRoot = "https://api.launchpad.net/devel"
@@ -41,6 +44,7 @@ type LPBug struct {
 	Owner       LPPerson `json:"owner_link"`
 	Description string   `json:"description"`
 	CreatedAt   string   `json:"date_created"`
+	WebLink     string   `json:"web_link"`
 	Messages    []LPMessage
 }
 
@@ -72,10 +76,8 @@ type launchpadAPI struct {
 	client *http.Client
 }
 
-func (lapi *launchpadAPI) Init() error {
-	lapi.client = &http.Client{
-		Timeout: defaultTimeout,
-	}
+func (lapi *launchpadAPI) Init(timeout time.Duration) error {
+	lapi.client = core.NewHTTPClient(timeout)
 	return nil
 }
 
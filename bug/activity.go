@@ -0,0 +1,67 @@
+package bug
+
+import (
+	"math"
+	"time"
+
+	"github.com/MichaelMure/git-bug/entity"
+)
+
+// The weights of the activity score
+const (
+	activityCommentWeight   = 1.0
+	activityCommenterWeight = 2.0
+	activityReactionWeight  = 0.5
+
+	// the score is halved every activityHalfLife since the last comment
+	activityHalfLife = 7 * 24 * time.Hour
+)
+
+// ActivityStats are the values of a bug the activity score is computed from
+type ActivityStats struct {
+	Comments   int
+	Commenters int
+	Reactions  int
+	// time of the most recent comment
+	LastComment time.Time
+}
+
+// Score return the activity score at the given time, see
+// Snapshot.ActivityScore
+func (s ActivityStats) Score(now time.Time) float64 {
+	score := activityCommentWeight*float64(s.Comments) +
+		activityCommenterWeight*float64(s.Commenters) +
+		activityReactionWeight*float64(s.Reactions)
+
+	age := now.Sub(s.LastComment)
+	if age < 0 {
+		age = 0
+	}
+
+	return score * math.Pow(0.5, float64(age)/float64(activityHalfLife))
+}
+
+// ActivityStats return the values of the bug the activity score is computed
+// from. Reactions are not supported yet and are always zero.
+func (snap *Snapshot) ActivityStats() ActivityStats {
+	stats := ActivityStats{Comments: len(snap.Comments)}
+	commenters := make(map[entity.Id]struct{})
+
+	for _, c := range snap.Comments {
+		commenters[c.Author.Id()] = struct{}{}
+
+		if t := c.UnixTime.Time(); t.After(stats.LastComment) {
+			stats.LastComment = t
+		}
+	}
+
+	stats.Commenters = len(commenters)
+	return stats
+}
+
+// ActivityScore return a score ranking how actively the bug is discussed,
+// from the number of comments, of unique commenters and of reactions. The
+// score is halved every 7 days since the last comment.
+func (snap *Snapshot) ActivityScore() float64 {
+	return snap.ActivityStats().Score(time.Now())
+}
@@ -0,0 +1,43 @@
+package bug
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/identity"
+	"github.com/MichaelMure/git-bug/util/timestamp"
+)
+
+func TestActivityScore(t *testing.T) {
+	now := time.Unix(1600000000, 0)
+
+	stats := ActivityStats{Comments: 3, Commenters: 2, Reactions: 2, LastComment: now}
+	require.Equal(t, 3*1.0+2*2.0+2*0.5, stats.Score(now))
+
+	// halved after a week
+	require.InDelta(t, 4.0, stats.Score(now.Add(7*24*time.Hour)), 0.0001)
+	require.InDelta(t, 2.0, stats.Score(now.Add(14*24*time.Hour)), 0.0001)
+
+	// a last comment in the future doesn't inflate the score
+	require.Equal(t, 8.0, stats.Score(now.Add(-time.Hour)))
+
+	rene := identity.NewBare("René Descartes", "rene@descartes.fr")
+	isaac := identity.NewBare("Isaac Newton", "isaac@newton.uk")
+	snap := Snapshot{
+		Comments: []Comment{
+			{Author: rene, UnixTime: timestamp.Timestamp(1600000000)},
+			{Author: isaac, UnixTime: timestamp.Timestamp(1600000200)},
+			{Author: rene, UnixTime: timestamp.Timestamp(1600000100)},
+		},
+	}
+
+	stats = snap.ActivityStats()
+	require.Equal(t, 3, stats.Comments)
+	require.Equal(t, 2, stats.Commenters)
+	require.Equal(t, 0, stats.Reactions)
+	require.Equal(t, int64(1600000200), stats.LastComment.Unix())
+
+	require.Equal(t, 0.0, (&Snapshot{}).ActivityScore())
+}
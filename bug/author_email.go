@@ -0,0 +1,79 @@
+package bug
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/identity"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+// MetaKeyEmailSuffix is the suffix of the identity metadata where the bridges
+// store the email of the remote account, like "gitlab-email"
+const MetaKeyEmailSuffix = "-email"
+
+// authorEmailCache keep in memory the email resolved for an author
+type authorEmailCache struct {
+	authorId entity.Id
+	email    string
+}
+
+// AuthorEmail return an email address of the author of the operation, or an
+// empty string if none is known. The email of the identity comes first, then
+// the email of the remote account stored by a bridge when importing it.
+// The result is kept in memory, to not read the identity again.
+func (op *OpBase) AuthorEmail(repo repository.Repo) (string, error) {
+	author := op.GetAuthor()
+
+	// the author can be replaced by an EditAuthorOperation
+	if op.authorEmail != nil && op.authorEmail.authorId == author.Id() {
+		return op.authorEmail.email, nil
+	}
+
+	email, err := resolveAuthorEmail(repo, author)
+	if err != nil {
+		return "", err
+	}
+
+	op.authorEmail = &authorEmailCache{authorId: author.Id(), email: email}
+	return email, nil
+}
+
+func resolveAuthorEmail(repo repository.Repo, author identity.Interface) (string, error) {
+	if _, ok := author.(*identity.IdentityStub); ok {
+		i, err := identity.ReadLocal(repo, author.Id())
+		if err != nil {
+			return "", err
+		}
+		author = i
+	}
+
+	if email := author.Email(); email != "" {
+		return email, nil
+	}
+
+	withMetadata, ok := author.(interface {
+		ImmutableMetadata() map[string]string
+	})
+	if !ok {
+		return "", nil
+	}
+
+	metadata := withMetadata.ImmutableMetadata()
+
+	// sorted for a stable result if several bridges stored an email
+	keys := make([]string, 0, len(metadata))
+	for key := range metadata {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if strings.HasSuffix(key, MetaKeyEmailSuffix) && metadata[key] != "" {
+			return metadata[key], nil
+		}
+	}
+
+	return "", nil
+}
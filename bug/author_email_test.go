@@ -0,0 +1,54 @@
+package bug
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/identity"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestAuthorEmail(t *testing.T) {
+	repo := repository.NewMockRepoForTest()
+	unix := time.Now().Unix()
+
+	rene := identity.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, rene.Commit(repo))
+
+	op := NewAddCommentOp(rene, unix, "message", nil)
+	email, err := op.AuthorEmail(repo)
+	require.NoError(t, err)
+	require.Equal(t, "rene@descartes.fr", email)
+
+	// an imported identity without public email
+	imported := identity.NewIdentity("Blaise Pascal", "")
+	imported.SetMetadata("gitlab-email", "blaise@pascal.fr")
+	imported.SetMetadata("gitlab-login", "bpascal")
+	require.NoError(t, imported.Commit(repo))
+
+	op = NewAddCommentOp(imported, unix, "message", nil)
+
+	// read back, the author is only a stub
+	data, err := json.Marshal(op)
+	require.NoError(t, err)
+	var after AddCommentOperation
+	require.NoError(t, json.Unmarshal(data, &after))
+	require.IsType(t, &identity.IdentityStub{}, after.Author)
+
+	email, err = after.AuthorEmail(repo)
+	require.NoError(t, err)
+	require.Equal(t, "blaise@pascal.fr", email)
+
+	// the email is kept in memory
+	require.NotNil(t, after.authorEmail)
+	require.Equal(t, "blaise@pascal.fr", after.authorEmail.email)
+
+	// unless the author is replaced
+	after.editedAuthor = rene
+	email, err = after.AuthorEmail(repo)
+	require.NoError(t, err)
+	require.Equal(t, "rene@descartes.fr", email)
+}
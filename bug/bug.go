@@ -27,6 +27,10 @@ const createClockEntryPattern = "create-clock-%d"
 const editClockEntryPrefix = "edit-clock-"
 const editClockEntryPattern = "edit-clock-%d"
 
+// metadata of the create operation recording the inputs of a deterministic id
+const deterministicIdNamespaceMetaKey = "deterministic-id-namespace"
+const deterministicIdContentMetaKey = "deterministic-id-content"
+
 var ErrBugNotExist = errors.New("bug doesn't exist")
 
 func NewErrMultipleMatchBug(matching []entity.Id) *entity.ErrMultipleMatch {
@@ -112,8 +116,29 @@ func ReadRemoteBug(repo repository.ClockedRepo, remote string, id string) (*Bug,
 	return readBug(repo, ref)
 }
 
+// LoadLocalBug read a local bug like ReadLocalBug, but without updating the
+// lamport clocks of the repository. Unlike ReadLocalBug, it can be called
+// concurrently. The caller is responsible to call WitnessClocks afterward.
+func LoadLocalBug(repo repository.Repo, id entity.Id) (*Bug, error) {
+	return loadBug(repo, bugsRefPattern+id.String())
+}
+
 // readBug will read and parse a Bug from git
 func readBug(repo repository.ClockedRepo, ref string) (*Bug, error) {
+	bug, err := loadBug(repo, ref)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := bug.WitnessClocks(repo); err != nil {
+		return nil, err
+	}
+
+	return bug, nil
+}
+
+// loadBug read and parse a Bug from git, without touching the lamport clocks
+func loadBug(repo repository.Repo, ref string) (*Bug, error) {
 	refSplit := strings.Split(ref, "/")
 	id := entity.Id(refSplit[len(refSplit)-1])
 
@@ -135,91 +160,24 @@ func readBug(repo repository.ClockedRepo, ref string) (*Bug, error) {
 
 	// Load each OperationPack
 	for _, hash := range hashes {
-		entries, err := repo.ListEntries(hash)
+		pc, err := readPackCommit(repo, hash)
 		if err != nil {
-			return nil, errors.Wrap(err, "can't list git tree entries")
+			return nil, err
 		}
 
 		bug.lastCommit = hash
 
-		var opsEntry repository.TreeEntry
-		opsFound := false
-		var rootEntry repository.TreeEntry
-		rootFound := false
-		var createTime uint64
-		var editTime uint64
-
-		for _, entry := range entries {
-			if entry.Name == opsEntryName {
-				opsEntry = entry
-				opsFound = true
-				continue
-			}
-			if entry.Name == rootEntryName {
-				rootEntry = entry
-				rootFound = true
-			}
-			if strings.HasPrefix(entry.Name, createClockEntryPrefix) {
-				n, err := fmt.Sscanf(entry.Name, createClockEntryPattern, &createTime)
-				if err != nil {
-					return nil, errors.Wrap(err, "can't read create lamport time")
-				}
-				if n != 1 {
-					return nil, fmt.Errorf("could not parse create time lamport value")
-				}
-			}
-			if strings.HasPrefix(entry.Name, editClockEntryPrefix) {
-				n, err := fmt.Sscanf(entry.Name, editClockEntryPattern, &editTime)
-				if err != nil {
-					return nil, errors.Wrap(err, "can't read edit lamport time")
-				}
-				if n != 1 {
-					return nil, fmt.Errorf("could not parse edit time lamport value")
-				}
-			}
-		}
-
-		if !opsFound {
-			return nil, errors.New("invalid tree, missing the ops entry")
-		}
-		if !rootFound {
-			return nil, errors.New("invalid tree, missing the root entry")
-		}
-
 		if bug.rootPack == "" {
-			bug.rootPack = rootEntry.Hash
-			bug.createTime = lamport.Time(createTime)
+			bug.rootPack = pc.rootHash
+			bug.createTime = pc.createTime
 		}
 
 		// Due to rebase, edit Lamport time are not necessarily ordered
-		if editTime > uint64(bug.editTime) {
-			bug.editTime = lamport.Time(editTime)
-		}
-
-		// Update the clocks
-		if err := repo.WitnessCreate(bug.createTime); err != nil {
-			return nil, errors.Wrap(err, "failed to update create lamport clock")
-		}
-		if err := repo.WitnessEdit(bug.editTime); err != nil {
-			return nil, errors.Wrap(err, "failed to update edit lamport clock")
+		if pc.editTime > bug.editTime {
+			bug.editTime = pc.editTime
 		}
 
-		data, err := repo.ReadData(opsEntry.Hash)
-		if err != nil {
-			return nil, errors.Wrap(err, "failed to read git blob data")
-		}
-
-		opp := &OperationPack{}
-		err = json.Unmarshal(data, &opp)
-
-		if err != nil {
-			return nil, errors.Wrap(err, "failed to decode OperationPack json")
-		}
-
-		// tag the pack with the commit hash
-		opp.commitHash = hash
-
-		bug.packs = append(bug.packs, *opp)
+		bug.packs = append(bug.packs, *pc.pack)
 	}
 
 	// Make sure that the identities are properly loaded
@@ -232,11 +190,105 @@ func readBug(repo repository.ClockedRepo, ref string) (*Bug, error) {
 	return &bug, nil
 }
 
+// WitnessClocks update the lamport clocks of the repository with the times
+// of the bug
+func (bug *Bug) WitnessClocks(repo repository.ClockedRepo) error {
+	if err := repo.WitnessCreate(bug.createTime); err != nil {
+		return errors.Wrap(err, "failed to update create lamport clock")
+	}
+	if err := repo.WitnessEdit(bug.editTime); err != nil {
+		return errors.Wrap(err, "failed to update edit lamport clock")
+	}
+	return nil
+}
+
 type StreamedBug struct {
 	Bug *Bug
 	Err error
 }
 
+// packCommit hold the data stored in a single commit of a bug
+type packCommit struct {
+	pack       *OperationPack
+	rootHash   git.Hash
+	createTime lamport.Time
+	editTime   lamport.Time
+}
+
+// readPackCommit read and decode the OperationPack stored in the given commit
+func readPackCommit(repo repository.Repo, hash git.Hash) (*packCommit, error) {
+	entries, err := repo.ListEntries(hash)
+	if err != nil {
+		return nil, errors.Wrap(err, "can't list git tree entries")
+	}
+
+	var opsEntry repository.TreeEntry
+	opsFound := false
+	var rootEntry repository.TreeEntry
+	rootFound := false
+	var createTime uint64
+	var editTime uint64
+
+	for _, entry := range entries {
+		if entry.Name == opsEntryName {
+			opsEntry = entry
+			opsFound = true
+			continue
+		}
+		if entry.Name == rootEntryName {
+			rootEntry = entry
+			rootFound = true
+		}
+		if strings.HasPrefix(entry.Name, createClockEntryPrefix) {
+			n, err := fmt.Sscanf(entry.Name, createClockEntryPattern, &createTime)
+			if err != nil {
+				return nil, errors.Wrap(err, "can't read create lamport time")
+			}
+			if n != 1 {
+				return nil, fmt.Errorf("could not parse create time lamport value")
+			}
+		}
+		if strings.HasPrefix(entry.Name, editClockEntryPrefix) {
+			n, err := fmt.Sscanf(entry.Name, editClockEntryPattern, &editTime)
+			if err != nil {
+				return nil, errors.Wrap(err, "can't read edit lamport time")
+			}
+			if n != 1 {
+				return nil, fmt.Errorf("could not parse edit time lamport value")
+			}
+		}
+	}
+
+	if !opsFound {
+		return nil, errors.New("invalid tree, missing the ops entry")
+	}
+	if !rootFound {
+		return nil, errors.New("invalid tree, missing the root entry")
+	}
+
+	data, err := repo.ReadData(opsEntry.Hash)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read git blob data")
+	}
+
+	opp := &OperationPack{}
+	err = json.Unmarshal(data, &opp)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode OperationPack json")
+	}
+
+	// tag the pack with the commit hash
+	opp.commitHash = hash
+
+	return &packCommit{
+		pack:       opp,
+		rootHash:   rootEntry.Hash,
+		createTime: lamport.Time(createTime),
+		editTime:   lamport.Time(editTime),
+	}, nil
+}
+
 // ReadAllLocalBugs read and parse all local bugs
 func ReadAllLocalBugs(repo repository.ClockedRepo) <-chan StreamedBug {
 	return readAllBugs(repo, bugsRefPattern)
@@ -324,8 +376,10 @@ func (bug *Bug) Validate() error {
 		return fmt.Errorf("first operation should be a Create op")
 	}
 
-	// The bug Id should be the hash of the first commit
-	if len(bug.packs) > 0 && string(bug.packs[0].commitHash) != bug.id.String() {
+	// The bug Id should be the hash of the first commit, or the deterministic
+	// id derived from the inputs recorded in the create operation
+	if len(bug.packs) > 0 && string(bug.packs[0].commitHash) != bug.id.String() &&
+		bug.id != deterministicId(firstOp) {
 		return fmt.Errorf("bug id should be the first commit hash")
 	}
 
@@ -487,6 +541,11 @@ func (bug *Bug) NeedCommit() bool {
 	return !bug.staging.IsEmpty()
 }
 
+// StagedOperations return the operations waiting in the staging area
+func (bug *Bug) StagedOperations() []Operation {
+	return bug.staging.Operations
+}
+
 func makeMediaTree(pack OperationPack) []repository.TreeEntry {
 	var tree []repository.TreeEntry
 	counter := 0
@@ -604,6 +663,42 @@ func (bug *Bug) Merge(repo repository.Repo, other Interface) (bool, error) {
 	return true, nil
 }
 
+// SetDeterministicId set the Id of a bug not stored yet to
+// entity.NewDeterministicId(namespace, content), instead of deriving it from
+// the hash of its first commit. This allow importers to find a bug from its
+// remote counterpart. The inputs are recorded in the create operation so
+// that the Id can be verified when the bug is read.
+func (bug *Bug) SetDeterministicId(namespace, content string) error {
+	if bug.lastCommit != "" {
+		return fmt.Errorf("can't set the id of a bug already stored")
+	}
+
+	firstOp := bug.FirstOp()
+	if firstOp == nil || firstOp.base().OperationType != CreateOp {
+		return fmt.Errorf("first operation should be a Create op")
+	}
+
+	firstOp.SetMetadata(deterministicIdNamespaceMetaKey, namespace)
+	firstOp.SetMetadata(deterministicIdContentMetaKey, content)
+
+	bug.id = entity.NewDeterministicId(namespace, content)
+	return nil
+}
+
+// deterministicId return the Id derived from the inputs recorded in the
+// create operation by SetDeterministicId, or an empty Id if there is none
+func deterministicId(createOp Operation) entity.Id {
+	namespace, ok := createOp.GetMetadata(deterministicIdNamespaceMetaKey)
+	if !ok {
+		return ""
+	}
+	content, ok := createOp.GetMetadata(deterministicIdContentMetaKey)
+	if !ok {
+		return ""
+	}
+	return entity.NewDeterministicId(namespace, content)
+}
+
 // Id return the Bug identifier
 func (bug *Bug) Id() entity.Id {
 	if bug.id == "" {
@@ -662,15 +757,27 @@ func (bug *Bug) LastOp() Operation {
 
 // Compile a bug in a easily usable snapshot
 func (bug *Bug) Compile() Snapshot {
+	return bug.CompileUntil(-1)
+}
+
+// CompileUntil compile only the first n operations of a bug, giving the
+// snapshot of the bug as it was at this point of its history. A negative n
+// compile all the operations.
+//
+// The operations are applied ordered by time and author, see opOrder.
+func (bug *Bug) CompileUntil(n int) Snapshot {
 	snap := Snapshot{
 		id:     bug.id,
 		Status: OpenStatus,
 	}
 
-	it := NewOperationIterator(bug)
+	applyEditedAuthors(bug)
 
-	for it.Next() {
-		op := it.Value()
+	for i, op := range sortOperations(bug.allOperations()) {
+		if n >= 0 && i >= n {
+			break
+		}
+		warnNewerSchema(op)
 		op.Apply(&snap)
 		snap.Operations = append(snap.Operations, op)
 	}
@@ -678,5 +785,17 @@ func (bug *Bug) Compile() Snapshot {
 	return snap
 }
 
+// allOperations return the operations of the bug, in storage order
+func (bug *Bug) allOperations() []Operation {
+	var ops []Operation
+
+	it := NewOperationIterator(bug)
+	for it.Next() {
+		ops = append(ops, it.Value())
+	}
+
+	return ops
+}
+
 // Sign post method for gqlgen
 func (bug *Bug) IsAuthored() {}
@@ -0,0 +1,100 @@
+package bug
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+
+	"github.com/pkg/errors"
+
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/identity"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+// ExportJSON write the committed operations of a bug as JSON lines, one line
+// per OperationPack, in the same format as they are stored in git.
+func ExportJSON(b *Bug, w io.Writer) error {
+	enc := json.NewEncoder(w)
+
+	for i := range b.packs {
+		if err := enc.Encode(&b.packs[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ImportJSON read a bug written by ExportJSON and store it in the repo as a
+// new bug, keeping the same OperationPack boundaries.
+//
+// As the ids of the identities and of the bug itself are derived from git
+// commits, they can change when importing into another repository. The
+// resolver is used to find the identity matching an author id of the export,
+// and the targets of the operations referencing another operation are
+// updated accordingly. The given metadata are added to the create operation.
+func ImportJSON(repo repository.ClockedRepo, r io.Reader, resolver identity.Resolver, metadata map[string]string) (*Bug, error) {
+	b := NewBug()
+
+	// map the ids of the exported operations to the new ones
+	opIds := make(map[entity.Id]entity.Id)
+
+	reader := bufio.NewReader(r)
+
+	for {
+		line, err := reader.ReadBytes('\n')
+		if err == io.EOF && len(line) == 0 {
+			break
+		}
+		if err != nil && err != io.EOF {
+			return nil, err
+		}
+
+		var pack OperationPack
+		if err := json.Unmarshal(line, &pack); err != nil {
+			return nil, errors.Wrap(err, "invalid operation pack")
+		}
+
+		for _, op := range pack.Operations {
+			oldId := op.Id()
+
+			if err := ensureIdentity(op, resolver); err != nil {
+				return nil, err
+			}
+
+			switch op := op.(type) {
+			case *EditCommentOperation:
+				if target, ok := opIds[op.Target]; ok {
+					op.Target = target
+				}
+			case *SetMetadataOperation:
+				if target, ok := opIds[op.Target]; ok {
+					op.Target = target
+				}
+			}
+
+			if _, ok := op.(*CreateOperation); ok {
+				for key, value := range metadata {
+					op.base().SetMetadata(key, value)
+				}
+			}
+
+			// the serialized form might have changed
+			op.base().id = entity.UnsetId
+			opIds[oldId] = op.Id()
+
+			b.Append(op)
+		}
+
+		if err := b.Commit(repo); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(b.packs) == 0 {
+		return nil, errors.New("no operation pack")
+	}
+
+	return b, nil
+}
@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/MichaelMure/git-bug/entity"
 	"github.com/MichaelMure/git-bug/identity"
 	"github.com/MichaelMure/git-bug/repository"
 	"github.com/stretchr/testify/assert"
@@ -101,6 +102,40 @@ func TestBugCommitLoad(t *testing.T) {
 	equivalentBug(t, bug1, bug3)
 }
 
+func TestBugDeterministicId(t *testing.T) {
+	repo := repository.NewMockRepoForTest()
+
+	rene := identity.NewIdentity("René Descartes", "rene@descartes.fr")
+
+	bug1 := NewBug()
+	bug1.Append(NewCreateOp(rene, time.Now().Unix(), "title", "message", nil))
+	assert.NoError(t, bug1.SetDeterministicId("test", "https://example.com/issues/1"))
+	assert.NoError(t, bug1.Commit(repo))
+	assert.Equal(t, entity.NewDeterministicId("test", "https://example.com/issues/1"), bug1.Id())
+
+	// a reloaded bug can be committed again
+	bug2, err := ReadLocalBug(repo, bug1.Id())
+	assert.NoError(t, err)
+	bug2.Append(NewAddCommentOp(rene, time.Now().Unix(), "message2", nil))
+	assert.NoError(t, bug2.Commit(repo))
+
+	bug3, err := ReadLocalBug(repo, bug1.Id())
+	assert.NoError(t, err)
+	assert.Len(t, bug3.packs, 2)
+	assert.Error(t, bug3.SetDeterministicId("test", "other"))
+
+	// an id that doesn't match the recorded inputs is rejected
+	bug3.id = entity.NewDeterministicId("test", "https://example.com/issues/2")
+	assert.Error(t, bug3.Validate())
+
+	// as well as a long id without recorded inputs
+	bug4 := NewBug()
+	bug4.Append(NewCreateOp(rene, time.Now().Unix(), "title", "message", nil))
+	assert.NoError(t, bug4.Commit(repo))
+	bug4.id = entity.NewDeterministicId("test", "https://example.com/issues/1")
+	assert.Error(t, bug4.Validate())
+}
+
 func equivalentBug(t *testing.T, expected, actual *Bug) {
 	assert.Equal(t, len(expected.packs), len(actual.packs))
 
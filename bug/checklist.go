@@ -0,0 +1,50 @@
+package bug
+
+import (
+	"regexp"
+	"strings"
+)
+
+// taskItemRegexp match a Markdown task list item, like "- [ ] todo" or
+// "1. [x] done". The first group is the content of the checkbox.
+var taskItemRegexp = regexp.MustCompile(`^\s*(?:[-*+]|\d+[.)])\s+\[([ xX])\](?:\s|$)`)
+
+// checklistProgress count the checked and total task list items of a
+// Markdown text. The items in fenced code blocks are ignored.
+func checklistProgress(message string) (done int, total int) {
+	inCode := false
+
+	for _, line := range strings.Split(message, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			inCode = !inCode
+			continue
+		}
+		if inCode {
+			continue
+		}
+
+		match := taskItemRegexp.FindStringSubmatch(line)
+		if match == nil {
+			continue
+		}
+
+		total++
+		if match[1] != " " {
+			done++
+		}
+	}
+
+	return done, total
+}
+
+// ChecklistProgress return the number of checked and total task list items
+// ("- [ ]" and "- [x]") in the description and the comments of the bug.
+func (snap *Snapshot) ChecklistProgress() (done int, total int) {
+	for _, comment := range snap.Comments {
+		d, t := checklistProgress(comment.Message)
+		done += d
+		total += t
+	}
+	return done, total
+}
@@ -0,0 +1,44 @@
+package bug
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/identity"
+)
+
+func TestChecklistProgress(t *testing.T) {
+	tests := []struct {
+		message string
+		done    int
+		total   int
+	}{
+		{"no checklist", 0, 0},
+		{"- [ ] todo", 0, 1},
+		{"- [x] done\n- [X] done\n- [ ] todo", 2, 3},
+		{"* [ ] star\n+ [x] plus\n1. [ ] numbered\n2) [x] numbered", 2, 4},
+		{"  - [x] nested", 1, 1},
+		{"- [] invalid\n- [y] invalid\n-[ ] invalid\n- [ ]invalid", 0, 0},
+		{"text - [ ] not at start", 0, 0},
+		{"```\n- [ ] in code\n```\n- [x] outside", 1, 1},
+	}
+
+	for _, test := range tests {
+		done, total := checklistProgress(test.message)
+		require.Equal(t, test.done, done, test.message)
+		require.Equal(t, test.total, total, test.message)
+	}
+
+	rene := identity.NewBare("René Descartes", "rene@descartes.fr")
+	snap := Snapshot{
+		Comments: []Comment{
+			{Author: rene, Message: "- [x] first\n- [ ] second"},
+			{Author: rene, Message: "- [x] third"},
+		},
+	}
+
+	done, total := snap.ChecklistProgress()
+	require.Equal(t, 2, done)
+	require.Equal(t, 3, total)
+}
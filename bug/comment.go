@@ -15,6 +15,9 @@ type Comment struct {
 	Message string
 	Files   []git.Hash
 
+	// Author of the bug this comment belong to, used for permission checks
+	bugAuthor identity.Interface
+
 	// Creation time of the comment.
 	// Should be used only for human display, never for ordering as we can't rely on it in a distributed system.
 	UnixTime timestamp.Timestamp
@@ -30,6 +33,21 @@ func (c Comment) Id() entity.Id {
 	return c.id
 }
 
+// CanEditedBy return true if the given user is allowed to edit the comment,
+// that is if they are the author of the comment or the creator of the bug.
+func (c Comment) CanEditedBy(user entity.Id) bool {
+	if c.Author != nil && c.Author.Id() == user {
+		return true
+	}
+	return c.bugAuthor != nil && c.bugAuthor.Id() == user
+}
+
+// WordCount return the number of words of the message, ignoring the Markdown
+// syntax
+func (c Comment) WordCount() int {
+	return wordCount(c.Message)
+}
+
 // FormatTimeRel format the UnixTime of the comment for human consumption
 func (c Comment) FormatTimeRel() string {
 	return humanize.Time(c.UnixTime.Time())
@@ -0,0 +1,56 @@
+package bug
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/MichaelMure/git-bug/identity"
+)
+
+func TestCommentCanEditedBy(t *testing.T) {
+	rene := identity.NewBare("René Descartes", "rene@descartes.fr")
+	isaac := identity.NewBare("Isaac Newton", "isaac@newton.uk")
+	blaise := identity.NewBare("Blaise Pascal", "blaise@pascal.fr")
+	unix := time.Now().Unix()
+
+	snapshot := Snapshot{}
+
+	create := NewCreateOp(rene, unix, "title", "message", nil)
+	create.Apply(&snapshot)
+	snapshot.Operations = append(snapshot.Operations, create)
+
+	addComment := NewAddCommentOp(isaac, unix, "message", nil)
+	addComment.Apply(&snapshot)
+	snapshot.Operations = append(snapshot.Operations, addComment)
+
+	assert.True(t, snapshot.Comments[0].CanEditedBy(rene.Id()))
+	assert.False(t, snapshot.Comments[0].CanEditedBy(isaac.Id()))
+
+	// the bug creator can edit any comment
+	assert.True(t, snapshot.Comments[1].CanEditedBy(rene.Id()))
+	assert.True(t, snapshot.Comments[1].CanEditedBy(isaac.Id()))
+	assert.False(t, snapshot.Comments[1].CanEditedBy(blaise.Id()))
+
+	assert.True(t, snapshot.WasEditedBy(rene.Id()))
+	assert.True(t, snapshot.WasEditedBy(isaac.Id()))
+	assert.False(t, snapshot.WasEditedBy(blaise.Id()))
+}
+
+func TestCommentWordCount(t *testing.T) {
+	cases := map[string]int{
+		"":                                    0,
+		"hello world":                         2,
+		"# Title\n\nSome *emphasis* here.":    4,
+		"- item one\n- item two\n1. three":    5,
+		"see [the docs](https://example.com)": 3,
+		"![screenshot](image.png)":            1,
+		"---\n> quoted   text":                2,
+	}
+
+	for message, expected := range cases {
+		c := Comment{Message: message}
+		assert.Equal(t, expected, c.WordCount(), message)
+	}
+}
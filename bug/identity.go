@@ -1,6 +1,7 @@
 package bug
 
 import (
+	"github.com/MichaelMure/git-bug/entity"
 	"github.com/MichaelMure/git-bug/identity"
 )
 
@@ -11,17 +12,71 @@ func (bug *Bug) EnsureIdentities(resolver identity.Resolver) error {
 	it := NewOperationIterator(bug)
 
 	for it.Next() {
-		op := it.Value()
-		base := op.base()
+		err := ensureIdentity(it.Value(), resolver)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ensureIdentity replace the author of the operation with the full Identity
+// if it's an IdentityStub
+func ensureIdentity(op Operation, resolver identity.Resolver) error {
+	base := op.base()
 
-		if stub, ok := base.Author.(*identity.IdentityStub); ok {
+	if stub, ok := base.Author.(*identity.IdentityStub); ok {
+		i, err := resolver.ResolveIdentity(stub.Id())
+		if err != nil {
+			return err
+		}
+
+		base.Author = i
+	}
+
+	if edit, ok := op.(*EditAuthorOperation); ok {
+		if stub, ok := edit.NewAuthor.(*identity.IdentityStub); ok {
 			i, err := resolver.ResolveIdentity(stub.Id())
 			if err != nil {
 				return err
 			}
 
-			base.Author = i
+			edit.NewAuthor = i
 		}
 	}
+
 	return nil
 }
+
+// IdentityIds return the ids of the identities referenced by the operations,
+// as authors or as replacement of an author. The legacy identities embedded
+// in the operations are not included, as they are not stored on their own.
+func (bug *Bug) IdentityIds() []entity.Id {
+	var result []entity.Id
+	seen := make(map[entity.Id]struct{})
+
+	add := func(i identity.Interface) {
+		if i == nil {
+			return
+		}
+		if _, ok := i.(*identity.Bare); ok {
+			return
+		}
+		if _, ok := seen[i.Id()]; ok {
+			return
+		}
+		seen[i.Id()] = struct{}{}
+		result = append(result, i.Id())
+	}
+
+	it := NewOperationIterator(bug)
+	for it.Next() {
+		op := it.Value()
+		add(op.base().Author)
+		if edit, ok := op.(*EditAuthorOperation); ok {
+			add(edit.NewAuthor)
+		}
+	}
+
+	return result
+}
@@ -52,10 +52,32 @@ func (l Label) Color() LabelColor {
 	return colors[id]
 }
 
+// ParseLabelColor parse a color in the "#rrggbb" hexadecimal notation, the
+// leading # being optional
+func ParseLabelColor(hex string) (LabelColor, error) {
+	hex = strings.TrimPrefix(hex, "#")
+	if len(hex) != 6 {
+		return LabelColor{}, fmt.Errorf("invalid color \"%s\"", hex)
+	}
+
+	var r, g, b uint8
+	_, err := fmt.Sscanf(hex, "%02x%02x%02x", &r, &g, &b)
+	if err != nil {
+		return LabelColor{}, fmt.Errorf("invalid color \"%s\"", hex)
+	}
+
+	return LabelColor{R: r, G: g, B: b, A: 255}, nil
+}
+
 func (lc LabelColor) RGBA() color.RGBA {
 	return color.RGBA(lc)
 }
 
+// Hex return the color in the "#rrggbb" hexadecimal notation
+func (lc LabelColor) Hex() string {
+	return fmt.Sprintf("#%.2x%.2x%.2x", lc.R, lc.G, lc.B)
+}
+
 type Term256 int
 
 func (lc LabelColor) Term256() Term256 {
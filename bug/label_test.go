@@ -33,3 +33,19 @@ func TestLabelRGBAEqual(t *testing.T) {
 
 	require.Equal(t, color1, color2)
 }
+
+func TestParseLabelColor(t *testing.T) {
+	c, err := ParseLabelColor("#ff5722")
+	require.NoError(t, err)
+	require.Equal(t, LabelColor{R: 255, G: 87, B: 34, A: 255}, c)
+	require.Equal(t, "#ff5722", c.Hex())
+
+	c, err = ParseLabelColor("00BCD4")
+	require.NoError(t, err)
+	require.Equal(t, LabelColor{R: 0, G: 188, B: 212, A: 255}, c)
+
+	for _, invalid := range []string{"", "#fff", "#gg0000", "red"} {
+		_, err = ParseLabelColor(invalid)
+		require.Error(t, err, invalid)
+	}
+}
@@ -0,0 +1,319 @@
+package bug
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/identity"
+	"github.com/MichaelMure/git-bug/repository"
+	"github.com/MichaelMure/git-bug/util/git"
+	"github.com/MichaelMure/git-bug/util/lamport"
+)
+
+// LazyBug is a read-only view of a Bug that only hold the hashes of the
+// commits storing its operations. The OperationPacks are read from git and
+// decoded only when iterated over, and only one of them is kept in memory
+// at a time. The compiled Snapshot is computed on first access and cached.
+type LazyBug struct {
+	repo    repository.ClockedRepo
+	id      entity.Id
+	commits []git.Hash
+
+	createTime lamport.Time
+	editTime   lamport.Time
+
+	firstOp  Operation
+	snapshot *Snapshot
+}
+
+// ReadLocalLazyBug prepare a LazyBug for the given id, without reading the
+// operations yet.
+func ReadLocalLazyBug(repo repository.ClockedRepo, id entity.Id) (*LazyBug, error) {
+	if err := id.Validate(); err != nil {
+		return nil, err
+	}
+
+	hashes, err := repo.ListCommits(bugsRefPattern + id.String())
+
+	// TODO: this is not perfect, it might be a command invoke error
+	if err != nil {
+		return nil, ErrBugNotExist
+	}
+
+	return &LazyBug{
+		repo:    repo,
+		id:      id,
+		commits: hashes,
+	}, nil
+}
+
+// Id return the Bug identifier
+func (lb *LazyBug) Id() entity.Id {
+	return lb.id
+}
+
+// Operations return an iterator that read the operations one pack at a time
+func (lb *LazyBug) Operations() *LazyOperationIterator {
+	return &LazyOperationIterator{
+		bug:      lb,
+		resolver: identity.NewSimpleResolver(lb.repo),
+		commit:   -1,
+	}
+}
+
+// Snapshot compile the bug on first access and return the cached result.
+//
+// To not keep all the operations in memory, the Operations of the snapshot
+// only hold the ones needed to compile it and to read its metadata: the
+// first and the last operation, the targets of the operations editing other
+// ones, and the most recent operation carrying each metadata key. The
+// values derived from the operations, like LastMetadata or LastEditUnix,
+// are the same as with the complete bug. Use Load for all the operations.
+func (lb *LazyBug) Snapshot() (*Snapshot, error) {
+	if lb.snapshot != nil {
+		return lb.snapshot, nil
+	}
+
+	// a first pass find the operations targeted by the others, as they need
+	// to be kept to be edited when reaching the edition
+	targets := make(map[entity.Id]struct{})
+	editedAuthors := make(map[entity.Id]identity.Interface)
+	seen := make(map[entity.Id]struct{})
+	// the operations are streamed in storage order, which need to be the
+	// order they are applied in
+	var ordering opOrdering
+	sorted := true
+
+	it := lb.Operations()
+	for it.Next() {
+		latest := ordering.latest
+		if order := ordering.next(it.Value()); latest != nil && order.less(*latest) {
+			sorted = false
+		}
+
+		switch op := it.Value().(type) {
+		case *SetMetadataOperation:
+			targets[op.Target] = struct{}{}
+		case *EditAuthorOperation:
+			targets[op.Target] = struct{}{}
+			// like applyEditedAuthors
+			if _, ok := seen[op.Target]; ok {
+				editedAuthors[op.Target] = op.NewAuthor
+			}
+			continue
+		}
+		seen[it.Value().Id()] = struct{}{}
+	}
+	if it.Err() != nil {
+		return nil, it.Err()
+	}
+
+	// rare: some operations are applied out of their storage order, the
+	// complete bug is read instead
+	if !sorted {
+		b, err := lb.Load()
+		if err != nil {
+			return nil, err
+		}
+		snap := b.Compile()
+		lb.firstOp = b.FirstOp()
+		lb.snapshot = &snap
+		return lb.snapshot, nil
+	}
+
+	snap := &Snapshot{
+		id:     lb.id,
+		Status: OpenStatus,
+	}
+
+	// the most recent operation carrying each metadata key
+	carriers := make(map[string]Operation)
+	// the last operation, when only kept for being the last one
+	var lastOnly Operation
+
+	keep := func(op Operation) bool {
+		if op == lb.firstOp {
+			return true
+		}
+		if _, ok := targets[op.Id()]; ok {
+			return true
+		}
+		for key := range op.AllMetadata() {
+			if carriers[key] == op {
+				return true
+			}
+		}
+		return false
+	}
+
+	it = lb.Operations()
+	for it.Next() {
+		op := it.Value()
+
+		if author, ok := editedAuthors[op.Id()]; ok {
+			op.base().editedAuthor = author
+		}
+
+		warnNewerSchema(op)
+		op.Apply(snap)
+
+		if lastOnly != nil {
+			snap.Operations = snap.Operations[:len(snap.Operations)-1]
+			lastOnly = nil
+		}
+
+		if lb.firstOp == nil {
+			lb.firstOp = op
+		}
+
+		// the previous carriers of the same keys are not needed anymore
+		var replaced []Operation
+		for key := range op.AllMetadata() {
+			if previous, ok := carriers[key]; ok {
+				replaced = append(replaced, previous)
+			}
+			carriers[key] = op
+		}
+		for _, previous := range replaced {
+			if !keep(previous) {
+				snap.Operations = removeOperation(snap.Operations, previous)
+			}
+		}
+
+		snap.Operations = append(snap.Operations, op)
+		if !keep(op) {
+			lastOnly = op
+		}
+	}
+	if it.Err() != nil {
+		return nil, it.Err()
+	}
+
+	lb.snapshot = snap
+	return snap, nil
+}
+
+// removeOperation remove an operation from a list, keeping the order
+func removeOperation(ops []Operation, op Operation) []Operation {
+	for i, o := range ops {
+		if o == op {
+			return append(ops[:i], ops[i+1:]...)
+		}
+	}
+	return ops
+}
+
+// FirstOp lookup for the very first operation of the bug
+func (lb *LazyBug) FirstOp() Operation {
+	if lb.firstOp == nil {
+		if _, err := lb.Snapshot(); err != nil {
+			return nil
+		}
+	}
+	return lb.firstOp
+}
+
+// CreateLamportTime return the Lamport time of creation. The operations need
+// to have been iterated over at least once.
+func (lb *LazyBug) CreateLamportTime() lamport.Time {
+	return lb.createTime
+}
+
+// EditLamportTime return the Lamport time of the last edit. The operations
+// need to have been iterated over at least once.
+func (lb *LazyBug) EditLamportTime() lamport.Time {
+	return lb.editTime
+}
+
+// Load read and decode the complete bug
+func (lb *LazyBug) Load() (*Bug, error) {
+	return ReadLocalBug(lb.repo, lb.id)
+}
+
+// LazyOperationIterator iterate over the operations of a LazyBug, reading
+// each OperationPack from git only when reaching it.
+type LazyOperationIterator struct {
+	bug      *LazyBug
+	resolver identity.Resolver
+	commit   int
+	pack     *OperationPack
+	opIndex  int
+	err      error
+}
+
+func (it *LazyOperationIterator) Next() bool {
+	if it.err != nil {
+		return false
+	}
+
+	if it.pack != nil {
+		it.opIndex++
+		if it.opIndex < len(it.pack.Operations) {
+			return true
+		}
+	}
+
+	// move to the next non-empty pack
+	for {
+		it.commit++
+		if it.commit >= len(it.bug.commits) {
+			it.pack = nil
+			return false
+		}
+
+		pc, err := readPackCommit(it.bug.repo, it.bug.commits[it.commit])
+		if err != nil {
+			it.err = err
+			it.pack = nil
+			return false
+		}
+
+		// Update the clocks, like when reading the complete bug
+		if it.commit == 0 {
+			it.bug.createTime = pc.createTime
+			if err := it.bug.repo.WitnessCreate(pc.createTime); err != nil {
+				it.err = errors.Wrap(err, "failed to update create lamport clock")
+				it.pack = nil
+				return false
+			}
+		}
+		if pc.editTime > it.bug.editTime {
+			it.bug.editTime = pc.editTime
+		}
+		if err := it.bug.repo.WitnessEdit(pc.editTime); err != nil {
+			it.err = errors.Wrap(err, "failed to update edit lamport clock")
+			it.pack = nil
+			return false
+		}
+
+		// Make sure that the identities are properly loaded
+		for _, op := range pc.pack.Operations {
+			err := ensureIdentity(op, it.resolver)
+			if err != nil {
+				it.err = err
+				it.pack = nil
+				return false
+			}
+		}
+
+		it.pack = pc.pack
+		it.opIndex = 0
+
+		if len(it.pack.Operations) > 0 {
+			return true
+		}
+	}
+}
+
+func (it *LazyOperationIterator) Value() Operation {
+	if it.pack == nil || it.opIndex >= len(it.pack.Operations) {
+		panic("Iterator is not valid anymore")
+	}
+
+	return it.pack.Operations[it.opIndex]
+}
+
+// Err return the error that stopped the iteration, if any
+func (it *LazyOperationIterator) Err() error {
+	return it.err
+}
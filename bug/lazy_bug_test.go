@@ -0,0 +1,78 @@
+package bug
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/identity"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestLazyBugSnapshot(t *testing.T) {
+	repo := repository.NewMockRepoForTest()
+
+	rene := identity.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, rene.Commit(repo))
+	isaac := identity.NewIdentity("Isaac Newton", "isaac@newton.uk")
+	require.NoError(t, isaac.Commit(repo))
+
+	unix := time.Now().Unix()
+
+	b := NewBug()
+	create := NewCreateOp(rene, unix, "title", "message", nil)
+	create.SetMetadata("origin", "github")
+	b.Append(create)
+
+	weighted := NewAddCommentOp(rene, unix, "comment 1", nil)
+	weighted.SetMetadata("weight", "3")
+	b.Append(weighted)
+
+	edited := NewAddCommentOp(rene, unix, "comment 2", nil)
+	edited.SetMetadata("weight", "5")
+	b.Append(edited)
+
+	failed := NewNoOpOp(rene, unix)
+	failed.SetMetadata("pipeline", "failed")
+	b.Append(failed)
+	require.NoError(t, b.Commit(repo))
+
+	for i := 0; i < 5; i++ {
+		b.Append(NewAddCommentOp(rene, unix+int64(i), "more", nil))
+	}
+	b.Append(NewSetMetadataOp(rene, unix, weighted.Id(), map[string]string{"health": "at-risk"}))
+	b.Append(NewEditAuthorOp(rene, unix, edited.Id(), isaac))
+	b.Append(NewStripMetadataOp(rene, unix, []string{"pipeline"}))
+	b.Append(NewAddCommentOp(rene, unix+10, "last", nil))
+	require.NoError(t, b.Commit(repo))
+
+	full, err := ReadLocalBug(repo, b.Id())
+	require.NoError(t, err)
+	expected := full.Compile()
+
+	lazy, err := ReadLocalLazyBug(repo, b.Id())
+	require.NoError(t, err)
+	snap, err := lazy.Snapshot()
+	require.NoError(t, err)
+
+	// the operations are not all kept
+	require.True(t, len(snap.Operations) < len(expected.Operations))
+
+	// but the values derived from them are the same
+	for _, key := range []string{"origin", "weight", "health", "pipeline"} {
+		expectedValue, expectedOk := expected.LastMetadata(key)
+		value, ok := snap.LastMetadata(key)
+		require.Equal(t, expectedOk, ok, key)
+		require.Equal(t, expectedValue, value, key)
+	}
+	require.Equal(t, expected.Operations[0].AllMetadata(), lazy.FirstOp().AllMetadata())
+	require.Equal(t, expected.LastEditUnix(), snap.LastEditUnix())
+	require.Equal(t, expected.Source(), snap.Source())
+
+	require.Equal(t, len(expected.Comments), len(snap.Comments))
+	for i := range expected.Comments {
+		require.Equal(t, expected.Comments[i].Author.Id(), snap.Comments[i].Author.Id())
+	}
+	require.Equal(t, isaac.Id(), snap.Comments[2].Author.Id())
+}
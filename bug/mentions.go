@@ -0,0 +1,74 @@
+package bug
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/MichaelMure/git-bug/entity"
+)
+
+// mentionRegexp match a "@login" mention. The mention must not follow a word
+// character, to ignore the email addresses. The first group is the login.
+var mentionRegexp = regexp.MustCompile(`(?:^|[^\w@/])@([A-Za-z0-9][A-Za-z0-9_.-]*)`)
+
+// mentionedLogins return the unique logins mentioned in a text, in order of
+// appearance
+func mentionedLogins(message string) []string {
+	var logins []string
+	seen := make(map[string]bool)
+
+	for _, match := range mentionRegexp.FindAllStringSubmatch(message, -1) {
+		// a mention at the end of a sentence
+		login := strings.TrimRight(match[1], ".-")
+		if login == "" || seen[login] {
+			continue
+		}
+		seen[login] = true
+		logins = append(logins, login)
+	}
+
+	return logins
+}
+
+// MentionedLogins return the unique logins @-mentioned in the comments of
+// the bug, in order of appearance
+func (snap *Snapshot) MentionedLogins() []string {
+	var logins []string
+	seen := make(map[string]bool)
+
+	for _, comment := range snap.Comments {
+		for _, login := range mentionedLogins(comment.Message) {
+			if !seen[login] {
+				seen[login] = true
+				logins = append(logins, login)
+			}
+		}
+	}
+
+	return logins
+}
+
+// MentionResolver find the identity having the given login, if any
+type MentionResolver func(login string) (entity.Id, bool)
+
+// Mentions resolve the identities @-mentioned in the comments of the bug.
+// The logins without a matching identity are returned separately.
+func (snap *Snapshot) Mentions(resolve MentionResolver) ([]entity.Id, []string) {
+	var ids []entity.Id
+	var unresolved []string
+	seen := make(map[entity.Id]bool)
+
+	for _, login := range snap.MentionedLogins() {
+		id, ok := resolve(login)
+		if !ok {
+			unresolved = append(unresolved, login)
+			continue
+		}
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+
+	return ids, unresolved
+}
@@ -0,0 +1,57 @@
+package bug
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/identity"
+)
+
+func TestMentionedLogins(t *testing.T) {
+	tests := []struct {
+		message string
+		logins  []string
+	}{
+		{"no mention", nil},
+		{"@rene, could you look?", []string{"rene"}},
+		{"thanks @isaac-newton and @blaise_pascal.", []string{"isaac-newton", "blaise_pascal"}},
+		{"@rene @rene (@isaac)", []string{"rene", "isaac"}},
+		{"mail rene@descartes.fr or see https://medium.com/@someone", nil},
+		{"@ alone", nil},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.logins, mentionedLogins(tt.message), tt.message)
+	}
+}
+
+func TestSnapshotMentions(t *testing.T) {
+	rene := identity.NewBare("René Descartes", "rene@descartes.fr")
+	unix := time.Now().Unix()
+
+	snapshot := Snapshot{}
+
+	create := NewCreateOp(rene, unix, "title", "ping @isaac", nil)
+	create.Apply(&snapshot)
+
+	addComment := NewAddCommentOp(rene, unix, "@blaise and @isaac again, @newton", nil)
+	addComment.Apply(&snapshot)
+
+	assert.Equal(t, []string{"isaac", "blaise", "newton"}, snapshot.MentionedLogins())
+
+	isaacId := entity.Id("isaac-id")
+	ids, unresolved := snapshot.Mentions(func(login string) (entity.Id, bool) {
+		switch login {
+		case "isaac", "newton":
+			return isaacId, true
+		default:
+			return "", false
+		}
+	})
+
+	assert.Equal(t, []entity.Id{isaacId}, ids)
+	assert.Equal(t, []string{"blaise"}, unresolved)
+}
@@ -19,6 +19,8 @@ type AddCommentOperation struct {
 	Message string `json:"message"`
 	// TODO: change for a map[string]util.hash to store the filename ?
 	Files []git.Hash `json:"files"`
+	// URLs or commit hashes the comment refers to
+	References []string `json:"references,omitempty"`
 }
 
 func (op *AddCommentOperation) base() *OpBase {
@@ -29,19 +31,25 @@ func (op *AddCommentOperation) Id() entity.Id {
 	return idOperation(op)
 }
 
+func (op *AddCommentOperation) Size() int {
+	return sizeOperation(op)
+}
+
 func (op *AddCommentOperation) Apply(snapshot *Snapshot) {
-	snapshot.addActor(op.Author)
-	snapshot.addParticipant(op.Author)
+	snapshot.addActor(op.GetAuthor())
+	snapshot.addParticipant(op.GetAuthor())
 
 	comment := Comment{
-		id:       op.Id(),
-		Message:  op.Message,
-		Author:   op.Author,
-		Files:    op.Files,
-		UnixTime: timestamp.Timestamp(op.UnixTime),
+		id:        op.Id(),
+		Message:   op.Message,
+		Author:    op.GetAuthor(),
+		Files:     op.Files,
+		bugAuthor: snapshot.Author,
+		UnixTime:  timestamp.Timestamp(op.UnixTime),
 	}
 
 	snapshot.Comments = append(snapshot.Comments, comment)
+	snapshot.addReferences(op.References)
 
 	item := &AddCommentTimelineItem{
 		CommentTimelineItem: NewCommentTimelineItem(op.Id(), comment),
@@ -63,6 +71,10 @@ func (op *AddCommentOperation) Validate() error {
 		return fmt.Errorf("message is not fully printable")
 	}
 
+	if err := validateReferences(op.References); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -79,8 +91,9 @@ func (op *AddCommentOperation) UnmarshalJSON(data []byte) error {
 	}
 
 	aux := struct {
-		Message string     `json:"message"`
-		Files   []git.Hash `json:"files"`
+		Message    string     `json:"message"`
+		Files      []git.Hash `json:"files"`
+		References []string   `json:"references,omitempty"`
 	}{}
 
 	err = json.Unmarshal(data, &aux)
@@ -91,6 +104,7 @@ func (op *AddCommentOperation) UnmarshalJSON(data []byte) error {
 	op.OpBase = base
 	op.Message = aux.Message
 	op.Files = aux.Files
+	op.References = aux.References
 
 	return nil
 }
@@ -120,7 +134,14 @@ func AddComment(b Interface, author identity.Interface, unixTime int64, message
 }
 
 func AddCommentWithFiles(b Interface, author identity.Interface, unixTime int64, message string, files []git.Hash) (*AddCommentOperation, error) {
+	return AddCommentWithReferences(b, author, unixTime, message, files, nil)
+}
+
+// AddCommentWithReferences is the same as AddCommentWithFiles, but also
+// record the URLs or commit hashes the comment refers to
+func AddCommentWithReferences(b Interface, author identity.Interface, unixTime int64, message string, files []git.Hash, references []string) (*AddCommentOperation, error) {
 	addCommentOp := NewAddCommentOp(author, unixTime, message, files)
+	addCommentOp.References = references
 	if err := addCommentOp.Validate(); err != nil {
 		return nil, err
 	}
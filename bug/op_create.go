@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/MichaelMure/git-bug/entity"
 	"github.com/MichaelMure/git-bug/identity"
@@ -14,12 +15,30 @@ import (
 
 var _ Operation = &CreateOperation{}
 
+// BugSource describe where a bug imported from an external bug tracker
+// originally comes from
+type BugSource struct {
+	// The bridge target (ex: "github")
+	Target string `json:"target"`
+	// The identifier of the bug in the external bug tracker
+	RemoteID string `json:"remote_id"`
+	// The URL of the bug in the external bug tracker
+	RemoteURL string `json:"remote_url"`
+}
+
 // CreateOperation define the initial creation of a bug
 type CreateOperation struct {
 	OpBase
 	Title   string     `json:"title"`
 	Message string     `json:"message"`
 	Files   []git.Hash `json:"files"`
+	// URLs or commit hashes the bug refers to
+	References []string `json:"references,omitempty"`
+	// Only set for imported bugs
+	OriginalSource *BugSource `json:"source,omitempty"`
+	// Creation time of the bug in the external bug tracker, if known. Only
+	// set for imported bugs.
+	ExternalCreatedAt int64 `json:"external_created_at,omitempty"`
 }
 
 func (op *CreateOperation) base() *OpBase {
@@ -30,22 +49,32 @@ func (op *CreateOperation) Id() entity.Id {
 	return idOperation(op)
 }
 
+func (op *CreateOperation) Size() int {
+	return sizeOperation(op)
+}
+
 func (op *CreateOperation) Apply(snapshot *Snapshot) {
-	snapshot.addActor(op.Author)
-	snapshot.addParticipant(op.Author)
+	snapshot.addActor(op.GetAuthor())
+	snapshot.addParticipant(op.GetAuthor())
 
 	snapshot.Title = op.Title
+	snapshot.References = nil
+	snapshot.addReferences(op.References)
 
 	comment := Comment{
-		id:       op.Id(),
-		Message:  op.Message,
-		Author:   op.Author,
-		UnixTime: timestamp.Timestamp(op.UnixTime),
+		id:        op.Id(),
+		Message:   op.Message,
+		Author:    op.GetAuthor(),
+		bugAuthor: op.GetAuthor(),
+		UnixTime:  timestamp.Timestamp(op.UnixTime),
 	}
 
 	snapshot.Comments = []Comment{comment}
-	snapshot.Author = op.Author
+	snapshot.Author = op.GetAuthor()
 	snapshot.CreatedAt = op.Time()
+	if op.ExternalCreatedAt != 0 {
+		snapshot.CreatedAt = time.Unix(op.ExternalCreatedAt, 0)
+	}
 
 	snapshot.Timeline = []TimelineItem{
 		&CreateTimelineItem{
@@ -79,6 +108,10 @@ func (op *CreateOperation) Validate() error {
 		return fmt.Errorf("message is not fully printable")
 	}
 
+	if err := validateReferences(op.References); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -95,9 +128,12 @@ func (op *CreateOperation) UnmarshalJSON(data []byte) error {
 	}
 
 	aux := struct {
-		Title   string     `json:"title"`
-		Message string     `json:"message"`
-		Files   []git.Hash `json:"files"`
+		Title             string     `json:"title"`
+		Message           string     `json:"message"`
+		Files             []git.Hash `json:"files"`
+		References        []string   `json:"references,omitempty"`
+		OriginalSource    *BugSource `json:"source,omitempty"`
+		ExternalCreatedAt int64      `json:"external_created_at,omitempty"`
 	}{}
 
 	err = json.Unmarshal(data, &aux)
@@ -109,6 +145,9 @@ func (op *CreateOperation) UnmarshalJSON(data []byte) error {
 	op.Title = aux.Title
 	op.Message = aux.Message
 	op.Files = aux.Files
+	op.References = aux.References
+	op.OriginalSource = aux.OriginalSource
+	op.ExternalCreatedAt = aux.ExternalCreatedAt
 
 	return nil
 }
@@ -139,8 +178,15 @@ func Create(author identity.Interface, unixTime int64, title, message string) (*
 }
 
 func CreateWithFiles(author identity.Interface, unixTime int64, title, message string, files []git.Hash) (*Bug, *CreateOperation, error) {
+	return CreateWithReferences(author, unixTime, title, message, files, nil)
+}
+
+// CreateWithReferences is the same as CreateWithFiles, but also record the
+// URLs or commit hashes the bug refers to
+func CreateWithReferences(author identity.Interface, unixTime int64, title, message string, files []git.Hash, references []string) (*Bug, *CreateOperation, error) {
 	newBug := NewBug()
 	createOp := NewCreateOp(author, unixTime, title, message, files)
+	createOp.References = references
 
 	if err := createOp.Validate(); err != nil {
 		return nil, createOp, err
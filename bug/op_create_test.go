@@ -24,10 +24,11 @@ func TestCreate(t *testing.T) {
 	assert.NoError(t, id.Validate())
 
 	comment := Comment{
-		id:       id,
-		Author:   rene,
-		Message:  "message",
-		UnixTime: timestamp.Timestamp(create.UnixTime),
+		id:        id,
+		Author:    rene,
+		bugAuthor: rene,
+		Message:   "message",
+		UnixTime:  timestamp.Timestamp(create.UnixTime),
 	}
 
 	expected := Snapshot{
@@ -67,3 +68,84 @@ func TestCreateSerialize(t *testing.T) {
 
 	assert.Equal(t, before, &after)
 }
+
+func TestCreateWithSourceSerialize(t *testing.T) {
+	var rene = identity.NewBare("René Descartes", "rene@descartes.fr")
+	unix := time.Now().Unix()
+	before := NewCreateOp(rene, unix, "title", "message", nil)
+	before.OriginalSource = &BugSource{
+		Target:    "github",
+		RemoteID:  "MDU6SXNzdWU1NjU2MjM4MjU=",
+		RemoteURL: "https://github.com/MichaelMure/git-bug/issues/1",
+	}
+	before.ExternalCreatedAt = unix - 3600
+
+	data, err := json.Marshal(before)
+	assert.NoError(t, err)
+
+	var after CreateOperation
+	err = json.Unmarshal(data, &after)
+	assert.NoError(t, err)
+
+	// enforce creating the IDs
+	before.Id()
+	rene.Id()
+
+	assert.Equal(t, before, &after)
+
+	snapshot := Snapshot{Operations: []Operation{&after}}
+	assert.Equal(t, before.OriginalSource, snapshot.Source())
+}
+
+func TestCreateExternalCreatedAt(t *testing.T) {
+	rene := identity.NewBare("René Descartes", "rene@descartes.fr")
+	created := time.Date(2018, 7, 14, 12, 0, 0, 0, time.UTC)
+
+	create := NewCreateOp(rene, time.Now().Unix(), "title", "message", nil)
+	create.ExternalCreatedAt = created.Unix()
+
+	snapshot := Snapshot{}
+	create.Apply(&snapshot)
+
+	assert.True(t, created.Equal(snapshot.CreatedAt))
+}
+
+func TestCreateReferences(t *testing.T) {
+	rene := identity.NewBare("René Descartes", "rene@descartes.fr")
+	unix := time.Now().Unix()
+
+	before := NewCreateOp(rene, unix, "title", "message", nil)
+	before.References = []string{"https://example.com/doc", "8b5afa2"}
+	assert.NoError(t, before.Validate())
+
+	data, err := json.Marshal(before)
+	assert.NoError(t, err)
+
+	var after CreateOperation
+	err = json.Unmarshal(data, &after)
+	assert.NoError(t, err)
+
+	// enforce creating the IDs
+	before.Id()
+	rene.Id()
+
+	assert.Equal(t, before, &after)
+
+	comment := NewAddCommentOp(rene, unix, "comment", nil)
+	comment.References = []string{"8b5afa2", "022ed5f"}
+	assert.NoError(t, comment.Validate())
+
+	snapshot := Snapshot{}
+	before.Apply(&snapshot)
+	comment.Apply(&snapshot)
+
+	assert.Equal(t, []string{"https://example.com/doc", "8b5afa2", "022ed5f"}, snapshot.References)
+
+	before.References = []string{"two words"}
+	assert.Error(t, before.Validate())
+
+	assert.True(t, IsCommitLike("8b5afa2"))
+	assert.True(t, IsCommitLike("a70b8b2f1c2e3d4b5a69788766554433221100ff"))
+	assert.False(t, IsCommitLike("abc"))
+	assert.False(t, IsCommitLike("https://example.com/doc"))
+}
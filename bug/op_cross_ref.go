@@ -0,0 +1,149 @@
+package bug
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/identity"
+	"github.com/MichaelMure/git-bug/util/git"
+	"github.com/MichaelMure/git-bug/util/timestamp"
+)
+
+var _ Operation = &CrossRefOperation{}
+
+// CrossRef is a reference to the bug from elsewhere, like a commit fixing it
+// or a merge request mentioning it
+type CrossRef struct {
+	Commit   git.Hash
+	URL      string
+	Author   identity.Interface
+	UnixTime timestamp.Timestamp
+}
+
+// CrossRefOperation will record that the bug is referenced by a commit of
+// the repository, or by something outside of it given by its URL
+type CrossRefOperation struct {
+	OpBase
+	Commit git.Hash `json:"commit,omitempty"`
+	URL    string   `json:"url,omitempty"`
+}
+
+func (op *CrossRefOperation) base() *OpBase {
+	return &op.OpBase
+}
+
+func (op *CrossRefOperation) Id() entity.Id {
+	return idOperation(op)
+}
+
+func (op *CrossRefOperation) Size() int {
+	return sizeOperation(op)
+}
+
+func (op *CrossRefOperation) Apply(snapshot *Snapshot) {
+	snapshot.addActor(op.GetAuthor())
+
+	for _, ref := range snapshot.CrossRefs {
+		if ref.Commit == op.Commit && ref.URL == op.URL {
+			return
+		}
+	}
+
+	snapshot.CrossRefs = append(snapshot.CrossRefs, CrossRef{
+		Commit:   op.Commit,
+		URL:      op.URL,
+		Author:   op.GetAuthor(),
+		UnixTime: timestamp.Timestamp(op.UnixTime),
+	})
+}
+
+func (op *CrossRefOperation) Validate() error {
+	if err := opBaseValidate(op, CrossRefOp); err != nil {
+		return err
+	}
+
+	if op.Commit == "" && op.URL == "" {
+		return fmt.Errorf("cross reference without commit or url")
+	}
+
+	if op.Commit != "" && !op.Commit.IsValid() {
+		return fmt.Errorf("invalid commit hash %s", op.Commit)
+	}
+
+	if op.URL != "" {
+		u, err := url.Parse(op.URL)
+		if err != nil || !u.IsAbs() {
+			return fmt.Errorf("invalid cross reference url %s", op.URL)
+		}
+	}
+
+	return nil
+}
+
+// UnmarshalJSON is a two step JSON unmarshaling
+// This workaround is necessary to avoid the inner OpBase.MarshalJSON
+// overriding the outer op's MarshalJSON
+func (op *CrossRefOperation) UnmarshalJSON(data []byte) error {
+	// Unmarshal OpBase and the op separately
+
+	base := OpBase{}
+	err := json.Unmarshal(data, &base)
+	if err != nil {
+		return err
+	}
+
+	aux := struct {
+		Commit git.Hash `json:"commit"`
+		URL    string   `json:"url"`
+	}{}
+
+	err = json.Unmarshal(data, &aux)
+	if err != nil {
+		return err
+	}
+
+	op.OpBase = base
+	op.Commit = aux.Commit
+	op.URL = aux.URL
+
+	return nil
+}
+
+// Sign post method for gqlgen
+func (op *CrossRefOperation) IsAuthored() {}
+
+func NewCrossRefOp(author identity.Interface, unixTime int64, commit git.Hash) *CrossRefOperation {
+	return &CrossRefOperation{
+		OpBase: newOpBase(CrossRefOp, author, unixTime),
+		Commit: commit,
+	}
+}
+
+func NewCrossRefURLOp(author identity.Interface, unixTime int64, url string) *CrossRefOperation {
+	return &CrossRefOperation{
+		OpBase: newOpBase(CrossRefOp, author, unixTime),
+		URL:    url,
+	}
+}
+
+// Convenience function to apply the operation
+func CrossReference(b Interface, author identity.Interface, unixTime int64, commit git.Hash) (*CrossRefOperation, error) {
+	crossRefOp := NewCrossRefOp(author, unixTime, commit)
+	if err := crossRefOp.Validate(); err != nil {
+		return nil, err
+	}
+	b.Append(crossRefOp)
+	return crossRefOp, nil
+}
+
+// Convenience function to apply the operation
+func CrossReferenceURL(b Interface, author identity.Interface, unixTime int64, url string) (*CrossRefOperation, error) {
+	crossRefOp := NewCrossRefURLOp(author, unixTime, url)
+	if err := crossRefOp.Validate(); err != nil {
+		return nil, err
+	}
+	b.Append(crossRefOp)
+	return crossRefOp, nil
+}
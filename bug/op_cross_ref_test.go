@@ -0,0 +1,64 @@
+package bug
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/identity"
+	"github.com/MichaelMure/git-bug/util/git"
+)
+
+func TestCrossRef(t *testing.T) {
+	snapshot := Snapshot{}
+
+	rene := identity.NewBare("René Descartes", "rene@descartes.fr")
+	unix := time.Now().Unix()
+	commit := git.Hash("a70b8b2f1c2e3d4b5a69788766554433221100ff")
+
+	op := NewCrossRefOp(rene, unix, commit)
+	require.NoError(t, op.Validate())
+	op.Apply(&snapshot)
+	NewCrossRefOp(rene, unix, commit).Apply(&snapshot)
+
+	require.Len(t, snapshot.CrossRefs, 1)
+	assert.Equal(t, commit, snapshot.CrossRefs[0].Commit)
+	assert.Len(t, snapshot.Actors, 1)
+
+	assert.Error(t, NewCrossRefOp(rene, unix, "a70b8b2").Validate())
+
+	url := "https://gitlab.com/foo/bar/-/merge_requests/12"
+	urlOp := NewCrossRefURLOp(rene, unix, url)
+	require.NoError(t, urlOp.Validate())
+	urlOp.Apply(&snapshot)
+	NewCrossRefURLOp(rene, unix, url).Apply(&snapshot)
+
+	require.Len(t, snapshot.CrossRefs, 2)
+	assert.Equal(t, url, snapshot.CrossRefs[1].URL)
+	assert.Empty(t, snapshot.CrossRefs[1].Commit)
+
+	assert.Error(t, NewCrossRefURLOp(rene, unix, "").Validate())
+	assert.Error(t, NewCrossRefURLOp(rene, unix, "merge_requests/12").Validate())
+}
+
+func TestCrossRefSerialize(t *testing.T) {
+	var rene = identity.NewBare("René Descartes", "rene@descartes.fr")
+	unix := time.Now().Unix()
+	before := NewCrossRefOp(rene, unix, "a70b8b2f1c2e3d4b5a69788766554433221100ff")
+
+	data, err := json.Marshal(before)
+	assert.NoError(t, err)
+
+	var after CrossRefOperation
+	err = json.Unmarshal(data, &after)
+	assert.NoError(t, err)
+
+	// enforce creating the IDs
+	before.Id()
+	rene.Id()
+
+	assert.Equal(t, before, &after)
+}
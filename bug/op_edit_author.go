@@ -0,0 +1,143 @@
+package bug
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/identity"
+)
+
+var _ Operation = &EditAuthorOperation{}
+
+// EditAuthorOperation replace the author of a previous operation of the bug.
+// As the operations are immutable, the stored author is kept as is (and is
+// still the one verified against the commit signature), but the new author is
+// reported everywhere once the bug is compiled.
+type EditAuthorOperation struct {
+	OpBase
+	Target    entity.Id          `json:"target"`
+	NewAuthor identity.Interface `json:"new_author"`
+}
+
+func (op *EditAuthorOperation) base() *OpBase {
+	return &op.OpBase
+}
+
+func (op *EditAuthorOperation) Id() entity.Id {
+	return idOperation(op)
+}
+
+func (op *EditAuthorOperation) Size() int {
+	return sizeOperation(op)
+}
+
+// Apply only record the new author on the target. As the author of an
+// operation is used when applying it, the edition is effectively done
+// beforehand when compiling the bug.
+func (op *EditAuthorOperation) Apply(snapshot *Snapshot) {
+	for _, target := range snapshot.Operations {
+		if target.Id() == op.Target {
+			target.base().editedAuthor = op.NewAuthor
+			return
+		}
+	}
+}
+
+func (op *EditAuthorOperation) Validate() error {
+	if err := opBaseValidate(op, EditAuthorOp); err != nil {
+		return err
+	}
+
+	if err := op.Target.Validate(); err != nil {
+		return errors.Wrap(err, "target hash is invalid")
+	}
+
+	if op.NewAuthor == nil {
+		return fmt.Errorf("new author not set")
+	}
+
+	if err := op.NewAuthor.Validate(); err != nil {
+		return errors.Wrap(err, "new author")
+	}
+
+	return nil
+}
+
+// UnmarshalJSON is a two step JSON unmarshaling
+// This workaround is necessary to avoid the inner OpBase.MarshalJSON
+// overriding the outer op's MarshalJSON
+func (op *EditAuthorOperation) UnmarshalJSON(data []byte) error {
+	// Unmarshal OpBase and the op separately
+
+	base := OpBase{}
+	err := json.Unmarshal(data, &base)
+	if err != nil {
+		return err
+	}
+
+	aux := struct {
+		Target    entity.Id       `json:"target"`
+		NewAuthor json.RawMessage `json:"new_author"`
+	}{}
+
+	err = json.Unmarshal(data, &aux)
+	if err != nil {
+		return err
+	}
+
+	// delegate the decoding of the identity
+	newAuthor, err := identity.UnmarshalJSON(aux.NewAuthor)
+	if err != nil {
+		return err
+	}
+
+	op.OpBase = base
+	op.Target = aux.Target
+	op.NewAuthor = newAuthor
+
+	return nil
+}
+
+// Sign post method for gqlgen
+func (op *EditAuthorOperation) IsAuthored() {}
+
+func NewEditAuthorOp(author identity.Interface, unixTime int64, target entity.Id, newAuthor identity.Interface) *EditAuthorOperation {
+	return &EditAuthorOperation{
+		OpBase:    newOpBase(EditAuthorOp, author, unixTime),
+		Target:    target,
+		NewAuthor: newAuthor,
+	}
+}
+
+// Convenience function to apply the operation
+func EditAuthor(b Interface, author identity.Interface, unixTime int64, target entity.Id, newAuthor identity.Interface) (*EditAuthorOperation, error) {
+	editAuthorOp := NewEditAuthorOp(author, unixTime, target, newAuthor)
+	if err := editAuthorOp.Validate(); err != nil {
+		return nil, err
+	}
+	b.Append(editAuthorOp)
+	return editAuthorOp, nil
+}
+
+// applyEditedAuthors record on the targeted operations the replacement of
+// their author, before they are applied on a snapshot
+func applyEditedAuthors(bug *Bug) {
+	ops := make(map[entity.Id]Operation)
+
+	it := NewOperationIterator(bug)
+	for it.Next() {
+		op := it.Value()
+
+		if edit, ok := op.(*EditAuthorOperation); ok {
+			if target, ok := ops[edit.Target]; ok {
+				target.base().editedAuthor = edit.NewAuthor
+			}
+			continue
+		}
+
+		ops[op.Id()] = op
+	}
+}
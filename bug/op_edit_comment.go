@@ -32,11 +32,15 @@ func (op *EditCommentOperation) Id() entity.Id {
 	return idOperation(op)
 }
 
+func (op *EditCommentOperation) Size() int {
+	return sizeOperation(op)
+}
+
 func (op *EditCommentOperation) Apply(snapshot *Snapshot) {
 	// Todo: currently any message can be edited, even by a different author
 	// crypto signature are needed.
 
-	snapshot.addActor(op.Author)
+	snapshot.addActor(op.GetAuthor())
 
 	var target TimelineItem
 
@@ -29,9 +29,13 @@ func (op *LabelChangeOperation) Id() entity.Id {
 	return idOperation(op)
 }
 
+func (op *LabelChangeOperation) Size() int {
+	return sizeOperation(op)
+}
+
 // Apply apply the operation
 func (op *LabelChangeOperation) Apply(snapshot *Snapshot) {
-	snapshot.addActor(op.Author)
+	snapshot.addActor(op.GetAuthor())
 
 	// Add in the set
 AddLoop:
@@ -63,7 +67,7 @@ AddLoop:
 
 	item := &LabelChangeTimelineItem{
 		id:       op.Id(),
-		Author:   op.Author,
+		Author:   op.GetAuthor(),
 		UnixTime: timestamp.Timestamp(op.UnixTime),
 		Added:    op.Added,
 		Removed:  op.Removed,
@@ -0,0 +1,139 @@
+package bug
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/identity"
+)
+
+var _ Operation = &LinkOperation{}
+
+// LinkDirection qualify the relationship between two bugs
+type LinkDirection string
+
+const (
+	// The bug is a child (sub-issue) of the target
+	ChildOf LinkDirection = "child-of"
+	// The bug is the parent of the target
+	ParentOf LinkDirection = "parent-of"
+	// The bug is loosely related to the target, for example a fork
+	RelatesTo LinkDirection = "relates-to"
+)
+
+func (ld LinkDirection) Validate() error {
+	switch ld {
+	case ChildOf, ParentOf, RelatesTo:
+		return nil
+	default:
+		return fmt.Errorf("unknown link direction %s", ld)
+	}
+}
+
+// Link is a directed relationship from a bug to another one
+type Link struct {
+	Direction LinkDirection
+	Target    entity.Id
+}
+
+// LinkOperation will link the bug to another one
+type LinkOperation struct {
+	OpBase
+	Direction LinkDirection `json:"direction"`
+	Target    entity.Id     `json:"target"`
+}
+
+func (op *LinkOperation) base() *OpBase {
+	return &op.OpBase
+}
+
+func (op *LinkOperation) Id() entity.Id {
+	return idOperation(op)
+}
+
+func (op *LinkOperation) Size() int {
+	return sizeOperation(op)
+}
+
+func (op *LinkOperation) Apply(snapshot *Snapshot) {
+	snapshot.addActor(op.GetAuthor())
+
+	link := Link{Direction: op.Direction, Target: op.Target}
+
+	for _, l := range snapshot.Links {
+		if l == link {
+			return
+		}
+	}
+
+	snapshot.Links = append(snapshot.Links, link)
+}
+
+func (op *LinkOperation) Validate() error {
+	if err := opBaseValidate(op, LinkOp); err != nil {
+		return err
+	}
+
+	if err := op.Direction.Validate(); err != nil {
+		return errors.Wrap(err, "direction invalid")
+	}
+
+	if err := op.Target.Validate(); err != nil {
+		return errors.Wrap(err, "target invalid")
+	}
+
+	return nil
+}
+
+// UnmarshalJSON is a two step JSON unmarshaling
+// This workaround is necessary to avoid the inner OpBase.MarshalJSON
+// overriding the outer op's MarshalJSON
+func (op *LinkOperation) UnmarshalJSON(data []byte) error {
+	// Unmarshal OpBase and the op separately
+
+	base := OpBase{}
+	err := json.Unmarshal(data, &base)
+	if err != nil {
+		return err
+	}
+
+	aux := struct {
+		Direction LinkDirection `json:"direction"`
+		Target    entity.Id     `json:"target"`
+	}{}
+
+	err = json.Unmarshal(data, &aux)
+	if err != nil {
+		return err
+	}
+
+	op.OpBase = base
+	op.Direction = aux.Direction
+	op.Target = aux.Target
+
+	return nil
+}
+
+// Sign post method for gqlgen
+func (op *LinkOperation) IsAuthored() {}
+
+func NewLinkOp(author identity.Interface, unixTime int64, direction LinkDirection, target entity.Id) *LinkOperation {
+	return &LinkOperation{
+		OpBase:    newOpBase(LinkOp, author, unixTime),
+		Direction: direction,
+		Target:    target,
+	}
+}
+
+// Convenience function to apply the operation
+func AddLink(b Interface, author identity.Interface, unixTime int64, direction LinkDirection, target entity.Id) (*LinkOperation, error) {
+	linkOp := NewLinkOp(author, unixTime, direction, target)
+	if err := linkOp.Validate(); err != nil {
+		return nil, err
+	}
+	b.Append(linkOp)
+	return linkOp, nil
+}
@@ -0,0 +1,59 @@
+package bug
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/identity"
+)
+
+func TestLink(t *testing.T) {
+	snapshot := Snapshot{}
+
+	rene := identity.NewBare("René Descartes", "rene@descartes.fr")
+	unix := time.Now().Unix()
+
+	parent := entity.Id("e51f8a8a1f1d7d9c9e4b6a9a4f2b4a1c1d7b6a3ffb8a9d7c4f5e3a2b1c0d9e8f")
+	child := entity.Id("a51f8a8a1f1d7d9c9e4b6a9a4f2b4a1c1d7b6a3ffb8a9d7c4f5e3a2b1c0d9e8f")
+
+	op1 := NewLinkOp(rene, unix, ChildOf, parent)
+	require.NoError(t, op1.Validate())
+	op1.Apply(&snapshot)
+
+	op2 := NewLinkOp(rene, unix, ParentOf, child)
+	op2.Apply(&snapshot)
+
+	// linking twice is idempotent
+	op1.Apply(&snapshot)
+
+	assert.Len(t, snapshot.Links, 2)
+	assert.Equal(t, []entity.Id{parent}, snapshot.LinkedTo(ChildOf))
+	assert.Equal(t, []entity.Id{child}, snapshot.LinkedTo(ParentOf))
+
+	invalid := NewLinkOp(rene, unix, "sibling-of", parent)
+	assert.Error(t, invalid.Validate())
+}
+
+func TestLinkSerialize(t *testing.T) {
+	var rene = identity.NewBare("René Descartes", "rene@descartes.fr")
+	unix := time.Now().Unix()
+	before := NewLinkOp(rene, unix, ChildOf, "e51f8a8a1f1d7d9c9e4b6a9a4f2b4a1c1d7b6a3ffb8a9d7c4f5e3a2b1c0d9e8f")
+
+	data, err := json.Marshal(before)
+	assert.NoError(t, err)
+
+	var after LinkOperation
+	err = json.Unmarshal(data, &after)
+	assert.NoError(t, err)
+
+	// enforce creating the IDs
+	before.Id()
+	rene.Id()
+
+	assert.Equal(t, before, &after)
+}
@@ -24,6 +24,10 @@ func (op *NoOpOperation) Id() entity.Id {
 	return idOperation(op)
 }
 
+func (op *NoOpOperation) Size() int {
+	return sizeOperation(op)
+}
+
 func (op *NoOpOperation) Apply(snapshot *Snapshot) {
 	// Nothing to do
 }
@@ -27,3 +27,30 @@ func TestNoopSerialize(t *testing.T) {
 
 	assert.Equal(t, before, &after)
 }
+
+func TestNoopLastMetadata(t *testing.T) {
+	var rene = identity.NewBare("René Descartes", "rene@descartes.fr")
+	unix := time.Now().Unix()
+
+	snapshot := Snapshot{}
+
+	_, ok := snapshot.LastMetadata("key")
+	assert.False(t, ok)
+
+	op1 := NewNoOpOp(rene, unix)
+	op1.SetMetadata("key", "value1")
+	op2 := NewNoOpOp(rene, unix)
+	op2.SetMetadata("other", "value")
+	op3 := NewNoOpOp(rene, unix)
+	op3.SetMetadata("key", "value2")
+
+	snapshot.Operations = []Operation{op1, op2}
+	value, ok := snapshot.LastMetadata("key")
+	assert.True(t, ok)
+	assert.Equal(t, "value1", value)
+
+	snapshot.Operations = append(snapshot.Operations, op3)
+	value, ok = snapshot.LastMetadata("key")
+	assert.True(t, ok)
+	assert.Equal(t, "value2", value)
+}
@@ -0,0 +1,84 @@
+package bug
+
+import (
+	"encoding/json"
+
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/identity"
+)
+
+var _ Operation = &PinOperation{}
+
+// PinOperation will pin or unpin a bug, to highlight it among the others
+type PinOperation struct {
+	OpBase
+	Pinned bool `json:"pinned"`
+}
+
+func (op *PinOperation) base() *OpBase {
+	return &op.OpBase
+}
+
+func (op *PinOperation) Id() entity.Id {
+	return idOperation(op)
+}
+
+func (op *PinOperation) Size() int {
+	return sizeOperation(op)
+}
+
+func (op *PinOperation) Apply(snapshot *Snapshot) {
+	snapshot.addActor(op.GetAuthor())
+	snapshot.Pinned = op.Pinned
+}
+
+func (op *PinOperation) Validate() error {
+	return opBaseValidate(op, PinOp)
+}
+
+// UnmarshalJSON is a two step JSON unmarshaling
+// This workaround is necessary to avoid the inner OpBase.MarshalJSON
+// overriding the outer op's MarshalJSON
+func (op *PinOperation) UnmarshalJSON(data []byte) error {
+	// Unmarshal OpBase and the op separately
+
+	base := OpBase{}
+	err := json.Unmarshal(data, &base)
+	if err != nil {
+		return err
+	}
+
+	aux := struct {
+		Pinned bool `json:"pinned"`
+	}{}
+
+	err = json.Unmarshal(data, &aux)
+	if err != nil {
+		return err
+	}
+
+	op.OpBase = base
+	op.Pinned = aux.Pinned
+
+	return nil
+}
+
+// Sign post method for gqlgen
+func (op *PinOperation) IsAuthored() {}
+
+func NewPinOp(author identity.Interface, unixTime int64, pinned bool) *PinOperation {
+	return &PinOperation{
+		OpBase: newOpBase(PinOp, author, unixTime),
+		Pinned: pinned,
+	}
+}
+
+// Convenience function to apply the operation
+func Pin(b Interface, author identity.Interface, unixTime int64, pinned bool) (*PinOperation, error) {
+	pinOp := NewPinOp(author, unixTime, pinned)
+	if err := pinOp.Validate(); err != nil {
+		return nil, err
+	}
+	b.Append(pinOp)
+	return pinOp, nil
+}
@@ -0,0 +1,48 @@
+package bug
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/identity"
+)
+
+func TestPin(t *testing.T) {
+	snapshot := Snapshot{}
+
+	rene := identity.NewBare("René Descartes", "rene@descartes.fr")
+	unix := time.Now().Unix()
+
+	op := NewPinOp(rene, unix, true)
+	require.NoError(t, op.Validate())
+	op.Apply(&snapshot)
+
+	assert.True(t, snapshot.Pinned)
+	assert.Len(t, snapshot.Actors, 1)
+
+	NewPinOp(rene, unix, false).Apply(&snapshot)
+	assert.False(t, snapshot.Pinned)
+}
+
+func TestPinSerialize(t *testing.T) {
+	var rene = identity.NewBare("René Descartes", "rene@descartes.fr")
+	unix := time.Now().Unix()
+	before := NewPinOp(rene, unix, true)
+
+	data, err := json.Marshal(before)
+	assert.NoError(t, err)
+
+	var after PinOperation
+	err = json.Unmarshal(data, &after)
+	assert.NoError(t, err)
+
+	// enforce creating the IDs
+	before.Id()
+	rene.Id()
+
+	assert.Equal(t, before, &after)
+}
@@ -0,0 +1,93 @@
+package bug
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/identity"
+)
+
+var _ Operation = &SetDueDateOperation{}
+
+// SetDueDateOperation will change the date before which the bug should be
+// resolved. A zero Due remove the due date.
+type SetDueDateOperation struct {
+	OpBase
+	Due time.Time `json:"due"`
+}
+
+func (op *SetDueDateOperation) base() *OpBase {
+	return &op.OpBase
+}
+
+func (op *SetDueDateOperation) Id() entity.Id {
+	return idOperation(op)
+}
+
+func (op *SetDueDateOperation) Size() int {
+	return sizeOperation(op)
+}
+
+func (op *SetDueDateOperation) Apply(snapshot *Snapshot) {
+	snapshot.addActor(op.GetAuthor())
+
+	if op.Due.IsZero() {
+		snapshot.DueDate = nil
+		return
+	}
+
+	due := op.Due
+	snapshot.DueDate = &due
+}
+
+func (op *SetDueDateOperation) Validate() error {
+	return opBaseValidate(op, SetDueDateOp)
+}
+
+// UnmarshalJSON is a two step JSON unmarshaling
+// This workaround is necessary to avoid the inner OpBase.MarshalJSON
+// overriding the outer op's MarshalJSON
+func (op *SetDueDateOperation) UnmarshalJSON(data []byte) error {
+	// Unmarshal OpBase and the op separately
+
+	base := OpBase{}
+	err := json.Unmarshal(data, &base)
+	if err != nil {
+		return err
+	}
+
+	aux := struct {
+		Due time.Time `json:"due"`
+	}{}
+
+	err = json.Unmarshal(data, &aux)
+	if err != nil {
+		return err
+	}
+
+	op.OpBase = base
+	op.Due = aux.Due
+
+	return nil
+}
+
+// Sign post method for gqlgen
+func (op *SetDueDateOperation) IsAuthored() {}
+
+func NewSetDueDateOp(author identity.Interface, unixTime int64, due time.Time) *SetDueDateOperation {
+	return &SetDueDateOperation{
+		OpBase: newOpBase(SetDueDateOp, author, unixTime),
+		Due:    due,
+	}
+}
+
+// Convenience function to apply the operation
+func SetDueDate(b Interface, author identity.Interface, unixTime int64, due time.Time) (*SetDueDateOperation, error) {
+	dueOp := NewSetDueDateOp(author, unixTime, due)
+	if err := dueOp.Validate(); err != nil {
+		return nil, err
+	}
+	b.Append(dueOp)
+	return dueOp, nil
+}
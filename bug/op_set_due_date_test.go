@@ -0,0 +1,60 @@
+package bug
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/identity"
+)
+
+func TestSetDueDate(t *testing.T) {
+	snapshot := Snapshot{Status: OpenStatus}
+
+	rene := identity.NewBare("René Descartes", "rene@descartes.fr")
+	unix := time.Now().Unix()
+
+	past := time.Now().Add(-time.Hour)
+	op := NewSetDueDateOp(rene, unix, past)
+	require.NoError(t, op.Validate())
+	op.Apply(&snapshot)
+
+	require.NotNil(t, snapshot.DueDate)
+	assert.True(t, snapshot.DueDate.Equal(past))
+	assert.True(t, snapshot.IsOverdue())
+
+	snapshot.Status = ClosedStatus
+	assert.False(t, snapshot.IsOverdue())
+	snapshot.Status = OpenStatus
+
+	future := time.Now().Add(time.Hour)
+	NewSetDueDateOp(rene, unix, future).Apply(&snapshot)
+	assert.False(t, snapshot.IsOverdue())
+
+	// a zero date remove the due date
+	NewSetDueDateOp(rene, unix, time.Time{}).Apply(&snapshot)
+	assert.Nil(t, snapshot.DueDate)
+	assert.False(t, snapshot.IsOverdue())
+}
+
+func TestSetDueDateSerialize(t *testing.T) {
+	var rene = identity.NewBare("René Descartes", "rene@descartes.fr")
+	unix := time.Now().Unix()
+	before := NewSetDueDateOp(rene, unix, time.Date(2020, 3, 1, 0, 0, 0, 0, time.UTC))
+
+	data, err := json.Marshal(before)
+	assert.NoError(t, err)
+
+	var after SetDueDateOperation
+	err = json.Unmarshal(data, &after)
+	assert.NoError(t, err)
+
+	// enforce creating the IDs
+	before.Id()
+	rene.Id()
+
+	assert.Equal(t, before, &after)
+}
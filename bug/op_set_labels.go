@@ -0,0 +1,134 @@
+package bug
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/pkg/errors"
+
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/identity"
+	"github.com/MichaelMure/git-bug/util/timestamp"
+)
+
+var _ Operation = &SetLabelsOperation{}
+
+// SetLabelsOperation define a Bug operation to replace the whole set of
+// labels at once, for example to condense a remote label history in a single
+// operation when importing. Unlike LabelChangeOperation, it doesn't depend on
+// the labels the bug had before.
+type SetLabelsOperation struct {
+	OpBase
+	Labels []Label `json:"labels"`
+}
+
+func (op *SetLabelsOperation) base() *OpBase {
+	return &op.OpBase
+}
+
+func (op *SetLabelsOperation) Id() entity.Id {
+	return idOperation(op)
+}
+
+func (op *SetLabelsOperation) Size() int {
+	return sizeOperation(op)
+}
+
+// Apply apply the operation
+func (op *SetLabelsOperation) Apply(snapshot *Snapshot) {
+	snapshot.addActor(op.GetAuthor())
+
+	var added, removed []Label
+	for _, label := range op.Labels {
+		if !labelExist(snapshot.Labels, label) {
+			added = append(added, label)
+		}
+	}
+	for _, label := range snapshot.Labels {
+		if !labelExist(op.Labels, label) {
+			removed = append(removed, label)
+		}
+	}
+
+	snapshot.Labels = append([]Label{}, op.Labels...)
+	sort.Slice(snapshot.Labels, func(i, j int) bool {
+		return string(snapshot.Labels[i]) < string(snapshot.Labels[j])
+	})
+
+	// displayed as the equivalent label change
+	item := &LabelChangeTimelineItem{
+		id:       op.Id(),
+		Author:   op.GetAuthor(),
+		UnixTime: timestamp.Timestamp(op.UnixTime),
+		Added:    added,
+		Removed:  removed,
+	}
+
+	snapshot.Timeline = append(snapshot.Timeline, item)
+}
+
+func (op *SetLabelsOperation) Validate() error {
+	if err := opBaseValidate(op, SetLabelsOp); err != nil {
+		return err
+	}
+
+	for i, l := range op.Labels {
+		if err := l.Validate(); err != nil {
+			return errors.Wrap(err, "label")
+		}
+		if labelExist(op.Labels[:i], l) {
+			return fmt.Errorf("duplicated label %s", l)
+		}
+	}
+
+	return nil
+}
+
+// UnmarshalJSON is a two step JSON unmarshaling
+// This workaround is necessary to avoid the inner OpBase.MarshalJSON
+// overriding the outer op's MarshalJSON
+func (op *SetLabelsOperation) UnmarshalJSON(data []byte) error {
+	// Unmarshal OpBase and the op separately
+
+	base := OpBase{}
+	err := json.Unmarshal(data, &base)
+	if err != nil {
+		return err
+	}
+
+	aux := struct {
+		Labels []Label `json:"labels"`
+	}{}
+
+	err = json.Unmarshal(data, &aux)
+	if err != nil {
+		return err
+	}
+
+	op.OpBase = base
+	op.Labels = aux.Labels
+
+	return nil
+}
+
+// Sign post method for gqlgen
+func (op *SetLabelsOperation) IsAuthored() {}
+
+func NewSetLabelsOp(author identity.Interface, unixTime int64, labels []Label) *SetLabelsOperation {
+	return &SetLabelsOperation{
+		OpBase: newOpBase(SetLabelsOp, author, unixTime),
+		Labels: labels,
+	}
+}
+
+// SetLabels is a convenience function to apply the operation. An empty set
+// remove all the labels.
+func SetLabels(b Interface, author identity.Interface, unixTime int64, labels []Label) (*SetLabelsOperation, error) {
+	setLabelsOp := NewSetLabelsOp(author, unixTime, labels)
+	if err := setLabelsOp.Validate(); err != nil {
+		return nil, err
+	}
+	b.Append(setLabelsOp)
+	return setLabelsOp, nil
+}
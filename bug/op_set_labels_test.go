@@ -0,0 +1,64 @@
+package bug
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/identity"
+)
+
+func TestSetLabels(t *testing.T) {
+	snapshot := Snapshot{Labels: []Label{"bug", "wontfix"}}
+
+	rene := identity.NewBare("René Descartes", "rene@descartes.fr")
+	unix := time.Now().Unix()
+
+	op := NewSetLabelsOp(rene, unix, []Label{"urgent", "bug", "core"})
+	require.NoError(t, op.Validate())
+	op.Apply(&snapshot)
+
+	assert.Equal(t, []Label{"bug", "core", "urgent"}, snapshot.Labels)
+	assert.Len(t, snapshot.Actors, 1)
+
+	require.Len(t, snapshot.Timeline, 1)
+	item := snapshot.Timeline[0].(*LabelChangeTimelineItem)
+	assert.Equal(t, op.Id(), item.Id())
+	assert.Equal(t, []Label{"urgent", "core"}, item.Added)
+	assert.Equal(t, []Label{"wontfix"}, item.Removed)
+
+	// the set is not aliased
+	op.Labels[0] = "changed"
+	assert.Equal(t, []Label{"bug", "core", "urgent"}, snapshot.Labels)
+
+	// an empty set remove all the labels
+	empty := NewSetLabelsOp(rene, unix, nil)
+	require.NoError(t, empty.Validate())
+	empty.Apply(&snapshot)
+	assert.Empty(t, snapshot.Labels)
+
+	require.Error(t, NewSetLabelsOp(rene, unix, []Label{"bug", "bug"}).Validate())
+	require.Error(t, NewSetLabelsOp(rene, unix, []Label{""}).Validate())
+}
+
+func TestSetLabelsSerialize(t *testing.T) {
+	var rene = identity.NewBare("René Descartes", "rene@descartes.fr")
+	unix := time.Now().Unix()
+	before := NewSetLabelsOp(rene, unix, []Label{"bug", "core"})
+
+	data, err := json.Marshal(before)
+	assert.NoError(t, err)
+
+	var after SetLabelsOperation
+	err = json.Unmarshal(data, &after)
+	assert.NoError(t, err)
+
+	// enforce creating the IDs
+	before.Id()
+	rene.Id()
+
+	assert.Equal(t, before, &after)
+}
@@ -25,6 +25,10 @@ func (op *SetMetadataOperation) Id() entity.Id {
 	return idOperation(op)
 }
 
+func (op *SetMetadataOperation) Size() int {
+	return sizeOperation(op)
+}
+
 func (op *SetMetadataOperation) Apply(snapshot *Snapshot) {
 	for _, target := range snapshot.Operations {
 		if target.Id() == op.Target {
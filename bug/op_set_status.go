@@ -26,13 +26,17 @@ func (op *SetStatusOperation) Id() entity.Id {
 	return idOperation(op)
 }
 
+func (op *SetStatusOperation) Size() int {
+	return sizeOperation(op)
+}
+
 func (op *SetStatusOperation) Apply(snapshot *Snapshot) {
 	snapshot.Status = op.Status
-	snapshot.addActor(op.Author)
+	snapshot.addActor(op.GetAuthor())
 
 	item := &SetStatusTimelineItem{
 		id:       op.Id(),
-		Author:   op.Author,
+		Author:   op.GetAuthor(),
 		UnixTime: timestamp.Timestamp(op.UnixTime),
 		Status:   op.Status,
 	}
@@ -29,13 +29,17 @@ func (op *SetTitleOperation) Id() entity.Id {
 	return idOperation(op)
 }
 
+func (op *SetTitleOperation) Size() int {
+	return sizeOperation(op)
+}
+
 func (op *SetTitleOperation) Apply(snapshot *Snapshot) {
 	snapshot.Title = op.Title
-	snapshot.addActor(op.Author)
+	snapshot.addActor(op.GetAuthor())
 
 	item := &SetTitleTimelineItem{
 		id:       op.Id(),
-		Author:   op.Author,
+		Author:   op.GetAuthor(),
 		UnixTime: timestamp.Timestamp(op.UnixTime),
 		Title:    op.Title,
 		Was:      op.Was,
@@ -0,0 +1,112 @@
+package bug
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/identity"
+)
+
+var _ Operation = &StripMetadataOperation{}
+
+// StripMetadataOperation hide the given metadata keys of all the previous
+// operations of the bug. As the operations are immutable, the metadata are
+// not removed from the stored operations but are not reported anymore once
+// the bug is compiled.
+type StripMetadataOperation struct {
+	OpBase
+	Keys []string `json:"keys"`
+}
+
+func (op *StripMetadataOperation) base() *OpBase {
+	return &op.OpBase
+}
+
+func (op *StripMetadataOperation) Id() entity.Id {
+	return idOperation(op)
+}
+
+func (op *StripMetadataOperation) Size() int {
+	return sizeOperation(op)
+}
+
+func (op *StripMetadataOperation) Apply(snapshot *Snapshot) {
+	for _, target := range snapshot.Operations {
+		base := target.base()
+
+		if base.strippedMetadata == nil {
+			base.strippedMetadata = make(map[string]struct{})
+		}
+
+		for _, key := range op.Keys {
+			base.strippedMetadata[key] = struct{}{}
+			delete(base.extraMetadata, key)
+		}
+	}
+}
+
+func (op *StripMetadataOperation) Validate() error {
+	if err := opBaseValidate(op, StripMetadataOp); err != nil {
+		return err
+	}
+
+	if len(op.Keys) == 0 {
+		return fmt.Errorf("no metadata key to strip")
+	}
+
+	for _, key := range op.Keys {
+		if key == "" {
+			return fmt.Errorf("empty metadata key")
+		}
+	}
+
+	return nil
+}
+
+// UnmarshalJSON is a two step JSON unmarshaling
+// This workaround is necessary to avoid the inner OpBase.MarshalJSON
+// overriding the outer op's MarshalJSON
+func (op *StripMetadataOperation) UnmarshalJSON(data []byte) error {
+	// Unmarshal OpBase and the op separately
+
+	base := OpBase{}
+	err := json.Unmarshal(data, &base)
+	if err != nil {
+		return err
+	}
+
+	aux := struct {
+		Keys []string `json:"keys"`
+	}{}
+
+	err = json.Unmarshal(data, &aux)
+	if err != nil {
+		return err
+	}
+
+	op.OpBase = base
+	op.Keys = aux.Keys
+
+	return nil
+}
+
+// Sign post method for gqlgen
+func (op *StripMetadataOperation) IsAuthored() {}
+
+func NewStripMetadataOp(author identity.Interface, unixTime int64, keys []string) *StripMetadataOperation {
+	return &StripMetadataOperation{
+		OpBase: newOpBase(StripMetadataOp, author, unixTime),
+		Keys:   keys,
+	}
+}
+
+// Convenience function to apply the operation
+func StripMetadata(b Interface, author identity.Interface, unixTime int64, keys []string) (*StripMetadataOperation, error) {
+	stripOp := NewStripMetadataOp(author, unixTime, keys)
+	if err := stripOp.Validate(); err != nil {
+		return nil, err
+	}
+	b.Append(stripOp)
+	return stripOp, nil
+}
@@ -0,0 +1,79 @@
+package bug
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/identity"
+)
+
+func TestStripMetadata(t *testing.T) {
+	snapshot := Snapshot{Status: OpenStatus}
+
+	rene := identity.NewBare("René Descartes", "rene@descartes.fr")
+	unix := time.Now().Unix()
+
+	create := NewCreateOp(rene, unix, "title", "message", nil)
+	create.SetMetadata("github-id", "1234")
+	create.SetMetadata("other", "value")
+	create.Apply(&snapshot)
+	snapshot.Operations = append(snapshot.Operations, create)
+
+	setMeta := NewSetMetadataOp(rene, unix, create.Id(), map[string]string{
+		"github-url": "https://github.com/foo/bar/issues/1",
+	})
+	setMeta.Apply(&snapshot)
+	snapshot.Operations = append(snapshot.Operations, setMeta)
+
+	strip := NewStripMetadataOp(rene, unix, []string{"github-id", "github-url"})
+	require.NoError(t, strip.Validate())
+	strip.Apply(&snapshot)
+	snapshot.Operations = append(snapshot.Operations, strip)
+
+	_, ok := create.GetMetadata("github-id")
+	assert.False(t, ok)
+	_, ok = create.GetMetadata("github-url")
+	assert.False(t, ok)
+	assert.Equal(t, map[string]string{"other": "value"}, create.AllMetadata())
+
+	// metadata added after the strip are visible
+	NewSetMetadataOp(rene, unix, create.Id(), map[string]string{
+		"github-id": "5678",
+	}).Apply(&snapshot)
+
+	val, ok := create.GetMetadata("github-id")
+	assert.True(t, ok)
+	assert.Equal(t, "5678", val)
+}
+
+func TestStripMetadataValidate(t *testing.T) {
+	rene := identity.NewBare("René Descartes", "rene@descartes.fr")
+	unix := time.Now().Unix()
+
+	assert.Error(t, NewStripMetadataOp(rene, unix, nil).Validate())
+	assert.Error(t, NewStripMetadataOp(rene, unix, []string{""}).Validate())
+	assert.NoError(t, NewStripMetadataOp(rene, unix, []string{"key"}).Validate())
+}
+
+func TestStripMetadataSerialize(t *testing.T) {
+	var rene = identity.NewBare("René Descartes", "rene@descartes.fr")
+	unix := time.Now().Unix()
+	before := NewStripMetadataOp(rene, unix, []string{"github-id", "github-url"})
+
+	data, err := json.Marshal(before)
+	assert.NoError(t, err)
+
+	var after StripMetadataOperation
+	err = json.Unmarshal(data, &after)
+	assert.NoError(t, err)
+
+	// enforce creating the IDs
+	before.Id()
+	rene.Id()
+
+	assert.Equal(t, before, &after)
+}
@@ -1,6 +1,7 @@
 package bug
 
 import (
+	"crypto/rand"
 	"crypto/sha256"
 	"encoding/json"
 	"fmt"
@@ -10,6 +11,7 @@ import (
 
 	"github.com/MichaelMure/git-bug/entity"
 	"github.com/MichaelMure/git-bug/identity"
+	"github.com/MichaelMure/git-bug/repository"
 	"github.com/MichaelMure/git-bug/util/git"
 )
 
@@ -26,6 +28,13 @@ const (
 	EditCommentOp
 	NoOpOp
 	SetMetadataOp
+	LinkOp
+	SetDueDateOp
+	StripMetadataOp
+	EditAuthorOp
+	PinOp
+	CrossRefOp
+	SetLabelsOp
 )
 
 // Operation define the interface to fulfill for an edit operation of a Bug
@@ -52,6 +61,80 @@ type Operation interface {
 	AllMetadata() map[string]string
 	// GetAuthor return the author identity
 	GetAuthor() identity.Interface
+	// AuthorEmail return an email address of the author, if any is known
+	AuthorEmail(repo repository.Repo) (string, error)
+	// Size return the length in bytes of the serialized operation
+	Size() int
+	// SetDeviceID record the device the operation has been created on
+	SetDeviceID(id string)
+	// GetDeviceID return the device the operation has been created on, if known
+	GetDeviceID() string
+	// Schema return the version of the serialization format of the operation
+	// known by this client
+	Schema() string
+	// Kind return the human readable name of the type of the operation, like
+	// "create" or "add-comment"
+	Kind() string
+}
+
+// opSchemas hold the version of the serialization format of each operation
+// type known by this client, as "major.minor". The minor version must be
+// bumped when an optional field is added, so that older clients can tell
+// they are missing something.
+//
+// 1.0: the initial format
+// 1.1: unix_time_nano and device_id, for all the operations
+// 1.2: original_source and external_created_at, for the create operation,
+//
+//	url for the cross-ref operation
+var opSchemas = map[OperationType]string{
+	CreateOp:        "1.2",
+	SetTitleOp:      "1.1",
+	AddCommentOp:    "1.1",
+	SetStatusOp:     "1.1",
+	LabelChangeOp:   "1.1",
+	EditCommentOp:   "1.1",
+	NoOpOp:          "1.1",
+	SetMetadataOp:   "1.1",
+	LinkOp:          "1.1",
+	SetDueDateOp:    "1.1",
+	StripMetadataOp: "1.1",
+	EditAuthorOp:    "1.1",
+	PinOp:           "1.1",
+	CrossRefOp:      "1.2",
+	SetLabelsOp:     "1.1",
+}
+
+// opKinds hold the human readable name of each operation type, for display
+var opKinds = map[OperationType]string{
+	CreateOp:        "create",
+	SetTitleOp:      "set-title",
+	AddCommentOp:    "add-comment",
+	SetStatusOp:     "set-status",
+	LabelChangeOp:   "label-change",
+	EditCommentOp:   "edit-comment",
+	NoOpOp:          "noop",
+	SetMetadataOp:   "set-metadata",
+	LinkOp:          "link",
+	SetDueDateOp:    "set-due-date",
+	StripMetadataOp: "strip-metadata",
+	EditAuthorOp:    "edit-author",
+	PinOp:           "pin",
+	CrossRefOp:      "cross-ref",
+	SetLabelsOp:     "set-labels",
+}
+
+// OperationKinds return the human readable names of all the operation types,
+// as returned by Operation.Kind
+func OperationKinds() []string {
+	kinds := make([]string, 0, len(opKinds))
+	for opType := CreateOp; ; opType++ {
+		kind, ok := opKinds[opType]
+		if !ok {
+			return kinds
+		}
+		kinds = append(kinds, kind)
+	}
 }
 
 func deriveId(data []byte) entity.Id {
@@ -82,17 +165,46 @@ func idOperation(op Operation) entity.Id {
 	return base.id
 }
 
+func sizeOperation(op Operation) int {
+	data, err := json.Marshal(op)
+	if err != nil {
+		panic(err)
+	}
+	return len(data)
+}
+
 // OpBase implement the common code for all operations
 type OpBase struct {
 	OperationType OperationType      `json:"type"`
 	Author        identity.Interface `json:"author"`
 	UnixTime      int64              `json:"timestamp"`
-	Metadata      map[string]string  `json:"metadata,omitempty"`
+	// Optional, the same time with a nanosecond precision, to order the
+	// operations created within the same second
+	UnixTimeNano int64             `json:"unix_time_nano,omitempty"`
+	Metadata     map[string]string `json:"metadata,omitempty"`
+	// Optional, an opaque identifier of the device the operation has been
+	// created on
+	DeviceID string `json:"device_id,omitempty"`
+	// Optional, the version of the serialization format the operation has
+	// been written with. Missing for the operations written before it existed.
+	SchemaVersion string `json:"schema,omitempty"`
+	// Optional, random bytes making the operation unique, so that two
+	// operations with the same content still get different ids. Missing for
+	// the operations written before it existed.
+	Nonce []byte `json:"nonce,omitempty"`
 	// Not serialized. Store the op's id in memory.
 	id entity.Id
 	// Not serialized. Store the extra metadata in memory,
 	// compiled from SetMetadataOperation.
 	extraMetadata map[string]string
+	// Not serialized. Store the metadata keys hidden in memory,
+	// compiled from StripMetadataOperation.
+	strippedMetadata map[string]struct{}
+	// Not serialized. Store the replacement of the author in memory,
+	// compiled from EditAuthorOperation.
+	editedAuthor identity.Interface
+	// Not serialized. Store the email of the author in memory once resolved.
+	authorEmail *authorEmailCache
 }
 
 // newOpBase is the constructor for an OpBase
@@ -101,10 +213,24 @@ func newOpBase(opType OperationType, author identity.Interface, unixTime int64)
 		OperationType: opType,
 		Author:        author,
 		UnixTime:      unixTime,
+		SchemaVersion: opSchemas[opType],
+		Nonce:         makeNonce(nonceSize),
 		id:            entity.UnsetId,
 	}
 }
 
+// nonceSize is the number of random bytes of the nonce of a new operation
+const nonceSize = 8
+
+func makeNonce(len int) []byte {
+	result := make([]byte, len)
+	_, err := rand.Read(result)
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
 func (op *OpBase) UnmarshalJSON(data []byte) error {
 	// Compute the Id when loading the op from disk.
 	op.id = deriveId(data)
@@ -113,7 +239,11 @@ func (op *OpBase) UnmarshalJSON(data []byte) error {
 		OperationType OperationType     `json:"type"`
 		Author        json.RawMessage   `json:"author"`
 		UnixTime      int64             `json:"timestamp"`
+		UnixTimeNano  int64             `json:"unix_time_nano,omitempty"`
 		Metadata      map[string]string `json:"metadata,omitempty"`
+		DeviceID      string            `json:"device_id,omitempty"`
+		SchemaVersion string            `json:"schema,omitempty"`
+		Nonce         []byte            `json:"nonce,omitempty"`
 	}{}
 
 	if err := json.Unmarshal(data, &aux); err != nil {
@@ -129,16 +259,30 @@ func (op *OpBase) UnmarshalJSON(data []byte) error {
 	op.OperationType = aux.OperationType
 	op.Author = author
 	op.UnixTime = aux.UnixTime
+	op.UnixTimeNano = aux.UnixTimeNano
 	op.Metadata = aux.Metadata
+	op.DeviceID = aux.DeviceID
+	op.SchemaVersion = aux.SchemaVersion
+	op.Nonce = aux.Nonce
 
 	return nil
 }
 
 // Time return the time when the operation was added
 func (op *OpBase) Time() time.Time {
+	if op.UnixTimeNano != 0 {
+		return time.Unix(0, op.UnixTimeNano)
+	}
 	return time.Unix(op.UnixTime, 0)
 }
 
+// SetTime set the time of the operation with a nanosecond precision
+func (op *OpBase) SetTime(t time.Time) {
+	op.UnixTime = t.Unix()
+	op.UnixTimeNano = t.UnixNano()
+	op.id = entity.UnsetId
+}
+
 // GetUnixTime return the unix timestamp when the operation was added
 func (op *OpBase) GetUnixTime() int64 {
 	return op.UnixTime
@@ -167,6 +311,10 @@ func opBaseValidate(op Operation, opType OperationType) error {
 		return errors.Wrap(err, "author")
 	}
 
+	if len(op.base().Nonce) > 64 {
+		return fmt.Errorf("nonce is too big")
+	}
+
 	for _, hash := range op.GetFiles() {
 		if !hash.IsValid() {
 			return fmt.Errorf("file with invalid hash %v", hash)
@@ -188,6 +336,12 @@ func (op *OpBase) SetMetadata(key string, value string) {
 
 // GetMetadata retrieve arbitrary metadata about the operation
 func (op *OpBase) GetMetadata(key string) (string, bool) {
+	if _, stripped := op.strippedMetadata[key]; stripped {
+		// only the metadata added after the strip are visible
+		val, ok := op.extraMetadata[key]
+		return val, ok
+	}
+
 	val, ok := op.Metadata[key]
 
 	if ok {
@@ -210,13 +364,44 @@ func (op *OpBase) AllMetadata() map[string]string {
 
 	// Original metadata take precedence
 	for key, val := range op.Metadata {
+		if _, stripped := op.strippedMetadata[key]; stripped {
+			continue
+		}
 		result[key] = val
 	}
 
 	return result
 }
 
-// GetAuthor return author identity
+// SetDeviceID record the device the operation has been created on
+func (op *OpBase) SetDeviceID(id string) {
+	op.DeviceID = id
+	op.id = entity.UnsetId
+}
+
+// GetDeviceID return the device the operation has been created on, if known
+func (op *OpBase) GetDeviceID() string {
+	return op.DeviceID
+}
+
+// Schema return the version of the serialization format of the operation
+// known by this client
+func (op *OpBase) Schema() string {
+	return opSchemas[op.OperationType]
+}
+
+// Kind return the human readable name of the type of the operation
+func (op *OpBase) Kind() string {
+	if kind, ok := opKinds[op.OperationType]; ok {
+		return kind
+	}
+	return "unknown"
+}
+
+// GetAuthor return author identity, as replaced by an EditAuthorOperation if any
 func (op *OpBase) GetAuthor() identity.Interface {
+	if op.editedAuthor != nil {
+		return op.editedAuthor
+	}
 	return op.Author
 }
@@ -1,5 +1,18 @@
 package bug
 
+// OperationIter is an iterator over the operations of a bug, either already
+// in memory with OperationIterator or read on the go with
+// LazyOperationIterator
+type OperationIter interface {
+	Next() bool
+	Value() Operation
+	// Err return the error that stopped the iteration, if any
+	Err() error
+}
+
+var _ OperationIter = &OperationIterator{}
+var _ OperationIter = &LazyOperationIterator{}
+
 type OperationIterator struct {
 	bug       *Bug
 	packIndex int
@@ -70,3 +83,8 @@ func (it *OperationIterator) Value() Operation {
 
 	return pack.Operations[it.opIndex]
 }
+
+// Err always return nil, as the operations are already in memory
+func (it *OperationIterator) Err() error {
+	return nil
+}
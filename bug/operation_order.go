@@ -0,0 +1,115 @@
+package bug
+
+import (
+	"sort"
+	"time"
+
+	"github.com/MichaelMure/git-bug/entity"
+)
+
+// opOrder is the position of an operation in the order the operations of a
+// bug are applied: by time and author, so that operations created within the
+// same second get a deterministic order.
+type opOrder struct {
+	create bool
+	key    int64
+	author entity.Id
+}
+
+// opOrdering compute the position of the operations of a bug, given in
+// storage order.
+//
+// An operation without a sub-second time is never moved ahead of the ones
+// stored before it, as it would otherwise be moved before the operations
+// recorded with a precise time in the same second. This also keep the storage
+// order of the bugs written before the sub-second time existed.
+type opOrdering struct {
+	// the latest position so far
+	latest *opOrder
+}
+
+// next return the position of the next operation in storage order
+func (o *opOrdering) next(op Operation) opOrder {
+	base := op.base()
+
+	order := opOrder{
+		key: base.UnixTimeNano,
+	}
+
+	if _, ok := op.(*CreateOperation); ok {
+		order.create = true
+	}
+
+	if base.Author != nil {
+		order.author = base.Author.Id()
+	}
+
+	if base.UnixTimeNano == 0 {
+		order.key = base.UnixTime * int64(time.Second)
+		if o.latest != nil && order.key <= o.latest.key {
+			order.key = o.latest.key + 1
+		}
+	}
+
+	if o.latest == nil || o.latest.less(order) {
+		o.latest = &order
+	}
+
+	return order
+}
+
+// less tell if the operation at o is applied before the one at other. The
+// create operation always come first.
+func (o opOrder) less(other opOrder) bool {
+	if o.create != other.create {
+		return o.create
+	}
+	if o.key != other.key {
+		return o.key < other.key
+	}
+	return o.author < other.author
+}
+
+// sortOperations return the operations, given in storage order, in the order
+// they are applied
+func sortOperations(ops []Operation) []Operation {
+	orders := make(map[Operation]opOrder, len(ops))
+	var ordering opOrdering
+	sorted := true
+
+	for _, op := range ops {
+		latest := ordering.latest
+		order := ordering.next(op)
+		if latest != nil && order.less(*latest) {
+			sorted = false
+		}
+		orders[op] = order
+	}
+
+	if sorted {
+		return ops
+	}
+
+	result := make([]Operation, len(ops))
+	copy(result, ops)
+	sort.SliceStable(result, func(i, j int) bool {
+		return orders[result[i]].less(orders[result[j]])
+	})
+
+	return result
+}
+
+// isAppliedLast tell if the last of the operations, given in storage order, is
+// also the last one applied
+func isAppliedLast(ops []Operation) bool {
+	var ordering opOrdering
+	var order opOrder
+	var latest *opOrder
+
+	for _, op := range ops {
+		latest = ordering.latest
+		order = ordering.next(op)
+	}
+
+	return latest == nil || !order.less(*latest)
+}
@@ -0,0 +1,74 @@
+package bug
+
+import (
+	"testing"
+	"time"
+
+	"github.com/MichaelMure/git-bug/identity"
+	"github.com/MichaelMure/git-bug/repository"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOperationOrder(t *testing.T) {
+	repo := repository.NewMockRepoForTest()
+
+	rene := identity.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, rene.Commit(repo))
+
+	base := time.Unix(1580000000, 0)
+
+	b := &WithSnapshot{Bug: NewBug()}
+	b.Append(NewCreateOp(rene, base.Unix(), "title", "message", nil))
+	b.Snapshot()
+
+	second := NewAddCommentOp(rene, base.Unix(), "second", nil)
+	second.SetTime(base.Add(200 * time.Millisecond))
+	b.Append(second)
+
+	// recorded after, but created before
+	first := NewAddCommentOp(rene, base.Unix(), "first", nil)
+	first.SetTime(base.Add(100 * time.Millisecond))
+	b.Append(first)
+
+	// without a sub-second time, in the same second: never moved ahead of
+	// the operations stored before it
+	label := NewLabelChangeOperation(rene, base.Unix(), []Label{"bug"}, nil)
+	b.Append(label)
+
+	// neither is an older one without a sub-second time
+	b.Append(NewAddCommentOp(rene, base.Unix()-10, "third", nil))
+
+	messages := func(snap *Snapshot) []string {
+		var result []string
+		for _, comment := range snap.Comments {
+			result = append(result, comment.Message)
+		}
+		return result
+	}
+	expected := []string{"message", "first", "second", "third"}
+
+	snap := b.Snapshot()
+	require.Equal(t, expected, messages(snap))
+	require.Equal(t, label.Id(), snap.Operations[3].Id())
+
+	// the same state before and after a commit and a reload
+	require.NoError(t, b.Commit(repo))
+	require.Equal(t, expected, messages(b.Snapshot()))
+
+	read, err := ReadLocalBug(repo, b.Id())
+	require.NoError(t, err)
+	compiled := read.Compile()
+	require.Equal(t, expected, messages(&compiled))
+	require.Equal(t, label.Id(), compiled.Operations[3].Id())
+	require.Equal(t, second.Id(), compiled.Comments[2].Id())
+
+	lazy, err := ReadLocalLazyBug(repo, b.Id())
+	require.NoError(t, err)
+	lazySnap, err := lazy.Snapshot()
+	require.NoError(t, err)
+	require.Equal(t, expected, messages(lazySnap))
+
+	// the history is cut in the applied order
+	until := read.CompileUntil(2)
+	require.Equal(t, []string{"message", "first"}, messages(&until))
+}
@@ -87,6 +87,34 @@ func (opp *OperationPack) unmarshalOp(raw []byte, _type OperationType) (Operatio
 		op := &LabelChangeOperation{}
 		err := json.Unmarshal(raw, &op)
 		return op, err
+	case LinkOp:
+		op := &LinkOperation{}
+		err := json.Unmarshal(raw, &op)
+		return op, err
+	case SetDueDateOp:
+		op := &SetDueDateOperation{}
+		err := json.Unmarshal(raw, &op)
+		return op, err
+	case StripMetadataOp:
+		op := &StripMetadataOperation{}
+		err := json.Unmarshal(raw, &op)
+		return op, err
+	case EditAuthorOp:
+		op := &EditAuthorOperation{}
+		err := json.Unmarshal(raw, &op)
+		return op, err
+	case PinOp:
+		op := &PinOperation{}
+		err := json.Unmarshal(raw, &op)
+		return op, err
+	case CrossRefOp:
+		op := &CrossRefOperation{}
+		err := json.Unmarshal(raw, &op)
+		return op, err
+	case SetLabelsOp:
+		op := &SetLabelsOperation{}
+		err := json.Unmarshal(raw, &op)
+		return op, err
 	case NoOpOp:
 		op := &NoOpOperation{}
 		err := json.Unmarshal(raw, &op)
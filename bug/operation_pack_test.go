@@ -61,3 +61,35 @@ func ensureIDs(t *testing.T, opp *OperationPack) {
 		require.NoError(t, id.Validate())
 	}
 }
+
+func TestOperationPackNanoTime(t *testing.T) {
+	rene := identity.NewBare("René Descartes", "rene@descartes.fr")
+	base := time.Unix(1580000000, 0)
+
+	second := NewAddCommentOp(rene, base.Unix(), "second", nil)
+	second.SetTime(base.Add(200 * time.Millisecond))
+	first := NewAddCommentOp(rene, base.Unix(), "first", nil)
+	first.SetTime(base.Add(100 * time.Millisecond))
+
+	// the storage order is kept, the operations are ordered when compiling
+	opp := &OperationPack{}
+	opp.Append(second)
+	opp.Append(first)
+
+	data, err := json.Marshal(opp)
+	require.NoError(t, err)
+
+	var read OperationPack
+	require.NoError(t, json.Unmarshal(data, &read))
+	require.Equal(t, "second", read.Operations[0].(*AddCommentOperation).Message)
+	require.Equal(t, base.Add(200*time.Millisecond), read.Operations[0].Time())
+	require.Equal(t, second.Id(), read.Operations[0].Id())
+
+	// without sub-second time, the field is omitted
+	opp = &OperationPack{}
+	opp.Append(NewAddCommentOp(rene, base.Unix(), "comment", nil))
+
+	data, err = json.Marshal(opp)
+	require.NoError(t, err)
+	require.NotContains(t, string(data), "unix_time_nano")
+}
@@ -1,6 +1,8 @@
 package bug
 
 import (
+	"encoding/json"
+	"strings"
 	"testing"
 	"time"
 
@@ -118,3 +120,61 @@ func TestID(t *testing.T) {
 		require.Equal(t, id1, id3)
 	}
 }
+
+func TestNonce(t *testing.T) {
+	rene := identity.NewBare("René Descartes", "rene@descartes.fr")
+	unix := time.Now().Unix()
+
+	// identical operations get a different id
+	op1 := NewAddCommentOp(rene, unix, "message", nil)
+	op2 := NewAddCommentOp(rene, unix, "message", nil)
+	require.Len(t, op1.Nonce, nonceSize)
+	require.NotEqual(t, op1.Nonce, op2.Nonce)
+	require.NotEqual(t, op1.Id(), op2.Id())
+
+	// the nonce survive the serialization
+	data, err := json.Marshal(op1)
+	require.NoError(t, err)
+	var decoded AddCommentOperation
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	require.Equal(t, op1.Nonce, decoded.Nonce)
+	require.Equal(t, op1.Id(), decoded.Id())
+
+	// an operation written without nonce keep an id derived from its content
+	op1.Nonce = nil
+	data, err = json.Marshal(op1)
+	require.NoError(t, err)
+	require.NotContains(t, string(data), "nonce")
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	require.Nil(t, decoded.Nonce)
+	require.Equal(t, deriveId(data), decoded.Id())
+
+	op1.Nonce = make([]byte, 65)
+	require.Error(t, op1.Validate())
+}
+
+func TestSize(t *testing.T) {
+	rene := identity.NewIdentity("René Descartes", "rene@descartes.fr")
+	unix := time.Now().Unix()
+
+	small := NewAddCommentOp(rene, unix, "message", nil)
+	large := NewAddCommentOp(rene, unix, strings.Repeat("a", 10000), nil)
+
+	data, err := json.Marshal(small)
+	require.NoError(t, err)
+	require.Equal(t, len(data), small.Size())
+
+	require.True(t, large.Size() > 10000)
+}
+
+func TestKind(t *testing.T) {
+	rene := identity.NewBare("René Descartes", "rene@descartes.fr")
+	unix := time.Now().Unix()
+
+	require.Equal(t, "create", NewCreateOp(rene, unix, "title", "message", nil).Kind())
+	require.Equal(t, "add-comment", NewAddCommentOp(rene, unix, "message", nil).Kind())
+	require.Equal(t, "set-status", NewSetStatusOp(rene, unix, ClosedStatus).Kind())
+
+	// every known operation type has a kind
+	require.Len(t, OperationKinds(), len(opSchemas))
+}
@@ -0,0 +1,32 @@
+package bug
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/MichaelMure/git-bug/util/text"
+)
+
+var commitLikeRegexp = regexp.MustCompile(`^[0-9a-fA-F]{7,40}$`)
+
+// IsCommitLike tell if a reference looks like a (possibly abbreviated) git
+// commit hash rather than an URL
+func IsCommitLike(reference string) bool {
+	return commitLikeRegexp.MatchString(reference)
+}
+
+func validateReferences(references []string) error {
+	for _, ref := range references {
+		if text.Empty(ref) {
+			return fmt.Errorf("reference is empty")
+		}
+		if strings.ContainsAny(ref, " \t\n") {
+			return fmt.Errorf("reference %q should not contain spaces", ref)
+		}
+		if !text.Safe(ref) {
+			return fmt.Errorf("reference is not fully printable")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package bug
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// SchemaWarningOutput is where the warnings about operations written with a
+// newer format are reported. It's a variable to be replaced in the tests.
+var SchemaWarningOutput io.Writer = os.Stderr
+
+// schemaWarned hold the operation types already warned about, to report
+// them only once
+var schemaWarned sync.Map
+
+// newerSchema return true if the version of a serialization format is newer
+// than the known one. Unparsable versions are considered not newer.
+func newerSchema(version, known string) bool {
+	major, minor, ok := parseSchema(version)
+	if !ok {
+		return false
+	}
+	knownMajor, knownMinor, ok := parseSchema(known)
+	if !ok {
+		return false
+	}
+
+	if major != knownMajor {
+		return major > knownMajor
+	}
+	return minor > knownMinor
+}
+
+func parseSchema(version string) (int, int, bool) {
+	parts := strings.SplitN(version, ".", 2)
+	if len(parts) != 2 {
+		return 0, 0, false
+	}
+	major, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, 0, false
+	}
+	minor, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return 0, 0, false
+	}
+	return major, minor, true
+}
+
+// warnNewerSchema report an operation written with a newer version of its
+// format than the known one. It's still applied, the unknown fields being
+// ignored, so the result might be incomplete.
+func warnNewerSchema(op Operation) {
+	base := op.base()
+	if !newerSchema(base.SchemaVersion, op.Schema()) {
+		return
+	}
+
+	if _, warned := schemaWarned.LoadOrStore(base.OperationType, struct{}{}); warned {
+		return
+	}
+
+	_, _ = fmt.Fprintf(SchemaWarningOutput,
+		"warning: some operations have been written by a newer version of git-bug (format %s, known %s), upgrading is advised\n",
+		base.SchemaVersion, op.Schema())
+}
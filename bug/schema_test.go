@@ -0,0 +1,51 @@
+package bug
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/identity"
+)
+
+func TestNewerSchema(t *testing.T) {
+	require.False(t, newerSchema("1.1", "1.1"))
+	require.False(t, newerSchema("1.0", "1.1"))
+	require.True(t, newerSchema("1.2", "1.1"))
+	require.True(t, newerSchema("1.10", "1.9"))
+	require.True(t, newerSchema("2.0", "1.9"))
+	require.False(t, newerSchema("", "1.1"))
+	require.False(t, newerSchema("invalid", "1.1"))
+}
+
+func TestOperationSchema(t *testing.T) {
+	rene := identity.NewBare("René Descartes", "rene@descartes.fr")
+	unix := time.Now().Unix()
+
+	op := NewAddCommentOp(rene, unix, "message", nil)
+	require.Equal(t, opSchemas[AddCommentOp], op.Schema())
+
+	data, err := json.Marshal(op)
+	require.NoError(t, err)
+
+	var read AddCommentOperation
+	err = json.Unmarshal(data, &read)
+	require.NoError(t, err)
+	require.Equal(t, op.Schema(), read.SchemaVersion)
+
+	// an operation written by a newer client is still applied, with a warning
+	var output bytes.Buffer
+	SchemaWarningOutput = &output
+	defer func() { SchemaWarningOutput = os.Stderr }()
+
+	read.SchemaVersion = "1.99"
+	snap := Snapshot{}
+	warnNewerSchema(&read)
+	read.Apply(&snap)
+	require.Contains(t, output.String(), "format 1.99")
+	require.Len(t, snap.Comments, 1)
+}
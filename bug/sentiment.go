@@ -0,0 +1,155 @@
+package bug
+
+import (
+	"strings"
+	"unicode"
+)
+
+// sentimentValences hold the valence of the words carrying a sentiment, from
+// -1 (very negative) to 1 (very positive). The list is short on purpose, and
+// tuned for the vocabulary of bug reports.
+var sentimentValences = map[string]float64{
+	// very negative
+	"awful":        -1,
+	"catastrophic": -1,
+	"disaster":     -1,
+	"disastrous":   -1,
+	"furious":      -1,
+	"garbage":      -1,
+	"hate":         -1,
+	"horrible":     -1,
+	"infuriating":  -1,
+	"terrible":     -1,
+	"unacceptable": -1,
+	"unusable":     -1,
+	"useless":      -1,
+	"worst":        -1,
+
+	// negative
+	"angry":         -0.6,
+	"annoyed":       -0.6,
+	"annoying":      -0.6,
+	"bad":           -0.6,
+	"blocker":       -0.6,
+	"broke":         -0.6,
+	"broken":        -0.6,
+	"corrupt":       -0.6,
+	"corrupted":     -0.6,
+	"crash":         -0.6,
+	"crashed":       -0.6,
+	"crashes":       -0.6,
+	"critical":      -0.6,
+	"data-loss":     -0.6,
+	"disappointed":  -0.6,
+	"disappointing": -0.6,
+	"frustrated":    -0.6,
+	"frustrating":   -0.6,
+	"lost":          -0.6,
+	"painful":       -0.6,
+	"ridiculous":    -0.6,
+	"severe":        -0.6,
+	"stupid":        -0.6,
+	"upset":         -0.6,
+	"urgent":        -0.6,
+	"worse":         -0.6,
+
+	// slightly negative
+	"bug":           -0.2,
+	"buggy":         -0.4,
+	"confusing":     -0.4,
+	"error":         -0.2,
+	"errors":        -0.2,
+	"fail":          -0.4,
+	"failed":        -0.4,
+	"failing":       -0.4,
+	"fails":         -0.4,
+	"failure":       -0.4,
+	"freeze":        -0.4,
+	"freezes":       -0.4,
+	"hang":          -0.4,
+	"hangs":         -0.4,
+	"issue":         -0.2,
+	"problem":       -0.2,
+	"regression":    -0.4,
+	"slow":          -0.4,
+	"stuck":         -0.4,
+	"unexpected":    -0.2,
+	"unfortunately": -0.2,
+	"weird":         -0.2,
+	"wrong":         -0.4,
+
+	// slightly positive
+	"fine":     0.2,
+	"fixed":    0.4,
+	"good":     0.4,
+	"helpful":  0.4,
+	"nice":     0.4,
+	"please":   0.2,
+	"resolved": 0.4,
+	"solved":   0.4,
+	"thank":    0.4,
+	"thanks":   0.4,
+	"useful":   0.4,
+	"welcome":  0.2,
+	"works":    0.2,
+
+	// positive
+	"appreciate":  0.6,
+	"appreciated": 0.6,
+	"glad":        0.6,
+	"great":       0.6,
+	"happy":       0.6,
+	"love":        0.6,
+	"nicely":      0.6,
+	"perfect":     0.6,
+	"smooth":      0.6,
+
+	// very positive
+	"amazing":   1,
+	"awesome":   1,
+	"brilliant": 1,
+	"excellent": 1,
+	"fantastic": 1,
+	"wonderful": 1,
+}
+
+// sentimentWords split a text in lower case words. The apostrophes and the
+// hyphens inside a word are kept, so that "data-loss" or "don't" are single
+// words.
+func sentimentWords(text string) []string {
+	fields := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '\'' && r != '-'
+	})
+
+	words := make([]string, 0, len(fields))
+	for _, field := range fields {
+		field = strings.Trim(field, "'-")
+		if field != "" {
+			words = append(words, field)
+		}
+	}
+	return words
+}
+
+// SentimentScore return a naive estimation of the sentiment expressed in
+// the title and the comments of the bug, from -1 (very negative) to 1 (very
+// positive). It's the sum of the valences of the words found in a fixed word
+// list, divided by the number of words, so that long discussions are not
+// scored higher than short ones.
+func (snap *Snapshot) SentimentScore() float64 {
+	words := sentimentWords(snap.Title)
+	for _, comment := range snap.Comments {
+		words = append(words, sentimentWords(comment.Message)...)
+	}
+
+	if len(words) == 0 {
+		return 0
+	}
+
+	var sum float64
+	for _, word := range words {
+		sum += sentimentValences[word]
+	}
+
+	return sum / float64(len(words))
+}
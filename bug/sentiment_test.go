@@ -0,0 +1,38 @@
+package bug
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSentimentWords(t *testing.T) {
+	require.Equal(t, []string{"it", "crashes", "don't", "know", "why", "data-loss", "v2"},
+		sentimentWords("It CRASHES!! Don't know why... -- data-loss (v2)"))
+	require.Empty(t, sentimentWords("  ... !! "))
+}
+
+func TestSentimentScore(t *testing.T) {
+	snap := &Snapshot{Title: ""}
+	require.Equal(t, 0.0, snap.SentimentScore())
+
+	negative := &Snapshot{
+		Title:    "Terrible crash",
+		Comments: []Comment{{Message: "the app is unusable"}},
+	}
+	// terrible (-1) + crash (-0.6) + unusable (-1), over 6 words
+	require.InDelta(t, -2.6/6, negative.SentimentScore(), 1e-9)
+
+	positive := &Snapshot{
+		Title:    "Awesome feature",
+		Comments: []Comment{{Message: "Thanks, it works great"}},
+	}
+	require.True(t, positive.SentimentScore() > 0)
+
+	neutral := &Snapshot{Title: "Add a button to the toolbar"}
+	require.Equal(t, 0.0, neutral.SentimentScore())
+
+	// always within the bounds
+	extreme := &Snapshot{Title: "awful horrible terrible"}
+	require.Equal(t, -1.0, extreme.SentimentScore())
+}
@@ -20,6 +20,17 @@ type Snapshot struct {
 	Actors       []identity.Interface
 	Participants []identity.Interface
 	CreatedAt    time.Time
+	Links        []Link
+	DueDate      *time.Time
+	Pinned       bool
+	// URLs or commit hashes referenced by the bug and its comments
+	References []string
+	// the commits referencing the bug
+	CrossRefs []CrossRef
+
+	// git tags pointing to a commit of the bug. They are not part of the
+	// operations, so they are filled by the cache and not by Compile.
+	Tags []string
 
 	Timeline []TimelineItem
 
@@ -54,6 +65,34 @@ func (snap *Snapshot) GetCreateMetadata(key string) (string, bool) {
 	return snap.Operations[0].GetMetadata(key)
 }
 
+// LastMetadata return the value of the given metadata key in the most recent
+// operation carrying it. This allow to store a value changing over time, one
+// operation (usually a NoOp) at a time.
+func (snap *Snapshot) LastMetadata(key string) (string, bool) {
+	for i := len(snap.Operations) - 1; i >= 0; i-- {
+		if value, ok := snap.Operations[i].GetMetadata(key); ok {
+			return value, true
+		}
+	}
+	return "", false
+}
+
+// addReferences append the references not already known, in order
+func (snap *Snapshot) addReferences(references []string) {
+	for _, ref := range references {
+		found := false
+		for _, known := range snap.References {
+			if known == ref {
+				found = true
+				break
+			}
+		}
+		if !found {
+			snap.References = append(snap.References, ref)
+		}
+	}
+}
+
 // SearchTimelineItem will search in the timeline for an item matching the given hash
 func (snap *Snapshot) SearchTimelineItem(id entity.Id) (TimelineItem, error) {
 	for i := range snap.Timeline {
@@ -138,5 +177,56 @@ func (snap *Snapshot) HasAnyActor(ids ...entity.Id) bool {
 	return false
 }
 
+// LinkedTo return the ids of the bugs linked with the given direction
+func (snap *Snapshot) LinkedTo(direction LinkDirection) []entity.Id {
+	var result []entity.Id
+	for _, l := range snap.Links {
+		if l.Direction == direction {
+			result = append(result, l.Target)
+		}
+	}
+	return result
+}
+
+// Source return where the bug has been imported from, or nil if it has been
+// created locally
+func (snap *Snapshot) Source() *BugSource {
+	if len(snap.Operations) == 0 {
+		return nil
+	}
+
+	create, ok := snap.Operations[0].(*CreateOperation)
+	if !ok {
+		return nil
+	}
+
+	return create.OriginalSource
+}
+
+// HasLink return true if the bug is linked to the target with the given direction
+func (snap *Snapshot) HasLink(direction LinkDirection, target entity.Id) bool {
+	for _, l := range snap.Links {
+		if l.Direction == direction && l.Target == target {
+			return true
+		}
+	}
+	return false
+}
+
+// IsOverdue return true if the bug is still open after its due date
+func (snap *Snapshot) IsOverdue() bool {
+	return snap.DueDate != nil && snap.Status == OpenStatus && time.Now().After(*snap.DueDate)
+}
+
+// WasEditedBy return true if the given author has made any operation on the bug
+func (snap *Snapshot) WasEditedBy(author entity.Id) bool {
+	for _, op := range snap.Operations {
+		if op.GetAuthor().Id() == author {
+			return true
+		}
+	}
+	return false
+}
+
 // Sign post method for gqlgen
 func (snap *Snapshot) IsAuthored() {}
@@ -0,0 +1,85 @@
+package bug
+
+import (
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/repository"
+	"github.com/MichaelMure/git-bug/util/git"
+)
+
+// StorageSize return the total size in bytes of the git objects (commits,
+// trees and blobs) reachable from the ref of a local bug. Objects shared
+// between the commits of the bug, like the root pack, are counted once.
+func StorageSize(repo repository.Repo, id entity.Id) (int64, error) {
+	commits, err := repo.ListCommits(bugsRefPattern + id.String())
+	if err != nil {
+		return 0, err
+	}
+
+	seen := make(map[git.Hash]struct{})
+	var total int64
+
+	for _, commit := range commits {
+		size, err := repo.ObjectSize(commit)
+		if err != nil {
+			return 0, err
+		}
+		total += size
+
+		tree, err := repo.GetTreeHash(commit)
+		if err != nil {
+			return 0, err
+		}
+
+		size, err = treeSize(repo, tree, seen)
+		if err != nil {
+			return 0, err
+		}
+		total += size
+	}
+
+	return total, nil
+}
+
+// treeSize return the size of a tree and of the objects it reference, skipping
+// the already seen ones
+func treeSize(repo repository.Repo, tree git.Hash, seen map[git.Hash]struct{}) (int64, error) {
+	if _, ok := seen[tree]; ok {
+		return 0, nil
+	}
+	seen[tree] = struct{}{}
+
+	total, err := repo.ObjectSize(tree)
+	if err != nil {
+		return 0, err
+	}
+
+	entries, err := repo.ListEntries(tree)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, entry := range entries {
+		switch entry.ObjectType {
+		case repository.Tree:
+			size, err := treeSize(repo, entry.Hash, seen)
+			if err != nil {
+				return 0, err
+			}
+			total += size
+
+		default:
+			if _, ok := seen[entry.Hash]; ok {
+				continue
+			}
+			seen[entry.Hash] = struct{}{}
+
+			size, err := repo.ObjectSize(entry.Hash)
+			if err != nil {
+				return 0, err
+			}
+			total += size
+		}
+	}
+
+	return total, nil
+}
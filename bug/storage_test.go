@@ -0,0 +1,34 @@
+package bug
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/identity"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestStorageSize(t *testing.T) {
+	repo := repository.NewMockRepoForTest()
+
+	rene := identity.NewIdentity("René Descartes", "rene@descartes.fr")
+	err := rene.Commit(repo)
+	require.NoError(t, err)
+
+	b := NewBug()
+	b.Append(NewCreateOp(rene, time.Now().Unix(), "title", "message", nil))
+	require.NoError(t, b.Commit(repo))
+
+	size1, err := StorageSize(repo, b.Id())
+	require.NoError(t, err)
+	require.True(t, size1 > 0)
+
+	b.Append(NewAddCommentOp(rene, time.Now().Unix(), "a rather long comment", nil))
+	require.NoError(t, b.Commit(repo))
+
+	size2, err := StorageSize(repo, b.Id())
+	require.NoError(t, err)
+	require.True(t, size2 > size1)
+}
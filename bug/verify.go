@@ -0,0 +1,46 @@
+package bug
+
+import (
+	"fmt"
+
+	"github.com/MichaelMure/git-bug/identity"
+	"github.com/MichaelMure/git-bug/repository"
+	"github.com/MichaelMure/git-bug/util/git"
+)
+
+// VerifyAuthors check that each operation has been committed with a
+// signature made by one of the keys of its author. The operations are not
+// signed individually, it's the git commit of the OperationPack holding them
+// that is. Authors without any key can't be verified and are accepted.
+// The staging area is not verified.
+func (bug *Bug) VerifyAuthors(repo repository.Repo) error {
+	for _, pack := range bug.packs {
+		for _, op := range pack.Operations {
+			if err := verifyOperation(repo, pack.commitHash, op); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// verifyOperation check that the commit storing an operation is signed by
+// one of the keys of the operation author
+func verifyOperation(repo repository.Repo, commit git.Hash, op Operation) error {
+	// the commit is signed by the original author, even if replaced later
+	author := op.base().Author
+	if len(author.Keys()) == 0 {
+		return nil
+	}
+
+	err := identity.VerifyCommit(repo, author, commit)
+	if err == repository.ErrNoSignature {
+		return fmt.Errorf("operation %s by %s is not signed", op.Id().Human(), author.DisplayName())
+	}
+	if err != nil {
+		return fmt.Errorf("operation %s by %s: %v", op.Id().Human(), author.DisplayName(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,31 @@
+package bug
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/identity"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestVerifyAuthors(t *testing.T) {
+	mockRepo := repository.NewMockRepoForTest()
+
+	// without keys, the authorship can't be verified
+	rene := identity.NewIdentity("René Descartes", "rene@descartes.fr")
+	b, _, err := Create(rene, time.Now().Unix(), "title", "message")
+	require.NoError(t, err)
+	require.NoError(t, b.Commit(mockRepo))
+	require.NoError(t, b.VerifyAuthors(mockRepo))
+
+	// the mock repository doesn't sign the commits
+	isaac := identity.NewIdentity("Isaac Newton", "isaac@newton.uk", identity.Key{
+		PubKey: "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIOMqqnkVzrm0SdG6UOoqKLsabgH5C9okWi0dh2l9GKJl",
+	})
+	_, err = AddComment(b, isaac, time.Now().Unix(), "comment")
+	require.NoError(t, err)
+	require.NoError(t, b.Commit(mockRepo))
+	require.Error(t, b.VerifyAuthors(mockRepo))
+}
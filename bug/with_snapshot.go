@@ -27,6 +27,20 @@ func (b *WithSnapshot) Append(op Operation) {
 		return
 	}
 
+	// the author of previous operations is changed, the snapshot need to be
+	// compiled again
+	if _, ok := op.(*EditAuthorOperation); ok {
+		b.snap = nil
+		return
+	}
+
+	// the operation is applied before some previous ones, the snapshot need to
+	// be compiled again
+	if !isAppliedLast(b.Bug.allOperations()) {
+		b.snap = nil
+		return
+	}
+
 	op.Apply(b.snap)
 	b.snap.Operations = append(b.snap.Operations, op)
 }
@@ -0,0 +1,37 @@
+package bug
+
+import (
+	"regexp"
+	"strings"
+)
+
+// markdownLinkRegexp match a Markdown link or image, like "[text](url)" or
+// "![alt](url)". The first group is the text of the link.
+var markdownLinkRegexp = regexp.MustCompile(`!?\[([^\]]*)\]\([^)]*\)`)
+
+// listMarkerRegexp match an ordered list marker, like "1." or "2)"
+var listMarkerRegexp = regexp.MustCompile(`^\d+[.)]$`)
+
+// markdownSyntax is the set of characters only used as Markdown syntax when
+// at the edges of a word
+const markdownSyntax = "#*_~`>|-+[]()!"
+
+// wordCount count the words of a Markdown text, ignoring the syntax like the
+// headers, the emphasis or the list markers, and the URL of the links. The
+// content of the code blocks is counted as words.
+func wordCount(message string) int {
+	message = markdownLinkRegexp.ReplaceAllString(message, "$1")
+
+	count := 0
+	for _, field := range strings.Fields(message) {
+		if listMarkerRegexp.MatchString(field) {
+			continue
+		}
+		if strings.Trim(field, markdownSyntax) == "" {
+			continue
+		}
+		count++
+	}
+
+	return count
+}
@@ -0,0 +1,309 @@
+package cache
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/identity"
+	"github.com/MichaelMure/git-bug/util/git"
+)
+
+const backupFormatVersion = 1
+
+// metaKeyBackupOrigin is the metadata key used to record the id an entity had
+// in the backup it was restored from, to make Restore idempotent.
+const metaKeyBackupOrigin = "backup-origin-id"
+
+const (
+	backupManifestName  = "manifest.json"
+	backupIdentitiesDir = "identities"
+	backupFilesDir      = "files"
+	backupBugsDir       = "bugs"
+)
+
+// backupManifest describe the content of a backup archive
+type backupManifest struct {
+	Version    uint        `json:"version"`
+	CreatedAt  time.Time   `json:"created_at"`
+	Bugs       []entity.Id `json:"bugs"`
+	Identities []entity.Id `json:"identities"`
+	Files      []git.Hash  `json:"files"`
+}
+
+// Backup write a portable snapshot of all the bugs and identities of the
+// repository as a tar.gz archive. The archive contains a JSON manifest, one
+// JSON lines file per identity and per bug and the media files referenced by
+// the bugs.
+func (c *RepoCache) Backup(w io.Writer) error {
+	manifest := backupManifest{
+		Version:   backupFormatVersion,
+		CreatedAt: time.Now(),
+	}
+
+	identities := make(map[entity.Id][]byte)
+	for _, id := range c.AllIdentityIds() {
+		i, err := c.ResolveIdentity(id)
+		if err != nil {
+			return err
+		}
+
+		var buf bytes.Buffer
+		if err := identity.ExportJSON(i.Identity, &buf); err != nil {
+			return errors.Wrapf(err, "identity %s", id)
+		}
+
+		identities[id] = buf.Bytes()
+		manifest.Identities = append(manifest.Identities, id)
+	}
+
+	bugs := make(map[entity.Id][]byte)
+	files := make(map[git.Hash]struct{})
+	for _, id := range c.AllBugsIds() {
+		b, err := c.ResolveBug(id)
+		if err != nil {
+			return err
+		}
+
+		var buf bytes.Buffer
+		if err := bug.ExportJSON(b.bug.Bug, &buf); err != nil {
+			return errors.Wrapf(err, "bug %s", id)
+		}
+
+		for _, op := range b.Snapshot().Operations {
+			for _, hash := range op.GetFiles() {
+				files[hash] = struct{}{}
+			}
+		}
+
+		bugs[id] = buf.Bytes()
+		manifest.Bugs = append(manifest.Bugs, id)
+	}
+
+	for hash := range files {
+		manifest.Files = append(manifest.Files, hash)
+	}
+
+	gw := gzip.NewWriter(w)
+	tw := tar.NewWriter(gw)
+
+	writeEntry := func(name string, data []byte) error {
+		err := tw.WriteHeader(&tar.Header{
+			Name:    name,
+			Mode:    0644,
+			Size:    int64(len(data)),
+			ModTime: manifest.CreatedAt,
+		})
+		if err != nil {
+			return err
+		}
+		_, err = tw.Write(data)
+		return err
+	}
+
+	data, err := json.Marshal(manifest)
+	if err != nil {
+		return err
+	}
+	if err := writeEntry(backupManifestName, data); err != nil {
+		return err
+	}
+
+	// identities first, as the bugs reference them
+	for _, id := range manifest.Identities {
+		if err := writeEntry(path.Join(backupIdentitiesDir, id.String()+".jsonl"), identities[id]); err != nil {
+			return err
+		}
+	}
+
+	for _, hash := range manifest.Files {
+		data, err := c.repo.ReadData(hash)
+		if err != nil {
+			return errors.Wrapf(err, "file %s", hash)
+		}
+		if err := writeEntry(path.Join(backupFilesDir, hash.String()), data); err != nil {
+			return err
+		}
+	}
+
+	for _, id := range manifest.Bugs {
+		if err := writeEntry(path.Join(backupBugsDir, id.String()+".jsonl"), bugs[id]); err != nil {
+			return err
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return err
+	}
+
+	return gw.Close()
+}
+
+// Restore read an archive written by Backup and add its bugs and identities
+// to the repository. As the ids of bugs and identities are derived from git
+// commits, the restored entities get new ids; the original one is recorded
+// in their metadata. Entities that already exist in the repository, either
+// with their original id or from a previous Restore, are skipped.
+func (c *RepoCache) Restore(r io.Reader) error {
+	gr, err := gzip.NewReader(r)
+	if err != nil {
+		return errors.Wrap(err, "invalid backup")
+	}
+	defer gr.Close()
+
+	tr := tar.NewReader(gr)
+
+	resolver := &backupResolver{
+		cache:      c,
+		identities: make(map[entity.Id]*IdentityCache),
+	}
+
+	var manifestRead bool
+
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return errors.Wrap(err, "invalid backup")
+		}
+
+		dir, name := path.Split(header.Name)
+		dir = strings.TrimSuffix(dir, "/")
+
+		if !manifestRead {
+			if header.Name != backupManifestName {
+				return fmt.Errorf("invalid backup: expected %s, got %s", backupManifestName, header.Name)
+			}
+
+			var manifest backupManifest
+			if err := json.NewDecoder(tr).Decode(&manifest); err != nil {
+				return errors.Wrap(err, "invalid backup manifest")
+			}
+			if manifest.Version != backupFormatVersion {
+				return fmt.Errorf("unsupported backup format version %d", manifest.Version)
+			}
+
+			manifestRead = true
+			continue
+		}
+
+		switch dir {
+		case backupIdentitiesDir:
+			err = c.restoreIdentity(entity.Id(strings.TrimSuffix(name, ".jsonl")), tr, resolver)
+		case backupFilesDir:
+			err = c.restoreFile(git.Hash(name), tr)
+		case backupBugsDir:
+			err = c.restoreBug(entity.Id(strings.TrimSuffix(name, ".jsonl")), tr, resolver)
+		default:
+			err = fmt.Errorf("unexpected entry %s", header.Name)
+		}
+		if err != nil {
+			return errors.Wrap(err, header.Name)
+		}
+	}
+
+	if !manifestRead {
+		return errors.New("invalid backup: empty archive")
+	}
+
+	return c.write()
+}
+
+func (c *RepoCache) restoreIdentity(id entity.Id, r io.Reader, resolver *backupResolver) error {
+	if existing, err := c.ResolveIdentity(id); err == nil {
+		resolver.identities[id] = existing
+		return nil
+	}
+	if existing, err := c.ResolveIdentityImmutableMetadata(metaKeyBackupOrigin, id.String()); err == nil {
+		resolver.identities[id] = existing
+		return nil
+	}
+
+	i, err := identity.ImportJSON(c.repo, r, map[string]string{
+		metaKeyBackupOrigin: id.String(),
+	})
+	if err != nil {
+		return err
+	}
+
+	cached := NewIdentityCache(c, i)
+	c.identities[i.Id()] = cached
+	c.identitiesExcerpts[i.Id()] = NewIdentityExcerpt(i)
+	resolver.identities[id] = cached
+
+	return nil
+}
+
+func (c *RepoCache) restoreFile(hash git.Hash, r io.Reader) error {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return err
+	}
+
+	stored, err := c.repo.StoreData(data)
+	if err != nil {
+		return err
+	}
+	if stored != hash {
+		return fmt.Errorf("file hash mismatch: expected %s, got %s", hash, stored)
+	}
+
+	return nil
+}
+
+func (c *RepoCache) restoreBug(id entity.Id, r io.Reader, resolver *backupResolver) error {
+	if c.BugExists(id) {
+		return nil
+	}
+	if _, err := c.ResolveBugCreateMetadata(metaKeyBackupOrigin, id.String()); err == nil {
+		return nil
+	}
+
+	b, err := bug.ImportJSON(c.repo, r, resolver, map[string]string{
+		metaKeyBackupOrigin: id.String(),
+	})
+	if err != nil {
+		return err
+	}
+
+	snap := b.Compile()
+	c.bugs[b.Id()] = NewBugCache(c, b)
+	c.setBugExcerpt(NewBugExcerpt(b, &snap))
+	c.notifyBugWatchers(b.Id())
+
+	return c.indexCommitRefs(b.Id(), &snap)
+}
+
+var _ identity.Resolver = &backupResolver{}
+
+// backupResolver resolve the identities referenced in a backup, mapping
+// their original ids to the restored identities.
+type backupResolver struct {
+	cache      *RepoCache
+	identities map[entity.Id]*IdentityCache
+}
+
+func (br *backupResolver) ResolveIdentity(id entity.Id) (identity.Interface, error) {
+	if i, ok := br.identities[id]; ok {
+		return i.Identity, nil
+	}
+
+	i, err := br.cache.ResolveIdentity(id)
+	if err != nil {
+		return nil, err
+	}
+	return i.Identity, nil
+}
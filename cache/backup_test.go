@@ -0,0 +1,76 @@
+package cache
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/repository"
+	"github.com/MichaelMure/git-bug/util/git"
+)
+
+func TestBackupRestore(t *testing.T) {
+	repo := repository.CreateTestRepo(false)
+	repoRestore := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo, repoRestore)
+
+	cache, err := NewRepoCache(repo)
+	require.NoError(t, err)
+
+	iden, err := cache.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	err = cache.SetUserIdentity(iden)
+	require.NoError(t, err)
+
+	fileHash, err := repo.StoreData([]byte("attached"))
+	require.NoError(t, err)
+
+	bug1, _, err := cache.NewBugWithFiles("title", "message", nil)
+	require.NoError(t, err)
+	comment, err := bug1.AddCommentWithFiles("comment", nil)
+	require.NoError(t, err)
+	_, err = bug1.EditComment(comment.Id(), "edited")
+	require.NoError(t, err)
+	require.NoError(t, bug1.Commit())
+
+	_, _, err = cache.NewBugWithFiles("other", "with a file", []git.Hash{fileHash})
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	err = cache.Backup(&buf)
+	require.NoError(t, err)
+
+	restored, err := NewRepoCache(repoRestore)
+	require.NoError(t, err)
+
+	err = restored.Restore(bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+
+	require.Len(t, restored.AllIdentityIds(), 1)
+	require.Len(t, restored.AllBugsIds(), 2)
+
+	b, err := restored.ResolveBugCreateMetadata(metaKeyBackupOrigin, bug1.Id().String())
+	require.NoError(t, err)
+	snap := b.Snapshot()
+	require.Equal(t, "title", snap.Title)
+	require.Len(t, snap.Comments, 2)
+	require.Equal(t, "edited", snap.Comments[1].Message)
+	require.Equal(t, "René Descartes", snap.Author.Name())
+
+	data, err := repoRestore.ReadData(fileHash)
+	require.NoError(t, err)
+	require.Equal(t, []byte("attached"), data)
+
+	// restoring again is a no-op
+	err = restored.Restore(bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+	require.Len(t, restored.AllIdentityIds(), 1)
+	require.Len(t, restored.AllBugsIds(), 2)
+
+	// restoring in the original repo doesn't duplicate anything either
+	err = cache.Restore(bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+	require.Len(t, cache.AllIdentityIds(), 1)
+	require.Len(t, cache.AllBugsIds(), 2)
+}
@@ -0,0 +1,119 @@
+package cache
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+const boardsDir = "boards"
+
+// Board is a view of the bugs of a project, arranged in columns each holding
+// the open bugs having a given label, like the Gitlab issue boards.
+type Board struct {
+	Name string `json:"name"`
+	// the label of each column, in order
+	Columns []string `json:"columns"`
+}
+
+func boardsDirPath(repo repository.Repo) string {
+	return path.Join(repo.GetPath(), "git-bug", boardsDir)
+}
+
+// StoreBoard store the configuration of a board, replacing the board of the
+// same name if any
+func (c *RepoCache) StoreBoard(board Board) error {
+	if board.Name == "" {
+		return fmt.Errorf("a board must have a name")
+	}
+
+	err := os.MkdirAll(boardsDirPath(c.repo), 0755)
+	if err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(board, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	// the name of a board is free-form
+	filename := url.PathEscape(board.Name) + ".json"
+
+	return ioutil.WriteFile(path.Join(boardsDirPath(c.repo), filename), data, 0644)
+}
+
+// Boards return the stored boards, sorted by name
+func (c *RepoCache) Boards() ([]Board, error) {
+	files, err := ioutil.ReadDir(boardsDirPath(c.repo))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var boards []Board
+	for _, file := range files {
+		if file.IsDir() || !strings.HasSuffix(file.Name(), ".json") {
+			continue
+		}
+
+		data, err := ioutil.ReadFile(path.Join(boardsDirPath(c.repo), file.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		var board Board
+		err = json.Unmarshal(data, &board)
+		if err != nil {
+			return nil, fmt.Errorf("invalid board %s: %v", file.Name(), err)
+		}
+
+		boards = append(boards, board)
+	}
+
+	sort.Slice(boards, func(i, j int) bool {
+		return boards[i].Name < boards[j].Name
+	})
+
+	return boards, nil
+}
+
+// ResolveBoard return the stored board with the given name
+func (c *RepoCache) ResolveBoard(name string) (Board, error) {
+	boards, err := c.Boards()
+	if err != nil {
+		return Board{}, err
+	}
+
+	for _, board := range boards {
+		if board.Name == name {
+			return board, nil
+		}
+	}
+
+	return Board{}, fmt.Errorf("unknown board \"%s\"", name)
+}
+
+// BoardColumn return the open bugs having the label of a column of a board,
+// the most recently edited first
+func (c *RepoCache) BoardColumn(label string) []entity.Id {
+	query := NewQuery()
+	query.OrderBy = OrderByEdit
+	query.Status = []Filter{func(repoCache *RepoCache, excerpt *BugExcerpt) bool {
+		return excerpt.Status == bug.OpenStatus
+	}}
+	query.Label = []Filter{LabelFilter(label)}
+
+	return c.QueryBugs(query)
+}
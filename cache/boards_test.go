@@ -0,0 +1,62 @@
+package cache
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestBoards(t *testing.T) {
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	cache, err := NewRepoCache(repo)
+	require.NoError(t, err)
+
+	iden, err := cache.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	err = cache.SetUserIdentity(iden)
+	require.NoError(t, err)
+
+	boards, err := cache.Boards()
+	require.NoError(t, err)
+	require.Empty(t, boards)
+
+	require.NoError(t, cache.StoreBoard(Board{Name: "team/dev", Columns: []string{"todo", "doing"}}))
+	require.NoError(t, cache.StoreBoard(Board{Name: "Backlog", Columns: []string{"todo"}}))
+	// replace the previous one
+	require.NoError(t, cache.StoreBoard(Board{Name: "Backlog", Columns: []string{"later"}}))
+	require.Error(t, cache.StoreBoard(Board{}))
+
+	boards, err = cache.Boards()
+	require.NoError(t, err)
+	require.Equal(t, []Board{
+		{Name: "Backlog", Columns: []string{"later"}},
+		{Name: "team/dev", Columns: []string{"todo", "doing"}},
+	}, boards)
+
+	board, err := cache.ResolveBoard("team/dev")
+	require.NoError(t, err)
+	require.Equal(t, []string{"todo", "doing"}, board.Columns)
+
+	_, err = cache.ResolveBoard("unknown")
+	require.Error(t, err)
+
+	b1, _, err := cache.NewBug("first", "message")
+	require.NoError(t, err)
+	_, _, err = b1.ChangeLabels([]string{"todo"}, nil)
+	require.NoError(t, err)
+
+	b2, _, err := cache.NewBug("second", "message")
+	require.NoError(t, err)
+	_, _, err = b2.ChangeLabels([]string{"todo"}, nil)
+	require.NoError(t, err)
+	_, err = b2.Close()
+	require.NoError(t, err)
+
+	require.Equal(t, []entity.Id{b1.Id()}, cache.BoardColumn("todo"))
+	require.Empty(t, cache.BoardColumn("doing"))
+}
@@ -28,14 +28,38 @@ func NewBugCache(repoCache *RepoCache, b *bug.Bug) *BugCache {
 }
 
 func (c *BugCache) Snapshot() *bug.Snapshot {
-	return c.bug.Snapshot()
+	snap := c.bug.Snapshot()
+
+	// the tags are loaded lazily, and retried on the next call on failure
+	if snap.Tags == nil {
+		tags, err := c.repoCache.repo.BugTags(c.Id())
+		if err == nil {
+			if tags == nil {
+				tags = []string{}
+			}
+			snap.Tags = tags
+		}
+	}
+
+	return snap
 }
 
 func (c *BugCache) Id() entity.Id {
 	return c.bug.Id()
 }
 
+// Operations return an iterator over the operations of the bug. See
+// RepoCache.ResolveBugOperations to stream them from git instead.
+func (c *BugCache) Operations() bug.OperationIter {
+	return bug.NewOperationIterator(c.bug)
+}
+
 func (c *BugCache) notifyUpdated() error {
+	err := c.repoCache.stampDevice(c.bug.StagedOperations())
+	if err != nil {
+		return err
+	}
+
 	return c.repoCache.bugUpdated(c.bug.Id())
 }
 
@@ -68,13 +92,51 @@ func (c *BugCache) AddComment(message string) (*bug.AddCommentOperation, error)
 	return c.AddCommentWithFiles(message, nil)
 }
 
+// AddCommentWithFiles add a comment with attached files. It fails with
+// ErrBugLocked if the bug is locked, see AddCommentOverrideLock, and with
+// ErrCommentTooShort if the message has less words than configured in
+// git-bug.min-comment-words.
 func (c *BugCache) AddCommentWithFiles(message string, files []git.Hash) (*bug.AddCommentOperation, error) {
+	return c.addComment(message, files, nil, nil)
+}
+
+// AddCommentWithReferences add a comment referring to some URLs or commit
+// hashes. It fails the same way as AddCommentWithFiles.
+func (c *BugCache) AddCommentWithReferences(message string, references []string) (*bug.AddCommentOperation, error) {
+	return c.addComment(message, nil, references, nil)
+}
+
+// AddCommentWithMetadata add a comment with the given metadata, set on the
+// operation before it's applied. It fails the same way as
+// AddCommentWithFiles.
+func (c *BugCache) AddCommentWithMetadata(message string, metadata map[string]string) (*bug.AddCommentOperation, error) {
+	return c.addComment(message, nil, nil, metadata)
+}
+
+func (c *BugCache) addComment(message string, files []git.Hash, references []string, metadata map[string]string) (*bug.AddCommentOperation, error) {
+	if c.IsLocked() {
+		return nil, ErrBugLocked
+	}
+
+	if err := c.repoCache.checkCommentLength(message); err != nil {
+		return nil, err
+	}
+
 	author, err := c.repoCache.GetUserIdentity()
 	if err != nil {
 		return nil, err
 	}
 
-	return c.AddCommentRaw(author, time.Now().Unix(), message, files, nil)
+	op, err := bug.AddCommentWithReferences(c.bug, author.Identity, time.Now().Unix(), message, files, references)
+	if err != nil {
+		return nil, err
+	}
+
+	for key, value := range metadata {
+		op.SetMetadata(key, value)
+	}
+
+	return op, c.notifyUpdated()
 }
 
 func (c *BugCache) AddCommentRaw(author *IdentityCache, unixTime int64, message string, files []git.Hash, metadata map[string]string) (*bug.AddCommentOperation, error) {
@@ -90,6 +152,26 @@ func (c *BugCache) AddCommentRaw(author *IdentityCache, unixTime int64, message
 	return op, c.notifyUpdated()
 }
 
+// AddCommentRawAt is the same as AddCommentRaw, with a time of a nanosecond
+// precision. The time and metadata are set before the operation is applied, so
+// that its id doesn't change afterward.
+func (c *BugCache) AddCommentRawAt(author *IdentityCache, t time.Time, message string, files []git.Hash, metadata map[string]string) (*bug.AddCommentOperation, error) {
+	op := bug.NewAddCommentOp(author.Identity, t.Unix(), message, files)
+	op.SetTime(t)
+
+	for key, value := range metadata {
+		op.SetMetadata(key, value)
+	}
+
+	if err := op.Validate(); err != nil {
+		return nil, err
+	}
+
+	c.bug.Append(op)
+
+	return op, c.notifyUpdated()
+}
+
 func (c *BugCache) ChangeLabels(added []string, removed []string) ([]bug.LabelChangeResult, *bug.LabelChangeOperation, error) {
 	author, err := c.repoCache.GetUserIdentity()
 	if err != nil {
@@ -117,6 +199,30 @@ func (c *BugCache) ChangeLabelsRaw(author *IdentityCache, unixTime int64, added
 	return changes, op, nil
 }
 
+// SetLabels replace the whole set of labels of the bug
+func (c *BugCache) SetLabels(labels []bug.Label) error {
+	author, err := c.repoCache.GetUserIdentity()
+	if err != nil {
+		return err
+	}
+
+	_, err = c.SetLabelsRaw(author, time.Now().Unix(), labels, nil)
+	return err
+}
+
+func (c *BugCache) SetLabelsRaw(author *IdentityCache, unixTime int64, labels []bug.Label, metadata map[string]string) (*bug.SetLabelsOperation, error) {
+	op, err := bug.SetLabels(c.bug, author.Identity, unixTime, labels)
+	if err != nil {
+		return nil, err
+	}
+
+	for key, value := range metadata {
+		op.SetMetadata(key, value)
+	}
+
+	return op, c.notifyUpdated()
+}
+
 func (c *BugCache) ForceChangeLabels(added []string, removed []string) (*bug.LabelChangeOperation, error) {
 	author, err := c.repoCache.GetUserIdentity()
 	if err != nil {
@@ -250,7 +356,154 @@ func (c *BugCache) SetMetadataRaw(author *IdentityCache, unixTime int64, target
 	return op, c.notifyUpdated()
 }
 
+func (c *BugCache) AddLink(direction bug.LinkDirection, target entity.Id) (*bug.LinkOperation, error) {
+	author, err := c.repoCache.GetUserIdentity()
+	if err != nil {
+		return nil, err
+	}
+
+	return c.AddLinkRaw(author, time.Now().Unix(), direction, target, nil)
+}
+
+func (c *BugCache) AddLinkRaw(author *IdentityCache, unixTime int64, direction bug.LinkDirection, target entity.Id, metadata map[string]string) (*bug.LinkOperation, error) {
+	op, err := bug.AddLink(c.bug, author.Identity, unixTime, direction, target)
+	if err != nil {
+		return nil, err
+	}
+
+	for key, value := range metadata {
+		op.SetMetadata(key, value)
+	}
+
+	return op, c.notifyUpdated()
+}
+
+// OpNoOp add an operation that doesn't change the bug state, only to carry
+// the given metadata
+func (c *BugCache) OpNoOp(metadata map[string]string) (*bug.NoOpOperation, error) {
+	author, err := c.repoCache.GetUserIdentity()
+	if err != nil {
+		return nil, err
+	}
+
+	return c.OpNoOpRaw(author, time.Now().Unix(), metadata)
+}
+
+func (c *BugCache) OpNoOpRaw(author *IdentityCache, unixTime int64, metadata map[string]string) (*bug.NoOpOperation, error) {
+	op, err := bug.NoOp(c.bug, author.Identity, unixTime, metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	return op, c.notifyUpdated()
+}
+
+func (c *BugCache) SetDueDate(due time.Time) (*bug.SetDueDateOperation, error) {
+	author, err := c.repoCache.GetUserIdentity()
+	if err != nil {
+		return nil, err
+	}
+
+	return c.SetDueDateRaw(author, time.Now().Unix(), due, nil)
+}
+
+func (c *BugCache) SetDueDateRaw(author *IdentityCache, unixTime int64, due time.Time, metadata map[string]string) (*bug.SetDueDateOperation, error) {
+	op, err := bug.SetDueDate(c.bug, author.Identity, unixTime, due)
+	if err != nil {
+		return nil, err
+	}
+
+	for key, value := range metadata {
+		op.SetMetadata(key, value)
+	}
+
+	return op, c.notifyUpdated()
+}
+
+// Pin mark the bug as pinned, to display it before the others
+func (c *BugCache) Pin() error {
+	return c.setPinned(true)
+}
+
+// Unpin remove the pinned mark of the bug
+func (c *BugCache) Unpin() error {
+	return c.setPinned(false)
+}
+
+func (c *BugCache) setPinned(pinned bool) error {
+	author, err := c.repoCache.GetUserIdentity()
+	if err != nil {
+		return err
+	}
+
+	_, err = c.PinRaw(author, time.Now().Unix(), pinned, nil)
+	return err
+}
+
+func (c *BugCache) PinRaw(author *IdentityCache, unixTime int64, pinned bool, metadata map[string]string) (*bug.PinOperation, error) {
+	op, err := bug.Pin(c.bug, author.Identity, unixTime, pinned)
+	if err != nil {
+		return nil, err
+	}
+
+	for key, value := range metadata {
+		op.SetMetadata(key, value)
+	}
+
+	return op, c.notifyUpdated()
+}
+
+func (c *BugCache) CrossRefRaw(author *IdentityCache, unixTime int64, commit git.Hash, metadata map[string]string) (*bug.CrossRefOperation, error) {
+	op, err := bug.CrossReference(c.bug, author.Identity, unixTime, commit)
+	if err != nil {
+		return nil, err
+	}
+
+	for key, value := range metadata {
+		op.SetMetadata(key, value)
+	}
+
+	return op, c.notifyUpdated()
+}
+
+func (c *BugCache) CrossRefURLRaw(author *IdentityCache, unixTime int64, url string, metadata map[string]string) (*bug.CrossRefOperation, error) {
+	op, err := bug.CrossReferenceURL(c.bug, author.Identity, unixTime, url)
+	if err != nil {
+		return nil, err
+	}
+
+	for key, value := range metadata {
+		op.SetMetadata(key, value)
+	}
+
+	return op, c.notifyUpdated()
+}
+
+// StorageSize return the size in bytes of the git objects holding the
+// committed operations of the bug
+func (c *BugCache) StorageSize() (int64, error) {
+	return bug.StorageSize(c.repoCache.repo, c.Id())
+}
+
+// VerifyAuthors check that the committed operations are signed by a key of
+// their author, when the author has some
+func (c *BugCache) VerifyAuthors() error {
+	return c.bug.VerifyAuthors(c.repoCache.repo)
+}
+
+// Commit write the pending operations in the repository. It fails if one of
+// them is larger than the configured maximum operation size.
 func (c *BugCache) Commit() error {
+	err := c.checkOperationsSize()
+	if err != nil {
+		return err
+	}
+	return c.CommitOverrideSizeLimit()
+}
+
+// CommitOverrideSizeLimit is the same as Commit, without the check of the
+// size of the operations, to intentionally store large operations.
+func (c *BugCache) CommitOverrideSizeLimit() error {
 	err := c.bug.Commit(c.repoCache.repo)
 	if err != nil {
 		return err
@@ -258,6 +511,9 @@ func (c *BugCache) Commit() error {
 	return c.notifyUpdated()
 }
 
+// CommitAsNeeded write the pending operations in the repository, if any.
+// Like CommitOverrideSizeLimit, the size of the operations is not checked, as
+// it's mostly used by the bridges to store what has been imported as is.
 func (c *BugCache) CommitAsNeeded() error {
 	err := c.bug.CommitAsNeeded(c.repoCache.repo)
 	if err != nil {
@@ -269,3 +525,18 @@ func (c *BugCache) CommitAsNeeded() error {
 func (c *BugCache) NeedCommit() bool {
 	return c.bug.NeedCommit()
 }
+
+func (c *BugCache) checkOperationsSize() error {
+	max, err := c.repoCache.maxOperationSize()
+	if err != nil {
+		return err
+	}
+
+	for _, op := range c.bug.StagedOperations() {
+		if size := op.Size(); size > max {
+			return ErrOperationTooLarge{Size: size, Max: max}
+		}
+	}
+
+	return nil
+}
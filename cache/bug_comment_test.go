@@ -0,0 +1,91 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestAddCommentWithMetadata(t *testing.T) {
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	cache, err := NewRepoCache(repo)
+	require.NoError(t, err)
+
+	iden, err := cache.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	err = cache.SetUserIdentity(iden)
+	require.NoError(t, err)
+
+	b, _, err := cache.NewBug("title", "message")
+	require.NoError(t, err)
+
+	op, err := b.AddCommentWithMetadata("comment", map[string]string{
+		"remote-id":  "1234",
+		"remote-url": "https://example.com/issues/1#note_1234",
+	})
+	require.NoError(t, err)
+	require.NoError(t, b.Commit())
+
+	id, err := b.ResolveOperationWithMetadata("remote-id", "1234")
+	require.NoError(t, err)
+	require.Equal(t, op.Id(), id)
+
+	// the metadata are part of the stored operation
+	require.NoError(t, cache.Close())
+	cache, err = NewRepoCache(repo)
+	require.NoError(t, err)
+	defer cache.Close()
+
+	b, err = cache.ResolveBug(b.Id())
+	require.NoError(t, err)
+
+	comment := b.Snapshot().Operations[1]
+	require.Equal(t, op.Id(), comment.Id())
+	url, ok := comment.GetMetadata("remote-url")
+	require.True(t, ok)
+	require.Equal(t, "https://example.com/issues/1#note_1234", url)
+
+	_, err = b.SetLocked(true)
+	require.NoError(t, err)
+	_, err = b.AddCommentWithMetadata("comment", nil)
+	require.Equal(t, ErrBugLocked, err)
+}
+
+func TestAddCommentRawAt(t *testing.T) {
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	cache, err := NewRepoCache(repo)
+	require.NoError(t, err)
+	defer cache.Close()
+
+	iden, err := cache.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	err = cache.SetUserIdentity(iden)
+	require.NoError(t, err)
+
+	b, _, err := cache.NewBug("title", "message")
+	require.NoError(t, err)
+
+	created := time.Unix(1580000000, 123456789)
+	op, err := b.AddCommentRawAt(iden, created, "comment", nil, map[string]string{
+		"remote-id": "1234",
+	})
+	require.NoError(t, err)
+
+	// the comment is recorded with the final id of the operation
+	comment := b.Snapshot().Comments[1]
+	require.Equal(t, op.Id(), comment.Id())
+	require.Equal(t, created, op.Time())
+
+	require.NoError(t, b.Commit())
+
+	id, err := b.ResolveOperationWithMetadata("remote-id", "1234")
+	require.NoError(t, err)
+	require.Equal(t, op.Id(), id)
+}
@@ -3,6 +3,7 @@ package cache
 import (
 	"encoding/gob"
 	"fmt"
+	"time"
 
 	"github.com/MichaelMure/git-bug/bug"
 	"github.com/MichaelMure/git-bug/entity"
@@ -24,6 +25,9 @@ type BugExcerpt struct {
 	EditLamportTime   lamport.Time
 	CreateUnixTime    int64
 	EditUnixTime      int64
+	// ExternalCreation tell if CreateUnixTime is the creation time in the
+	// external bug tracker the bug has been imported from
+	ExternalCreation bool
 
 	Status       bug.Status
 	Labels       []bug.Label
@@ -39,6 +43,29 @@ type BugExcerpt struct {
 	AuthorId     entity.Id
 
 	CreateMetadata map[string]string
+
+	// unix time of the due date, or 0 if there is none
+	DueUnixTime int64
+
+	// number of checked and total Markdown task list items
+	ChecklistDone  int
+	ChecklistTotal int
+
+	// pinned bugs are highlighted among the others
+	Pinned bool
+
+	// the values of the activity score, which depend on the current time
+	LenCommenters       int
+	LastCommentUnixTime int64
+
+	// the weight of the bug, or 0 if there is none
+	Weight int
+
+	// number of URLs or commit hashes referenced
+	LenReferences int
+
+	// the sentiment score of the title and comments, from -1 to 1
+	Sentiment float64
 }
 
 // identity.Bare data are directly embedded in the bug excerpt
@@ -60,7 +87,15 @@ func (l LegacyAuthorExcerpt) DisplayName() string {
 	panic("invalid person data")
 }
 
-func NewBugExcerpt(b bug.Interface, snap *bug.Snapshot) *BugExcerpt {
+// ExcerptSource is the subset of a bug needed to build its excerpt
+type ExcerptSource interface {
+	Id() entity.Id
+	CreateLamportTime() lamport.Time
+	EditLamportTime() lamport.Time
+	FirstOp() bug.Operation
+}
+
+func NewBugExcerpt(b ExcerptSource, snap *bug.Snapshot) *BugExcerpt {
 	participantsIds := make([]entity.Id, len(snap.Participants))
 	for i, participant := range snap.Participants {
 		participantsIds[i] = participant.Id()
@@ -75,8 +110,9 @@ func NewBugExcerpt(b bug.Interface, snap *bug.Snapshot) *BugExcerpt {
 		Id:                b.Id(),
 		CreateLamportTime: b.CreateLamportTime(),
 		EditLamportTime:   b.EditLamportTime(),
-		CreateUnixTime:    b.FirstOp().GetUnixTime(),
+		CreateUnixTime:    snap.CreatedAt.Unix(),
 		EditUnixTime:      snap.LastEditUnix(),
+		ExternalCreation:  isExternalCreation(b),
 		Status:            snap.Status,
 		Labels:            snap.Labels,
 		Actors:            actorsIds,
@@ -84,8 +120,23 @@ func NewBugExcerpt(b bug.Interface, snap *bug.Snapshot) *BugExcerpt {
 		Title:             snap.Title,
 		LenComments:       len(snap.Comments),
 		CreateMetadata:    b.FirstOp().AllMetadata(),
+		Pinned:            snap.Pinned,
+		LenReferences:     len(snap.References),
+		Sentiment:         snap.SentimentScore(),
+	}
+
+	if snap.DueDate != nil {
+		e.DueUnixTime = snap.DueDate.Unix()
 	}
 
+	e.ChecklistDone, e.ChecklistTotal = snap.ChecklistProgress()
+
+	activity := snap.ActivityStats()
+	e.LenCommenters = activity.Commenters
+	e.LastCommentUnixTime = activity.LastComment.Unix()
+
+	e.Weight, _ = Weight(snap)
+
 	switch snap.Author.(type) {
 	case *identity.Identity:
 		e.AuthorId = snap.Author.Id()
@@ -119,6 +170,13 @@ func (b BugsById) Swap(i, j int) {
 	b[i], b[j] = b[j], b[i]
 }
 
+// isExternalCreation tell if the creation time of the bug comes from an
+// external bug tracker
+func isExternalCreation(b ExcerptSource) bool {
+	create, ok := b.FirstOp().(*bug.CreateOperation)
+	return ok && create.ExternalCreatedAt != 0
+}
+
 type BugsByCreationTime []*BugExcerpt
 
 func (b BugsByCreationTime) Len() int {
@@ -126,6 +184,14 @@ func (b BugsByCreationTime) Len() int {
 }
 
 func (b BugsByCreationTime) Less(i, j int) bool {
+	// The logical clocks of imported bugs only reflect the order of import,
+	// so the original creation time is used instead.
+	if b[i].ExternalCreation || b[j].ExternalCreation {
+		if b[i].CreateUnixTime != b[j].CreateUnixTime {
+			return b[i].CreateUnixTime < b[j].CreateUnixTime
+		}
+	}
+
 	if b[i].CreateLamportTime < b[j].CreateLamportTime {
 		return true
 	}
@@ -147,6 +213,16 @@ func (b BugsByCreationTime) Swap(i, j int) {
 	b[i], b[j] = b[j], b[i]
 }
 
+// ActivityScore return the activity score of the bug at the given time, see
+// bug.Snapshot.ActivityScore
+func (b *BugExcerpt) ActivityScore(now time.Time) float64 {
+	return bug.ActivityStats{
+		Comments:    b.LenComments,
+		Commenters:  b.LenCommenters,
+		LastComment: time.Unix(b.LastCommentUnixTime, 0),
+	}.Score(now)
+}
+
 type BugsByEditTime []*BugExcerpt
 
 func (b BugsByEditTime) Len() int {
@@ -174,3 +250,68 @@ func (b BugsByEditTime) Less(i, j int) bool {
 func (b BugsByEditTime) Swap(i, j int) {
 	b[i], b[j] = b[j], b[i]
 }
+
+type BugsByWeight []*BugExcerpt
+
+func (b BugsByWeight) Len() int {
+	return len(b)
+}
+
+func (b BugsByWeight) Less(i, j int) bool {
+	if b[i].Weight != b[j].Weight {
+		return b[i].Weight < b[j].Weight
+	}
+	return b[i].Id < b[j].Id
+}
+
+func (b BugsByWeight) Swap(i, j int) {
+	b[i], b[j] = b[j], b[i]
+}
+
+type BugsBySentiment []*BugExcerpt
+
+func (b BugsBySentiment) Len() int {
+	return len(b)
+}
+
+func (b BugsBySentiment) Less(i, j int) bool {
+	if b[i].Sentiment != b[j].Sentiment {
+		return b[i].Sentiment < b[j].Sentiment
+	}
+	return b[i].Id < b[j].Id
+}
+
+func (b BugsBySentiment) Swap(i, j int) {
+	b[i], b[j] = b[j], b[i]
+}
+
+// BugsByActivity sort the bugs by activity score. The scores are computed
+// once when creating the sorter as they depend on the current time.
+type BugsByActivity struct {
+	excerpts []*BugExcerpt
+	scores   []float64
+}
+
+func newBugsByActivity(excerpts []*BugExcerpt, now time.Time) *BugsByActivity {
+	scores := make([]float64, len(excerpts))
+	for i, e := range excerpts {
+		scores[i] = e.ActivityScore(now)
+	}
+	return &BugsByActivity{excerpts: excerpts, scores: scores}
+}
+
+func (b *BugsByActivity) Len() int {
+	return len(b.excerpts)
+}
+
+func (b *BugsByActivity) Less(i, j int) bool {
+	if b.scores[i] != b.scores[j] {
+		return b.scores[i] < b.scores[j]
+	}
+	return b.excerpts[i].EditUnixTime < b.excerpts[j].EditUnixTime
+}
+
+func (b *BugsByActivity) Swap(i, j int) {
+	b.excerpts[i], b.excerpts[j] = b.excerpts[j], b.excerpts[i]
+	b.scores[i], b.scores[j] = b.scores[j], b.scores[i]
+}
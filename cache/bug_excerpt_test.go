@@ -0,0 +1,50 @@
+package cache
+
+import (
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBugsByCreationTime(t *testing.T) {
+	local := &BugExcerpt{Id: "local", CreateLamportTime: 1, CreateUnixTime: 2000}
+	importedOld := &BugExcerpt{Id: "old", CreateLamportTime: 2, CreateUnixTime: 1000, ExternalCreation: true}
+	importedNew := &BugExcerpt{Id: "new", CreateLamportTime: 3, CreateUnixTime: 3000, ExternalCreation: true}
+	later := &BugExcerpt{Id: "later", CreateLamportTime: 4, CreateUnixTime: 1500}
+
+	excerpts := []*BugExcerpt{importedNew, later, local, importedOld}
+	sort.Sort(BugsByCreationTime(excerpts))
+
+	// imported bugs are placed at their original creation time, the other
+	// ones keep the order of their logical clock
+	require.Equal(t, []*BugExcerpt{importedOld, local, later, importedNew}, excerpts)
+}
+
+func TestBugsByActivity(t *testing.T) {
+	now := time.Unix(1600000000, 0)
+	week := int64(7 * 24 * 60 * 60)
+
+	quiet := &BugExcerpt{Id: "quiet", LenComments: 1, LenCommenters: 1, LastCommentUnixTime: now.Unix()}
+	busy := &BugExcerpt{Id: "busy", LenComments: 10, LenCommenters: 4, LastCommentUnixTime: now.Unix()}
+	stale := &BugExcerpt{Id: "stale", LenComments: 10, LenCommenters: 4, LastCommentUnixTime: now.Unix() - 10*week}
+
+	excerpts := []*BugExcerpt{quiet, stale, busy}
+	sort.Sort(sort.Reverse(newBugsByActivity(excerpts, now)))
+
+	require.Equal(t, []*BugExcerpt{busy, quiet, stale}, excerpts)
+}
+
+func TestBugsBySentiment(t *testing.T) {
+	angry := &BugExcerpt{Id: "angry", Sentiment: -0.5}
+	neutral := &BugExcerpt{Id: "neutral"}
+	happy := &BugExcerpt{Id: "happy", Sentiment: 0.2}
+	other := &BugExcerpt{Id: "other"}
+
+	excerpts := []*BugExcerpt{happy, other, angry, neutral}
+	sortExcerpts(excerpts, &Query{OrderBy: OrderBySentiment, OrderDirection: OrderAscending})
+
+	// the most negative first, then by id
+	require.Equal(t, []*BugExcerpt{angry, neutral, other, happy}, excerpts)
+}
@@ -0,0 +1,81 @@
+package cache
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/entity"
+)
+
+// Fork split a bug by creating a new one with the given title, holding a copy
+// of the selected comments. Both bugs are linked together with a RelatesTo
+// link and a comment pointing to the fork is added to the original bug.
+// Nothing is removed from the original bug.
+//
+// The copied comments keep their original author and time when the author
+// can be resolved, otherwise the current user is used.
+// Both bugs are written in the repository (commit).
+func (c *BugCache) Fork(newTitle string, commentIds []entity.Id) (*BugCache, error) {
+	author, err := c.repoCache.GetUserIdentity()
+	if err != nil {
+		return nil, err
+	}
+
+	snap := c.Snapshot()
+
+	comments := make([]*bug.Comment, len(commentIds))
+	for i, id := range commentIds {
+		comment, err := snap.SearchComment(id)
+		if err != nil {
+			return nil, fmt.Errorf("comment %s not found in bug %s", id.Human(), c.Id().Human())
+		}
+		comments[i] = comment
+	}
+
+	unixTime := time.Now().Unix()
+	message := fmt.Sprintf("Forked from bug %s (%s)", c.Id().Human(), snap.Title)
+
+	fork, _, err := c.repoCache.NewBugRaw(author, unixTime, newTitle, message, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, comment := range comments {
+		commentAuthor := author
+		if comment.Author != nil {
+			resolved, err := c.repoCache.ResolveIdentity(comment.Author.Id())
+			if err == nil {
+				commentAuthor = resolved
+			}
+		}
+
+		_, err = fork.AddCommentRaw(commentAuthor, int64(comment.UnixTime), comment.Message, comment.Files, nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	_, err = fork.AddLinkRaw(author, unixTime, bug.RelatesTo, c.Id(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = fork.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = c.AddLinkRaw(author, unixTime, bug.RelatesTo, fork.Id(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = c.AddCommentRaw(author, unixTime,
+		fmt.Sprintf("Forked to bug %s (%s)", fork.Id().Human(), newTitle), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return fork, c.Commit()
+}
@@ -0,0 +1,56 @@
+package cache
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestBugFork(t *testing.T) {
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	cache, err := NewRepoCache(repo)
+	require.NoError(t, err)
+
+	iden, err := cache.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	err = cache.SetUserIdentity(iden)
+	require.NoError(t, err)
+
+	original, _, err := cache.NewBug("two issues", "first and second issue")
+	require.NoError(t, err)
+	_, err = original.AddComment("about the first issue")
+	require.NoError(t, err)
+	_, err = original.AddComment("about the second issue")
+	require.NoError(t, err)
+	require.NoError(t, original.Commit())
+
+	second := original.Snapshot().Comments[2]
+
+	_, err = original.Fork("second issue", []entity.Id{"unknown"})
+	require.Error(t, err)
+
+	fork, err := original.Fork("second issue", []entity.Id{second.Id()})
+	require.NoError(t, err)
+	require.False(t, fork.NeedCommit())
+	require.False(t, original.NeedCommit())
+
+	forkSnap := fork.Snapshot()
+	require.Equal(t, "second issue", forkSnap.Title)
+	require.Len(t, forkSnap.Comments, 2)
+	require.Equal(t, "about the second issue", forkSnap.Comments[1].Message)
+	require.Equal(t, second.UnixTime, forkSnap.Comments[1].UnixTime)
+	require.True(t, forkSnap.HasLink(bug.RelatesTo, original.Id()))
+
+	// the fork is additive
+	snap := original.Snapshot()
+	require.Len(t, snap.Comments, 4)
+	require.Equal(t, "about the second issue", snap.Comments[2].Message)
+	require.Contains(t, snap.Comments[3].Message, fork.Id().Human())
+	require.True(t, snap.HasLink(bug.RelatesTo, fork.Id()))
+}
@@ -0,0 +1,93 @@
+package cache
+
+import (
+	"github.com/pkg/errors"
+)
+
+// BugIterator iterate over the bugs matching a Query, in the order of the
+// query. The filters are applied during the iteration and the bugs are read
+// only when reaching them, so that the bugs don't have to be all loaded in
+// memory at once.
+type BugIterator struct {
+	cache    *RepoCache
+	query    *Query
+	excerpts []*BugExcerpt
+	index    int
+	value    *BugCache
+	err      error
+}
+
+// QueryBugsIter return an iterator over the bugs matching the given Query.
+// A nil query match all the bugs, in no particular order.
+func (c *RepoCache) QueryBugsIter(query *Query) *BugIterator {
+	excerpts := make([]*BugExcerpt, 0, len(c.bugExcerpts))
+	for _, excerpt := range c.bugExcerpts {
+		excerpts = append(excerpts, excerpt)
+	}
+
+	if query != nil {
+		sortExcerpts(excerpts, query)
+	}
+
+	return &BugIterator{
+		cache:    c,
+		query:    query,
+		excerpts: excerpts,
+		index:    -1,
+	}
+}
+
+// Next move to the next matching bug, and return false when the iteration
+// is over
+func (it *BugIterator) Next() bool {
+	if it.err != nil {
+		return false
+	}
+
+	it.value = nil
+
+	for it.index+1 < len(it.excerpts) {
+		it.index++
+		if it.query == nil || it.query.Match(it.cache, it.excerpts[it.index]) {
+			return true
+		}
+	}
+
+	// release the excerpts once done
+	it.excerpts = nil
+	it.index = 0
+	return false
+}
+
+// Excerpt return the excerpt of the current bug, without reading the bug
+func (it *BugIterator) Excerpt() *BugExcerpt {
+	if it.index < 0 || it.index >= len(it.excerpts) {
+		panic("Iterator is not valid anymore")
+	}
+	return it.excerpts[it.index]
+}
+
+// Value return the current bug, reading it from the repository if needed.
+// If that fails, Value return nil, the error is available with Err and
+// the iteration stop.
+func (it *BugIterator) Value() *BugCache {
+	if it.value != nil {
+		return it.value
+	}
+
+	excerpt := it.Excerpt()
+
+	b, err := it.cache.ResolveBug(excerpt.Id)
+	if err != nil {
+		it.err = errors.Wrapf(err, "can't load bug %s", excerpt.Id.Human())
+		return nil
+	}
+
+	it.value = b
+	return b
+}
+
+// Err return the error that stopped the iteration, if any
+func (it *BugIterator) Err() error {
+	return it.err
+}
@@ -0,0 +1,50 @@
+package cache
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestQueryBugsIter(t *testing.T) {
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	cache, err := NewRepoCache(repo)
+	require.NoError(t, err)
+
+	iden, err := cache.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	err = cache.SetUserIdentity(iden)
+	require.NoError(t, err)
+
+	for _, title := range []string{"foo", "bar", "baz"} {
+		_, _, err := cache.NewBug(title, "message")
+		require.NoError(t, err)
+	}
+
+	query, err := ParseQuery("title:ba sort:id-asc")
+	require.NoError(t, err)
+
+	var ids []entity.Id
+	it := cache.QueryBugsIter(query)
+	for it.Next() {
+		require.Equal(t, it.Excerpt().Id, it.Value().Id())
+		require.Contains(t, it.Value().Snapshot().Title, "ba")
+		ids = append(ids, it.Excerpt().Id)
+	}
+	require.NoError(t, it.Err())
+
+	// same result as the eager version
+	require.Equal(t, cache.QueryBugs(query), ids)
+
+	count := 0
+	it = cache.QueryBugsIter(nil)
+	for it.Next() {
+		count++
+	}
+	require.Equal(t, 3, count)
+}
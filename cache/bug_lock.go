@@ -0,0 +1,57 @@
+package cache
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/util/git"
+)
+
+// MetaKeyLocked is the metadata key marking a bug as locked, that is
+// restricted to maintainers for new comments. As the lock change over time,
+// it is carried by NoOp operations and the current value is the one of the
+// most recent operation. The key comes from the Gitlab bridge, where the
+// locked issues are found.
+const MetaKeyLocked = "gitlab:locked"
+
+// ErrBugLocked is returned when trying to comment on a locked bug
+var ErrBugLocked = errors.New("the bug is locked, only maintainers can comment")
+
+// IsLocked return true if new comments on the bug are restricted
+func (c *BugCache) IsLocked() bool {
+	value, _ := c.Snapshot().LastMetadata(MetaKeyLocked)
+	return value == "true"
+}
+
+func (c *BugCache) SetLocked(locked bool) (*bug.NoOpOperation, error) {
+	author, err := c.repoCache.GetUserIdentity()
+	if err != nil {
+		return nil, err
+	}
+
+	return c.SetLockedRaw(author, time.Now().Unix(), locked, nil)
+}
+
+func (c *BugCache) SetLockedRaw(author *IdentityCache, unixTime int64, locked bool, metadata map[string]string) (*bug.NoOpOperation, error) {
+	meta := map[string]string{
+		MetaKeyLocked: strconv.FormatBool(locked),
+	}
+	for key, value := range metadata {
+		meta[key] = value
+	}
+
+	return c.OpNoOpRaw(author, unixTime, meta)
+}
+
+// AddCommentOverrideLock is the same as AddCommentWithFiles, without the
+// check of the lock. It's meant for the maintainers.
+func (c *BugCache) AddCommentOverrideLock(message string, files []git.Hash) (*bug.AddCommentOperation, error) {
+	author, err := c.repoCache.GetUserIdentity()
+	if err != nil {
+		return nil, err
+	}
+
+	return c.AddCommentRaw(author, time.Now().Unix(), message, files, nil)
+}
@@ -0,0 +1,46 @@
+package cache
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestBugLock(t *testing.T) {
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	cache, err := NewRepoCache(repo)
+	require.NoError(t, err)
+
+	iden, err := cache.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	err = cache.SetUserIdentity(iden)
+	require.NoError(t, err)
+
+	b, _, err := cache.NewBug("title", "message")
+	require.NoError(t, err)
+	require.False(t, b.IsLocked())
+
+	_, err = b.SetLocked(true)
+	require.NoError(t, err)
+	require.True(t, b.IsLocked())
+
+	_, err = b.AddComment("comment")
+	require.Equal(t, ErrBugLocked, err)
+
+	_, err = b.AddCommentOverrideLock("comment", nil)
+	require.NoError(t, err)
+
+	_, err = b.SetLocked(false)
+	require.NoError(t, err)
+	require.False(t, b.IsLocked())
+
+	_, err = b.AddComment("another comment")
+	require.NoError(t, err)
+	require.NoError(t, b.Commit())
+
+	require.Len(t, b.Snapshot().Comments, 3)
+}
@@ -0,0 +1,50 @@
+package cache
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestBugPin(t *testing.T) {
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	cache, err := NewRepoCache(repo)
+	require.NoError(t, err)
+
+	iden, err := cache.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	err = cache.SetUserIdentity(iden)
+	require.NoError(t, err)
+
+	b1, _, err := cache.NewBug("first", "message")
+	require.NoError(t, err)
+	b2, _, err := cache.NewBug("second", "message")
+	require.NoError(t, err)
+
+	err = b1.Pin()
+	require.NoError(t, err)
+	require.True(t, b1.Snapshot().Pinned)
+
+	query := NewQuery()
+	query.OnlyPinned = true
+	require.Equal(t, []entity.Id{b1.Id()}, cache.QueryBugs(query))
+
+	// the pinned bug comes first, even if the ordering would put it last
+	query = NewQuery()
+	query.OrderDirection = OrderDescending
+	query.PinnedFirst = true
+	require.Equal(t, []entity.Id{b1.Id(), b2.Id()}, cache.QueryBugs(query))
+
+	err = b1.Unpin()
+	require.NoError(t, err)
+	require.False(t, b1.Snapshot().Pinned)
+
+	query = NewQuery()
+	query.OnlyPinned = true
+	require.Empty(t, cache.QueryBugs(query))
+}
@@ -0,0 +1,66 @@
+package cache
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+// Reflog return the past values of the git ref of the bug, most recent
+// first. Only the updates made since the reflog is enabled are recorded.
+func (c *BugCache) Reflog() ([]repository.RefLogEntry, error) {
+	return c.repoCache.repo.RefLog(bugsRefPrefix + c.Id().String())
+}
+
+// Reset restore the bug to a previous state from its reflog, designated
+// with its selector like "@{2}". The reset is itself recorded in the
+// reflog, so it can be undone the same way.
+func (c *BugCache) Reset(selector string) error {
+	if c.NeedCommit() {
+		return fmt.Errorf("the bug has uncommitted operations")
+	}
+
+	if !strings.HasPrefix(selector, "@{") {
+		selector = fmt.Sprintf("@{%s}", selector)
+	}
+
+	entries, err := c.Reflog()
+	if err != nil {
+		return err
+	}
+
+	var target *repository.RefLogEntry
+	for i := range entries {
+		if entries[i].Selector == selector {
+			target = &entries[i]
+			break
+		}
+	}
+	if target == nil {
+		return fmt.Errorf("no reflog entry %s for this bug", selector)
+	}
+
+	ref := bugsRefPrefix + c.Id().String()
+
+	err = c.repoCache.repo.UpdateRef(ref, target.Hash)
+	if err != nil {
+		return err
+	}
+
+	b, err := bug.ReadLocalBug(c.repoCache.repo, c.Id())
+	if err != nil {
+		// don't leave a broken bug behind
+		if len(entries) > 0 {
+			_ = c.repoCache.repo.UpdateRef(ref, entries[0].Hash)
+		}
+		return errors.Wrapf(err, "reading the bug at %s", selector)
+	}
+
+	c.bug = &bug.WithSnapshot{Bug: b}
+
+	return c.repoCache.bugUpdated(c.Id())
+}
@@ -0,0 +1,55 @@
+package cache
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestBugReset(t *testing.T) {
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	cache, err := NewRepoCache(repo)
+	require.NoError(t, err)
+
+	iden, err := cache.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	err = cache.SetUserIdentity(iden)
+	require.NoError(t, err)
+
+	b, _, err := cache.NewBug("first", "message")
+	require.NoError(t, err)
+
+	_, err = b.SetTitle("second")
+	require.NoError(t, err)
+	require.NoError(t, b.Commit())
+
+	entries, err := b.Reflog()
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	require.Equal(t, "@{0}", entries[0].Selector)
+	require.Equal(t, "@{1}", entries[1].Selector)
+	require.False(t, entries[0].Time.IsZero())
+
+	err = b.Reset("@{1}")
+	require.NoError(t, err)
+	require.Equal(t, "first", b.Snapshot().Title)
+
+	excerpt, err := cache.ResolveBugExcerpt(b.Id())
+	require.NoError(t, err)
+	require.Equal(t, "first", excerpt.Title)
+
+	// the reset is recorded, and can be undone
+	entries, err = b.Reflog()
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+
+	err = b.Reset("1")
+	require.NoError(t, err)
+	require.Equal(t, "second", b.Snapshot().Title)
+
+	require.Error(t, b.Reset("@{10}"))
+}
@@ -0,0 +1,56 @@
+package cache
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	bugsRefPrefix = "refs/bugs/"
+
+	// tags created by AddTag are named bug/<bug id>/<name>
+	tagRefNamePrefix = "bug/"
+)
+
+// AddTag create a git tag refs/tags/bug/<bug id>/<name> pointing to the
+// current tip commit of the bug. The bug must not have uncommitted operations.
+func (c *BugCache) AddTag(name string) error {
+	if name == "" || strings.ContainsAny(name, " \t\n") {
+		return fmt.Errorf("invalid tag name \"%s\"", name)
+	}
+
+	if c.NeedCommit() {
+		return fmt.Errorf("the bug has uncommitted operations")
+	}
+
+	hashes, err := c.repoCache.repo.ListCommits(bugsRefPrefix + c.Id().String())
+	if err != nil {
+		return err
+	}
+	if len(hashes) == 0 {
+		return fmt.Errorf("the bug has no commit")
+	}
+
+	ref := fmt.Sprintf("refs/tags/%s%s/%s", tagRefNamePrefix, c.Id(), name)
+
+	exist, err := c.repoCache.repo.RefExist(ref)
+	if err != nil {
+		return err
+	}
+	if exist {
+		return fmt.Errorf("tag %s already exist", strings.TrimPrefix(ref, "refs/tags/"))
+	}
+
+	err = c.repoCache.repo.UpdateRef(ref, hashes[len(hashes)-1])
+	if err != nil {
+		return err
+	}
+
+	tags, err := c.repoCache.repo.BugTags(c.Id())
+	if err != nil {
+		return err
+	}
+	c.bug.Snapshot().Tags = tags
+
+	return nil
+}
@@ -0,0 +1,64 @@
+package cache
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestBugTags(t *testing.T) {
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	cache, err := NewRepoCache(repo)
+	require.NoError(t, err)
+
+	iden, err := cache.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	err = cache.SetUserIdentity(iden)
+	require.NoError(t, err)
+
+	b1, _, err := cache.NewBug("title", "message")
+	require.NoError(t, err)
+	b2, _, err := cache.NewBug("other title", "message")
+	require.NoError(t, err)
+
+	require.Empty(t, b1.Snapshot().Tags)
+
+	// uncommitted operations can't be tagged
+	_, err = b1.AddComment("comment")
+	require.NoError(t, err)
+	require.Error(t, b1.AddTag("v1"))
+
+	require.NoError(t, b1.Commit())
+	require.NoError(t, b1.AddTag("v1"))
+	require.Error(t, b1.AddTag("v1"))
+	require.Error(t, b1.AddTag("with space"))
+
+	tag := "bug/" + b1.Id().String() + "/v1"
+	require.Equal(t, []string{tag}, b1.Snapshot().Tags)
+
+	// the tag still point to a commit of the bug after more edits
+	_, err = b1.AddComment("another comment")
+	require.NoError(t, err)
+	require.NoError(t, b1.Commit())
+
+	tags, err := repo.BugTags(b1.Id())
+	require.NoError(t, err)
+	require.Equal(t, []string{tag}, tags)
+
+	require.Len(t, queryTag(cache, "v*"), 1)
+	require.Len(t, queryTag(cache, tag), 1)
+	require.Empty(t, queryTag(cache, "v2"))
+
+	require.Empty(t, b2.Snapshot().Tags)
+}
+
+func queryTag(cache *RepoCache, pattern string) []entity.Id {
+	query := NewQuery()
+	query.Tag = []Filter{TagFilter(pattern)}
+	return cache.QueryBugs(query)
+}
@@ -0,0 +1,97 @@
+package cache
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/entity"
+)
+
+// LabelTransferred is the label of the bugs transferred to another repository
+const LabelTransferred = "transferred"
+
+// transferRemote is the name of the remote refs used to move the bugs and
+// identities between repositories
+const transferRemote = "git-bug-transfer"
+
+// TransferTo copy the bug, with the identities of its authors and its
+// attached files, into another repository where it keeps the same id. The
+// bug is then closed here with a comment telling where it went, and labeled
+// "transferred". A bug already transferred is skipped.
+func (c *BugCache) TransferTo(dest *RepoCache) error {
+	src := c.repoCache
+
+	if filepath.Clean(src.GetPath()) == filepath.Clean(dest.GetPath()) {
+		return fmt.Errorf("can't transfer a bug to its own repository")
+	}
+
+	for _, l := range c.Snapshot().Labels {
+		if l.String() == LabelTransferred {
+			return nil
+		}
+	}
+
+	// only the committed state can be fetched
+	if c.NeedCommit() {
+		if err := c.Commit(); err != nil {
+			return err
+		}
+	}
+
+	// fetch the refs of the bug and identities from the source repository,
+	// and merge them like any other remote
+	refs := []string{bugsRefPrefix + c.Id().String()}
+	for _, id := range c.bug.IdentityIds() {
+		refs = append(refs, identitiesRefPrefix+id.String())
+	}
+
+	for _, ref := range refs {
+		remoteRef := fmt.Sprintf("refs/remotes/%s/%s", transferRemote, strings.TrimPrefix(ref, "refs/"))
+		_, err := dest.repo.FetchRefs(src.GetPath(), "+"+ref+":"+remoteRef)
+		if err != nil {
+			return err
+		}
+	}
+
+	for result := range dest.MergeAll(transferRemote) {
+		if result.Err != nil {
+			return result.Err
+		}
+		if result.Status == entity.MergeStatusInvalid {
+			return errors.Errorf("merge failure: %s", result.Reason)
+		}
+	}
+
+	if !dest.BugExists(c.Id()) {
+		return fmt.Errorf("the bug %s is missing after the transfer", c.Id().Human())
+	}
+
+	message := fmt.Sprintf("Transferred to %s", transferName(dest))
+	if _, err := c.AddComment(message); err != nil {
+		return err
+	}
+	if c.Snapshot().Status != bug.ClosedStatus {
+		if _, err := c.Close(); err != nil {
+			return err
+		}
+	}
+	if _, _, err := c.ChangeLabels([]string{LabelTransferred}, nil); err != nil {
+		return err
+	}
+
+	return c.Commit()
+}
+
+// transferName return a name for the destination repository of a transfer,
+// the path of its working directory
+func transferName(dest *RepoCache) string {
+	p := filepath.Clean(dest.GetPath())
+	if filepath.Base(p) == ".git" {
+		return filepath.Dir(p)
+	}
+	return p
+}
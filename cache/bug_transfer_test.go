@@ -0,0 +1,67 @@
+package cache
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/repository"
+	"github.com/MichaelMure/git-bug/util/git"
+)
+
+func TestBugTransferTo(t *testing.T) {
+	repoA := repository.CreateTestRepo(false)
+	repoB := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repoA, repoB)
+
+	cacheA, err := NewRepoCache(repoA)
+	require.NoError(t, err)
+	defer cacheA.Close()
+
+	cacheB, err := NewRepoCache(repoB)
+	require.NoError(t, err)
+	defer cacheB.Close()
+
+	iden, err := cacheA.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	require.NoError(t, cacheA.SetUserIdentity(iden))
+
+	hash, err := cacheA.StoreData([]byte("attached"))
+	require.NoError(t, err)
+
+	b, _, err := cacheA.NewBug("title", "message")
+	require.NoError(t, err)
+	_, err = b.AddCommentWithFiles("with a file", []git.Hash{hash})
+	require.NoError(t, err)
+
+	err = b.TransferTo(cacheB)
+	require.NoError(t, err)
+
+	transferred, err := cacheB.ResolveBug(b.Id())
+	require.NoError(t, err)
+	require.Equal(t, "title", transferred.Snapshot().Title)
+	require.Len(t, transferred.Snapshot().Comments, 2)
+	require.Equal(t, bug.OpenStatus, transferred.Snapshot().Status)
+
+	_, err = cacheB.ResolveIdentity(iden.Id())
+	require.NoError(t, err)
+
+	data, err := repoB.ReadData(hash)
+	require.NoError(t, err)
+	require.Equal(t, []byte("attached"), data)
+
+	snap := b.Snapshot()
+	require.Equal(t, bug.ClosedStatus, snap.Status)
+	require.Contains(t, snap.Labels, bug.Label(LabelTransferred))
+	require.False(t, b.NeedCommit())
+
+	// a second transfer does nothing
+	count := len(snap.Operations)
+	err = b.TransferTo(cacheB)
+	require.NoError(t, err)
+	require.Len(t, b.Snapshot().Operations, count)
+
+	err = b.TransferTo(cacheA)
+	require.Error(t, err)
+}
@@ -0,0 +1,112 @@
+package cache
+
+import (
+	"context"
+	"sync"
+
+	"github.com/MichaelMure/git-bug/entity"
+)
+
+// bugWatchers hold the channels registered with WatchBugs
+type bugWatchers struct {
+	mu       sync.Mutex
+	channels map[chan entity.Id]struct{}
+}
+
+// BugEvent is the notification that a bug has been updated
+type BugEvent struct {
+	// the bug updated
+	Id entity.Id
+}
+
+// WatchBugs register a channel that will receive the id of every bug created
+// or updated through the cache, including by a merge. The channel is
+// buffered and an event is dropped if the receiver is not keeping up, so it
+// should be used as a signal to refresh rather than as an exhaustive log.
+//
+// The returned function unregister and close the channel. The channel is
+// also closed when the cache is closed.
+func (c *RepoCache) WatchBugs() (<-chan entity.Id, func()) {
+	c.watchers.mu.Lock()
+	defer c.watchers.mu.Unlock()
+
+	if c.watchers.channels == nil {
+		c.watchers.channels = make(map[chan entity.Id]struct{})
+	}
+
+	ch := make(chan entity.Id, 10)
+	c.watchers.channels[ch] = struct{}{}
+
+	var once sync.Once
+	cancel := func() {
+		once.Do(func() {
+			c.watchers.mu.Lock()
+			defer c.watchers.mu.Unlock()
+			// the channel might already be closed with the cache
+			if _, ok := c.watchers.channels[ch]; ok {
+				delete(c.watchers.channels, ch)
+				close(ch)
+			}
+		})
+	}
+
+	return ch, cancel
+}
+
+// notifyBugWatchers signal the registered watchers that a bug changed
+func (c *RepoCache) notifyBugWatchers(id entity.Id) {
+	c.watchers.mu.Lock()
+	defer c.watchers.mu.Unlock()
+
+	for ch := range c.watchers.channels {
+		select {
+		case ch <- id:
+		default:
+		}
+	}
+}
+
+// closeBugWatchers unregister and close all the watchers channels
+func (c *RepoCache) closeBugWatchers() {
+	c.watchers.mu.Lock()
+	defer c.watchers.mu.Unlock()
+
+	for ch := range c.watchers.channels {
+		delete(c.watchers.channels, ch)
+		close(ch)
+	}
+}
+
+// Watch return a channel receiving an event each time this bug is updated
+// through the cache, with the same delivery guarantee as WatchBugs. The
+// channel is closed when the context is canceled or the cache is closed.
+func (c *BugCache) Watch(ctx context.Context) <-chan BugEvent {
+	events, cancel := c.repoCache.WatchBugs()
+	out := make(chan BugEvent, cap(events))
+	id := c.Id()
+
+	go func() {
+		defer close(out)
+		defer cancel()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case updated, ok := <-events:
+				if !ok {
+					return
+				}
+				if updated != id {
+					continue
+				}
+				select {
+				case out <- BugEvent{Id: updated}:
+				default:
+				}
+			}
+		}
+	}()
+
+	return out
+}
@@ -0,0 +1,82 @@
+package cache
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestWatchBugs(t *testing.T) {
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	cache, err := NewRepoCache(repo)
+	require.NoError(t, err)
+
+	iden, err := cache.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	err = cache.SetUserIdentity(iden)
+	require.NoError(t, err)
+
+	events, cancel := cache.WatchBugs()
+
+	bug1, _, err := cache.NewBug("title", "message")
+	require.NoError(t, err)
+	require.Equal(t, bug1.Id(), <-events)
+
+	_, err = bug1.AddComment("comment")
+	require.NoError(t, err)
+	require.Equal(t, bug1.Id(), <-events)
+
+	cancel()
+	_, ok := <-events
+	require.False(t, ok)
+
+	// no more events after cancel
+	_, err = bug1.AddComment("comment")
+	require.NoError(t, err)
+
+	// calling cancel twice is harmless
+	cancel()
+}
+
+func TestBugWatch(t *testing.T) {
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	cache, err := NewRepoCache(repo)
+	require.NoError(t, err)
+
+	iden, err := cache.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	err = cache.SetUserIdentity(iden)
+	require.NoError(t, err)
+
+	bug1, _, err := cache.NewBug("title", "message")
+	require.NoError(t, err)
+	bug2, _, err := cache.NewBug("title", "message")
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	events := bug1.Watch(ctx)
+
+	// only the events of the watched bug are received
+	_, err = bug2.AddComment("comment")
+	require.NoError(t, err)
+	_, err = bug1.AddComment("comment")
+	require.NoError(t, err)
+	require.Equal(t, BugEvent{Id: bug1.Id()}, <-events)
+
+	cancel()
+	_, ok := <-events
+	require.False(t, ok)
+
+	// closing the cache close the channel
+	events = bug2.Watch(context.Background())
+	require.NoError(t, cache.Close())
+	_, ok = <-events
+	require.False(t, ok)
+}
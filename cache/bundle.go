@@ -0,0 +1,47 @@
+package cache
+
+import (
+	"io"
+
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+// BundleBugs write a git bundle holding the given bugs and the identities
+// they reference, to transfer them to another repository without a shared
+// remote. Only the committed state of the bugs can be bundled, so they are
+// committed first if needed.
+func (c *RepoCache) BundleBugs(ids []entity.Id, w io.Writer) error {
+	seen := make(map[entity.Id]bool)
+	var bundled []entity.Id
+
+	for _, id := range ids {
+		b, err := c.ResolveBug(id)
+		if err != nil {
+			return err
+		}
+
+		if err := b.CommitAsNeeded(); err != nil {
+			return err
+		}
+
+		for _, id := range append([]entity.Id{b.Id()}, b.bug.IdentityIds()...) {
+			if !seen[id] {
+				seen[id] = true
+				bundled = append(bundled, id)
+			}
+		}
+	}
+
+	return c.repo.BundleBugs(bundled, w)
+}
+
+// UnbundleBugs read a git bundle created with BundleBugs and merge the bugs
+// and identities it holds, like when pulling from a remote.
+func (c *RepoCache) UnbundleBugs(r io.Reader) (<-chan entity.MergeResult, error) {
+	if err := c.repo.UnbundleBugs(r); err != nil {
+		return nil, err
+	}
+
+	return c.MergeAll(repository.BundleRemote), nil
+}
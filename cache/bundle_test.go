@@ -0,0 +1,88 @@
+package cache
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/repository"
+	"github.com/MichaelMure/git-bug/util/git"
+)
+
+func TestBundleBugs(t *testing.T) {
+	repoA := repository.CreateTestRepo(false)
+	repoB := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repoA, repoB)
+
+	cacheA, err := NewRepoCache(repoA)
+	require.NoError(t, err)
+	defer cacheA.Close()
+
+	cacheB, err := NewRepoCache(repoB)
+	require.NoError(t, err)
+	defer cacheB.Close()
+
+	iden, err := cacheA.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	require.NoError(t, cacheA.SetUserIdentity(iden))
+
+	hash, err := cacheA.StoreData([]byte("attached"))
+	require.NoError(t, err)
+
+	b1, _, err := cacheA.NewBug("first", "message")
+	require.NoError(t, err)
+	_, err = b1.AddCommentWithFiles("with a file", []git.Hash{hash})
+	require.NoError(t, err)
+
+	b2, _, err := cacheA.NewBug("second", "message")
+	require.NoError(t, err)
+
+	other, _, err := cacheA.NewBug("not bundled", "message")
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	err = cacheA.BundleBugs([]entity.Id{b1.Id(), b2.Id()}, &buf)
+	require.NoError(t, err)
+	require.False(t, b1.NeedCommit())
+
+	results, err := cacheB.UnbundleBugs(&buf)
+	require.NoError(t, err)
+	for result := range results {
+		require.NoError(t, result.Err)
+	}
+
+	unbundled, err := cacheB.ResolveBug(b1.Id())
+	require.NoError(t, err)
+	require.Equal(t, "first", unbundled.Snapshot().Title)
+	require.Len(t, unbundled.Snapshot().Comments, 2)
+
+	require.True(t, cacheB.BugExists(b2.Id()))
+	require.False(t, cacheB.BugExists(other.Id()))
+
+	_, err = cacheB.ResolveIdentity(iden.Id())
+	require.NoError(t, err)
+
+	data, err := repoB.ReadData(hash)
+	require.NoError(t, err)
+	require.Equal(t, []byte("attached"), data)
+
+	// an update of a bug is merged over the existing one
+	_, err = b1.AddComment("update")
+	require.NoError(t, err)
+
+	buf.Reset()
+	err = cacheA.BundleBugs([]entity.Id{b1.Id()}, &buf)
+	require.NoError(t, err)
+
+	results, err = cacheB.UnbundleBugs(&buf)
+	require.NoError(t, err)
+	for result := range results {
+		require.NoError(t, result.Err)
+	}
+
+	excerpt, err := cacheB.ResolveBugExcerpt(b1.Id())
+	require.NoError(t, err)
+	require.Equal(t, 3, excerpt.LenComments)
+}
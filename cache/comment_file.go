@@ -0,0 +1,68 @@
+package cache
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+
+	"github.com/MichaelMure/git-bug/util/text"
+)
+
+// ErrBinaryFile is returned when trying to use a binary file as a comment
+var ErrBinaryFile = errors.New("binary file can't be used as a comment, attach it to the bug instead")
+
+// AddCommentFromFile add a comment with the content of the given file, which
+// is convenient for long text like a full log file. The file size is checked
+// against the configured maximum operation size (git-bug.max-operation-size)
+// before reading it, and binary files are rejected.
+func (c *BugCache) AddCommentFromFile(path string) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	if info.IsDir() {
+		return fmt.Errorf("%s is a directory", path)
+	}
+
+	max, err := c.repoCache.maxOperationSize()
+	if err != nil {
+		return err
+	}
+	if info.Size() > int64(max) {
+		return ErrOperationTooLarge{Size: int(info.Size()), Max: max}
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	if isBinary(data) {
+		return ErrBinaryFile
+	}
+
+	message, err := text.Cleanup(string(data))
+	if err != nil {
+		return err
+	}
+	if message == "" {
+		return fmt.Errorf("%s is empty", path)
+	}
+
+	_, err = c.AddComment(message)
+	return err
+}
+
+// isBinary use the same heuristic as git: a NUL byte in the first 8000 bytes
+// means binary data. Invalid UTF-8 is also rejected as it can't be displayed.
+func isBinary(data []byte) bool {
+	head := data
+	if len(head) > 8000 {
+		head = head[:8000]
+	}
+	return bytes.IndexByte(head, 0) >= 0 || !utf8.Valid(data)
+}
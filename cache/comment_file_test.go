@@ -0,0 +1,60 @@
+package cache
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestAddCommentFromFile(t *testing.T) {
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	cache, err := NewRepoCache(repo)
+	require.NoError(t, err)
+
+	iden, err := cache.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	err = cache.SetUserIdentity(iden)
+	require.NoError(t, err)
+
+	b, _, err := cache.NewBug("title", "message")
+	require.NoError(t, err)
+
+	dir, err := ioutil.TempDir("", "")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	logFile := filepath.Join(dir, "build.log")
+	err = ioutil.WriteFile(logFile, []byte("# build log\nstep 1\r\nstep 2\n"), 0644)
+	require.NoError(t, err)
+
+	err = b.AddCommentFromFile(logFile)
+	require.NoError(t, err)
+	require.Equal(t, "# build log\nstep 1\nstep 2", b.Snapshot().Comments[1].Message)
+
+	binFile := filepath.Join(dir, "core.dump")
+	err = ioutil.WriteFile(binFile, []byte{0x7f, 'E', 'L', 'F', 0, 0, 1}, 0644)
+	require.NoError(t, err)
+
+	err = b.AddCommentFromFile(binFile)
+	require.Equal(t, ErrBinaryFile, err)
+
+	err = repository.NewGitBugConfig(repo).LocalConfig().StoreString(configKeyMaxOperationSize, "100")
+	require.NoError(t, err)
+
+	bigFile := filepath.Join(dir, "big.log")
+	err = ioutil.WriteFile(bigFile, []byte(strings.Repeat("a", 101)), 0644)
+	require.NoError(t, err)
+
+	err = b.AddCommentFromFile(bigFile)
+	require.IsType(t, ErrOperationTooLarge{}, err)
+
+	require.Len(t, b.Snapshot().Comments, 2)
+}
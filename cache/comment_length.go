@@ -0,0 +1,62 @@
+package cache
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+// configKeyMinCommentWords is the config key, under the "git-bug." namespace,
+// to require a minimum number of words in the new comments
+const configKeyMinCommentWords = "min-comment-words"
+
+// ErrCommentTooShort is returned when adding a comment with less words than
+// the configured minimum
+type ErrCommentTooShort struct {
+	Words int
+	Min   int
+}
+
+func (e ErrCommentTooShort) Error() string {
+	return fmt.Sprintf("comment too short (%d words, minimum is %d words)", e.Words, e.Min)
+}
+
+// minCommentWords return the minimum number of words of a new comment, as
+// configured in git-bug.min-comment-words. Zero means no minimum.
+func (c *RepoCache) minCommentWords() (int, error) {
+	val, err := repository.NewGitBugConfig(c.repo).LocalConfig().ReadString(configKeyMinCommentWords)
+	if err == repository.ErrNoConfigEntry {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	min, err := strconv.Atoi(val)
+	if err != nil || min < 0 {
+		return 0, fmt.Errorf("invalid %s%s value: %s", repository.GitBugNamespace, configKeyMinCommentWords, val)
+	}
+
+	return min, nil
+}
+
+// checkCommentLength check that a new comment has at least the configured
+// minimum number of words
+func (c *RepoCache) checkCommentLength(message string) error {
+	min, err := c.minCommentWords()
+	if err != nil {
+		return err
+	}
+	if min == 0 {
+		return nil
+	}
+
+	words := bug.Comment{Message: message}.WordCount()
+	if words < min {
+		return ErrCommentTooShort{Words: words, Min: min}
+	}
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package cache
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestMinCommentWords(t *testing.T) {
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	cache, err := NewRepoCache(repo)
+	require.NoError(t, err)
+
+	iden, err := cache.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	err = cache.SetUserIdentity(iden)
+	require.NoError(t, err)
+
+	b, _, err := cache.NewBug("title", "message")
+	require.NoError(t, err)
+
+	// no minimum by default
+	_, err = b.AddComment("+1")
+	require.NoError(t, err)
+
+	err = repo.LocalConfig().StoreString("git-bug.min-comment-words", "3")
+	require.NoError(t, err)
+
+	_, err = b.AddComment("## +1")
+	require.Equal(t, ErrCommentTooShort{Words: 1, Min: 3}, err)
+
+	_, err = b.AddComment("it happens **here** too")
+	require.NoError(t, err)
+
+	require.Len(t, b.Snapshot().Comments, 3)
+
+	err = repo.LocalConfig().StoreString("git-bug.min-comment-words", "many")
+	require.NoError(t, err)
+
+	_, err = b.AddComment("it happens here too")
+	require.Error(t, err)
+}
@@ -0,0 +1,213 @@
+package cache
+
+import (
+	"bufio"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/repository"
+	"github.com/MichaelMure/git-bug/util/git"
+)
+
+const commitRefsDir = "commit-refs"
+
+// commitPrefixRegexp match a full or abbreviated commit hash, as searched in
+// the index
+var commitPrefixRegexp = regexp.MustCompile(`^[0-9a-f]{4,40}$`)
+
+// fixKeywordRegexp match the references to a bug in a commit message, like
+// "Fixes 4995bfb" or "closes #4995bfb"
+var fixKeywordRegexp = regexp.MustCompile(`(?i)\b(?:fix(?:e[sd])?|close[sd]?|resolve[sd]?)\s+#?([0-9a-f]{7,64})\b`)
+
+func commitRefsDirPath(repo repository.Repo) string {
+	return path.Join(repo.GetPath(), "git-bug", commitRefsDir)
+}
+
+// fixedBugPrefixes return the prefixes of the ids of the bugs a commit
+// message claim to fix
+func fixedBugPrefixes(message string) []string {
+	var prefixes []string
+	for _, match := range fixKeywordRegexp.FindAllStringSubmatch(message, -1) {
+		prefixes = append(prefixes, match[1])
+	}
+	return prefixes
+}
+
+// CrossReference record that a bug is referenced by a commit, given as
+// anything git rev-parse can resolve. Referencing the same commit twice
+// doesn't do anything.
+func (c *RepoCache) CrossReference(bugId entity.Id, commitSHA string) error {
+	commit, err := c.repo.ResolveCommit(commitSHA)
+	if err != nil {
+		return fmt.Errorf("unknown commit %s: %v", commitSHA, err)
+	}
+
+	b, err := c.ResolveBug(bugId)
+	if err != nil {
+		return err
+	}
+
+	for _, ref := range b.Snapshot().CrossRefs {
+		if ref.Commit == commit {
+			return nil
+		}
+	}
+
+	author, err := c.GetUserIdentity()
+	if err != nil {
+		return err
+	}
+
+	_, err = b.CrossRefRaw(author, time.Now().Unix(), commit, nil)
+	if err != nil {
+		return err
+	}
+
+	return b.Commit()
+}
+
+// CrossReferenceCommit cross reference a commit with the bugs its message
+// claim to fix or close, and return their ids. References to unknown bugs
+// are ignored.
+func (c *RepoCache) CrossReferenceCommit(commitSHA string, message string) ([]entity.Id, error) {
+	var ids []entity.Id
+
+	for _, prefix := range fixedBugPrefixes(message) {
+		b, err := c.ResolveBugPrefix(prefix)
+		if err == bug.ErrBugNotExist {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if err := c.CrossReference(b.Id(), commitSHA); err != nil {
+			return nil, err
+		}
+		ids = append(ids, b.Id())
+	}
+
+	return ids, nil
+}
+
+// BugsByCommit return the ids of the bugs referenced by a commit, given with
+// its full hash or a prefix of at least 4 characters. A prefix matching
+// several commits return the bugs referenced by any of them.
+func (c *RepoCache) BugsByCommit(sha string) ([]entity.Id, error) {
+	sha = strings.ToLower(sha)
+	if !commitPrefixRegexp.MatchString(sha) {
+		return nil, fmt.Errorf("invalid commit hash %s", sha)
+	}
+
+	commit := git.Hash(sha)
+	if commit.IsValid() {
+		return readCommitRefs(c.repo, commit)
+	}
+
+	entries, err := ioutil.ReadDir(commitRefsDirPath(c.repo))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var result []entity.Id
+	for _, entry := range entries {
+		if !strings.HasPrefix(entry.Name(), sha) {
+			continue
+		}
+
+		ids, err := readCommitRefs(c.repo, git.Hash(entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, id := range ids {
+			if !containsId(result, id) {
+				result = append(result, id)
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// indexCommitRefs add a bug to the index of the commits referencing it
+func (c *RepoCache) indexCommitRefs(id entity.Id, snap *bug.Snapshot) error {
+	for _, ref := range snap.CrossRefs {
+		if ref.Commit == "" {
+			continue
+		}
+
+		ids, err := readCommitRefs(c.repo, ref.Commit)
+		if err != nil {
+			return err
+		}
+
+		if containsId(ids, id) {
+			continue
+		}
+
+		if err := os.MkdirAll(commitRefsDirPath(c.repo), 0755); err != nil {
+			return err
+		}
+
+		f, err := os.OpenFile(path.Join(commitRefsDirPath(c.repo), ref.Commit.String()),
+			os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+		if err != nil {
+			return err
+		}
+
+		_, err = fmt.Fprintln(f, id)
+		if closeErr := f.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// readCommitRefs read the ids of the bugs referenced by a commit, one per
+// line in its index file
+func readCommitRefs(repo repository.Repo, commit git.Hash) ([]entity.Id, error) {
+	f, err := os.Open(path.Join(commitRefsDirPath(repo), commit.String()))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var ids []entity.Id
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		id := entity.Id(scanner.Text())
+		if err := id.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid index of commit %s: %v", commit, err)
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, scanner.Err()
+}
+
+func containsId(ids []entity.Id, id entity.Id) bool {
+	for _, i := range ids {
+		if i == id {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,75 @@
+package cache
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestFixedBugPrefixes(t *testing.T) {
+	require.Equal(t, []string{"4995bfb", "a70b8b2"},
+		fixedBugPrefixes("Handle the empty titles\n\nFixes #4995bfb, closes a70b8b2"))
+	require.Empty(t, fixedBugPrefixes("Fix the prefix of the ids"))
+}
+
+func TestCrossReference(t *testing.T) {
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	cache, err := NewRepoCache(repo)
+	require.NoError(t, err)
+
+	iden, err := cache.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	require.NoError(t, cache.SetUserIdentity(iden))
+
+	b1, _, err := cache.NewBug("first", "message")
+	require.NoError(t, err)
+	b2, _, err := cache.NewBug("second", "message")
+	require.NoError(t, err)
+
+	tree, err := repo.StoreTree(nil)
+	require.NoError(t, err)
+	commit, err := repo.StoreCommit(tree)
+	require.NoError(t, err)
+
+	require.NoError(t, cache.CrossReference(b1.Id(), string(commit)))
+	// already referenced, given with an abbreviated hash
+	require.NoError(t, cache.CrossReference(b1.Id(), string(commit[:10])))
+
+	require.Len(t, b1.Snapshot().Operations, 2)
+	require.Equal(t, commit, b1.Snapshot().CrossRefs[0].Commit)
+
+	message := "Fix both\n\nFixes #" + b2.Id().Human() + ", closes " + b1.Id().Human() + ", fixes 0000000"
+	ids, err := cache.CrossReferenceCommit(string(commit), message)
+	require.NoError(t, err)
+	require.Equal(t, []entity.Id{b2.Id(), b1.Id()}, ids)
+
+	ids, err = cache.BugsByCommit(string(commit))
+	require.NoError(t, err)
+	require.Equal(t, []entity.Id{b1.Id(), b2.Id()}, ids)
+
+	// searched by prefix
+	ids, err = cache.BugsByCommit(strings.ToUpper(string(commit[:7])))
+	require.NoError(t, err)
+	require.Equal(t, []entity.Id{b1.Id(), b2.Id()}, ids)
+
+	_, err = cache.BugsByCommit(string(commit[:3]))
+	require.Error(t, err)
+
+	ids, err = cache.BugsByCommit("0123456789012345678901234567890123456789")
+	require.NoError(t, err)
+	require.Empty(t, ids)
+
+	_, err = cache.BugsByCommit("../../config")
+	require.Error(t, err)
+
+	err = cache.CrossReference(b1.Id(), "unknown")
+	require.Error(t, err)
+
+	require.NoError(t, cache.Close())
+}
@@ -0,0 +1,110 @@
+package cache
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+const (
+	// configKeyTrackDevice is the config key, under the "git-bug." namespace,
+	// to enable the recording of the device on the new operations
+	configKeyTrackDevice = "track-device"
+
+	// configKeyDeviceId is the config key, under the "git-bug." namespace,
+	// where the identifier of the device is cached
+	configKeyDeviceId = "device-id"
+)
+
+// the files holding the machine id, depending on the system
+var machineIdFiles = []string{
+	"/etc/machine-id",
+	"/var/lib/dbus/machine-id",
+}
+
+// trackDevice return true if the device should be recorded on the new
+// operations, as configured in git-bug.track-device
+func (c *RepoCache) trackDevice() bool {
+	track, err := repository.NewGitBugConfig(c.repo).LocalConfig().ReadBool(configKeyTrackDevice)
+	return err == nil && track
+}
+
+// DeviceId return the opaque identifier of the device, computed on first use
+// and cached in git-bug.device-id
+func (c *RepoCache) DeviceId() (string, error) {
+	config := repository.NewGitBugConfig(c.repo).LocalConfig()
+
+	id, err := config.ReadString(configKeyDeviceId)
+	if err == nil {
+		return id, nil
+	}
+	if err != repository.ErrNoConfigEntry {
+		return "", err
+	}
+
+	id, err = computeDeviceId()
+	if err != nil {
+		return "", err
+	}
+
+	return id, config.StoreString(configKeyDeviceId, id)
+}
+
+// computeDeviceId hash the hostname and the machine id, so that the device
+// can be recognized without exposing those. When the machine id is not
+// available, random bytes are used instead.
+func computeDeviceId() (string, error) {
+	hostname, err := os.Hostname()
+	if err != nil {
+		return "", err
+	}
+
+	var machineId []byte
+	for _, path := range machineIdFiles {
+		data, err := ioutil.ReadFile(path)
+		if err == nil && len(strings.TrimSpace(string(data))) > 0 {
+			machineId = []byte(strings.TrimSpace(string(data)))
+			break
+		}
+	}
+
+	if machineId == nil {
+		machineId = make([]byte, 32)
+		if _, err := rand.Read(machineId); err != nil {
+			return "", err
+		}
+	}
+
+	h := sha256.New()
+	h.Write([]byte(hostname))
+	h.Write(machineId)
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// stampDevice record the device on the given operations that don't have one
+// yet, if enabled
+func (c *RepoCache) stampDevice(ops []bug.Operation) error {
+	if !c.trackDevice() {
+		return nil
+	}
+
+	deviceId, err := c.DeviceId()
+	if err != nil {
+		return err
+	}
+
+	for _, op := range ops {
+		if op.GetDeviceID() == "" {
+			op.SetDeviceID(deviceId)
+		}
+	}
+
+	return nil
+}
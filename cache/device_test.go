@@ -0,0 +1,60 @@
+package cache
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestDeviceTracking(t *testing.T) {
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	cache, err := NewRepoCache(repo)
+	require.NoError(t, err)
+
+	iden, err := cache.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	err = cache.SetUserIdentity(iden)
+	require.NoError(t, err)
+
+	// disabled by default
+	b1, create1, err := cache.NewBug("title", "message")
+	require.NoError(t, err)
+	require.Empty(t, create1.GetDeviceID())
+
+	err = repository.NewGitBugConfig(repo).LocalConfig().StoreBool(configKeyTrackDevice, true)
+	require.NoError(t, err)
+
+	deviceId, err := cache.DeviceId()
+	require.NoError(t, err)
+	require.Len(t, deviceId, 64)
+
+	// the device id is cached
+	again, err := cache.DeviceId()
+	require.NoError(t, err)
+	require.Equal(t, deviceId, again)
+
+	b2, create2, err := cache.NewBug("title", "message")
+	require.NoError(t, err)
+	require.Equal(t, deviceId, create2.GetDeviceID())
+
+	comment, err := b1.AddComment("comment")
+	require.NoError(t, err)
+	require.Equal(t, deviceId, comment.GetDeviceID())
+	require.NoError(t, b1.Commit())
+
+	// the device survive a round trip in git
+	read, err := bug.ReadLocalBug(repo, b2.Id())
+	require.NoError(t, err)
+	require.Equal(t, deviceId, read.FirstOp().GetDeviceID())
+	require.Equal(t, create2.Id(), read.FirstOp().Id())
+
+	read, err = bug.ReadLocalBug(repo, b1.Id())
+	require.NoError(t, err)
+	require.Empty(t, read.FirstOp().GetDeviceID())
+	require.Equal(t, deviceId, read.LastOp().GetDeviceID())
+}
@@ -0,0 +1,154 @@
+package cache
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/entity"
+)
+
+// LabelDuplicate is the label of the bugs marked as duplicate of another one
+const LabelDuplicate = "duplicate"
+
+// titleWords return the set of the lowercase words of a title
+func titleWords(title string) map[string]struct{} {
+	words := make(map[string]struct{})
+	for _, word := range strings.FieldsFunc(strings.ToLower(title), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	}) {
+		words[word] = struct{}{}
+	}
+	return words
+}
+
+// jaccard return the Jaccard index of two sets, the size of their
+// intersection divided by the size of their union
+func jaccard(a, b map[string]struct{}) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+
+	intersection := 0
+	for word := range a {
+		if _, ok := b[word]; ok {
+			intersection++
+		}
+	}
+
+	return float64(intersection) / float64(len(a)+len(b)-intersection)
+}
+
+// DuplicateBugs group the bugs whose titles are similar, as suspected
+// duplicates. The similarity is the Jaccard index of the sets of words of the
+// titles, from 0.0 (no common word) to 1.0 (the same words). Two bugs are in
+// the same group if their similarity is at least the threshold, or if they
+// are both similar to a third bug of the group.
+//
+// The bugs of a group are sorted by creation, the oldest first, and the
+// groups by their oldest bug.
+func (c *RepoCache) DuplicateBugs(threshold float64) ([][]entity.Id, error) {
+	if threshold < 0 || threshold > 1 {
+		return nil, fmt.Errorf("the threshold must be between 0.0 and 1.0")
+	}
+
+	excerpts := make([]*BugExcerpt, 0, len(c.bugExcerpts))
+	for _, excerpt := range c.bugExcerpts {
+		excerpts = append(excerpts, excerpt)
+	}
+	sort.Sort(BugsByCreationTime(excerpts))
+
+	words := make([]map[string]struct{}, len(excerpts))
+	for i, excerpt := range excerpts {
+		words[i] = titleWords(excerpt.Title)
+	}
+
+	// union-find over the indexes of the excerpts, the root of a group
+	// being its oldest bug
+	parent := make([]int, len(excerpts))
+	for i := range parent {
+		parent[i] = i
+	}
+	var find func(i int) int
+	find = func(i int) int {
+		if parent[i] != i {
+			parent[i] = find(parent[i])
+		}
+		return parent[i]
+	}
+
+	for i := range excerpts {
+		for j := i + 1; j < len(excerpts); j++ {
+			if len(words[i]) == 0 || len(words[j]) == 0 {
+				continue
+			}
+			if jaccard(words[i], words[j]) < threshold {
+				continue
+			}
+			ri, rj := find(i), find(j)
+			if ri < rj {
+				parent[rj] = ri
+			} else if rj < ri {
+				parent[ri] = rj
+			}
+		}
+	}
+
+	groups := make(map[int][]entity.Id)
+	var roots []int
+	for i, excerpt := range excerpts {
+		root := find(i)
+		if _, ok := groups[root]; !ok {
+			roots = append(roots, root)
+		}
+		groups[root] = append(groups[root], excerpt.Id)
+	}
+
+	var result [][]entity.Id
+	for _, root := range roots {
+		if len(groups[root]) > 1 {
+			result = append(result, groups[root])
+		}
+	}
+
+	return result, nil
+}
+
+// MarkDuplicateOf link the bug to the original one it duplicates, then close
+// it with a comment and the "duplicate" label
+func (c *BugCache) MarkDuplicateOf(original *BugCache) error {
+	if c.Id() == original.Id() {
+		return fmt.Errorf("a bug can't be a duplicate of itself")
+	}
+
+	if !c.Snapshot().HasLink(bug.RelatesTo, original.Id()) {
+		if _, err := c.AddLink(bug.RelatesTo, original.Id()); err != nil {
+			return err
+		}
+	}
+
+	message := fmt.Sprintf("Duplicate of %s", original.Id().Human())
+	if _, err := c.AddComment(message); err != nil {
+		return err
+	}
+
+	if c.Snapshot().Status != bug.ClosedStatus {
+		if _, err := c.Close(); err != nil {
+			return err
+		}
+	}
+
+	for _, l := range c.Snapshot().Labels {
+		if l.String() == LabelDuplicate {
+			return c.Commit()
+		}
+	}
+
+	if _, _, err := c.ChangeLabels([]string{LabelDuplicate}, nil); err != nil {
+		return err
+	}
+
+	return c.Commit()
+}
@@ -0,0 +1,68 @@
+package cache
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestJaccard(t *testing.T) {
+	require.Equal(t, 1.0, jaccard(titleWords("Crash on start"), titleWords("crash, on START!")))
+	require.Equal(t, 0.5, jaccard(titleWords("crash on start"), titleWords("crash at start")))
+	require.Equal(t, 0.0, jaccard(titleWords("crash"), titleWords("")))
+}
+
+func TestDuplicateBugs(t *testing.T) {
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	cache, err := NewRepoCache(repo)
+	require.NoError(t, err)
+	defer cache.Close()
+
+	rene, err := cache.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	require.NoError(t, cache.SetUserIdentity(rene))
+
+	newBug := func(title string) *BugCache {
+		b, _, err := cache.NewBug(title, "message")
+		require.NoError(t, err)
+		return b
+	}
+
+	crash1 := newBug("Crash on start")
+	other := newBug("Add a dark theme")
+	crash2 := newBug("crash at start")
+	crash3 := newBug("Crash at start on Windows")
+	theme := newBug("Dark theme")
+
+	_, err = cache.DuplicateBugs(1.5)
+	require.Error(t, err)
+
+	groups, err := cache.DuplicateBugs(0.5)
+	require.NoError(t, err)
+	require.Equal(t, [][]entity.Id{
+		{crash1.Id(), crash2.Id(), crash3.Id()},
+		{other.Id(), theme.Id()},
+	}, groups)
+
+	groups, err = cache.DuplicateBugs(0.9)
+	require.NoError(t, err)
+	require.Empty(t, groups)
+
+	require.Error(t, crash1.MarkDuplicateOf(crash1))
+
+	err = crash2.MarkDuplicateOf(crash1)
+	require.NoError(t, err)
+
+	snap := crash2.Snapshot()
+	require.Equal(t, bug.ClosedStatus, snap.Status)
+	require.Contains(t, snap.Labels, bug.Label(LabelDuplicate))
+	require.True(t, snap.HasLink(bug.RelatesTo, crash1.Id()))
+	require.Equal(t, "Duplicate of "+crash1.Id().Human(), snap.Comments[len(snap.Comments)-1].Message)
+	require.False(t, crash2.NeedCommit())
+}
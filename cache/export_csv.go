@@ -0,0 +1,95 @@
+package cache
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/MichaelMure/git-bug/bug"
+)
+
+// csvHeader is the first record of the CSV export
+var csvHeader = []string{
+	"id", "human_id", "title", "status", "author_name", "author_email",
+	"created_at", "last_edit_at", "labels", "milestone", "priority", "assignees",
+}
+
+// ExportCSV write the bugs matching the query as RFC 4180 CSV, one record by
+// bug in the order of the query, after a header record. A nil query export
+// all the bugs. The times are written in RFC 3339 and the labels are
+// separated by commas.
+//
+// The priority is the one of the most prioritized label of the bug, as found
+// in the label metadata. The bugs have no milestone or assignees, these
+// columns are left empty so that the format doesn't change once they do.
+func (c *RepoCache) ExportCSV(w io.Writer, query *Query) error {
+	priorities := make(map[bug.Label]int)
+	labelMetadata, err := c.AllLabelMetadata()
+	if err != nil {
+		return err
+	}
+	for _, meta := range labelMetadata {
+		if meta.Priority != nil {
+			priorities[bug.Label(meta.Name)] = *meta.Priority
+		}
+	}
+
+	cw := csv.NewWriter(w)
+
+	if err := cw.Write(csvHeader); err != nil {
+		return err
+	}
+
+	for _, id := range c.QueryBugs(query) {
+		excerpt, err := c.ResolveBugExcerpt(id)
+		if err != nil {
+			return err
+		}
+
+		var authorName, authorEmail string
+		switch {
+		case excerpt.AuthorId != "":
+			author, err := c.ResolveIdentity(excerpt.AuthorId)
+			if err != nil {
+				return err
+			}
+			authorName, authorEmail = author.DisplayName(), author.Email()
+		case excerpt.LegacyAuthor != LegacyAuthorExcerpt{}:
+			authorName = excerpt.LegacyAuthor.DisplayName()
+		}
+
+		labels := make([]string, len(excerpt.Labels))
+		priority := ""
+		best := 0
+		for i, label := range excerpt.Labels {
+			labels[i] = label.String()
+			// lower values first
+			if p, ok := priorities[label]; ok && (priority == "" || p < best) {
+				priority, best = strconv.Itoa(p), p
+			}
+		}
+
+		err = cw.Write([]string{
+			id.String(),
+			c.HumanId(id),
+			excerpt.Title,
+			excerpt.Status.String(),
+			authorName,
+			authorEmail,
+			time.Unix(excerpt.CreateUnixTime, 0).UTC().Format(time.RFC3339),
+			time.Unix(excerpt.EditUnixTime, 0).UTC().Format(time.RFC3339),
+			strings.Join(labels, ","),
+			"", // milestone
+			priority,
+			"", // assignees
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
@@ -0,0 +1,61 @@
+package cache
+
+import (
+	"bytes"
+	"encoding/csv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestExportCSV(t *testing.T) {
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	cache, err := NewRepoCache(repo)
+	require.NoError(t, err)
+	defer cache.Close()
+
+	rene, err := cache.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+
+	high, low := 1, 5
+	require.NoError(t, cache.StoreLabelMetadata(LabelMetadata{Name: "critical", Priority: &high}))
+	require.NoError(t, cache.StoreLabelMetadata(LabelMetadata{Name: "minor", Priority: &low}))
+
+	first, _, err := cache.NewBugRaw(rene, 1577836800, "first, \"quoted\"", "message", nil, nil)
+	require.NoError(t, err)
+	_, _, err = first.ChangeLabelsRaw(rene, 1577840400, []string{"minor", "critical", "bug"}, nil, nil)
+	require.NoError(t, err)
+
+	second, _, err := cache.NewBugRaw(rene, 1577923200, "second", "message", nil, nil)
+	require.NoError(t, err)
+	_, err = second.CloseRaw(rene, 1577923200, nil)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	err = cache.ExportCSV(&buf, &Query{OrderBy: OrderByCreation, OrderDirection: OrderAscending})
+	require.NoError(t, err)
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	require.NoError(t, err)
+	require.Equal(t, [][]string{
+		csvHeader,
+		{first.Id().String(), first.Id().Human(), "first, \"quoted\"", "open", "René Descartes", "rene@descartes.fr",
+			"2020-01-01T00:00:00Z", "2020-01-01T01:00:00Z", "bug,critical,minor", "", "1", ""},
+		{second.Id().String(), second.Id().Human(), "second", "closed", "René Descartes", "rene@descartes.fr",
+			"2020-01-02T00:00:00Z", "2020-01-02T00:00:00Z", "", "", "", ""},
+	}, records)
+
+	// filtered
+	query, err := ParseQuery("status:closed")
+	require.NoError(t, err)
+	buf.Reset()
+	require.NoError(t, cache.ExportCSV(&buf, query))
+	records, err = csv.NewReader(&buf).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	require.Equal(t, "second", records[1][2])
+}
@@ -1,7 +1,10 @@
 package cache
 
 import (
+	"fmt"
+	"path"
 	"strings"
+	"time"
 
 	"github.com/MichaelMure/git-bug/bug"
 )
@@ -109,6 +112,87 @@ func NoLabelFilter() Filter {
 	}
 }
 
+// OverdueFilter return a Filter that match the open bugs past their due date
+func OverdueFilter() Filter {
+	return func(repoCache *RepoCache, excerpt *BugExcerpt) bool {
+		return excerpt.Status == bug.OpenStatus &&
+			excerpt.DueUnixTime != 0 &&
+			time.Now().Unix() > excerpt.DueUnixTime
+	}
+}
+
+// HasChecklistFilter return a Filter that match the bugs having at least one
+// Markdown task list item
+func HasChecklistFilter() Filter {
+	return func(repoCache *RepoCache, excerpt *BugExcerpt) bool {
+		return excerpt.ChecklistTotal > 0
+	}
+}
+
+// ChecklistCompleteFilter return a Filter that match the bugs having all their
+// Markdown task list items checked
+func ChecklistCompleteFilter() Filter {
+	return func(repoCache *RepoCache, excerpt *BugExcerpt) bool {
+		return excerpt.ChecklistTotal > 0 && excerpt.ChecklistDone == excerpt.ChecklistTotal
+	}
+}
+
+// TagFilter return a Filter that match the bugs having a git tag matching the
+// given glob pattern, either on the full tag name or on the name given with
+// BugCache.AddTag.
+func TagFilter(pattern string) Filter {
+	return func(repoCache *RepoCache, excerpt *BugExcerpt) bool {
+		tags, err := repoCache.repo.BugTags(excerpt.Id)
+		if err != nil {
+			return false
+		}
+
+		short := fmt.Sprintf("%s%s/", tagRefNamePrefix, excerpt.Id)
+		for _, tag := range tags {
+			if match, _ := path.Match(pattern, tag); match {
+				return true
+			}
+			if strings.HasPrefix(tag, short) {
+				if match, _ := path.Match(pattern, strings.TrimPrefix(tag, short)); match {
+					return true
+				}
+			}
+		}
+		return false
+	}
+}
+
+// LastMetadataFilter return a Filter that match the bugs where the most recent
+// operation carrying the given metadata key has the given value, see
+// bug.Snapshot.LastMetadata. As the excerpts don't hold this data, the bugs
+// are loaded.
+func LastMetadataFilter(key string, value string) Filter {
+	return func(repoCache *RepoCache, excerpt *BugExcerpt) bool {
+		b, err := repoCache.ResolveBug(excerpt.Id)
+		if err != nil {
+			return false
+		}
+		current, ok := b.Snapshot().LastMetadata(key)
+		return ok && current == value
+	}
+}
+
+// AnyFilter return a Filter that match if any of the given filters match
+func AnyFilter(filters ...Filter) Filter {
+	if len(filters) == 1 {
+		return filters[0]
+	}
+
+	return func(repoCache *RepoCache, excerpt *BugExcerpt) bool {
+		for _, f := range filters {
+			if f(repoCache, excerpt) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
 // Filters is a collection of Filter that implement a complex filter
 type Filters struct {
 	Status      []Filter
@@ -118,6 +202,10 @@ type Filters struct {
 	Label       []Filter
 	Title       []Filter
 	NoFilters   []Filter
+	Overdue     []Filter
+	Tag         []Filter
+	Checklist   []Filter
+	Metadata    []Filter
 }
 
 // Match check if a bug match the set of filters
@@ -150,6 +238,22 @@ func (f *Filters) Match(repoCache *RepoCache, excerpt *BugExcerpt) bool {
 		return false
 	}
 
+	if match := f.andMatch(f.Overdue, repoCache, excerpt); !match {
+		return false
+	}
+
+	if match := f.andMatch(f.Tag, repoCache, excerpt); !match {
+		return false
+	}
+
+	if match := f.andMatch(f.Checklist, repoCache, excerpt); !match {
+		return false
+	}
+
+	if match := f.andMatch(f.Metadata, repoCache, excerpt); !match {
+		return false
+	}
+
 	return true
 }
 
@@ -2,8 +2,11 @@ package cache
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/MichaelMure/git-bug/bug"
 )
 
 func TestTitleFilter(t *testing.T) {
@@ -32,3 +35,47 @@ func TestTitleFilter(t *testing.T) {
 		})
 	}
 }
+
+func TestOverdueFilter(t *testing.T) {
+	past := time.Now().Add(-time.Hour).Unix()
+	future := time.Now().Add(time.Hour).Unix()
+
+	tests := []struct {
+		name    string
+		status  bug.Status
+		due     int64
+		overdue bool
+	}{
+		{name: "no due date", status: bug.OpenStatus, due: 0, overdue: false},
+		{name: "past due date", status: bug.OpenStatus, due: past, overdue: true},
+		{name: "future due date", status: bug.OpenStatus, due: future, overdue: false},
+		{name: "closed", status: bug.ClosedStatus, due: past, overdue: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			excerpt := &BugExcerpt{Status: tt.status, DueUnixTime: tt.due}
+			assert.Equal(t, tt.overdue, OverdueFilter()(nil, excerpt))
+		})
+	}
+}
+
+func TestChecklistFilters(t *testing.T) {
+	tests := []struct {
+		name     string
+		done     int
+		total    int
+		has      bool
+		complete bool
+	}{
+		{name: "no checklist", done: 0, total: 0, has: false, complete: false},
+		{name: "started", done: 1, total: 3, has: true, complete: false},
+		{name: "complete", done: 2, total: 2, has: true, complete: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			excerpt := &BugExcerpt{ChecklistDone: tt.done, ChecklistTotal: tt.total}
+			assert.Equal(t, tt.has, HasChecklistFilter()(nil, excerpt))
+			assert.Equal(t, tt.complete, ChecklistCompleteFilter()(nil, excerpt))
+		})
+	}
+}
@@ -0,0 +1,185 @@
+package cache
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/identity"
+)
+
+// HealthSeverity qualify how serious a problem found by Healthcheck is
+type HealthSeverity int
+
+const (
+	// The data is usable but something is not as expected
+	HealthWarning HealthSeverity = iota
+	// Some data can't be used
+	HealthError
+)
+
+func (s HealthSeverity) String() string {
+	switch s {
+	case HealthWarning:
+		return "warning"
+	case HealthError:
+		return "error"
+	default:
+		return "unknown"
+	}
+}
+
+// HealthIssue is a problem found by Healthcheck
+type HealthIssue struct {
+	Severity HealthSeverity
+	// the bug affected, if any
+	BugID       entity.Id
+	Description string
+	// a suggested way to fix the problem, if any
+	Suggestion string
+}
+
+// Healthcheck verify the integrity of the repository data and of the cache:
+//   - all the bugs can be read, that is their refs point to valid commits
+//     holding deserializable operations
+//   - the identities referenced by the bugs exist
+//   - no two bugs have been imported from the same remote issue
+//   - the cache index match the bug refs
+func (c *RepoCache) Healthcheck() []HealthIssue {
+	var issues []HealthIssue
+
+	ids, err := bug.ListLocalIds(c.repo)
+	if err != nil {
+		return []HealthIssue{{
+			Severity:    HealthError,
+			Description: fmt.Sprintf("the bugs can't be listed: %v", err),
+		}}
+	}
+
+	inRefs := make(map[entity.Id]struct{}, len(ids))
+
+	for _, id := range ids {
+		inRefs[id] = struct{}{}
+
+		b, err := bug.ReadLocalBug(c.repo, id)
+		if err != nil {
+			issues = append(issues, HealthIssue{
+				Severity:    HealthError,
+				BugID:       id,
+				Description: fmt.Sprintf("the bug can't be read: %v", err),
+				Suggestion:  fmt.Sprintf("fetch the bug again from a remote, or delete the ref refs/bugs/%s", id),
+			})
+			continue
+		}
+
+		if err := b.Validate(); err != nil {
+			issues = append(issues, HealthIssue{
+				Severity:    HealthError,
+				BugID:       id,
+				Description: fmt.Sprintf("the bug is invalid: %v", err),
+				Suggestion:  fmt.Sprintf("fetch the bug again from a remote, or delete the ref refs/bugs/%s", id),
+			})
+			continue
+		}
+
+		issues = append(issues, c.checkBugIdentities(b)...)
+
+		if _, ok := c.bugExcerpts[id]; !ok {
+			issues = append(issues, HealthIssue{
+				Severity:    HealthWarning,
+				BugID:       id,
+				Description: "the bug is missing from the cache index",
+				Suggestion:  fmt.Sprintf("delete %s to rebuild the cache", bugCacheFilePath(c.repo)),
+			})
+		}
+	}
+
+	for id := range c.bugExcerpts {
+		if _, ok := inRefs[id]; !ok {
+			issues = append(issues, HealthIssue{
+				Severity:    HealthWarning,
+				BugID:       id,
+				Description: "the cache index has a bug that doesn't exist anymore",
+				Suggestion:  fmt.Sprintf("delete %s to rebuild the cache", bugCacheFilePath(c.repo)),
+			})
+		}
+	}
+
+	issues = append(issues, c.checkDuplicateRemotes()...)
+
+	sort.SliceStable(issues, func(i, j int) bool {
+		return issues[i].BugID < issues[j].BugID
+	})
+
+	return issues
+}
+
+// checkBugIdentities verify that the identities of the operation authors exist
+func (c *RepoCache) checkBugIdentities(b *bug.Bug) []HealthIssue {
+	var issues []HealthIssue
+	seen := make(map[entity.Id]struct{})
+
+	it := bug.NewOperationIterator(b)
+	for it.Next() {
+		author := it.Value().GetAuthor()
+
+		// legacy identities are embedded in the operations
+		if _, ok := author.(*identity.Bare); ok {
+			continue
+		}
+
+		id := author.Id()
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+
+		if _, err := identity.ReadLocal(c.repo, id); err != nil {
+			issues = append(issues, HealthIssue{
+				Severity:    HealthError,
+				BugID:       b.Id(),
+				Description: fmt.Sprintf("the identity %s can't be read: %v", id.Human(), err),
+				Suggestion:  "pull the identities from a remote with git bug pull",
+			})
+		}
+	}
+
+	return issues
+}
+
+// checkDuplicateRemotes find the bugs imported from the same remote issue by
+// the same bridge, identified by their creation metadata ending in "-url"
+func (c *RepoCache) checkDuplicateRemotes() []HealthIssue {
+	byRemote := make(map[string][]entity.Id)
+
+	for id, excerpt := range c.bugExcerpts {
+		for key, value := range excerpt.CreateMetadata {
+			if strings.HasSuffix(key, "-url") && value != "" {
+				remote := key + "=" + value
+				byRemote[remote] = append(byRemote[remote], id)
+			}
+		}
+	}
+
+	var issues []HealthIssue
+	for remote, ids := range byRemote {
+		if len(ids) < 2 {
+			continue
+		}
+
+		sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+		for _, id := range ids[1:] {
+			issues = append(issues, HealthIssue{
+				Severity:    HealthWarning,
+				BugID:       id,
+				Description: fmt.Sprintf("the bug has been imported from %s like %s", remote, ids[0].Human()),
+				Suggestion:  "close one of the duplicates",
+			})
+		}
+	}
+
+	return issues
+}
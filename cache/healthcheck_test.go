@@ -0,0 +1,51 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestHealthcheck(t *testing.T) {
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	cache, err := NewRepoCache(repo)
+	require.NoError(t, err)
+
+	iden, err := cache.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	err = cache.SetUserIdentity(iden)
+	require.NoError(t, err)
+
+	metadata := map[string]string{"github-url": "https://github.com/a/b/issues/1"}
+
+	bug1, _, err := cache.NewBugRaw(iden, time.Now().Unix(), "title", "message", nil, metadata)
+	require.NoError(t, err)
+
+	require.Empty(t, cache.Healthcheck())
+
+	bug2, _, err := cache.NewBugRaw(iden, time.Now().Unix(), "imported again", "message", nil, metadata)
+	require.NoError(t, err)
+
+	issues := cache.Healthcheck()
+	require.Len(t, issues, 1)
+	require.Equal(t, HealthWarning, issues[0].Severity)
+
+	duplicate := bug2.Id()
+	if bug1.Id() > bug2.Id() {
+		duplicate = bug1.Id()
+	}
+	require.Equal(t, duplicate, issues[0].BugID)
+
+	// a stale entry in the cache index
+	stale := entity.Id("e51f8a8a1f1d7d9c9e4b6a9a4f2b4a1c1d7b6a3ffb8a9d7c4f5e3a2b1c0d9e8f")
+	cache.bugExcerpts[stale] = &BugExcerpt{Id: stale}
+
+	issues = cache.Healthcheck()
+	require.Len(t, issues, 2)
+}
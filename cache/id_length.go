@@ -0,0 +1,54 @@
+package cache
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+// configKeyIdLength is the config key, under the "git-bug." namespace, to
+// set the length of the ids shown to humans
+const configKeyIdLength = "id-length"
+
+// defaultIdLength is the length of the human ids when not configured
+const defaultIdLength = 7
+
+// IdLength return the length of the ids shown to humans, as configured in
+// git-bug.id-length. In large repositories, longer ids avoid the collisions
+// of their prefix. The value is read once and kept in memory.
+func (c *RepoCache) IdLength() (int, error) {
+	if c.idLength != 0 {
+		return c.idLength, nil
+	}
+
+	val, err := repository.NewGitBugConfig(c.repo).LocalConfig().ReadString(configKeyIdLength)
+	if err == repository.ErrNoConfigEntry {
+		c.idLength = defaultIdLength
+		return c.idLength, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	length, err := strconv.Atoi(val)
+	if err != nil || length < entity.HumanIdMinLength || length > entity.HumanIdMaxLength {
+		return 0, fmt.Errorf("invalid %s%s value: %s, it should be between %d and %d",
+			repository.GitBugNamespace, configKeyIdLength, val,
+			entity.HumanIdMinLength, entity.HumanIdMaxLength)
+	}
+
+	c.idLength = length
+	return c.idLength, nil
+}
+
+// HumanId return the given id shortened to the configured length. If the
+// configuration is invalid, the default length is used.
+func (c *RepoCache) HumanId(id entity.Id) string {
+	length, err := c.IdLength()
+	if err != nil {
+		length = defaultIdLength
+	}
+	return entity.HumanId(id, length)
+}
@@ -0,0 +1,43 @@
+package cache
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestIdLength(t *testing.T) {
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	c, err := NewRepoCache(repo)
+	require.NoError(t, err)
+	defer c.Close()
+
+	iden, err := c.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+
+	length, err := c.IdLength()
+	require.NoError(t, err)
+	require.Equal(t, 7, length)
+	require.Equal(t, iden.Id().Human(), c.HumanId(iden.Id()))
+
+	err = repo.LocalConfig().StoreString("git-bug.id-length", "10")
+	require.NoError(t, err)
+
+	// the value is kept in memory
+	require.Equal(t, iden.Id().Human(), c.HumanId(iden.Id()))
+
+	c.idLength = 0
+	require.Equal(t, iden.Id().String()[:10], c.HumanId(iden.Id()))
+
+	c.idLength = 0
+
+	err = repo.LocalConfig().StoreString("git-bug.id-length", "2")
+	require.NoError(t, err)
+	_, err = c.IdLength()
+	require.Error(t, err)
+	require.Equal(t, iden.Id().Human(), c.HumanId(iden.Id()))
+}
@@ -41,3 +41,12 @@ func (i *IdentityCache) CommitAsNeeded() error {
 	}
 	return i.notifyUpdated()
 }
+
+// AddKeys add the given public keys to the valid keys of the identity, and
+// commit the change if any
+func (i *IdentityCache) AddKeys(keys ...identity.Key) error {
+	if !i.Identity.AddKeys(keys...) {
+		return nil
+	}
+	return i.Commit()
+}
@@ -0,0 +1,140 @@
+package cache
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+const labelMetadataDir = "label-metadata"
+
+// LabelMetadata are the values attached to a label in a bug tracker, like
+// the color and description of the Gitlab labels. A label doesn't need
+// metadata, its color is otherwise derived from its name.
+type LabelMetadata struct {
+	Name string `json:"name"`
+	// color in the "#rrggbb" notation, or empty
+	Color       string `json:"color,omitempty"`
+	Description string `json:"description,omitempty"`
+	// the priority of the label, lower values first, or nil if not
+	// prioritized
+	Priority *int `json:"priority,omitempty"`
+	// false for a label inherited from a group
+	ProjectLabel bool `json:"project_label"`
+}
+
+func labelMetadataDirPath(repo repository.Repo) string {
+	return path.Join(repo.GetPath(), "git-bug", labelMetadataDir)
+}
+
+// StoreLabelMetadata store the metadata of a label, replacing the previous
+// ones if any
+func (c *RepoCache) StoreLabelMetadata(meta LabelMetadata) error {
+	if err := bug.Label(meta.Name).Validate(); err != nil {
+		return fmt.Errorf("invalid label: %v", err)
+	}
+
+	if meta.Color != "" {
+		if _, err := bug.ParseLabelColor(meta.Color); err != nil {
+			return err
+		}
+	}
+
+	err := os.MkdirAll(labelMetadataDirPath(c.repo), 0755)
+	if err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(meta, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	// labels are free-form
+	filename := url.PathEscape(meta.Name) + ".json"
+
+	return ioutil.WriteFile(path.Join(labelMetadataDirPath(c.repo), filename), data, 0644)
+}
+
+// AllLabelMetadata return the stored label metadata, sorted by label name
+func (c *RepoCache) AllLabelMetadata() ([]LabelMetadata, error) {
+	files, err := ioutil.ReadDir(labelMetadataDirPath(c.repo))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var all []LabelMetadata
+	for _, file := range files {
+		if file.IsDir() || !strings.HasSuffix(file.Name(), ".json") {
+			continue
+		}
+
+		data, err := ioutil.ReadFile(path.Join(labelMetadataDirPath(c.repo), file.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		var meta LabelMetadata
+		err = json.Unmarshal(data, &meta)
+		if err != nil {
+			return nil, fmt.Errorf("invalid label metadata %s: %v", file.Name(), err)
+		}
+
+		all = append(all, meta)
+	}
+
+	sort.Slice(all, func(i, j int) bool {
+		return all[i].Name < all[j].Name
+	})
+
+	return all, nil
+}
+
+// ResolveLabelMetadata return the stored metadata of a label, or false if
+// there is none
+func (c *RepoCache) ResolveLabelMetadata(label bug.Label) (LabelMetadata, bool, error) {
+	filename := url.PathEscape(label.String()) + ".json"
+
+	data, err := ioutil.ReadFile(path.Join(labelMetadataDirPath(c.repo), filename))
+	if os.IsNotExist(err) {
+		return LabelMetadata{}, false, nil
+	}
+	if err != nil {
+		return LabelMetadata{}, false, err
+	}
+
+	var meta LabelMetadata
+	err = json.Unmarshal(data, &meta)
+	if err != nil {
+		return LabelMetadata{}, false, fmt.Errorf("invalid label metadata %s: %v", filename, err)
+	}
+
+	return meta, true, nil
+}
+
+// LabelColor return the color of a label, the one of its metadata if any,
+// or the one derived from its name
+func (c *RepoCache) LabelColor(label bug.Label) bug.LabelColor {
+	meta, ok, err := c.ResolveLabelMetadata(label)
+	if err != nil || !ok || meta.Color == "" {
+		return label.Color()
+	}
+
+	color, err := bug.ParseLabelColor(meta.Color)
+	if err != nil {
+		return label.Color()
+	}
+
+	return color
+}
@@ -0,0 +1,58 @@
+package cache
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestLabelMetadata(t *testing.T) {
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	cache, err := NewRepoCache(repo)
+	require.NoError(t, err)
+	defer cache.Close()
+
+	all, err := cache.AllLabelMetadata()
+	require.NoError(t, err)
+	require.Empty(t, all)
+
+	priority := 1
+	err = cache.StoreLabelMetadata(LabelMetadata{
+		Name:         "scope/backend",
+		Color:        "#00bcd4",
+		Description:  "The server side",
+		Priority:     &priority,
+		ProjectLabel: true,
+	})
+	require.NoError(t, err)
+
+	err = cache.StoreLabelMetadata(LabelMetadata{Name: "bug"})
+	require.NoError(t, err)
+
+	err = cache.StoreLabelMetadata(LabelMetadata{Name: "invalid", Color: "red"})
+	require.Error(t, err)
+
+	meta, ok, err := cache.ResolveLabelMetadata("scope/backend")
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, "The server side", meta.Description)
+	require.Equal(t, 1, *meta.Priority)
+
+	_, ok, err = cache.ResolveLabelMetadata("unknown")
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	all, err = cache.AllLabelMetadata()
+	require.NoError(t, err)
+	require.Len(t, all, 2)
+	require.Equal(t, "bug", all[0].Name)
+
+	require.Equal(t, bug.LabelColor{R: 0, G: 188, B: 212, A: 255}, cache.LabelColor("scope/backend"))
+	require.Equal(t, bug.Label("bug").Color(), cache.LabelColor("bug"))
+	require.Equal(t, bug.Label("unknown").Color(), cache.LabelColor("unknown"))
+}
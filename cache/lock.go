@@ -0,0 +1,224 @@
+package cache
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"sync"
+	"time"
+
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+const lockfile = "LOCK"
+
+// lockTimeout is how long to wait for another process to release the lock
+// of the repository before giving up
+var lockTimeout = 10 * time.Second
+
+// lockRetryDelay is the delay between two attempts at acquiring the lock
+const lockRetryDelay = 50 * time.Millisecond
+
+// ErrRepoLocked is returned when the lock of the repository couldn't be
+// acquired because another process held it for too long
+var ErrRepoLocked = fmt.Errorf("the repository is locked by another git-bug process")
+
+// repoLock is a lock of a repository shared between the processes. The
+// cache files are read under the shared lock and written under the exclusive
+// one, so a process never write while another one is reading. The lock is
+// only held for the duration of each read or write: a long-lived process,
+// like the webui, doesn't block the writers of the other processes. As they
+// can then run at the same time, the cache files are merged with the ones
+// on disk when written.
+//
+// The lock is held with the file locking of the OS, so it's released
+// automatically if the process crash.
+type repoLock struct {
+	mu sync.Mutex
+	f  *os.File
+	// the lock currently held on the file
+	held lockMode
+	// number of callers currently holding the shared and the exclusive lock
+	shared    int
+	exclusive int
+}
+
+// lockMode is a mode of the file lock, stronger modes covering the weaker ones
+type lockMode int
+
+const (
+	unlocked lockMode = iota
+	sharedLock
+	exclusiveLock
+)
+
+// repoLockFilePath return the path of the lock file. As the repository path is
+// the git common directory, all the worktrees of a repository share the same
+// lock and cache.
+func repoLockFilePath(repo repository.Repo) string {
+	return path.Join(repo.GetPath(), "git-bug", lockfile)
+}
+
+// newRepoLock open the lock file of the repository, without locking it yet
+func newRepoLock(repo repository.Repo) (*repoLock, error) {
+	lockPath := repoLockFilePath(repo)
+
+	err := os.MkdirAll(path.Dir(lockPath), 0755)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.OpenFile(lockPath, os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		return nil, err
+	}
+
+	return &repoLock{f: f}, nil
+}
+
+// tryAcquire try once to take the file lock in the given mode, if not
+// already held. It must be called with l.mu held.
+func (l *repoLock) tryAcquire(mode lockMode) (bool, error) {
+	if l.held >= mode {
+		return true, nil
+	}
+
+	if l.held == sharedLock {
+		// the shared lock is released rather than upgraded, as two processes
+		// upgrading at the same time would wait for each other
+		if err := unlockFile(l.f); err != nil {
+			return false, err
+		}
+		l.held = unlocked
+	}
+
+	ok, err := tryLockFile(l.f, mode == exclusiveLock)
+	if err != nil {
+		return false, err
+	}
+	if ok {
+		l.held = mode
+		return true, nil
+	}
+
+	// get back the shared lock while waiting for the exclusive one, if
+	// another caller still need it
+	if l.shared > 0 {
+		ok, err := tryLockFile(l.f, false)
+		if err != nil {
+			return false, err
+		}
+		if ok {
+			l.held = sharedLock
+		}
+	}
+
+	return false, nil
+}
+
+// lock take the shared or the exclusive lock and return the function
+// releasing it, waiting up to lockTimeout for the other processes to release
+// theirs. Calls can be nested, the file lock is only released when the
+// outermost caller release it.
+func (l *repoLock) lock(exclusive bool) (func(), error) {
+	mode := sharedLock
+	if exclusive {
+		mode = exclusiveLock
+	}
+
+	deadline := time.Now().Add(lockTimeout)
+
+	l.mu.Lock()
+	for {
+		ok, err := l.tryAcquire(mode)
+		if err != nil {
+			l.mu.Unlock()
+			return nil, err
+		}
+		if ok {
+			break
+		}
+
+		if time.Now().After(deadline) {
+			l.mu.Unlock()
+			return nil, ErrRepoLocked
+		}
+
+		// wait without blocking the other goroutines
+		l.mu.Unlock()
+		time.Sleep(lockRetryDelay)
+		l.mu.Lock()
+	}
+
+	if exclusive {
+		l.exclusive++
+	} else {
+		l.shared++
+	}
+	l.mu.Unlock()
+
+	released := false
+	return func() {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+
+		if released {
+			return
+		}
+		released = true
+
+		if exclusive {
+			l.exclusive--
+		} else {
+			l.shared--
+		}
+
+		switch {
+		case l.exclusive > 0:
+		case l.shared > 0:
+			if l.held == exclusiveLock {
+				// downgrading from the exclusive lock never conflict
+				l.held = unlocked
+				if ok, _ := tryLockFile(l.f, false); ok {
+					l.held = sharedLock
+				}
+			}
+		default:
+			_ = unlockFile(l.f)
+			l.held = unlocked
+		}
+	}, nil
+}
+
+// close release the lock and close the lock file
+func (l *repoLock) close() error {
+	err := unlockFile(l.f)
+	if err != nil {
+		_ = l.f.Close()
+		return err
+	}
+	return l.f.Close()
+}
+
+// lockShared take the shared lock of the repository, so that no other
+// git-bug process write it while reading. The returned function release it.
+func (c *RepoCache) lockShared() (func(), error) {
+	if c.lock == nil {
+		return func() {}, nil
+	}
+	return c.lock.lock(false)
+}
+
+// LockExclusive take the exclusive lock of the repository, so that no other
+// git-bug process can read or write it, waiting for the other processes to
+// finish their current read or write. The returned function release it and
+// must be called once the writes are done.
+//
+// Calls can be nested, the lock is released when the outermost caller
+// release it.
+func (c *RepoCache) LockExclusive() (func(), error) {
+	if c.lock == nil {
+		return func() {}, nil
+	}
+	return c.lock.lock(true)
+}
@@ -0,0 +1,201 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestRepoLock(t *testing.T) {
+	defer func(timeout time.Duration) { lockTimeout = timeout }(lockTimeout)
+	lockTimeout = 200 * time.Millisecond
+
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	c, err := NewRepoCache(repo)
+	require.NoError(t, err)
+	defer c.Close()
+
+	// another process can read at the same time
+	other, err := newRepoLock(repo)
+	require.NoError(t, err)
+	releaseOther, err := other.lock(false)
+	require.NoError(t, err)
+
+	// but not while we write
+	_, err = c.LockExclusive()
+	require.Equal(t, ErrRepoLocked, err)
+
+	releaseOther()
+
+	release, err := c.LockExclusive()
+	require.NoError(t, err)
+
+	// nested calls don't release too early
+	releaseNested, err := c.LockExclusive()
+	require.NoError(t, err)
+	releaseNested()
+
+	_, err = other.lock(false)
+	require.Equal(t, ErrRepoLocked, err)
+
+	release()
+
+	releaseOther, err = other.lock(false)
+	require.NoError(t, err)
+	releaseOther()
+	require.NoError(t, other.close())
+}
+
+func TestRepoLockReadDuringWrite(t *testing.T) {
+	defer func(timeout time.Duration) { lockTimeout = timeout }(lockTimeout)
+	lockTimeout = 200 * time.Millisecond
+
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	c, err := NewRepoCache(repo)
+	require.NoError(t, err)
+	defer c.Close()
+
+	releaseShared, err := c.lockShared()
+	require.NoError(t, err)
+
+	// writing while reading in the same process doesn't upgrade the lock
+	release, err := c.LockExclusive()
+	require.NoError(t, err)
+
+	other, err := newRepoLock(repo)
+	require.NoError(t, err)
+	defer other.close()
+
+	_, err = other.lock(false)
+	require.Equal(t, ErrRepoLocked, err)
+
+	// back to the shared lock once the write is done
+	release()
+	releaseOther, err := other.lock(false)
+	require.NoError(t, err)
+	releaseOther()
+
+	releaseShared()
+}
+
+func TestRepoLockLongLivedReader(t *testing.T) {
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	// say, the webui, open for the whole test
+	reader, err := NewRepoCache(repo)
+	require.NoError(t, err)
+	defer reader.Close()
+
+	// say, a bridge pull, writing while the reader is open
+	writer, err := NewRepoCache(repo)
+	require.NoError(t, err)
+
+	rene, err := writer.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	require.NoError(t, writer.SetUserIdentity(rene))
+
+	for i := 0; i < 3; i++ {
+		_, _, err = writer.NewBug("title", "message")
+		require.NoError(t, err)
+	}
+	require.NoError(t, writer.Close())
+
+	// a read waits for the current write to finish
+	writer, err = NewRepoCache(repo)
+	require.NoError(t, err)
+	defer writer.Close()
+
+	release, err := writer.LockExclusive()
+	require.NoError(t, err)
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		release()
+	}()
+
+	fresh, err := NewRepoCache(repo)
+	require.NoError(t, err)
+	defer fresh.Close()
+	require.Len(t, fresh.AllBugsIds(), 3)
+
+	// and the long-lived reader can still write
+	_, err = reader.LockExclusive()
+	require.NoError(t, err)
+}
+
+func TestRepoLockWaitDontBlock(t *testing.T) {
+	defer func(timeout time.Duration) { lockTimeout = timeout }(lockTimeout)
+	lockTimeout = 2 * time.Second
+
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	c, err := NewRepoCache(repo)
+	require.NoError(t, err)
+	defer c.Close()
+
+	other, err := newRepoLock(repo)
+	require.NoError(t, err)
+	defer other.close()
+	releaseOther, err := other.lock(false)
+	require.NoError(t, err)
+
+	// a goroutine wait for the exclusive lock
+	done := make(chan error)
+	go func() {
+		release, err := c.LockExclusive()
+		if err == nil {
+			release()
+		}
+		done <- err
+	}()
+	time.Sleep(100 * time.Millisecond)
+
+	// while the others can still read
+	start := time.Now()
+	releaseShared, err := c.lockShared()
+	require.NoError(t, err)
+	require.True(t, time.Since(start) < time.Second)
+	releaseShared()
+
+	releaseOther()
+	require.NoError(t, <-done)
+}
+
+func TestRepoCacheWriteMerge(t *testing.T) {
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	// say, the webui
+	reader, err := NewRepoCache(repo)
+	require.NoError(t, err)
+	defer reader.Close()
+
+	rene, err := reader.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	require.NoError(t, reader.SetUserIdentity(rene))
+
+	// say, a bridge pull
+	writer, err := NewRepoCache(repo)
+	require.NoError(t, err)
+	b1, _, err := writer.NewBug("title", "message")
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+
+	// the long-lived process doesn't overwrite the excerpts of the other one
+	b2, _, err := reader.NewBug("title", "message")
+	require.NoError(t, err)
+
+	fresh, err := NewRepoCache(repo)
+	require.NoError(t, err)
+	defer fresh.Close()
+	require.ElementsMatch(t, []entity.Id{b1.Id(), b2.Id()}, fresh.AllBugsIds())
+}
@@ -0,0 +1,63 @@
+//go:build windows
+// +build windows
+
+package cache
+
+import (
+	"os"
+	"syscall"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+const (
+	lockfileFailImmediately = 0x1
+	lockfileExclusiveLock   = 0x2
+
+	errorLockViolation syscall.Errno = 33
+	errorNotLocked     syscall.Errno = 158
+)
+
+var (
+	kernel32         = windows.NewLazySystemDLL("kernel32.dll")
+	procLockFileEx   = kernel32.NewProc("LockFileEx")
+	procUnlockFileEx = kernel32.NewProc("UnlockFileEx")
+)
+
+// tryLockFile take a shared or exclusive lock on the file without blocking,
+// and return false if another process hold a conflicting lock. An already
+// held lock is converted.
+func tryLockFile(f *os.File, exclusive bool) (bool, error) {
+	// LockFileEx doesn't convert locks, the one held has to be released first
+	err := unlockFile(f)
+	if err != nil {
+		return false, err
+	}
+
+	var flags uint32 = lockfileFailImmediately
+	if exclusive {
+		flags |= lockfileExclusiveLock
+	}
+
+	ol := new(windows.Overlapped)
+	r, _, err := procLockFileEx.Call(f.Fd(), uintptr(flags), 0, 1, 0, uintptr(unsafe.Pointer(ol)))
+	if r != 0 {
+		return true, nil
+	}
+	if err == errorLockViolation {
+		return false, nil
+	}
+
+	return false, err
+}
+
+// unlockFile release the lock held on the file
+func unlockFile(f *os.File) error {
+	ol := new(windows.Overlapped)
+	r, _, err := procUnlockFileEx.Call(f.Fd(), 0, 1, 0, uintptr(unsafe.Pointer(ol)))
+	if r == 0 && err != errorNotLocked {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+package cache
+
+import (
+	"fmt"
+
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/identity"
+)
+
+// ResolveIdentityExternalAccount retrieve the identity of an account of an
+// external provider like "github" or "gitlab", as recorded by its bridge in
+// the "<provider>-login" metadata. Without a match, the login of the
+// identities is used, if unambiguous.
+func (c *RepoCache) ResolveIdentityExternalAccount(provider string, login string) (*IdentityCache, error) {
+	if provider != "" {
+		i, err := c.ResolveIdentityImmutableMetadata(fmt.Sprintf("%s-login", provider), login)
+		if err != identity.ErrIdentityNotExist {
+			return i, err
+		}
+	}
+
+	matching := make([]entity.Id, 0, 5)
+
+	for id, i := range c.identitiesExcerpts {
+		if i.Login == login {
+			matching = append(matching, id)
+		}
+	}
+
+	if len(matching) > 1 {
+		return nil, identity.NewErrMultipleMatch(matching)
+	}
+
+	if len(matching) == 0 {
+		return nil, identity.ErrIdentityNotExist
+	}
+
+	return c.ResolveIdentity(matching[0])
+}
+
+// Mentions return the identities @-mentioned in the comments of the bug, and
+// separately the logins without a matching identity. The logins are looked
+// up for the provider the bug has been imported from, if any.
+func (c *BugCache) Mentions() ([]entity.Id, []string) {
+	snap := c.Snapshot()
+	provider, _ := snap.GetCreateMetadata(metaKeyOrigin)
+
+	return snap.Mentions(func(login string) (entity.Id, bool) {
+		i, err := c.repoCache.ResolveIdentityExternalAccount(provider, login)
+		if err != nil {
+			return "", false
+		}
+		return i.Id(), true
+	})
+}
@@ -0,0 +1,49 @@
+package cache
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestBugMentions(t *testing.T) {
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	cache, err := NewRepoCache(repo)
+	require.NoError(t, err)
+
+	rene, err := cache.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	err = cache.SetUserIdentity(rene)
+	require.NoError(t, err)
+
+	isaac, err := cache.NewIdentityRaw("Isaac Newton", "", "isaac", "", map[string]string{
+		"github-login": "newton",
+	})
+	require.NoError(t, err)
+
+	// found with its login
+	b1, _, err := cache.NewBug("first", "ping @isaac and @blaise")
+	require.NoError(t, err)
+
+	ids, unresolved := b1.Mentions()
+	require.Equal(t, []entity.Id{isaac.Id()}, ids)
+	require.Equal(t, []string{"blaise"}, unresolved)
+
+	// found with the login of the provider the bug is imported from
+	b2, _, err := cache.NewBugRaw(rene, 1000, "second", "ping @newton", nil, map[string]string{
+		metaKeyOrigin: "github",
+	})
+	require.NoError(t, err)
+
+	ids, unresolved = b2.Mentions()
+	require.Equal(t, []entity.Id{isaac.Id()}, ids)
+	require.Empty(t, unresolved)
+
+	_, err = cache.ResolveIdentityExternalAccount("gitlab", "newton")
+	require.Error(t, err)
+}
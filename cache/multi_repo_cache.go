@@ -3,11 +3,11 @@ package cache
 import (
 	"fmt"
 
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/entity"
 	"github.com/MichaelMure/git-bug/repository"
 )
 
-const lockfile = "lock"
-
 // MultiRepoCache is the root cache, holding multiple RepoCache.
 type MultiRepoCache struct {
 	repos map[string]*RepoCache
@@ -63,6 +63,17 @@ func (c *MultiRepoCache) ResolveRepo(ref string) (*RepoCache, error) {
 	return r, nil
 }
 
+// RepoOfBug retrieve the repository holding a bug. A bug transferred to
+// another repository keep its id, in which case any of them is returned.
+func (c *MultiRepoCache) RepoOfBug(id entity.Id) (*RepoCache, error) {
+	for _, r := range c.repos {
+		if r.BugExists(id) {
+			return r, nil
+		}
+	}
+	return nil, bug.ErrBugNotExist
+}
+
 // Close will do anything that is needed to close the cache properly
 func (c *MultiRepoCache) Close() error {
 	for _, cachedRepo := range c.repos {
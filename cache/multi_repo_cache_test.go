@@ -0,0 +1,38 @@
+package cache
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestMultiRepoCacheRepoOfBug(t *testing.T) {
+	repoA := repository.CreateTestRepo(false)
+	repoB := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repoA, repoB)
+
+	multi := NewMultiRepoCache()
+	require.NoError(t, multi.RegisterRepository("a", repoA))
+	require.NoError(t, multi.RegisterRepository("b", repoB))
+	defer multi.Close()
+
+	cacheB, err := multi.ResolveRepo("b")
+	require.NoError(t, err)
+
+	iden, err := cacheB.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	require.NoError(t, cacheB.SetUserIdentity(iden))
+
+	b, _, err := cacheB.NewBug("title", "message")
+	require.NoError(t, err)
+
+	found, err := multi.RepoOfBug(b.Id())
+	require.NoError(t, err)
+	require.Equal(t, cacheB, found)
+
+	_, err = multi.RepoOfBug("unknown")
+	require.Equal(t, bug.ErrBugNotExist, err)
+}
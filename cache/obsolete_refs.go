@@ -0,0 +1,186 @@
+package cache
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+const (
+	// metadata key holding the target of the bridge a bug has been imported from
+	metaKeyOrigin = "origin"
+
+	// config keys, under the "git-bug." namespace, of the bridges configuration
+	bridgeConfigKeyPrefix = "bridge."
+	bridgeConfigKeyTarget = ".target"
+)
+
+// bridgeTargets is the set of the known bridge targets, whose metadata keys
+// are prefixed by the target name (ex: github-id, gitlab:locked)
+var bridgeTargets = make(map[string]struct{})
+
+// RegisterBridgeTarget declare a bridge target, so that its metadata can be
+// recognized by ObsoleteRefs
+func RegisterBridgeTarget(target string) {
+	bridgeTargets[target] = struct{}{}
+}
+
+// metadataTarget return the bridge target a metadata refers to, if any
+func metadataTarget(key, value string) (string, bool) {
+	target := key
+	if key == metaKeyOrigin {
+		target = value
+	} else if i := strings.IndexAny(key, "-:"); i > 0 {
+		target = key[:i]
+	}
+
+	_, ok := bridgeTargets[target]
+	return target, ok
+}
+
+// configuredTargets return the set of the targets of the configured bridges
+func (c *RepoCache) configuredTargets() (map[string]struct{}, error) {
+	configs, err := repository.NewGitBugConfig(c.repo).LocalConfig().ReadAll(bridgeConfigKeyPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]struct{})
+	for key, value := range configs {
+		if strings.HasSuffix(key, bridgeConfigKeyTarget) {
+			result[value] = struct{}{}
+		}
+	}
+
+	return result, nil
+}
+
+// ObsoleteRefs return the bridge targets referenced in the metadata of the
+// bugs that don't have a configured bridge anymore.
+func (c *RepoCache) ObsoleteRefs() ([]string, error) {
+	configured, err := c.configuredTargets()
+	if err != nil {
+		return nil, err
+	}
+
+	obsolete := make(map[string]struct{})
+
+	for _, id := range c.AllBugsIds() {
+		b, err := c.ResolveBug(id)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, target := range b.metadataTargets() {
+			if _, ok := configured[target]; !ok {
+				obsolete[target] = struct{}{}
+			}
+		}
+	}
+
+	result := make([]string, 0, len(obsolete))
+	for target := range obsolete {
+		result = append(result, target)
+	}
+	sort.Strings(result)
+
+	return result, nil
+}
+
+// StripTargetMetadata hide the metadata referring to the given bridge target
+// in all the bugs, and return the number of bugs changed.
+func (c *RepoCache) StripTargetMetadata(target string) (int, error) {
+	count := 0
+
+	for _, id := range c.AllBugsIds() {
+		b, err := c.ResolveBug(id)
+		if err != nil {
+			return count, err
+		}
+
+		keys := b.targetMetadataKeys(target)
+		if len(keys) == 0 {
+			continue
+		}
+
+		_, err = b.StripMetadata(keys)
+		if err != nil {
+			return count, err
+		}
+
+		err = b.Commit()
+		if err != nil {
+			return count, err
+		}
+
+		count++
+	}
+
+	return count, nil
+}
+
+// metadataTargets return the bridge targets referenced in the metadata of
+// the bug operations
+func (c *BugCache) metadataTargets() []string {
+	set := make(map[string]struct{})
+
+	for _, op := range c.Snapshot().Operations {
+		for key, value := range op.AllMetadata() {
+			if target, ok := metadataTarget(key, value); ok {
+				set[target] = struct{}{}
+			}
+		}
+	}
+
+	result := make([]string, 0, len(set))
+	for target := range set {
+		result = append(result, target)
+	}
+	return result
+}
+
+// targetMetadataKeys return the metadata keys of the bug operations referring
+// to the given bridge target
+func (c *BugCache) targetMetadataKeys(target string) []string {
+	set := make(map[string]struct{})
+
+	for _, op := range c.Snapshot().Operations {
+		for key, value := range op.AllMetadata() {
+			if t, ok := metadataTarget(key, value); ok && t == target {
+				set[key] = struct{}{}
+			}
+		}
+	}
+
+	result := make([]string, 0, len(set))
+	for key := range set {
+		result = append(result, key)
+	}
+	sort.Strings(result)
+	return result
+}
+
+func (c *BugCache) StripMetadata(keys []string) (*bug.StripMetadataOperation, error) {
+	author, err := c.repoCache.GetUserIdentity()
+	if err != nil {
+		return nil, err
+	}
+
+	return c.StripMetadataRaw(author, time.Now().Unix(), keys, nil)
+}
+
+func (c *BugCache) StripMetadataRaw(author *IdentityCache, unixTime int64, keys []string, metadata map[string]string) (*bug.StripMetadataOperation, error) {
+	op, err := bug.StripMetadata(c.bug, author.Identity, unixTime, keys)
+	if err != nil {
+		return nil, err
+	}
+
+	for key, value := range metadata {
+		op.SetMetadata(key, value)
+	}
+
+	return op, c.notifyUpdated()
+}
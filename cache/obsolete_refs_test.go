@@ -0,0 +1,68 @@
+package cache
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestObsoleteRefs(t *testing.T) {
+	RegisterBridgeTarget("github")
+	RegisterBridgeTarget("gitlab")
+
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	cache, err := NewRepoCache(repo)
+	require.NoError(t, err)
+
+	iden, err := cache.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	err = cache.SetUserIdentity(iden)
+	require.NoError(t, err)
+
+	imported, _, err := cache.NewBugRaw(iden, 1000, "imported", "message", nil, map[string]string{
+		metaKeyOrigin: "github",
+		"github-id":   "1234",
+	})
+	require.NoError(t, err)
+
+	exported, _, err := cache.NewBug("exported", "message")
+	require.NoError(t, err)
+	_, err = exported.SetMetadata(exported.Snapshot().Operations[0].Id(), map[string]string{
+		"gitlab-id": "42",
+	})
+	require.NoError(t, err)
+	require.NoError(t, exported.Commit())
+
+	_, _, err = cache.NewBug("local", "message")
+	require.NoError(t, err)
+
+	err = repository.NewGitBugConfig(repo).LocalConfig().StoreString("bridge.default.target", "gitlab")
+	require.NoError(t, err)
+
+	obsolete, err := cache.ObsoleteRefs()
+	require.NoError(t, err)
+	require.Equal(t, []string{"github"}, obsolete)
+
+	count, err := cache.StripTargetMetadata("github")
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+
+	obsolete, err = cache.ObsoleteRefs()
+	require.NoError(t, err)
+	require.Empty(t, obsolete)
+
+	_, ok := imported.Snapshot().Operations[0].GetMetadata("github-id")
+	require.False(t, ok)
+
+	// the strip survive a reload from git
+	require.NoError(t, cache.Close())
+	require.NoError(t, cache.load())
+
+	obsolete, err = cache.ObsoleteRefs()
+	require.NoError(t, err)
+	require.Empty(t, obsolete)
+}
@@ -0,0 +1,46 @@
+package cache
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+// DefaultMaxOperationSize is the maximum size in bytes of a serialized
+// operation, when not configured otherwise
+const DefaultMaxOperationSize = 1024 * 1024
+
+// configKeyMaxOperationSize is the config key, under the "git-bug." namespace,
+// to change the maximum size of an operation
+const configKeyMaxOperationSize = "max-operation-size"
+
+// ErrOperationTooLarge is returned when committing an operation larger than
+// the configured maximum size
+type ErrOperationTooLarge struct {
+	Size int
+	Max  int
+}
+
+func (e ErrOperationTooLarge) Error() string {
+	return fmt.Sprintf("operation too large (%d bytes, maximum is %d bytes)", e.Size, e.Max)
+}
+
+// maxOperationSize return the maximum size of an operation, as configured
+// in git-bug.max-operation-size
+func (c *RepoCache) maxOperationSize() (int, error) {
+	val, err := repository.NewGitBugConfig(c.repo).LocalConfig().ReadString(configKeyMaxOperationSize)
+	if err == repository.ErrNoConfigEntry {
+		return DefaultMaxOperationSize, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	max, err := strconv.Atoi(val)
+	if err != nil || max <= 0 {
+		return 0, fmt.Errorf("invalid %s%s value: %s", repository.GitBugNamespace, configKeyMaxOperationSize, val)
+	}
+
+	return max, nil
+}
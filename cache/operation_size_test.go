@@ -0,0 +1,50 @@
+package cache
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestMaxOperationSize(t *testing.T) {
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	cache, err := NewRepoCache(repo)
+	require.NoError(t, err)
+
+	iden, err := cache.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	err = cache.SetUserIdentity(iden)
+	require.NoError(t, err)
+
+	err = repo.LocalConfig().StoreString("git-bug.max-operation-size", "1000")
+	require.NoError(t, err)
+
+	b, _, err := cache.NewBug("title", "message")
+	require.NoError(t, err)
+
+	_, err = b.AddComment("small")
+	require.NoError(t, err)
+	require.NoError(t, b.Commit())
+
+	_, err = b.AddComment(strings.Repeat("a", 2000))
+	require.NoError(t, err)
+
+	err = b.Commit()
+	require.IsType(t, ErrOperationTooLarge{}, err)
+	require.True(t, b.NeedCommit())
+
+	require.NoError(t, b.CommitOverrideSizeLimit())
+	require.False(t, b.NeedCommit())
+	require.Len(t, b.Snapshot().Comments, 3)
+
+	// imported content is stored as is
+	_, err = b.AddComment(strings.Repeat("a", 2000))
+	require.NoError(t, err)
+	require.NoError(t, b.CommitAsNeeded())
+	require.False(t, b.NeedCommit())
+}
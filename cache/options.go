@@ -0,0 +1,15 @@
+package cache
+
+// Option is a functional option to tune the behavior of a RepoCache
+type Option func(c *RepoCache)
+
+// WithLazyLoading make the RepoCache read the bugs one OperationPack at a
+// time when building the cache, instead of decoding all of them at once.
+// The operations given by ResolveBugOperations are also streamed from git
+// rather than read at once. This trade some speed for a lower memory usage
+// on large repositories.
+func WithLazyLoading() Option {
+	return func(c *RepoCache) {
+		c.lazyLoading = true
+	}
+}
@@ -0,0 +1,483 @@
+package cache
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/repository"
+	"github.com/MichaelMure/git-bug/util/git"
+)
+
+const (
+	identitiesRefPrefix = "refs/identities/"
+
+	// patchRemote is the pseudo remote the refs read from a patch are stored
+	// under before being merged, as with a regular pull
+	patchRemote = "git-bug-patch"
+
+	// patchRefHeader is the mail header holding the ref and the commit a
+	// message carry
+	patchRefHeader = "Git-Bug-Ref"
+
+	// the same fixed date as git format-patch, to recognize the start of a
+	// message in a mbox
+	patchFromDate = "Mon Sep 17 00:00:00 2001"
+
+	patchLineLength = 76
+)
+
+var patchFromLine = regexp.MustCompile(`^From ([0-9a-f]{40}|[0-9a-f]{64}) ` + patchFromDate + `$`)
+
+// patchObject is a raw git object carried in a patch
+type patchObject struct {
+	objectType string
+	hash       git.Hash
+	data       []byte
+}
+
+// patchMessage is a message of a patch, carrying a single commit of a bug or
+// of an identity with the git objects it introduce
+type patchMessage struct {
+	ref     string
+	commit  git.Hash
+	from    string
+	date    time.Time
+	subject string
+	body    string
+	objects []patchObject
+}
+
+// FormatPatch write the given bug, and the identities of its authors, as a
+// mbox in the format of git format-patch, one message per commit. The raw
+// git objects are carried along so that ApplyPatch recreate the exact same
+// commits, allowing to merge the bug later with a regular pull.
+func (c *RepoCache) FormatPatch(id entity.Id, w io.Writer) error {
+	b, err := c.ResolveBug(id)
+	if err != nil {
+		return err
+	}
+
+	snap := b.Snapshot()
+
+	// the identities first, as the bug can't be read without them
+	var refs []string
+	seen := make(map[entity.Id]struct{})
+	for _, op := range snap.Operations {
+		authorId := op.GetAuthor().Id()
+		if _, ok := seen[authorId]; ok {
+			continue
+		}
+		seen[authorId] = struct{}{}
+		refs = append(refs, identitiesRefPrefix+authorId.String())
+	}
+	refs = append(refs, bugsRefPrefix+id.String())
+
+	var messages []*patchMessage
+	written := make(map[git.Hash]struct{})
+
+	for _, ref := range refs {
+		commits, err := c.repo.ListCommits(ref)
+		if err != nil {
+			return err
+		}
+
+		for i, commit := range commits {
+			msg, err := c.patchMessage(snap, ref, commit, i, written)
+			if err != nil {
+				return err
+			}
+			messages = append(messages, msg)
+		}
+	}
+
+	for i, msg := range messages {
+		msg.subject = fmt.Sprintf("[PATCH %d/%d] %s", i+1, len(messages), msg.subject)
+		if err := msg.write(w); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// patchMessage build the message carrying a commit of a ref, with the
+// objects not already written in a previous message
+func (c *RepoCache) patchMessage(snap *bug.Snapshot, ref string, commit git.Hash, index int, written map[git.Hash]struct{}) (*patchMessage, error) {
+	msg := &patchMessage{
+		ref:    ref,
+		commit: commit,
+	}
+
+	err := c.collectObjects(commit, &msg.objects, written)
+	if err != nil {
+		return nil, err
+	}
+
+	if strings.HasPrefix(ref, identitiesRefPrefix) {
+		i, err := c.ResolveIdentity(entity.Id(strings.TrimPrefix(ref, identitiesRefPrefix)))
+		if err != nil {
+			return nil, err
+		}
+		msg.from = fmt.Sprintf("%s <%s>", i.Name(), i.Email())
+		msg.date = i.LastModification().Time()
+		msg.subject = fmt.Sprintf("identity %s", i.DisplayName())
+		msg.body = fmt.Sprintf("Identity %s, version %d.", i.Id().Human(), index+1)
+		return msg, nil
+	}
+
+	ops, err := c.readPackOperations(commit)
+	if err != nil {
+		return nil, err
+	}
+	if len(ops) == 0 {
+		return nil, fmt.Errorf("commit %s has no operation", commit)
+	}
+
+	author, err := c.ResolveIdentity(ops[0].GetAuthor().Id())
+	if err != nil {
+		return nil, err
+	}
+
+	msg.from = fmt.Sprintf("%s <%s>", author.Name(), author.Email())
+	msg.date = ops[0].Time()
+	msg.subject = snap.Title
+
+	var body strings.Builder
+	fmt.Fprintf(&body, "Bug %s, %d operation(s):\n\n", snap.Id().Human(), len(ops))
+	for _, op := range ops {
+		fmt.Fprintf(&body, "  %s %s %s\n", op.Id().Human(), op.Time().Format(time.RFC3339), operationName(op))
+	}
+	msg.body = body.String()
+
+	return msg, nil
+}
+
+// readPackOperations read the operations stored in a commit of a bug
+func (c *RepoCache) readPackOperations(commit git.Hash) ([]bug.Operation, error) {
+	entries, err := c.repo.ListEntries(commit)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, entry := range entries {
+		if entry.Name != "ops" {
+			continue
+		}
+
+		data, err := c.repo.ReadData(entry.Hash)
+		if err != nil {
+			return nil, err
+		}
+
+		var pack bug.OperationPack
+		if err := json.Unmarshal(data, &pack); err != nil {
+			return nil, err
+		}
+
+		return pack.Operations, nil
+	}
+
+	return nil, nil
+}
+
+// operationName return the name of the type of an operation, like
+// "AddComment" for an AddCommentOperation
+func operationName(op bug.Operation) string {
+	name := fmt.Sprintf("%T", op)
+	name = name[strings.LastIndex(name, ".")+1:]
+	return strings.TrimSuffix(name, "Operation")
+}
+
+// collectObjects add the commit, and recursively its tree, to the objects,
+// skipping the ones already written
+func (c *RepoCache) collectObjects(commit git.Hash, objects *[]patchObject, written map[git.Hash]struct{}) error {
+	err := c.collectObject(commit, objects, written)
+	if err != nil {
+		return err
+	}
+
+	tree, err := c.repo.GetTreeHash(commit)
+	if err != nil {
+		return err
+	}
+
+	return c.collectTree(tree, objects, written)
+}
+
+func (c *RepoCache) collectTree(tree git.Hash, objects *[]patchObject, written map[git.Hash]struct{}) error {
+	if _, ok := written[tree]; ok {
+		return nil
+	}
+
+	err := c.collectObject(tree, objects, written)
+	if err != nil {
+		return err
+	}
+
+	entries, err := c.repo.ListEntries(tree)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		switch entry.ObjectType {
+		case repository.Tree:
+			err = c.collectTree(entry.Hash, objects, written)
+		default:
+			err = c.collectObject(entry.Hash, objects, written)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *RepoCache) collectObject(hash git.Hash, objects *[]patchObject, written map[git.Hash]struct{}) error {
+	if _, ok := written[hash]; ok {
+		return nil
+	}
+
+	objectType, data, err := c.repo.ReadRawObject(hash)
+	if err != nil {
+		return err
+	}
+
+	*objects = append(*objects, patchObject{
+		objectType: objectType,
+		hash:       hash,
+		data:       data,
+	})
+	written[hash] = struct{}{}
+
+	return nil
+}
+
+// write the message in the mbox format of git format-patch, the objects
+// being compressed and base64 encoded after the "---" separator
+func (msg *patchMessage) write(w io.Writer) error {
+	var payload bytes.Buffer
+	gz := gzip.NewWriter(&payload)
+	for _, object := range msg.objects {
+		_, err := fmt.Fprintf(gz, "%s %s %d\n", object.objectType, object.hash, len(object.data))
+		if err != nil {
+			return err
+		}
+		if _, err := gz.Write(object.data); err != nil {
+			return err
+		}
+	}
+	if err := gz.Close(); err != nil {
+		return err
+	}
+
+	encoded := base64.StdEncoding.EncodeToString(payload.Bytes())
+
+	var buf strings.Builder
+	fmt.Fprintf(&buf, "From %s %s\n", msg.commit, patchFromDate)
+	fmt.Fprintf(&buf, "From: %s\n", msg.from)
+	fmt.Fprintf(&buf, "Date: %s\n", msg.date.Format(time.RFC1123Z))
+	fmt.Fprintf(&buf, "Subject: %s\n", msg.subject)
+	fmt.Fprintf(&buf, "%s: %s %s\n", patchRefHeader, msg.ref, msg.commit)
+	fmt.Fprintf(&buf, "\n%s\n---\n", strings.TrimRight(msg.body, "\n"))
+	for len(encoded) > patchLineLength {
+		buf.WriteString(encoded[:patchLineLength] + "\n")
+		encoded = encoded[patchLineLength:]
+	}
+	fmt.Fprintf(&buf, "%s\n-- \ngit-bug\n\n", encoded)
+
+	_, err := io.WriteString(w, buf.String())
+	return err
+}
+
+// readPatch parse the messages of a patch written by FormatPatch
+func readPatch(r io.Reader) ([]*patchMessage, error) {
+	var messages []*patchMessage
+	var current *patchMessage
+	var payload strings.Builder
+	inHeaders, inPayload := false, false
+
+	finish := func() error {
+		if current == nil {
+			return nil
+		}
+		if current.ref == "" {
+			return fmt.Errorf("message of commit %s: missing %s header", current.commit, patchRefHeader)
+		}
+		objects, err := decodePatchObjects(payload.String())
+		if err != nil {
+			return errors.Wrapf(err, "message of commit %s", current.commit)
+		}
+		current.objects = objects
+		messages = append(messages, current)
+		return nil
+	}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		if match := patchFromLine.FindStringSubmatch(line); match != nil {
+			if err := finish(); err != nil {
+				return nil, err
+			}
+			current = &patchMessage{commit: git.Hash(match[1])}
+			payload.Reset()
+			inHeaders, inPayload = true, false
+			continue
+		}
+
+		switch {
+		case current == nil:
+			continue
+		case inHeaders:
+			if line == "" {
+				inHeaders = false
+				continue
+			}
+			if strings.HasPrefix(line, patchRefHeader+": ") {
+				fields := strings.Fields(strings.TrimPrefix(line, patchRefHeader+": "))
+				if len(fields) != 2 || git.Hash(fields[1]) != current.commit {
+					return nil, fmt.Errorf("message of commit %s: invalid %s header", current.commit, patchRefHeader)
+				}
+				current.ref = fields[0]
+			}
+		case line == "---" && !inPayload:
+			inPayload = true
+		case line == "-- ":
+			inPayload = false
+		case inPayload:
+			payload.WriteString(line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := finish(); err != nil {
+		return nil, err
+	}
+
+	if len(messages) == 0 {
+		return nil, fmt.Errorf("no git-bug patch found")
+	}
+
+	return messages, nil
+}
+
+func decodePatchObjects(encoded string) ([]patchObject, error) {
+	data, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, err
+	}
+
+	gz, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+
+	raw, err := ioutil.ReadAll(gz)
+	if err != nil {
+		return nil, err
+	}
+
+	var objects []patchObject
+	for len(raw) > 0 {
+		i := bytes.IndexByte(raw, '\n')
+		if i < 0 {
+			return nil, fmt.Errorf("truncated object header")
+		}
+
+		fields := strings.Fields(string(raw[:i]))
+		if len(fields) != 3 {
+			return nil, fmt.Errorf("invalid object header")
+		}
+		size, err := strconv.Atoi(fields[2])
+		if err != nil || size < 0 || i+1+size > len(raw) {
+			return nil, fmt.Errorf("invalid object size")
+		}
+
+		objects = append(objects, patchObject{
+			objectType: fields[0],
+			hash:       git.Hash(fields[1]),
+			data:       raw[i+1 : i+1+size],
+		})
+		raw = raw[i+1+size:]
+	}
+
+	return objects, nil
+}
+
+// ApplyPatch read a patch written by FormatPatch, store its git objects and
+// merge the bug and identities it carry, the same way as with a pull.
+func (c *RepoCache) ApplyPatch(r io.Reader) (<-chan entity.MergeResult, error) {
+	messages, err := readPatch(r)
+	if err != nil {
+		return nil, err
+	}
+
+	// the last commit of each ref
+	heads := make(map[string]git.Hash)
+	var order []string
+
+	for _, msg := range messages {
+		var remoteRef string
+		switch {
+		case strings.HasPrefix(msg.ref, bugsRefPrefix):
+			remoteRef = fmt.Sprintf("refs/remotes/%s/bugs/%s", patchRemote, strings.TrimPrefix(msg.ref, bugsRefPrefix))
+		case strings.HasPrefix(msg.ref, identitiesRefPrefix):
+			remoteRef = fmt.Sprintf("refs/remotes/%s/identities/%s", patchRemote, strings.TrimPrefix(msg.ref, identitiesRefPrefix))
+		default:
+			return nil, fmt.Errorf("unexpected ref %s in the patch", msg.ref)
+		}
+
+		if err := entity.Id(remoteRef[strings.LastIndex(remoteRef, "/")+1:]).Validate(); err != nil {
+			return nil, errors.Wrapf(err, "invalid ref %s in the patch", msg.ref)
+		}
+
+		for _, object := range msg.objects {
+			switch object.objectType {
+			case "blob", "tree", "commit":
+			default:
+				return nil, fmt.Errorf("unexpected object type %s in the patch", object.objectType)
+			}
+
+			hash, err := c.repo.StoreRawObject(object.objectType, object.data)
+			if err != nil {
+				return nil, err
+			}
+			if hash != object.hash {
+				return nil, fmt.Errorf("corrupted patch: object %s stored as %s", object.hash, hash)
+			}
+		}
+
+		if _, ok := heads[remoteRef]; !ok {
+			order = append(order, remoteRef)
+		}
+		heads[remoteRef] = msg.commit
+	}
+
+	for _, ref := range order {
+		if err := c.repo.UpdateRef(ref, heads[ref]); err != nil {
+			return nil, err
+		}
+	}
+
+	return c.MergeAll(patchRemote), nil
+}
@@ -0,0 +1,62 @@
+package cache
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestFormatApplyPatch(t *testing.T) {
+	repoA := repository.CreateTestRepo(false)
+	repoB := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repoA, repoB)
+
+	cacheA, err := NewRepoCache(repoA)
+	require.NoError(t, err)
+	cacheB, err := NewRepoCache(repoB)
+	require.NoError(t, err)
+
+	rene, err := cacheA.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	err = cacheA.SetUserIdentity(rene)
+	require.NoError(t, err)
+
+	b, _, err := cacheA.NewBug("title", "message")
+	require.NoError(t, err)
+	_, err = b.AddComment("comment")
+	require.NoError(t, err)
+	require.NoError(t, b.Commit())
+
+	var patch bytes.Buffer
+	err = cacheA.FormatPatch(b.Id(), &patch)
+	require.NoError(t, err)
+	require.Contains(t, patch.String(), "Subject: [PATCH 1/3] identity René Descartes")
+	require.Contains(t, patch.String(), "Subject: [PATCH 3/3] title")
+
+	results, err := cacheB.ApplyPatch(bytes.NewReader(patch.Bytes()))
+	require.NoError(t, err)
+	for result := range results {
+		require.NoError(t, result.Err)
+		require.Equal(t, entity.MergeStatusNew, result.Status)
+	}
+
+	imported, err := cacheB.ResolveBug(b.Id())
+	require.NoError(t, err)
+	require.Equal(t, "title", imported.Snapshot().Title)
+	require.Len(t, imported.Snapshot().Comments, 2)
+
+	// applying the same patch again change nothing
+	results, err = cacheB.ApplyPatch(bytes.NewReader(patch.Bytes()))
+	require.NoError(t, err)
+	for result := range results {
+		require.NoError(t, result.Err)
+		require.Equal(t, entity.MergeStatusNothing, result.Status)
+	}
+
+	_, err = cacheB.ApplyPatch(bytes.NewBufferString("not a patch"))
+	require.Error(t, err)
+}
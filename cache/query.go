@@ -10,6 +10,11 @@ type Query struct {
 	Filters
 	OrderBy
 	OrderDirection
+
+	// only match the pinned bugs
+	OnlyPinned bool
+	// list the pinned bugs first, whatever the ordering
+	PinnedFirst bool
 }
 
 // Return an identity query with default sorting (creation-desc)
@@ -20,11 +25,30 @@ func NewQuery() *Query {
 	}
 }
 
+// Match check if a bug match the query
+func (q *Query) Match(repoCache *RepoCache, excerpt *BugExcerpt) bool {
+	if q.OnlyPinned && !excerpt.Pinned {
+		return false
+	}
+	return q.Filters.Match(repoCache, excerpt)
+}
+
+// OnlyStatus return true if the query doesn't filter on anything else than
+// the status of the bugs
+func (q *Query) OnlyStatus() bool {
+	f := q.Filters
+	return !q.OnlyPinned && len(f.Author) == 0 && len(f.Actor) == 0 &&
+		len(f.Participant) == 0 && len(f.Label) == 0 && len(f.Title) == 0 &&
+		len(f.NoFilters) == 0 && len(f.Overdue) == 0 && len(f.Tag) == 0 &&
+		len(f.Checklist) == 0 && len(f.Metadata) == 0
+}
+
 // ParseQuery parse a query DSL
 //
 // Ex: "status:open author:descartes sort:edit-asc"
 //
-// Supported filter qualifiers and syntax are described in docs/queries.md
+// Terms without qualifier are searched in the bug titles. Supported filter
+// qualifiers and syntax are described in docs/queries.md
 func ParseQuery(query string) (*Query, error) {
 	fields := splitQuery(query)
 
@@ -36,8 +60,13 @@ func ParseQuery(query string) (*Query, error) {
 	sortingDone := false
 
 	for _, field := range fields {
-		split := strings.Split(field, ":")
-		if len(split) != 2 {
+		split := strings.SplitN(field, ":", 2)
+		if len(split) == 1 {
+			// keyword search
+			result.Title = append(result.Title, TitleFilter(removeQuote(field)))
+			continue
+		}
+		if split[0] == "" {
 			return nil, fmt.Errorf("can't parse \"%s\"", field)
 		}
 
@@ -65,8 +94,12 @@ func ParseQuery(query string) (*Query, error) {
 			result.Participant = append(result.Participant, f)
 
 		case "label":
-			f := LabelFilter(qualifierQuery)
-			result.Label = append(result.Label, f)
+			// a comma separated list match any of the labels
+			var filters []Filter
+			for _, label := range strings.Split(qualifierQuery, ",") {
+				filters = append(filters, LabelFilter(label))
+			}
+			result.Label = append(result.Label, AnyFilter(filters...))
 
 		case "title":
 			f := TitleFilter(qualifierQuery)
@@ -164,6 +197,30 @@ func (q *Query) parseSorting(query string) error {
 		q.OrderBy = OrderByEdit
 		q.OrderDirection = OrderAscending
 
+	// default DESC
+	case "activity", "activity-desc":
+		q.OrderBy = OrderByActivity
+		q.OrderDirection = OrderDescending
+	case "activity-asc":
+		q.OrderBy = OrderByActivity
+		q.OrderDirection = OrderAscending
+
+	// default DESC
+	case "weight", "weight-desc":
+		q.OrderBy = OrderByWeight
+		q.OrderDirection = OrderDescending
+	case "weight-asc":
+		q.OrderBy = OrderByWeight
+		q.OrderDirection = OrderAscending
+
+	// default ASC, to surface the most negative bugs first
+	case "sentiment", "sentiment-asc":
+		q.OrderBy = OrderBySentiment
+		q.OrderDirection = OrderAscending
+	case "sentiment-desc":
+		q.OrderBy = OrderBySentiment
+		q.OrderDirection = OrderDescending
+
 	default:
 		return fmt.Errorf("unknow sorting %s", query)
 	}
@@ -1,6 +1,12 @@
 package cache
 
-import "testing"
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/repository"
+)
 
 func TestQueryParse(t *testing.T) {
 
@@ -8,7 +14,10 @@ func TestQueryParse(t *testing.T) {
 		input string
 		ok    bool
 	}{
-		{"gibberish", false},
+		{"gibberish", true},
+		{`"multi word gibberish"`, true},
+		{":gibberish", false},
+		{"unknown:gibberish", false},
 
 		{"status:", false},
 
@@ -24,11 +33,17 @@ func TestQueryParse(t *testing.T) {
 
 		{"label:hello", true},
 		{`label:"Good first issue"`, true},
+		{"label:bug,enhancement", true},
 
 		{"title:titleOne", true},
 		{`title:"Bug titleTwo"`, true},
 
 		{"sort:edit", true},
+		{"sort:activity", true},
+		{"sort:activity-asc", true},
+		{"sort:weight", true},
+		{"sort:sentiment", true},
+		{"sort:sentiment-desc", true},
 		{"sort:unknown", false},
 	}
 
@@ -39,3 +54,47 @@ func TestQueryParse(t *testing.T) {
 		}
 	}
 }
+
+func TestQueryMatch(t *testing.T) {
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	cache, err := NewRepoCache(repo)
+	require.NoError(t, err)
+
+	iden, err := cache.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	err = cache.SetUserIdentity(iden)
+	require.NoError(t, err)
+
+	crash, _, err := cache.NewBug("crash on start", "message")
+	require.NoError(t, err)
+	_, _, err = crash.ChangeLabels([]string{"bug"}, nil)
+	require.NoError(t, err)
+
+	feature, _, err := cache.NewBug("dark mode", "message")
+	require.NoError(t, err)
+	_, _, err = feature.ChangeLabels([]string{"enhancement"}, nil)
+	require.NoError(t, err)
+
+	_, _, err = cache.NewBug("crash on exit", "message")
+	require.NoError(t, err)
+
+	var tests = []struct {
+		input    string
+		expected int
+	}{
+		{"label:bug", 1},
+		{"label:bug,enhancement", 2},
+		{"label:bug label:enhancement", 0},
+		{"crash", 2},
+		{`"on start"`, 1},
+		{"crash label:bug,enhancement", 1},
+	}
+
+	for _, test := range tests {
+		query, err := ParseQuery(test.input)
+		require.NoError(t, err)
+		require.Len(t, cache.QueryBugs(query), test.expected, test.input)
+	}
+}
@@ -0,0 +1,90 @@
+package cache
+
+import (
+	"time"
+
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/entity"
+)
+
+// ReindexIdentities replace the author of all the operations authored by the
+// identity oldId with the identity newId, by adding EditAuthorOperation with
+// the user identity as author. It's meant to be used after merging two
+// identities of the same person. The modified bugs are committed. It returns
+// the number of re-authored operations.
+func (c *RepoCache) ReindexIdentities(oldId entity.Id, newId entity.Id) (int, error) {
+	if oldId == newId {
+		return 0, nil
+	}
+
+	author, err := c.GetUserIdentity()
+	if err != nil {
+		return 0, err
+	}
+
+	newAuthor, err := c.ResolveIdentity(newId)
+	if err != nil {
+		return 0, err
+	}
+
+	unixTime := time.Now().Unix()
+	count := 0
+
+	for _, id := range c.AllBugsIds() {
+		b, err := c.ResolveBug(id)
+		if err != nil {
+			return count, err
+		}
+
+		var targets []entity.Id
+		for _, op := range b.Snapshot().Operations {
+			if _, ok := op.(*bug.EditAuthorOperation); ok {
+				continue
+			}
+			if op.GetAuthor().Id() == oldId {
+				targets = append(targets, op.Id())
+			}
+		}
+
+		if len(targets) == 0 {
+			continue
+		}
+
+		for _, target := range targets {
+			_, err := b.EditAuthorRaw(author, unixTime, target, newAuthor, nil)
+			if err != nil {
+				return count, err
+			}
+			count++
+		}
+
+		err = b.Commit()
+		if err != nil {
+			return count, err
+		}
+	}
+
+	return count, nil
+}
+
+func (c *BugCache) EditAuthor(target entity.Id, newAuthor *IdentityCache) (*bug.EditAuthorOperation, error) {
+	author, err := c.repoCache.GetUserIdentity()
+	if err != nil {
+		return nil, err
+	}
+
+	return c.EditAuthorRaw(author, time.Now().Unix(), target, newAuthor, nil)
+}
+
+func (c *BugCache) EditAuthorRaw(author *IdentityCache, unixTime int64, target entity.Id, newAuthor *IdentityCache, metadata map[string]string) (*bug.EditAuthorOperation, error) {
+	op, err := bug.EditAuthor(c.bug, author.Identity, unixTime, target, newAuthor.Identity)
+	if err != nil {
+		return nil, err
+	}
+
+	for key, value := range metadata {
+		op.SetMetadata(key, value)
+	}
+
+	return op, c.notifyUpdated()
+}
@@ -0,0 +1,76 @@
+package cache
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestReindexIdentities(t *testing.T) {
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	cache, err := NewRepoCache(repo)
+	require.NoError(t, err)
+
+	oldIden, err := cache.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	newIden, err := cache.NewIdentity("René Descartes", "rene.descartes@example.com")
+	require.NoError(t, err)
+	other, err := cache.NewIdentity("Blaise Pascal", "blaise@pascal.fr")
+	require.NoError(t, err)
+	err = cache.SetUserIdentity(oldIden)
+	require.NoError(t, err)
+
+	bug1, _, err := cache.NewBug("title", "message")
+	require.NoError(t, err)
+	_, err = bug1.AddCommentRaw(other, 1000, "other", nil, nil)
+	require.NoError(t, err)
+	_, err = bug1.AddComment("comment")
+	require.NoError(t, err)
+	require.NoError(t, bug1.Commit())
+
+	bug2, _, err := cache.NewBugRaw(other, 1000, "title", "message", nil, nil)
+	require.NoError(t, err)
+
+	count, err := cache.ReindexIdentities(oldIden.Id(), newIden.Id())
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+
+	check := func(cache *RepoCache) {
+		b, err := cache.ResolveBug(bug1.Id())
+		require.NoError(t, err)
+
+		snap := b.Snapshot()
+		require.Len(t, snap.Operations, 5)
+		require.Equal(t, newIden.Id(), snap.Author.Id())
+		require.Equal(t, newIden.Id(), snap.Comments[0].Author.Id())
+		require.Equal(t, other.Id(), snap.Comments[1].Author.Id())
+		require.Equal(t, newIden.Id(), snap.Comments[2].Author.Id())
+		require.False(t, b.NeedCommit())
+
+		for _, actor := range snap.Actors {
+			require.NotEqual(t, oldIden.Id(), actor.Id())
+		}
+
+		b, err = cache.ResolveBug(bug2.Id())
+		require.NoError(t, err)
+		require.Len(t, b.Snapshot().Operations, 1)
+	}
+
+	check(cache)
+
+	// reindexing again doesn't change anything
+	count, err = cache.ReindexIdentities(oldIden.Id(), newIden.Id())
+	require.NoError(t, err)
+	require.Equal(t, 0, count)
+
+	require.NoError(t, cache.Close())
+
+	// the change is stored in the repository
+	cache, err = NewRepoCache(repo)
+	require.NoError(t, err)
+	check(cache)
+}
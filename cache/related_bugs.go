@@ -0,0 +1,39 @@
+package cache
+
+import (
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/entity"
+)
+
+// RelatedBugs resolve the bugs linked from the given snapshot, in the order
+// of the links. The bugs that can't be found, for example because they have
+// been removed after being linked, are ignored.
+func (c *RepoCache) RelatedBugs(snap *bug.Snapshot) ([]*BugCache, error) {
+	var result []*BugCache
+	seen := make(map[entity.Id]struct{})
+
+	for _, link := range snap.Links {
+		if _, ok := seen[link.Target]; ok {
+			continue
+		}
+		seen[link.Target] = struct{}{}
+
+		b, err := c.ResolveBug(link.Target)
+		if err == bug.ErrBugNotExist {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, b)
+	}
+
+	return result, nil
+}
+
+// RelatedBugs resolve the bugs linked from this bug, ignoring the ones that
+// can't be found
+func (c *BugCache) RelatedBugs() ([]*BugCache, error) {
+	return c.repoCache.RelatedBugs(c.Snapshot())
+}
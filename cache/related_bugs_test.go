@@ -0,0 +1,49 @@
+package cache
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestRelatedBugs(t *testing.T) {
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	cache, err := NewRepoCache(repo)
+	require.NoError(t, err)
+
+	iden, err := cache.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	err = cache.SetUserIdentity(iden)
+	require.NoError(t, err)
+
+	parent, _, err := cache.NewBug("parent", "message")
+	require.NoError(t, err)
+	related, _, err := cache.NewBug("related", "message")
+	require.NoError(t, err)
+	child, _, err := cache.NewBug("child", "message")
+	require.NoError(t, err)
+
+	related0, err := child.RelatedBugs()
+	require.NoError(t, err)
+	require.Empty(t, related0)
+
+	_, err = child.AddLink(bug.ChildOf, parent.Id())
+	require.NoError(t, err)
+	_, err = child.AddLink(bug.RelatesTo, related.Id())
+	require.NoError(t, err)
+	// a bug that doesn't exist (anymore)
+	_, err = child.AddLink(bug.RelatesTo, entity.Id("1234567890123456789012345678901234567890123456789012345678901234"))
+	require.NoError(t, err)
+
+	bugs, err := child.RelatedBugs()
+	require.NoError(t, err)
+	require.Len(t, bugs, 2)
+	require.Equal(t, parent.Id(), bugs[0].Id())
+	require.Equal(t, related.Id(), bugs[1].Id())
+}
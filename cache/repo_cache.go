@@ -2,24 +2,23 @@ package cache
 
 import (
 	"bytes"
+	"context"
 	"encoding/gob"
 	"fmt"
-	"io"
-	"io/ioutil"
 	"os"
 	"path"
+	"runtime"
 	"sort"
-	"strconv"
 	"time"
 
 	"github.com/pkg/errors"
+	"golang.org/x/sync/errgroup"
 
 	"github.com/MichaelMure/git-bug/bug"
 	"github.com/MichaelMure/git-bug/entity"
 	"github.com/MichaelMure/git-bug/identity"
 	"github.com/MichaelMure/git-bug/repository"
 	"github.com/MichaelMure/git-bug/util/git"
-	"github.com/MichaelMure/git-bug/util/process"
 )
 
 const bugCacheFile = "bug-cache"
@@ -27,7 +26,9 @@ const identityCacheFile = "identity-cache"
 
 // 1: original format
 // 2: added cache for identities with a reference in the bug cache
-const formatVersion = 2
+// 3: added the due date, external creation, checklist, pinned, activity, weight,
+// references and sentiment values to the bug excerpt
+const formatVersion = 3
 
 type ErrInvalidCacheFormat struct {
 	message string
@@ -51,14 +52,18 @@ var _ repository.RepoCommon = &RepoCache{}
 // 4. The same way, the cache maintain in memory a single copy of the loaded identities.
 //
 // The cache also protect the on-disk data by locking the git repository for its
-// own usage, by writing a lock file. Of course, normal git operations are not
-// affected, only git-bug related one.
+// own usage, with a lock file shared with the other git-bug processes. Of
+// course, normal git operations are not affected, only git-bug related one.
 type RepoCache struct {
 	// the underlying repo
 	repo repository.ClockedRepo
 
 	// excerpt of bugs data for all bugs
 	bugExcerpts map[entity.Id]*BugExcerpt
+	// index of the bug ids by status, maintained along the excerpts
+	bugsByStatus map[bug.Status]map[entity.Id]struct{}
+	// ids of the excerpts changed since the bug cache file was written
+	changedBugExcerpts map[entity.Id]struct{}
 	// bug loaded in memory
 	bugs map[entity.Id]*BugCache
 
@@ -69,19 +74,36 @@ type RepoCache struct {
 
 	// the user identity's id, if known
 	userIdentityId entity.Id
+
+	// read the bugs lazily when building the cache
+	lazyLoading bool
+
+	// channels registered to be notified of bug changes
+	watchers bugWatchers
+
+	// lock shared with the other git-bug processes using the repository
+	lock *repoLock
+
+	// length of the human ids, once read from the config
+	idLength int
 }
 
-func NewRepoCache(r repository.ClockedRepo) (*RepoCache, error) {
+func NewRepoCache(r repository.ClockedRepo, opts ...Option) (*RepoCache, error) {
 	c := &RepoCache{
 		repo:       r,
 		bugs:       make(map[entity.Id]*BugCache),
 		identities: make(map[entity.Id]*IdentityCache),
 	}
 
-	err := c.lock()
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	lock, err := newRepoLock(r)
 	if err != nil {
 		return &RepoCache{}, err
 	}
+	c.lock = lock
 
 	err = c.load()
 	if err == nil {
@@ -134,36 +156,27 @@ func (c *RepoCache) GetUserEmail() (string, error) {
 	return c.repo.GetUserEmail()
 }
 
-func (c *RepoCache) lock() error {
-	lockPath := repoLockFilePath(c.repo)
-
-	err := repoIsAvailable(c.repo)
-	if err != nil {
-		return err
-	}
-
-	f, err := os.Create(lockPath)
-	if err != nil {
-		return err
-	}
-
-	pid := fmt.Sprintf("%d", os.Getpid())
-	_, err = f.WriteString(pid)
-	if err != nil {
-		return err
-	}
-
-	return f.Close()
+// StoreData will store arbitrary data and return the corresponding hash
+func (c *RepoCache) StoreData(data []byte) (git.Hash, error) {
+	return c.repo.StoreData(data)
 }
 
 func (c *RepoCache) Close() error {
+	c.closeBugWatchers()
+
 	c.identities = make(map[entity.Id]*IdentityCache)
 	c.identitiesExcerpts = nil
 	c.bugs = make(map[entity.Id]*BugCache)
 	c.bugExcerpts = nil
+	c.bugsByStatus = nil
+	c.changedBugExcerpts = nil
 
-	lockPath := repoLockFilePath(c.repo)
-	return os.Remove(lockPath)
+	if c.lock == nil {
+		return nil
+	}
+	err := c.lock.close()
+	c.lock = nil
+	return err
 }
 
 // bugUpdated is a callback to trigger when the excerpt of a bug changed,
@@ -174,7 +187,13 @@ func (c *RepoCache) bugUpdated(id entity.Id) error {
 		panic("missing bug in the cache")
 	}
 
-	c.bugExcerpts[id] = NewBugExcerpt(b.bug, b.Snapshot())
+	snap := b.Snapshot()
+	c.setBugExcerpt(NewBugExcerpt(b.bug, snap))
+	c.notifyBugWatchers(id)
+
+	if err := c.indexCommitRefs(id, snap); err != nil {
+		return err
+	}
 
 	// we only need to write the bug cache
 	return c.writeBugCache()
@@ -196,7 +215,13 @@ func (c *RepoCache) identityUpdated(id entity.Id) error {
 
 // load will try to read from the disk all the cache files
 func (c *RepoCache) load() error {
-	err := c.loadBugCache()
+	release, err := c.lockShared()
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	err = c.loadBugCache()
 	if err != nil {
 		return err
 	}
@@ -205,11 +230,25 @@ func (c *RepoCache) load() error {
 
 // load will try to read from the disk the bug cache file
 func (c *RepoCache) loadBugCache() error {
-	f, err := os.Open(bugCacheFilePath(c.repo))
+	excerpts, err := c.readBugCacheFile()
 	if err != nil {
 		return err
 	}
 
+	c.bugExcerpts = excerpts
+	c.changedBugExcerpts = nil
+	c.rebuildStatusIndex()
+	return nil
+}
+
+// readBugCacheFile read and decode the bug cache file
+func (c *RepoCache) readBugCacheFile() (map[entity.Id]*BugExcerpt, error) {
+	f, err := os.Open(bugCacheFilePath(c.repo))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
 	decoder := gob.NewDecoder(f)
 
 	aux := struct {
@@ -219,26 +258,35 @@ func (c *RepoCache) loadBugCache() error {
 
 	err = decoder.Decode(&aux)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	if aux.Version != 2 {
-		return ErrInvalidCacheFormat{
-			message: fmt.Sprintf("unknown cache format version %v", aux.Version),
-		}
+	if err := checkFormatVersion(aux.Version); err != nil {
+		return nil, err
 	}
 
-	c.bugExcerpts = aux.Excerpts
-	return nil
+	return aux.Excerpts, nil
 }
 
 // load will try to read from the disk the identity cache file
 func (c *RepoCache) loadIdentityCache() error {
-	f, err := os.Open(identityCacheFilePath(c.repo))
+	excerpts, err := c.readIdentityCacheFile()
 	if err != nil {
 		return err
 	}
 
+	c.identitiesExcerpts = excerpts
+	return nil
+}
+
+// readIdentityCacheFile read and decode the identity cache file
+func (c *RepoCache) readIdentityCacheFile() (map[entity.Id]*IdentityExcerpt, error) {
+	f, err := os.Open(identityCacheFilePath(c.repo))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
 	decoder := gob.NewDecoder(f)
 
 	aux := struct {
@@ -248,30 +296,65 @@ func (c *RepoCache) loadIdentityCache() error {
 
 	err = decoder.Decode(&aux)
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	if err := checkFormatVersion(aux.Version); err != nil {
+		return nil, err
 	}
 
-	if aux.Version != 2 {
+	return aux.Excerpts, nil
+}
+
+// checkFormatVersion check the format version of a cache file. An older
+// cache lack some values, it's rebuilt. A newer one has been written by a
+// newer version of git-bug, it's left untouched.
+func checkFormatVersion(version uint) error {
+	switch {
+	case version < formatVersion:
+		return fmt.Errorf("outdated cache format version %v", version)
+	case version > formatVersion:
 		return ErrInvalidCacheFormat{
-			message: fmt.Sprintf("unknown cache format version %v", aux.Version),
+			message: fmt.Sprintf("unknown cache format version %v", version),
 		}
 	}
-
-	c.identitiesExcerpts = aux.Excerpts
 	return nil
 }
 
 // write will serialize on disk all the cache files
 func (c *RepoCache) write() error {
-	err := c.writeBugCache()
+	release, err := c.LockExclusive()
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	err = c.writeBugCache()
 	if err != nil {
 		return err
 	}
 	return c.writeIdentityCache()
 }
 
-// write will serialize on disk the bug cache file
+// write will serialize on disk the bug cache file. The excerpts written by
+// the other processes since the cache was loaded are merged first, so that
+// only the excerpts changed by this process are replaced.
 func (c *RepoCache) writeBugCache() error {
+	release, err := c.LockExclusive()
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	// a missing or unreadable file is simply replaced
+	if onDisk, err := c.readBugCacheFile(); err == nil {
+		for id, excerpt := range onDisk {
+			if _, changed := c.changedBugExcerpts[id]; !changed {
+				c.storeBugExcerpt(excerpt)
+			}
+		}
+	}
+
 	var data bytes.Buffer
 
 	aux := struct {
@@ -284,7 +367,7 @@ func (c *RepoCache) writeBugCache() error {
 
 	encoder := gob.NewEncoder(&data)
 
-	err := encoder.Encode(aux)
+	err = encoder.Encode(aux)
 	if err != nil {
 		return err
 	}
@@ -299,11 +382,33 @@ func (c *RepoCache) writeBugCache() error {
 		return err
 	}
 
-	return f.Close()
+	err = f.Close()
+	if err != nil {
+		return err
+	}
+
+	c.changedBugExcerpts = nil
+	return nil
 }
 
-// write will serialize on disk the identity cache file
+// write will serialize on disk the identity cache file, after merging the
+// excerpts written by the other processes since the cache was loaded
 func (c *RepoCache) writeIdentityCache() error {
+	release, err := c.LockExclusive()
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	// a missing or unreadable file is simply replaced
+	if onDisk, err := c.readIdentityCacheFile(); err == nil {
+		for id, excerpt := range onDisk {
+			if _, ok := c.identitiesExcerpts[id]; !ok {
+				c.identitiesExcerpts[id] = excerpt
+			}
+		}
+	}
+
 	var data bytes.Buffer
 
 	aux := struct {
@@ -316,7 +421,7 @@ func (c *RepoCache) writeIdentityCache() error {
 
 	encoder := gob.NewEncoder(&data)
 
-	err := encoder.Encode(aux)
+	err = encoder.Encode(aux)
 	if err != nil {
 		return err
 	}
@@ -362,22 +467,140 @@ func (c *RepoCache) buildCache() error {
 	_, _ = fmt.Fprintf(os.Stderr, "Building bug cache... ")
 
 	c.bugExcerpts = make(map[entity.Id]*BugExcerpt)
+	c.bugsByStatus = make(map[bug.Status]map[entity.Id]struct{})
 
-	allBugs := bug.ReadAllLocalBugs(c.repo)
-
-	for b := range allBugs {
-		if b.Err != nil {
-			return b.Err
+	if c.lazyLoading {
+		err := c.buildBugCacheLazily()
+		if err != nil {
+			return err
 		}
 
-		snap := b.Bug.Compile()
-		c.bugExcerpts[b.Bug.Id()] = NewBugExcerpt(b.Bug, &snap)
+		_, _ = fmt.Fprintln(os.Stderr, "Done.")
+		return nil
+	}
+
+	err := c.buildBugCacheConcurrently()
+	if err != nil {
+		return err
 	}
 
 	_, _ = fmt.Fprintln(os.Stderr, "Done.")
 	return nil
 }
 
+// loadedBug is a bug read and compiled by a buildBugCacheConcurrently worker
+type loadedBug struct {
+	bug  *bug.Bug
+	snap bug.Snapshot
+}
+
+// buildBugCacheConcurrently read and compile the bugs with a pool of workers,
+// one per CPU. Only the git reads and the compilation are done in parallel,
+// the lamport clocks and the excerpts are updated sequentially as the
+// results come in.
+func (c *RepoCache) buildBugCacheConcurrently() error {
+	ids, err := bug.ListLocalIds(c.repo)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	wg, ctx := errgroup.WithContext(ctx)
+	sem := make(chan struct{}, runtime.NumCPU())
+	loaded := make(chan loadedBug)
+
+	wg.Go(func() error {
+		for _, id := range ids {
+			id := id
+
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				return nil
+			}
+
+			wg.Go(func() error {
+				defer func() { <-sem }()
+
+				b, err := bug.LoadLocalBug(c.repo, id)
+				if err != nil {
+					return err
+				}
+
+				select {
+				case loaded <- loadedBug{bug: b, snap: b.Compile()}:
+				case <-ctx.Done():
+				}
+				return nil
+			})
+		}
+		return nil
+	})
+
+	go func() {
+		_ = wg.Wait()
+		close(loaded)
+	}()
+
+	var applyErr error
+	for l := range loaded {
+		if applyErr != nil {
+			continue
+		}
+
+		if err := l.bug.WitnessClocks(c.repo); err != nil {
+			applyErr = err
+			// stop the workers, but keep draining the channel
+			cancel()
+			continue
+		}
+
+		c.setBugExcerpt(NewBugExcerpt(l.bug, &l.snap))
+
+		if err := c.indexCommitRefs(l.bug.Id(), &l.snap); err != nil {
+			applyErr = err
+			cancel()
+		}
+	}
+
+	if err := wg.Wait(); err != nil {
+		return err
+	}
+
+	return applyErr
+}
+
+// buildBugCacheLazily compute the bug excerpts one bug at a time, without
+// keeping more than a single OperationPack decoded at once
+func (c *RepoCache) buildBugCacheLazily() error {
+	ids, err := bug.ListLocalIds(c.repo)
+	if err != nil {
+		return err
+	}
+
+	for _, id := range ids {
+		b, err := bug.ReadLocalLazyBug(c.repo, id)
+		if err != nil {
+			return err
+		}
+
+		snap, err := b.Snapshot()
+		if err != nil {
+			return err
+		}
+
+		c.setBugExcerpt(NewBugExcerpt(b, snap))
+
+		if err := c.indexCommitRefs(id, snap); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // ResolveBug retrieve a bug matching the exact given id
 func (c *RepoCache) ResolveBug(id entity.Id) (*BugCache, error) {
 	cached, ok := c.bugs[id]
@@ -396,6 +619,36 @@ func (c *RepoCache) ResolveBug(id entity.Id) (*BugCache, error) {
 	return cached, nil
 }
 
+// ResolveBugOperations return an iterator over the operations of the bug
+// matching the exact given id. In lazy loading mode, a bug not already in
+// memory is not read completely: its operations are streamed from git one
+// OperationPack at a time.
+func (c *RepoCache) ResolveBugOperations(id entity.Id) (bug.OperationIter, error) {
+	if cached, ok := c.bugs[id]; ok || !c.lazyLoading {
+		if !ok {
+			var err error
+			cached, err = c.ResolveBug(id)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return cached.Operations(), nil
+	}
+
+	lb, err := bug.ReadLocalLazyBug(c.repo, id)
+	if err != nil {
+		return nil, err
+	}
+	return lb.Operations(), nil
+}
+
+// BugExists return true if a bug with the exact given id is known by the
+// cache. Unlike ResolveBug, the bug is not read from the repository.
+func (c *RepoCache) BugExists(id entity.Id) bool {
+	_, ok := c.bugExcerpts[id]
+	return ok
+}
+
 // ResolveBugExcerpt retrieve a BugExcerpt matching the exact given id
 func (c *RepoCache) ResolveBugExcerpt(id entity.Id) (*BugExcerpt, error) {
 	e, ok := c.bugExcerpts[id]
@@ -467,24 +720,7 @@ func (c *RepoCache) QueryBugs(query *Query) []entity.Id {
 		}
 	}
 
-	var sorter sort.Interface
-
-	switch query.OrderBy {
-	case OrderById:
-		sorter = BugsById(filtered)
-	case OrderByCreation:
-		sorter = BugsByCreationTime(filtered)
-	case OrderByEdit:
-		sorter = BugsByEditTime(filtered)
-	default:
-		panic("missing sort type")
-	}
-
-	if query.OrderDirection == OrderDescending {
-		sorter = sort.Reverse(sorter)
-	}
-
-	sort.Sort(sorter)
+	sortExcerpts(filtered, query)
 
 	result := make([]entity.Id, len(filtered))
 
@@ -555,19 +791,110 @@ func (c *RepoCache) NewBugWithFiles(title string, message string, files []git.Ha
 	return c.NewBugRaw(author, time.Now().Unix(), title, message, files, nil)
 }
 
+// NewBugWithReferences create a new bug referring to some URLs or commit
+// hashes. The new bug is written in the repository (commit)
+func (c *RepoCache) NewBugWithReferences(title string, message string, references []string) (*BugCache, *bug.CreateOperation, error) {
+	author, err := c.GetUserIdentity()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return c.newBug(nil, author, time.Now().Unix(), title, message, nil, references, nil, nil)
+}
+
 // NewBugWithFilesMeta create a new bug with attached files for the message, as
 // well as metadata for the Create operation.
 // The new bug is written in the repository (commit)
 func (c *RepoCache) NewBugRaw(author *IdentityCache, unixTime int64, title string, message string, files []git.Hash, metadata map[string]string) (*BugCache, *bug.CreateOperation, error) {
-	b, op, err := bug.CreateWithFiles(author.Identity, unixTime, title, message, files)
+	return c.NewBugRawWithSource(author, unixTime, title, message, files, nil, metadata)
+}
+
+// NewBugRawWithSource is the same as NewBugRaw, but also record where an
+// imported bug comes from.
+// The new bug is written in the repository (commit)
+func (c *RepoCache) NewBugRawWithSource(author *IdentityCache, unixTime int64, title string, message string, files []git.Hash, source *bug.BugSource, metadata map[string]string) (*BugCache, *bug.CreateOperation, error) {
+	return c.newBug(nil, author, unixTime, title, message, files, nil, source, metadata)
+}
+
+// BugCreateArgs hold the content of a new bug created with NewBugWithID
+type BugCreateArgs struct {
+	// Author of the bug, the user identity if nil
+	Author *IdentityCache
+	// UnixTime of the creation, the current time if zero
+	UnixTime int64
+	Title    string
+	Message  string
+	Files    []git.Hash
+	// URLs or commit hashes the bug refers to
+	References []string
+	Source     *bug.BugSource
+	Metadata   map[string]string
+}
+
+// NewBugWithID create a new bug with the Id
+// entity.NewDeterministicId(namespace, content) instead of one derived from
+// its content, so that it can be found again from the same inputs.
+// It returns entity.ErrAlreadyExist if a bug with this Id already exist.
+// The new bug is written in the repository (commit)
+func (c *RepoCache) NewBugWithID(namespace, content string, args BugCreateArgs) (*BugCache, error) {
+	id := entity.NewDeterministicId(namespace, content)
+
+	_, inCache := c.bugs[id]
+	if inCache || c.BugExists(id) {
+		return nil, entity.ErrAlreadyExist
+	}
+
+	author := args.Author
+	if author == nil {
+		var err error
+		author, err = c.GetUserIdentity()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	unixTime := args.UnixTime
+	if unixTime == 0 {
+		unixTime = time.Now().Unix()
+	}
+
+	b, _, err := c.newBug(&deterministicId{namespace, content}, author, unixTime, args.Title, args.Message, args.Files, args.References, args.Source, args.Metadata)
+	return b, err
+}
+
+// deterministicId hold the inputs of the deterministic Id of a new bug
+type deterministicId struct {
+	namespace, content string
+}
+
+// newBug create and commit a new bug, with the given deterministic Id if not nil
+func (c *RepoCache) newBug(id *deterministicId, author *IdentityCache, unixTime int64, title string, message string, files []git.Hash, references []string, source *bug.BugSource, metadata map[string]string) (*BugCache, *bug.CreateOperation, error) {
+	b, op, err := bug.CreateWithReferences(author.Identity, unixTime, title, message, files, references)
 	if err != nil {
 		return nil, nil, err
 	}
 
+	if id != nil {
+		if err := b.SetDeterministicId(id.namespace, id.content); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	op.OriginalSource = source
+	if source != nil {
+		// the importers give the creation time of the remote issue
+		op.ExternalCreatedAt = unixTime
+	}
+
 	for key, value := range metadata {
 		op.SetMetadata(key, value)
 	}
 
+	err = c.stampDevice(b.StagedOperations())
+	if err != nil {
+		return nil, nil, err
+	}
+
 	err = b.Commit(c.repo)
 	if err != nil {
 		return nil, nil, err
@@ -592,17 +919,14 @@ func (c *RepoCache) NewBugRaw(author *IdentityCache, unixTime int64, title strin
 // Fetch retrieve updates from a remote
 // This does not change the local bugs or identities state
 func (c *RepoCache) Fetch(remote string) (string, error) {
-	stdout1, err := identity.Fetch(c.repo, remote)
-	if err != nil {
-		return stdout1, err
-	}
-
-	stdout2, err := bug.Fetch(c.repo, remote)
-	if err != nil {
-		return stdout2, err
-	}
+	return c.FetchBugRefs(remote, true)
+}
 
-	return stdout1 + stdout2, nil
+// FetchBugRefs retrieve the bugs, and optionally the identities, updates
+// from a remote with a single fetch.
+// This does not change the local bugs or identities state
+func (c *RepoCache) FetchBugRefs(remote string, includeIdentities bool) (string, error) {
+	return c.repo.FetchBugRefs(remote, includeIdentities)
 }
 
 // MergeAll will merge all the available remote bug and identities
@@ -640,7 +964,12 @@ func (c *RepoCache) MergeAll(remote string) <-chan entity.MergeResult {
 			case entity.MergeStatusNew, entity.MergeStatusUpdated:
 				b := result.Entity.(*bug.Bug)
 				snap := b.Compile()
-				c.bugExcerpts[result.Id] = NewBugExcerpt(b, &snap)
+				c.setBugExcerpt(NewBugExcerpt(b, &snap))
+				c.notifyBugWatchers(result.Id)
+
+				if err := c.indexCommitRefs(result.Id, &snap); err != nil {
+					out <- entity.NewMergeError(err, result.Id)
+				}
 			}
 		}
 
@@ -690,70 +1019,6 @@ func (c *RepoCache) Pull(remote string) error {
 	return nil
 }
 
-func repoLockFilePath(repo repository.Repo) string {
-	return path.Join(repo.GetPath(), "git-bug", lockfile)
-}
-
-// repoIsAvailable check is the given repository is locked by a Cache.
-// Note: this is a smart function that will cleanup the lock file if the
-// corresponding process is not there anymore.
-// If no error is returned, the repo is free to edit.
-func repoIsAvailable(repo repository.Repo) error {
-	lockPath := repoLockFilePath(repo)
-
-	// Todo: this leave way for a racey access to the repo between the test
-	// if the file exist and the actual write. It's probably not a problem in
-	// practice because using a repository will be done from user interaction
-	// or in a context where a single instance of git-bug is already guaranteed
-	// (say, a server with the web UI running). But still, that might be nice to
-	// have a mutex or something to guard that.
-
-	// Todo: this will fail if somehow the filesystem is shared with another
-	// computer. Should add a configuration that prevent the cleaning of the
-	// lock file
-
-	f, err := os.Open(lockPath)
-
-	if err != nil && !os.IsNotExist(err) {
-		return err
-	}
-
-	if err == nil {
-		// lock file already exist
-		buf, err := ioutil.ReadAll(io.LimitReader(f, 10))
-		if err != nil {
-			return err
-		}
-		if len(buf) == 10 {
-			return fmt.Errorf("the lock file should be < 10 bytes")
-		}
-
-		pid, err := strconv.Atoi(string(buf))
-		if err != nil {
-			return err
-		}
-
-		if process.IsRunning(pid) {
-			return fmt.Errorf("the repository you want to access is already locked by the process pid %d", pid)
-		}
-
-		// The lock file is just laying there after a crash, clean it
-
-		fmt.Println("A lock file is present but the corresponding process is not, removing it.")
-		err = f.Close()
-		if err != nil {
-			return err
-		}
-
-		err = os.Remove(lockPath)
-		if err != nil {
-			return err
-		}
-	}
-
-	return nil
-}
-
 // ResolveIdentity retrieve an identity matching the exact given id
 func (c *RepoCache) ResolveIdentity(id entity.Id) (*IdentityCache, error) {
 	cached, ok := c.identities[id]
@@ -1,10 +1,16 @@
 package cache
 
 import (
+	"bytes"
+	"encoding/gob"
+	"io/ioutil"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/misc/random_bugs"
 	"github.com/MichaelMure/git-bug/repository"
 )
 
@@ -52,6 +58,10 @@ func TestCache(t *testing.T) {
 	require.Len(t, cache.bugExcerpts, 2)
 	require.Len(t, cache.bugs, 2)
 
+	// Existence check without reading the bug
+	require.True(t, cache.BugExists(bug1.Id()))
+	require.False(t, cache.BugExists(entity.Id("0123456789012345678901234567890123456789012345678901234567890123")))
+
 	// Resolving
 	_, err = cache.ResolveIdentity(iden1.Id())
 	require.NoError(t, err)
@@ -72,6 +82,24 @@ func TestCache(t *testing.T) {
 	require.NoError(t, err)
 	require.Len(t, cache.QueryBugs(query), 2)
 
+	// Counting by status
+	require.Equal(t, map[bug.Status]int{bug.OpenStatus: 2}, cache.CountByStatus())
+	_, err = bug2.Close()
+	require.NoError(t, err)
+	require.Equal(t, map[bug.Status]int{bug.OpenStatus: 1, bug.ClosedStatus: 1}, cache.CountByStatus())
+	require.Equal(t, []entity.Id{bug2.Id()}, cache.BugsByStatus(bug.ClosedStatus))
+	require.Equal(t, 2, cache.CountBugs(NewQuery()))
+	query, err = ParseQuery("status:closed")
+	require.NoError(t, err)
+	require.Equal(t, 1, cache.CountBugs(query))
+	query, err = ParseQuery("status:open status:closed")
+	require.NoError(t, err)
+	require.Equal(t, 2, cache.CountBugs(query))
+	query, err = ParseQuery("status:open author:nobody")
+	require.NoError(t, err)
+	require.Equal(t, 0, cache.CountBugs(query))
+	require.NoError(t, bug2.Commit())
+
 	// Close
 	require.NoError(t, cache.Close())
 	require.Empty(t, cache.bugs)
@@ -85,6 +113,7 @@ func TestCache(t *testing.T) {
 	require.Empty(t, cache.identities)
 	require.Len(t, cache.bugExcerpts, 2)
 	require.Len(t, cache.identitiesExcerpts, 2)
+	require.Equal(t, map[bug.Status]int{bug.OpenStatus: 1, bug.ClosedStatus: 1}, cache.CountByStatus())
 
 	// Resolving load from the disk
 	_, err = cache.ResolveIdentity(iden1.Id())
@@ -102,6 +131,141 @@ func TestCache(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestCacheLazyLoading(t *testing.T) {
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	cache, err := NewRepoCache(repo, WithLazyLoading())
+	require.NoError(t, err)
+	require.True(t, cache.lazyLoading)
+
+	iden, err := cache.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	err = cache.SetUserIdentity(iden)
+	require.NoError(t, err)
+
+	b, _, err := cache.NewBug("title", "message")
+	require.NoError(t, err)
+	_, err = b.AddComment("comment")
+	require.NoError(t, err)
+	_, err = b.OpNoOp(map[string]string{MetaKeyWeight: "3"})
+	require.NoError(t, err)
+	_, err = b.AddComment("another comment")
+	require.NoError(t, err)
+	require.NoError(t, b.Commit())
+
+	expected := *cache.bugExcerpts[b.Id()]
+	require.Equal(t, 3, expected.Weight)
+
+	// rebuilding the cache lazily yield the same excerpt
+	require.NoError(t, cache.buildCache())
+	require.Len(t, cache.bugExcerpts, 1)
+	require.Equal(t, expected, *cache.bugExcerpts[b.Id()])
+
+	// the operations are streamed without reading the complete bug
+	delete(cache.bugs, b.Id())
+	it, err := cache.ResolveBugOperations(b.Id())
+	require.NoError(t, err)
+	count := 0
+	for it.Next() {
+		count++
+	}
+	require.NoError(t, it.Err())
+	require.Equal(t, 4, count)
+	require.NotContains(t, cache.bugs, b.Id())
+
+	// the bugs are still read completely when resolved
+	b, err = cache.ResolveBug(b.Id())
+	require.NoError(t, err)
+	_, err = b.AddComment("yet another comment")
+	require.NoError(t, err)
+	require.Len(t, b.Snapshot().Comments, 4)
+}
+
+func TestNewBugWithID(t *testing.T) {
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	cache, err := NewRepoCache(repo)
+	require.NoError(t, err)
+
+	iden, err := cache.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	err = cache.SetUserIdentity(iden)
+	require.NoError(t, err)
+
+	id := entity.NewDeterministicId("test", "https://example.com/issues/1")
+
+	b, err := cache.NewBugWithID("test", "https://example.com/issues/1", BugCreateArgs{
+		Title:    "title",
+		Message:  "message",
+		Metadata: map[string]string{"key": "value"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, id, b.Id())
+	require.Equal(t, iden.Id(), b.Snapshot().Author.Id())
+
+	_, err = cache.NewBugWithID("test", "https://example.com/issues/1", BugCreateArgs{Title: "other", Message: "message"})
+	require.Equal(t, entity.ErrAlreadyExist, err)
+
+	// the bug is stored under the given id
+	require.NoError(t, cache.Close())
+	cache, err = NewRepoCache(repo)
+	require.NoError(t, err)
+
+	b, err = cache.ResolveBug(id)
+	require.NoError(t, err)
+	require.Equal(t, "title", b.Snapshot().Title)
+
+	// and can be updated
+	_, err = b.AddComment("comment")
+	require.NoError(t, err)
+	require.NoError(t, b.Commit())
+
+	_, err = cache.ResolveBugCreateMetadata("key", "value")
+	require.NoError(t, err)
+}
+
+func TestCacheBuildConcurrently(t *testing.T) {
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	random_bugs.FillRepoWithSeed(repo, 15, 42)
+
+	cache, err := NewRepoCache(repo)
+	require.NoError(t, err)
+	require.Len(t, cache.bugExcerpts, 15)
+
+	for b := range bug.ReadAllLocalBugs(repo) {
+		require.NoError(t, b.Err)
+		snap := b.Bug.Compile()
+		require.Equal(t, *NewBugExcerpt(b.Bug, &snap), *cache.bugExcerpts[b.Bug.Id()])
+	}
+}
+
+func benchmarkBuildCache(bugNumber int, t *testing.B) {
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	random_bugs.FillRepoWithSeed(repo, bugNumber, 42)
+
+	cache, err := NewRepoCache(repo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.ResetTimer()
+
+	for n := 0; n < t.N; n++ {
+		if err := cache.buildCache(); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkBuildCache5(b *testing.B)   { benchmarkBuildCache(5, b) }
+func BenchmarkBuildCache25(b *testing.B)  { benchmarkBuildCache(25, b) }
+func BenchmarkBuildCache150(b *testing.B) { benchmarkBuildCache(150, b) }
+
 func TestPushPull(t *testing.T) {
 	repoA, repoB, remote := repository.SetupReposAndRemote(t)
 	defer repository.CleanupTestRepos(t, repoA, repoB, remote)
@@ -156,3 +320,55 @@ func TestPushPull(t *testing.T) {
 
 	require.Len(t, cacheA.AllBugsIds(), 2)
 }
+
+func TestCacheFormatVersion(t *testing.T) {
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	cache, err := NewRepoCache(repo)
+	require.NoError(t, err)
+
+	iden, err := cache.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	require.NoError(t, cache.SetUserIdentity(iden))
+
+	b, _, err := cache.NewBug("title", "message")
+	require.NoError(t, err)
+	require.NoError(t, b.Pin())
+	require.NoError(t, b.Commit())
+
+	// an older cache, lacking the newer values
+	pinned := *cache.bugExcerpts[b.Id()]
+	writeBugCacheVersion := func(version uint) {
+		excerpt := pinned
+		excerpt.Pinned = false
+
+		var data bytes.Buffer
+		err := gob.NewEncoder(&data).Encode(struct {
+			Version  uint
+			Excerpts map[entity.Id]*BugExcerpt
+		}{
+			Version:  version,
+			Excerpts: map[entity.Id]*BugExcerpt{b.Id(): &excerpt},
+		})
+		require.NoError(t, err)
+		require.NoError(t, ioutil.WriteFile(bugCacheFilePath(repo), data.Bytes(), 0644))
+	}
+
+	require.NoError(t, cache.Close())
+	writeBugCacheVersion(formatVersion - 1)
+
+	// is rebuilt
+	cache, err = NewRepoCache(repo)
+	require.NoError(t, err)
+	excerpt, err := cache.ResolveBugExcerpt(b.Id())
+	require.NoError(t, err)
+	require.True(t, excerpt.Pinned)
+
+	// a newer cache is left untouched
+	require.NoError(t, cache.Close())
+	writeBugCacheVersion(formatVersion + 1)
+
+	_, err = NewRepoCache(repo)
+	require.IsType(t, ErrInvalidCacheFormat{}, err)
+}
@@ -0,0 +1,103 @@
+package cache
+
+import (
+	"regexp"
+	"time"
+
+	"github.com/MichaelMure/git-bug/entity"
+)
+
+// SearchReplaceChange describe the change of a single comment by a search
+// and replace
+type SearchReplaceChange struct {
+	BugId entity.Id
+	// id of the operation that created the comment
+	Target entity.Id
+	Before string
+	After  string
+}
+
+// PreviewSearchReplace compute the changes that BulkSearchReplace would make
+// on the description and comments of the bugs matching the query, without
+// applying them. A nil query match all the bugs.
+func (c *RepoCache) PreviewSearchReplace(pattern string, replacement string, query *Query) ([]SearchReplaceChange, error) {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []entity.Id
+	if query == nil {
+		ids = c.AllBugsIds()
+	} else {
+		ids = c.QueryBugs(query)
+	}
+
+	var changes []SearchReplaceChange
+
+	for _, id := range ids {
+		b, err := c.ResolveBug(id)
+		if err != nil {
+			return nil, err
+		}
+
+		// the first comment is the bug description
+		for _, comment := range b.Snapshot().Comments {
+			after := re.ReplaceAllString(comment.Message, replacement)
+			if after == comment.Message {
+				continue
+			}
+
+			changes = append(changes, SearchReplaceChange{
+				BugId:  id,
+				Target: comment.Id(),
+				Before: comment.Message,
+				After:  after,
+			})
+		}
+	}
+
+	return changes, nil
+}
+
+// BulkSearchReplace replace all the matches of the regex pattern in the
+// description and comments of the bugs matching the query, by adding
+// EditCommentOperation with the user identity as author. The modified bugs
+// are committed. It returns the number of edited comments.
+func (c *RepoCache) BulkSearchReplace(pattern string, replacement string, query *Query) (int, error) {
+	author, err := c.GetUserIdentity()
+	if err != nil {
+		return 0, err
+	}
+
+	changes, err := c.PreviewSearchReplace(pattern, replacement, query)
+	if err != nil {
+		return 0, err
+	}
+
+	unixTime := time.Now().Unix()
+	edited := make(map[entity.Id]*BugCache)
+
+	for i, change := range changes {
+		b, err := c.ResolveBug(change.BugId)
+		if err != nil {
+			return i, err
+		}
+
+		_, err = b.EditCommentRaw(author, unixTime, change.Target, change.After, nil)
+		if err != nil {
+			return i, err
+		}
+
+		edited[b.Id()] = b
+	}
+
+	for _, b := range edited {
+		err := b.Commit()
+		if err != nil {
+			return len(changes), err
+		}
+	}
+
+	return len(changes), nil
+}
@@ -0,0 +1,56 @@
+package cache
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestBulkSearchReplace(t *testing.T) {
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	cache, err := NewRepoCache(repo)
+	require.NoError(t, err)
+
+	iden, err := cache.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	err = cache.SetUserIdentity(iden)
+	require.NoError(t, err)
+
+	bug1, _, err := cache.NewBug("title", "see https://old.example.com/doc")
+	require.NoError(t, err)
+	_, err = bug1.AddComment("moved from https://old.example.com/wiki")
+	require.NoError(t, err)
+	_, err = bug1.AddComment("unrelated")
+	require.NoError(t, err)
+	require.NoError(t, bug1.Commit())
+
+	bug2, _, err := cache.NewBug("title", "nothing to see")
+	require.NoError(t, err)
+
+	_, err = cache.PreviewSearchReplace("(", "", nil)
+	require.Error(t, err)
+
+	changes, err := cache.PreviewSearchReplace(`old\.example\.com`, "new.example.com", nil)
+	require.NoError(t, err)
+	require.Len(t, changes, 2)
+	require.Equal(t, "see https://new.example.com/doc", changes[0].After)
+
+	// preview doesn't change anything
+	require.Len(t, bug1.Snapshot().Operations, 3)
+
+	count, err := cache.BulkSearchReplace(`old\.example\.com`, "new.example.com", nil)
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+
+	snap := bug1.Snapshot()
+	require.Equal(t, "see https://new.example.com/doc", snap.Comments[0].Message)
+	require.Equal(t, "moved from https://new.example.com/wiki", snap.Comments[1].Message)
+	require.Equal(t, "unrelated", snap.Comments[2].Message)
+	require.False(t, bug1.NeedCommit())
+
+	require.Len(t, bug2.Snapshot().Operations, 1)
+}
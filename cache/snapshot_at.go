@@ -0,0 +1,26 @@
+package cache
+
+import (
+	"fmt"
+
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/entity"
+)
+
+// SnapshotAt return the snapshot of a bug as it was after its first opIndex
+// operations, in the order of the operation log. This allow to inspect the
+// state of a bug at any point of its history.
+func (c *RepoCache) SnapshotAt(id entity.Id, opIndex int) (*bug.Snapshot, error) {
+	b, err := c.ResolveBug(id)
+	if err != nil {
+		return nil, err
+	}
+
+	count := len(b.Snapshot().Operations)
+	if opIndex < 1 || opIndex > count {
+		return nil, fmt.Errorf("invalid operation index %d, the bug has %d operations", opIndex, count)
+	}
+
+	snap := b.bug.CompileUntil(opIndex)
+	return &snap, nil
+}
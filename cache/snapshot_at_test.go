@@ -0,0 +1,58 @@
+package cache
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestSnapshotAt(t *testing.T) {
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	cache, err := NewRepoCache(repo)
+	require.NoError(t, err)
+	defer cache.Close()
+
+	rene, err := cache.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	require.NoError(t, cache.SetUserIdentity(rene))
+
+	b, _, err := cache.NewBug("first title", "message")
+	require.NoError(t, err)
+	_, err = b.SetTitle("second title")
+	require.NoError(t, err)
+	_, err = b.AddComment("comment")
+	require.NoError(t, err)
+	_, err = b.Close()
+	require.NoError(t, err)
+	require.NoError(t, b.Commit())
+
+	snap, err := cache.SnapshotAt(b.Id(), 1)
+	require.NoError(t, err)
+	require.Equal(t, "first title", snap.Title)
+	require.Len(t, snap.Comments, 1)
+	require.Len(t, snap.Operations, 1)
+
+	snap, err = cache.SnapshotAt(b.Id(), 3)
+	require.NoError(t, err)
+	require.Equal(t, "second title", snap.Title)
+	require.Len(t, snap.Comments, 2)
+	require.Equal(t, bug.OpenStatus, snap.Status)
+
+	snap, err = cache.SnapshotAt(b.Id(), 4)
+	require.NoError(t, err)
+	require.Equal(t, bug.ClosedStatus, snap.Status)
+
+	// the current snapshot is left untouched
+	require.Equal(t, bug.ClosedStatus, b.Snapshot().Status)
+	require.Len(t, b.Snapshot().Operations, 4)
+
+	_, err = cache.SnapshotAt(b.Id(), 0)
+	require.Error(t, err)
+	_, err = cache.SnapshotAt(b.Id(), 5)
+	require.Error(t, err)
+}
@@ -1,5 +1,10 @@
 package cache
 
+import (
+	"sort"
+	"time"
+)
+
 type OrderBy int
 
 const (
@@ -7,6 +12,9 @@ const (
 	OrderById
 	OrderByCreation
 	OrderByEdit
+	OrderByActivity
+	OrderByWeight
+	OrderBySentiment
 )
 
 type OrderDirection int
@@ -16,3 +24,37 @@ const (
 	OrderAscending
 	OrderDescending
 )
+
+// sortExcerpts sort the excerpts in place according to the query ordering
+func sortExcerpts(excerpts []*BugExcerpt, query *Query) {
+	var sorter sort.Interface
+
+	switch query.OrderBy {
+	case OrderById:
+		sorter = BugsById(excerpts)
+	case OrderByCreation:
+		sorter = BugsByCreationTime(excerpts)
+	case OrderByEdit:
+		sorter = BugsByEditTime(excerpts)
+	case OrderByActivity:
+		sorter = newBugsByActivity(excerpts, time.Now())
+	case OrderByWeight:
+		sorter = BugsByWeight(excerpts)
+	case OrderBySentiment:
+		sorter = BugsBySentiment(excerpts)
+	default:
+		panic("missing sort type")
+	}
+
+	if query.OrderDirection == OrderDescending {
+		sorter = sort.Reverse(sorter)
+	}
+
+	sort.Sort(sorter)
+
+	if query.PinnedFirst {
+		sort.SliceStable(excerpts, func(i, j int) bool {
+			return excerpts[i].Pinned && !excerpts[j].Pinned
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package cache
+
+import (
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/entity"
+)
+
+// setBugExcerpt store the updated excerpt of a bug and keep the status index
+// in sync. The excerpt is kept over the one of the file when writing it.
+func (c *RepoCache) setBugExcerpt(excerpt *BugExcerpt) {
+	if c.changedBugExcerpts == nil {
+		c.changedBugExcerpts = make(map[entity.Id]struct{})
+	}
+	c.changedBugExcerpts[excerpt.Id] = struct{}{}
+
+	c.storeBugExcerpt(excerpt)
+}
+
+// storeBugExcerpt store the excerpt of a bug and keep the status index in sync
+func (c *RepoCache) storeBugExcerpt(excerpt *BugExcerpt) {
+	if old, ok := c.bugExcerpts[excerpt.Id]; ok {
+		delete(c.bugsByStatus[old.Status], old.Id)
+	}
+
+	c.bugExcerpts[excerpt.Id] = excerpt
+	c.indexBugStatus(excerpt)
+}
+
+func (c *RepoCache) indexBugStatus(excerpt *BugExcerpt) {
+	if c.bugsByStatus == nil {
+		c.bugsByStatus = make(map[bug.Status]map[entity.Id]struct{})
+	}
+
+	ids, ok := c.bugsByStatus[excerpt.Status]
+	if !ok {
+		ids = make(map[entity.Id]struct{})
+		c.bugsByStatus[excerpt.Status] = ids
+	}
+
+	ids[excerpt.Id] = struct{}{}
+}
+
+// rebuildStatusIndex compute the status index from scratch, from the excerpts
+func (c *RepoCache) rebuildStatusIndex() {
+	c.bugsByStatus = make(map[bug.Status]map[entity.Id]struct{})
+
+	for _, excerpt := range c.bugExcerpts {
+		c.indexBugStatus(excerpt)
+	}
+}
+
+// BugsByStatus return the ids of the bugs having the given status, in no
+// particular order
+func (c *RepoCache) BugsByStatus(status bug.Status) []entity.Id {
+	result := make([]entity.Id, 0, len(c.bugsByStatus[status]))
+	for id := range c.bugsByStatus[status] {
+		result = append(result, id)
+	}
+	return result
+}
+
+// CountByStatus return the number of bugs for each status, without having to
+// go through the excerpts
+func (c *RepoCache) CountByStatus() map[bug.Status]int {
+	result := make(map[bug.Status]int, len(c.bugsByStatus))
+	for status, ids := range c.bugsByStatus {
+		result[status] = len(ids)
+	}
+	return result
+}
+
+// CountBugs return the number of bugs matching the query. A query filtering
+// only on the status, or not at all, is answered from the status index
+// without going through the excerpts.
+func (c *RepoCache) CountBugs(query *Query) int {
+	if query == nil {
+		return len(c.bugExcerpts)
+	}
+
+	if !query.OnlyStatus() {
+		return len(c.QueryBugs(query))
+	}
+
+	count := 0
+	for status, n := range c.CountByStatus() {
+		// the status filters only look at the status of the excerpt
+		if query.orMatch(query.Status, c, &BugExcerpt{Status: status}) {
+			count += n
+		}
+	}
+	return count
+}
@@ -0,0 +1,58 @@
+package cache
+
+import (
+	"strconv"
+
+	"github.com/MichaelMure/git-bug/bug"
+)
+
+// MetaKeyWeight is the metadata key holding the weight of a bug, an estimation
+// of the effort needed. As the weight change over time, it is carried by NoOp
+// operations and the current value is the one of the most recent operation.
+// An empty value means no weight. The key comes from the Gitlab bridge, where
+// the weight of the issues is found.
+const MetaKeyWeight = "gitlab:weight"
+
+// Weight return the current weight of the bug, or false if it has none
+func Weight(snap *bug.Snapshot) (int, bool) {
+	value, _ := snap.LastMetadata(MetaKeyWeight)
+	if value == "" {
+		return 0, false
+	}
+
+	weight, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, false
+	}
+
+	return weight, true
+}
+
+// Statistics are aggregated values over all the bugs of a repository
+type Statistics struct {
+	TotalBugs  int
+	OpenBugs   int
+	ClosedBugs int
+
+	// sum of the weight of the open bugs, for capacity planning
+	TotalWeight int
+}
+
+// Statistics compute the statistics of the bugs of the repository
+func (c *RepoCache) Statistics() Statistics {
+	var stats Statistics
+
+	for _, excerpt := range c.bugExcerpts {
+		stats.TotalBugs++
+
+		switch excerpt.Status {
+		case bug.OpenStatus:
+			stats.OpenBugs++
+			stats.TotalWeight += excerpt.Weight
+		case bug.ClosedStatus:
+			stats.ClosedBugs++
+		}
+	}
+
+	return stats
+}
@@ -0,0 +1,55 @@
+package cache
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+func TestWeight(t *testing.T) {
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	cache, err := NewRepoCache(repo)
+	require.NoError(t, err)
+	defer cache.Close()
+
+	rene, err := cache.NewIdentity("René Descartes", "rene@descartes.fr")
+	require.NoError(t, err)
+	require.NoError(t, cache.SetUserIdentity(rene))
+
+	light, _, err := cache.NewBug("light", "message")
+	require.NoError(t, err)
+	heavy, _, err := cache.NewBug("heavy", "message")
+	require.NoError(t, err)
+	closed, _, err := cache.NewBug("closed", "message")
+	require.NoError(t, err)
+	_, _, err = cache.NewBug("none", "message")
+	require.NoError(t, err)
+
+	_, err = light.OpNoOp(map[string]string{MetaKeyWeight: "2"})
+	require.NoError(t, err)
+	_, err = heavy.OpNoOp(map[string]string{MetaKeyWeight: "5"})
+	require.NoError(t, err)
+	_, err = heavy.OpNoOp(map[string]string{MetaKeyWeight: "8"})
+	require.NoError(t, err)
+	_, err = closed.OpNoOp(map[string]string{MetaKeyWeight: "13"})
+	require.NoError(t, err)
+	_, err = closed.Close()
+	require.NoError(t, err)
+
+	weight, ok := Weight(heavy.Snapshot())
+	require.True(t, ok)
+	require.Equal(t, 8, weight)
+
+	stats := cache.Statistics()
+	require.Equal(t, Statistics{TotalBugs: 4, OpenBugs: 3, ClosedBugs: 1, TotalWeight: 10}, stats)
+
+	query, err := ParseQuery("status:open sort:weight")
+	require.NoError(t, err)
+	ids := cache.QueryBugs(query)
+	require.Equal(t, []entity.Id{heavy.Id(), light.Id()}, ids[:2])
+}
@@ -13,6 +13,7 @@ var (
 	addTitle       string
 	addMessage     string
 	addMessageFile string
+	addReferences  []string
 )
 
 func runAddBug(cmd *cobra.Command, args []string) error {
@@ -44,7 +45,7 @@ func runAddBug(cmd *cobra.Command, args []string) error {
 		}
 	}
 
-	b, _, err := backend.NewBug(addTitle, addMessage)
+	b, _, err := backend.NewBugWithReferences(addTitle, addMessage, addReferences)
 	if err != nil {
 		return err
 	}
@@ -75,4 +76,7 @@ func init() {
 	addCmd.Flags().StringVarP(&addMessageFile, "file", "F", "",
 		"Take the message from the given file. Use - to read the message from the standard input",
 	)
+	addCmd.Flags().StringSliceVarP(&addReferences, "reference", "r", nil,
+		"Record an URL or a commit hash the bug refers to. Can be repeated",
+	)
 }
@@ -0,0 +1,62 @@
+package commands
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/util/interrupt"
+)
+
+func runAm(cmd *cobra.Command, args []string) error {
+	backend, err := cache.NewRepoCache(repo)
+	if err != nil {
+		return err
+	}
+	defer backend.Close()
+	interrupt.RegisterCleaner(backend.Close)
+
+	var r io.Reader = os.Stdin
+	if len(args) == 1 && args[0] != "-" {
+		f, err := os.Open(args[0])
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		r = f
+	}
+
+	results, err := backend.ApplyPatch(r)
+	if err != nil {
+		return err
+	}
+
+	for result := range results {
+		if result.Err != nil {
+			fmt.Println(result.Err)
+		}
+
+		if result.Status != entity.MergeStatusNothing {
+			fmt.Printf("%s: %s\n", result.Id.Human(), result)
+		}
+	}
+
+	return nil
+}
+
+var amCmd = &cobra.Command{
+	Use:     "am [<patch-file>]",
+	Short:   "Apply a patch file written by \"git bug format-patch\".",
+	Long:    `Apply a patch file written by "git bug format-patch", read from the standard input if no file is given. The bug and identities it carry are merged the same way as with a pull.`,
+	PreRunE: loadRepo,
+	Args:    cobra.MaximumNArgs(1),
+	RunE:    runAm,
+}
+
+func init() {
+	RootCmd.AddCommand(amCmd)
+}
@@ -0,0 +1,59 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/util/colors"
+	"github.com/MichaelMure/git-bug/util/interrupt"
+)
+
+func runBlame(cmd *cobra.Command, args []string) error {
+	backend, err := cache.NewRepoCache(repo)
+	if err != nil {
+		return err
+	}
+	defer backend.Close()
+	interrupt.RegisterCleaner(backend.Close)
+
+	// a commit of the repository can be given with any revision, a commit
+	// only known from a bridge with its hash or a prefix of it
+	sha := args[0]
+	if commit, err := repo.ResolveCommit(sha); err == nil {
+		sha = commit.String()
+	}
+
+	ids, err := backend.BugsByCommit(sha)
+	if err != nil {
+		return err
+	}
+
+	for _, id := range ids {
+		excerpt, err := backend.ResolveBugExcerpt(id)
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("%s %s %s\n",
+			colors.Cyan(id.Human()),
+			colors.Yellow(excerpt.Status),
+			excerpt.Title,
+		)
+	}
+
+	return nil
+}
+
+var blameCmd = &cobra.Command{
+	Use:     "blame <commit>",
+	Short:   "List the bugs cross referenced by a commit.",
+	PreRunE: loadRepo,
+	RunE:    runBlame,
+	Args:    cobra.ExactArgs(1),
+}
+
+func init() {
+	RootCmd.AddCommand(blameCmd)
+}
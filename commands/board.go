@@ -0,0 +1,112 @@
+package commands
+
+import (
+	"fmt"
+	"strings"
+
+	text "github.com/MichaelMure/go-term-text"
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/util/colors"
+	"github.com/MichaelMure/git-bug/util/interrupt"
+)
+
+const (
+	boardColumnWidth = 32
+	// number of bugs displayed in each column
+	boardColumnBugs = 5
+)
+
+func runBoard(cmd *cobra.Command, args []string) error {
+	backend, err := cache.NewRepoCache(repo)
+	if err != nil {
+		return err
+	}
+	defer backend.Close()
+	interrupt.RegisterCleaner(backend.Close)
+
+	var board cache.Board
+
+	if len(args) == 1 {
+		board, err = backend.ResolveBoard(args[0])
+		if err != nil {
+			return err
+		}
+	} else {
+		boards, err := backend.Boards()
+		if err != nil {
+			return err
+		}
+
+		switch len(boards) {
+		case 0:
+			return fmt.Errorf("no board, they can be imported with a bridge")
+		case 1:
+			board = boards[0]
+		default:
+			for _, b := range boards {
+				fmt.Println(b.Name)
+			}
+			return nil
+		}
+	}
+
+	fmt.Println(colors.Bold(board.Name))
+	fmt.Println()
+
+	columns := make([][]string, len(board.Columns))
+	height := 0
+
+	for i, label := range board.Columns {
+		ids := backend.BoardColumn(label)
+
+		cells := []string{fmt.Sprintf("%s (%d)", label, len(ids))}
+		for j, id := range ids {
+			if j == boardColumnBugs {
+				cells = append(cells, fmt.Sprintf("... %d more", len(ids)-boardColumnBugs))
+				break
+			}
+			excerpt, err := backend.ResolveBugExcerpt(id)
+			if err != nil {
+				return err
+			}
+			cells = append(cells, fmt.Sprintf("%s %s", id.Human(), excerpt.Title))
+		}
+
+		columns[i] = cells
+		if len(cells) > height {
+			height = len(cells)
+		}
+	}
+
+	for row := 0; row < height; row++ {
+		var line strings.Builder
+		for _, cells := range columns {
+			cell := ""
+			if row < len(cells) {
+				cell = text.TruncateMax(cells[row], boardColumnWidth-2)
+			}
+			cell = text.LeftPadMaxLine(cell, boardColumnWidth, 0)
+			if row == 0 {
+				cell = colors.Cyan(cell)
+			}
+			line.WriteString(cell)
+		}
+		fmt.Println(strings.TrimRight(line.String(), " "))
+	}
+
+	return nil
+}
+
+var boardCmd = &cobra.Command{
+	Use:     "board [<name>]",
+	Short:   "Display a board, with the open bugs in columns by label.",
+	PreRunE: loadRepo,
+	RunE:    runBoard,
+	Args:    cobra.MaximumNArgs(1),
+}
+
+func init() {
+	RootCmd.AddCommand(boardCmd)
+}
@@ -2,10 +2,14 @@ package commands
 
 import (
 	"fmt"
+	"os"
+	"sort"
+	"text/tabwriter"
 
 	"github.com/spf13/cobra"
 
 	"github.com/MichaelMure/git-bug/bridge"
+	"github.com/MichaelMure/git-bug/bridge/core"
 	"github.com/MichaelMure/git-bug/cache"
 	"github.com/MichaelMure/git-bug/util/interrupt"
 )
@@ -23,11 +27,39 @@ func runBridge(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	for _, c := range configured {
-		fmt.Println(c)
+	if len(configured) == 0 {
+		return nil
 	}
 
-	return nil
+	sort.Strings(configured)
+
+	// print a capability matrix of the configured bridges
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+
+	_, _ = fmt.Fprint(w, "NAME\tTARGET")
+	for _, capability := range core.Capabilities {
+		_, _ = fmt.Fprintf(w, "\t%s", capability.Name())
+	}
+	_, _ = fmt.Fprintln(w)
+
+	for _, name := range configured {
+		b, err := bridge.LoadBridge(backend, name)
+		if err != nil {
+			return err
+		}
+
+		_, _ = fmt.Fprintf(w, "%s\t%s", name, b.Target())
+		for _, capability := range core.Capabilities {
+			mark := "-"
+			if b.Capabilities().Has(capability) {
+				mark = "x"
+			}
+			_, _ = fmt.Fprintf(w, "\t%s", mark)
+		}
+		_, _ = fmt.Fprintln(w)
+	}
+
+	return w.Flush()
 }
 
 var bridgeCmd = &cobra.Command{
@@ -35,6 +35,8 @@ func runBridgeAuth(cmd *cobra.Command, args []string) error {
 		switch cred := cred.(type) {
 		case *auth.Token:
 			value = cred.Value
+		case *auth.Secret:
+			value = cred.Value
 		}
 
 		var userFmt string
@@ -77,5 +77,9 @@ func init() {
 	bridgeAuthCmd.AddCommand(bridgeAuthAddTokenCmd)
 	bridgeAuthAddTokenCmd.Flags().StringVarP(&bridgeAuthAddTokenTarget, "target", "t", "",
 		fmt.Sprintf("The target of the bridge. Valid values are [%s]", strings.Join(bridge.Targets(), ",")))
+	bridgeAuthAddTokenCmd.Flags().BoolVar(&auth.UseKeychain, "keychain", false,
+		fmt.Sprintf("Store the token in the system keychain instead of the git config. Can also be enabled with %s=1", auth.KeychainEnv))
+	bridgeAuthAddTokenCmd.Flags().BoolVar(&auth.KeychainFallback, "keychain-fallback", false,
+		"Store the token in the git config if the system keychain can't be used, instead of failing")
 	bridgeAuthAddTokenCmd.Flags().SortFlags = false
 }
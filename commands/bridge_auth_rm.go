@@ -1,14 +1,30 @@
 package commands
 
 import (
+	"bufio"
 	"fmt"
+	"os"
+	"strings"
 
 	"github.com/spf13/cobra"
 
 	"github.com/MichaelMure/git-bug/bridge/core/auth"
 )
 
+var (
+	bridgeAuthRmTarget string
+	bridgeAuthRmAll    bool
+)
+
 func runBridgeAuthRm(cmd *cobra.Command, args []string) error {
+	if bridgeAuthRmAll {
+		return runBridgeAuthRmAll(args)
+	}
+
+	if len(args) != 1 {
+		return fmt.Errorf("a credential id is required")
+	}
+
 	cred, err := auth.LoadWithPrefix(repo, args[0])
 	if err != nil {
 		return err
@@ -23,14 +39,59 @@ func runBridgeAuthRm(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+func runBridgeAuthRmAll(args []string) error {
+	if len(args) != 0 {
+		return fmt.Errorf("a credential id can't be given with --all")
+	}
+	if bridgeAuthRmTarget == "" {
+		return fmt.Errorf("--all requires a target (--target)")
+	}
+
+	creds, err := auth.List(repo, auth.WithTarget(bridgeAuthRmTarget))
+	if err != nil {
+		return err
+	}
+
+	if len(creds) == 0 {
+		fmt.Printf("no credential for target %s\n", bridgeAuthRmTarget)
+		return nil
+	}
+
+	fmt.Printf("remove all %d credentials for target %s? [y/N]: ", len(creds), bridgeAuthRmTarget)
+
+	line, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil {
+		return err
+	}
+
+	line = strings.ToLower(strings.TrimSpace(line))
+	if line != "y" && line != "yes" {
+		fmt.Println("aborted")
+		return nil
+	}
+
+	count, err := auth.RevokeAll(repo, bridgeAuthRmTarget)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%d credentials removed\n", count)
+	return nil
+}
+
 var bridgeAuthRmCmd = &cobra.Command{
-	Use:     "rm <id>",
-	Short:   "Remove a credential.",
+	Use:     "rm [<id>]",
+	Short:   "Remove a credential, or all the credentials of a target.",
 	PreRunE: loadRepo,
 	RunE:    runBridgeAuthRm,
-	Args:    cobra.ExactArgs(1),
+	Args:    cobra.MaximumNArgs(1),
 }
 
 func init() {
 	bridgeAuthCmd.AddCommand(bridgeAuthRmCmd)
+	bridgeAuthRmCmd.Flags().StringVarP(&bridgeAuthRmTarget, "target", "t", "",
+		"The target of the credentials to remove, used with --all")
+	bridgeAuthRmCmd.Flags().BoolVarP(&bridgeAuthRmAll, "all", "a", false,
+		"Remove all the credentials of the target")
+	bridgeAuthRmCmd.Flags().SortFlags = false
 }
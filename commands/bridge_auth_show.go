@@ -52,6 +52,8 @@ func runBridgeAuthShow(cmd *cobra.Command, args []string) error {
 	switch cred := cred.(type) {
 	case *auth.Token:
 		fmt.Printf("Value: %s\n", cred.Value)
+	case *auth.Secret:
+		fmt.Printf("Value: %s\n", cred.Value)
 	}
 
 	return nil
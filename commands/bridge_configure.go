@@ -22,11 +22,16 @@ const (
 )
 
 var (
-	bridgeConfigureName       string
-	bridgeConfigureTarget     string
-	bridgeConfigureParams     core.BridgeParams
-	bridgeConfigureToken      string
-	bridgeConfigureTokenStdin bool
+	bridgeConfigureName        string
+	bridgeConfigureTarget      string
+	bridgeConfigureParams      core.BridgeParams
+	bridgeConfigureToken       string
+	bridgeConfigureTokenStdin  bool
+	bridgeConfigureInteractive bool
+
+	bridgeConfigureNotifySlack        string
+	bridgeConfigureNotifyWebhook      string
+	bridgeConfigureNotifySMTPPassword string
 )
 
 func runBridgeConfigure(cmd *cobra.Command, args []string) error {
@@ -37,6 +42,10 @@ func runBridgeConfigure(cmd *cobra.Command, args []string) error {
 	defer backend.Close()
 	interrupt.RegisterCleaner(backend.Close)
 
+	if !bridgeConfigureInteractive || core.NonInteractiveFromEnv() {
+		bridgeConfigureParams.NonInteractive = true
+	}
+
 	if (bridgeConfigureTokenStdin || bridgeConfigureToken != "" || bridgeConfigureParams.CredPrefix != "") &&
 		(bridgeConfigureName == "" || bridgeConfigureTarget == "") {
 		return fmt.Errorf("you must provide a bridge name and target to configure a bridge with a credential")
@@ -61,6 +70,15 @@ func runBridgeConfigure(cmd *cobra.Command, args []string) error {
 		bridgeConfigureParams.TokenRaw = bridgeConfigureToken
 	}
 
+	if bridgeConfigureParams.NonInteractive {
+		switch {
+		case bridgeConfigureTarget == "":
+			return core.ErrMissingParam("target")
+		case bridgeConfigureName == "":
+			return core.ErrMissingParam("name")
+		}
+	}
+
 	if bridgeConfigureTarget == "" {
 		bridgeConfigureTarget, err = promptTarget()
 		if err != nil {
@@ -80,6 +98,11 @@ func runBridgeConfigure(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	err = storeNotifySecrets(backend)
+	if err != nil {
+		return err
+	}
+
 	err = b.Configure(bridgeConfigureParams)
 	if err != nil {
 		return err
@@ -89,6 +112,39 @@ func runBridgeConfigure(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// storeNotifySecrets store the secrets of the notifiers as credentials, and
+// reference them in the bridge parameters
+func storeNotifySecrets(backend *cache.RepoCache) error {
+	userId := auth.DefaultUserId
+	user, err := backend.GetUserIdentity()
+	if err == nil {
+		userId = user.Id()
+	}
+
+	store := func(value, target string) (string, error) {
+		if value == "" {
+			return "", nil
+		}
+		secret := auth.NewSecret(userId, value, target)
+		err := auth.Store(repo, secret)
+		if err != nil {
+			return "", err
+		}
+		return secret.ID().String(), nil
+	}
+
+	bridgeConfigureParams.NotifySlack, err = store(bridgeConfigureNotifySlack, "notify-slack")
+	if err != nil {
+		return err
+	}
+	bridgeConfigureParams.NotifyWebhook, err = store(bridgeConfigureNotifyWebhook, "notify-webhook")
+	if err != nil {
+		return err
+	}
+	bridgeConfigureParams.NotifySMTPPassword, err = store(bridgeConfigureNotifySMTPPassword, "notify-smtp")
+	return err
+}
+
 func promptTarget() (string, error) {
 	targets := bridge.Targets()
 
@@ -155,7 +211,8 @@ var bridgeConfigureCmd = &cobra.Command{
 	Short: "Configure a new bridge.",
 	Long: `	Configure a new bridge by passing flags or/and using interactive terminal prompts. You can avoid all the terminal prompts by passing all the necessary flags to configure your bridge.
 	Repository configuration can be made by passing either the --url flag or the --project and --owner flags. If the three flags are provided git-bug will use --project and --owner flags.
-	Token configuration can be directly passed with the --token flag or in the terminal prompt. If you don't already have one you can use the interactive procedure to generate one.`,
+	Token configuration can be directly passed with the --token flag or in the terminal prompt. If you don't already have one you can use the interactive procedure to generate one.
+	For a scripted setup, --interactive=false or the GIT_BUG_NON_INTERACTIVE=1 environment variable disable all the prompts and make a missing parameter an error.`,
 	Example: `# Interactive example
 [1]: github
 [2]: launchpad-preview
@@ -221,6 +278,35 @@ func init() {
 	bridgeConfigureCmd.Flags().StringVarP(&bridgeConfigureParams.CredPrefix, "credential", "c", "", "The identifier or prefix of an already known credential for the API (see \"git-bug bridge auth\")")
 	bridgeConfigureCmd.Flags().StringVar(&bridgeConfigureToken, "token", "", "A raw authentication token for the API")
 	bridgeConfigureCmd.Flags().BoolVar(&bridgeConfigureTokenStdin, "token-stdin", false, "Will read the token from stdin and ignore --token")
+	bridgeConfigureCmd.Flags().BoolVar(&auth.UseKeychain, "keychain", false, fmt.Sprintf("Store the new token in the system keychain instead of the git config. Can also be enabled with %s=1", auth.KeychainEnv))
+	bridgeConfigureCmd.Flags().BoolVar(&auth.KeychainFallback, "keychain-fallback", false, "Store the new token in the git config if the system keychain can't be used, instead of failing")
 	bridgeConfigureCmd.Flags().StringVarP(&bridgeConfigureParams.Project, "project", "p", "", "The name of the target repository")
+	bridgeConfigureCmd.Flags().BoolVar(&bridgeConfigureParams.ImportIterations, "import-iterations", false, "Import the iterations (sprints) the issues are assigned to (Gitlab only)")
+	bridgeConfigureCmd.Flags().BoolVar(&bridgeConfigureParams.ImportMRComments, "import-mr-comments", false, "Import the comments of the merge requests related to an issue, except the ones from bots (Gitlab only)")
+	bridgeConfigureCmd.Flags().BoolVar(&bridgeConfigureParams.ImportBoards, "import-boards", false, "Import the issue boards, to display them with \"git bug board\" (Gitlab only)")
+	bridgeConfigureCmd.Flags().BoolVar(&bridgeConfigureParams.ImportPipelineStatus, "import-pipeline-status", false, "Import the status of the CI pipelines of the merge requests related to an issue (Gitlab only)")
+	bridgeConfigureCmd.Flags().IntVar(&bridgeConfigureParams.MaxRetries, "max-retries", 3, "Number of retries of the API calls failing with a transient server error (Gitlab only)")
+	bridgeConfigureCmd.Flags().BoolVar(&bridgeConfigureParams.ImportProjectBoard, "import-project-board", false, "Synchronize the columns of a classic project board as \"column:<name>\" labels (Github only)")
+	bridgeConfigureCmd.Flags().IntVar(&bridgeConfigureParams.ProjectV2, "project-v2", 0, "Number of a project of the owner whose Status field is synchronized with the status of the bugs (Github only)")
+	bridgeConfigureCmd.Flags().StringSliceVar(&bridgeConfigureParams.ProjectStatusMap, "project-status-map", nil, "Bug status of the options of the Status field of the project, as <option>=<open|closed> (Github only)")
+	bridgeConfigureCmd.Flags().BoolVar(&bridgeConfigureParams.ImportIssueTypes, "issue-types", false, "Synchronize the issue types of the repository, listed during the configuration (Github only)")
+	bridgeConfigureCmd.Flags().StringSliceVar(&bridgeConfigureParams.ExportLabelFilter, "export-label-filter", nil, "Only export the bugs having one of these labels")
+	bridgeConfigureCmd.Flags().StringSliceVar(&bridgeConfigureParams.ImportLabelFilter, "import-label-filter", nil, "Only import the issues having one of these labels (Github and Gitlab only)")
+	bridgeConfigureCmd.Flags().StringSliceVar(&bridgeConfigureParams.ImportAssigneeFilter, "import-assignee-filter", nil, "Only import the issues assigned to one of these logins (Github and Gitlab only)")
+	bridgeConfigureCmd.Flags().StringVar(&bridgeConfigureParams.ImportCreatedAfter, "import-created-after", "", "Only import the issues created after this date, as YYYY-MM-DD or RFC3339 (Github and Gitlab only)")
+	bridgeConfigureCmd.Flags().BoolVar(&bridgeConfigureParams.ImportWorkflowFailures, "import-workflow-failures", false, "Import the failures of the Github Actions workflows as bugs labeled \"ci-failure\", closed when the workflow succeed again (Github only)")
+	bridgeConfigureCmd.Flags().BoolVar(&bridgeConfigureParams.ImportCodeScanning, "import-code-scanning", false, "Import the code scanning alerts as bugs labeled \"security\" and \"code-scanning\", closed when the alert is dismissed or fixed (Github only)")
+	bridgeConfigureCmd.Flags().BoolVar(&bridgeConfigureParams.ImportDependabot, "import-dependabot", false, "Import the Dependabot alerts as bugs labeled \"security\" and \"dependabot\", closed when the alert is dismissed or fixed. Require the security_events token scope (Github only)")
+	bridgeConfigureCmd.Flags().BoolVar(&bridgeConfigureParams.ImportSecretScanning, "import-secret-scanning", false, "Import the open secret scanning alerts as bugs labeled \"security\", \"secret-scanning\" and the type of the secret, closed when the alert is resolved. Require the security_events token scope (Github only)")
+	bridgeConfigureCmd.Flags().StringVar(&bridgeConfigureParams.EMUSlug, "emu-slug", "", "The slug of the enterprise of the Enterprise Managed Users, stripped from their login when importing their identity (Github only)")
+	bridgeConfigureCmd.Flags().StringVar(&bridgeConfigureParams.EmailDomain, "email-domain", "", "The domain of the corporate emails of the Enterprise Managed Users, used in place of their noreply email. Require --emu-slug (Github only)")
+	bridgeConfigureCmd.Flags().StringVar(&bridgeConfigureNotifySlack, "notify-slack-webhook", "", "The URL of a Slack Incoming Webhook notified after each successful import")
+	bridgeConfigureCmd.Flags().StringVar(&bridgeConfigureNotifyWebhook, "notify-webhook", "", "An URL receiving the events of each successful import as JSON")
+	bridgeConfigureCmd.Flags().StringSliceVar(&bridgeConfigureParams.NotifyEmailTo, "notify-email-to", nil, "The recipients of the email sent after each successful import")
+	bridgeConfigureCmd.Flags().StringVar(&bridgeConfigureParams.NotifyEmailFrom, "notify-email-from", "", "The sender of the notification emails, also used as the SMTP username")
+	bridgeConfigureCmd.Flags().StringVar(&bridgeConfigureParams.NotifySMTPAddr, "notify-smtp-addr", "", "The address of the SMTP server sending the notification emails, as host:port")
+	bridgeConfigureCmd.Flags().StringVar(&bridgeConfigureNotifySMTPPassword, "notify-smtp-password", "", "The password of the SMTP server, if required")
+	bridgeConfigureCmd.Flags().BoolVar(&bridgeConfigureInteractive, "interactive", true,
+		fmt.Sprintf("Allow terminal prompts for the missing parameters. Prompts can also be disabled by setting %s=1", core.NonInteractiveEnv))
 	bridgeConfigureCmd.Flags().SortFlags = false
 }
@@ -0,0 +1,65 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/bridge"
+	"github.com/MichaelMure/git-bug/bridge/core/auth"
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/util/interrupt"
+)
+
+var (
+	bridgeImportURLCredential string
+	bridgeImportURLToken      string
+)
+
+func runBridgeImportURL(cmd *cobra.Command, args []string) error {
+	backend, err := cache.NewRepoCache(repo)
+	if err != nil {
+		return err
+	}
+	defer backend.Close()
+	interrupt.RegisterCleaner(backend.Close)
+
+	var cred auth.Credential
+
+	switch {
+	case bridgeImportURLCredential != "":
+		cred, err = auth.LoadWithPrefix(repo, bridgeImportURLCredential)
+		if err != nil {
+			return err
+		}
+	case bridgeImportURLToken != "":
+		// the token is only used for this import, so it isn't stored
+		cred = auth.NewToken(auth.DefaultUserId, bridgeImportURLToken, "")
+	default:
+		return fmt.Errorf("a credential or a token is required")
+	}
+
+	b, err := bridge.ImportBugFromURL(context.Background(), backend, args[0], cred)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("imported bug %s: %s\n", b.Id().Human(), b.Snapshot().Title)
+	return nil
+}
+
+var bridgeImportURLCmd = &cobra.Command{
+	Use:     "import-url <url>",
+	Short:   "Import a single Github or Gitlab issue from its URL, without configuring a bridge.",
+	PreRunE: loadRepo,
+	RunE:    runBridgeImportURL,
+	Args:    cobra.ExactArgs(1),
+}
+
+func init() {
+	bridgeCmd.AddCommand(bridgeImportURLCmd)
+	bridgeImportURLCmd.Flags().StringVarP(&bridgeImportURLCredential, "credential", "c", "", "The identifier or prefix of an already known credential for the API (see \"git-bug bridge auth\")")
+	bridgeImportURLCmd.Flags().StringVar(&bridgeImportURLToken, "token", "", "A raw authentication token for the API")
+	bridgeImportURLCmd.Flags().SortFlags = false
+}
@@ -4,22 +4,29 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strings"
 	"sync"
 	"time"
 
+	text "github.com/MichaelMure/go-term-text"
 	"github.com/araddon/dateparse"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 
 	"github.com/MichaelMure/git-bug/bridge"
 	"github.com/MichaelMure/git-bug/bridge/core"
+	"github.com/MichaelMure/git-bug/bridge/core/auth"
 	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/entity"
 	"github.com/MichaelMure/git-bug/util/interrupt"
 )
 
 var (
-	bridgePullImportSince string
-	bridgePullNoResume    bool
+	bridgePullImportSince  string
+	bridgePullNoResume     bool
+	bridgePullTimeout      time.Duration
+	bridgePullTotalTimeout time.Duration
+	bridgePullMigration    bool
 )
 
 func runBridgePull(cmd *cobra.Command, args []string) error {
@@ -46,6 +53,18 @@ func runBridgePull(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	err = b.SetTimeouts(bridgePullTimeout, bridgePullTotalTimeout)
+	if err != nil {
+		return err
+	}
+
+	if bridgePullMigration {
+		err = b.SetUseMigrationAPI()
+		if err != nil {
+			return err
+		}
+	}
+
 	parentCtx := context.Background()
 	ctx, cancel := context.WithCancel(parentCtx)
 	defer cancel()
@@ -75,50 +94,74 @@ func runBridgePull(cmd *cobra.Command, args []string) error {
 		return nil
 	})
 
-	var events <-chan core.ImportResult
+	var since time.Time
 	switch {
 	case bridgePullNoResume:
-		events, err = b.ImportAllSince(ctx, time.Time{})
+		since = time.Time{}
 	case bridgePullImportSince != "":
-		since, err2 := parseSince(bridgePullImportSince)
-		if err2 != nil {
-			return errors.Wrap(err2, "import time parsing")
+		since, err = parseSince(bridgePullImportSince)
+		if err != nil {
+			return errors.Wrap(err, "import time parsing")
 		}
-		events, err = b.ImportAllSince(ctx, since)
 	default:
-		events, err = b.ImportAll(ctx)
+		since = b.LastImportTime()
 	}
 
+	notifiers, err := b.Notifiers(loadNotifySecret)
 	if err != nil {
 		return err
 	}
 
-	importedIssues := 0
-	importedIdentities := 0
-	for result := range events {
-		switch result.Event {
-		case core.ImportEventNothing:
-			// filtered
-
-		case core.ImportEventBug:
-			importedIssues++
-			fmt.Println(result.String())
-
-		case core.ImportEventIdentity:
-			importedIdentities++
-			fmt.Println(result.String())
-
+	// collect the events to notify, from the start of the import
+	collected := make(chan []core.BridgeEvent, 1)
+	bridgeEvents := b.Events()
+	go func() {
+		var events []core.BridgeEvent
+		for event := range bridgeEvents {
+			events = append(events, event)
+		}
+		collected <- events
+	}()
+
+	progress := make(chan core.ProgressEvent)
+	importErr := make(chan error, 1)
+	go func() {
+		importErr <- b.ImportAllWithProgress(ctx, since, progress)
+	}()
+
+	var last core.ProgressEvent
+	for event := range progress {
+		last = event
+
+		// the details are printed above the progress bar
+		switch event.Result.Event {
+		case core.ImportEventNothing, core.ImportEventTotal:
 		case core.ImportEventError:
-			if result.Err != context.Canceled {
-				fmt.Println(result.String())
+			if event.Result.Err != context.Canceled {
+				fmt.Printf("\r\033[K%s\n", event.Result.String())
 			}
-
 		default:
-			fmt.Println(result.String())
+			fmt.Printf("\r\033[K%s\n", event.Result.String())
 		}
+
+		fmt.Print(progressLine(event))
+	}
+	if last.Done > 0 || last.Total > 0 {
+		fmt.Println()
+	}
+
+	err = <-importErr
+	if err != nil && err != context.Canceled {
+		return err
 	}
 
-	fmt.Printf("imported %d issues and %d identities with %s bridge\n", importedIssues, importedIdentities, b.Name)
+	fmt.Printf("imported %d issues and %d identities with %s bridge\n", last.Imported, last.Identities, b.Name)
+	printSyncStats(b.Stats())
+
+	// the events are all collected once the import is done
+	if err == nil && len(last.Errors) == 0 {
+		notify(notifiers, <-collected)
+	}
 
 	// send done signal
 	close(done)
@@ -126,6 +169,65 @@ func runBridgePull(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// loadNotifySecret load the value of a secret stored for a notifier
+func loadNotifySecret(id string) (string, error) {
+	cred, err := auth.LoadWithId(repo, entity.Id(id))
+	if err != nil {
+		return "", err
+	}
+	secret, ok := cred.(*auth.Secret)
+	if !ok {
+		return "", fmt.Errorf("credential %s is not a secret", entity.Id(id).Human())
+	}
+	return secret.Value, nil
+}
+
+// notify send the events of a successful import to the notifiers, and report
+// their failures without failing the import
+func notify(notifiers []core.Notifier, events []core.BridgeEvent) {
+	for _, n := range notifiers {
+		for _, event := range events {
+			err := n.Notify(event)
+			if err != nil {
+				fmt.Printf("notification error: %s\n", err)
+				break
+			}
+		}
+	}
+}
+
+// printSyncStats report the time spent waiting for the API rate limit, if any
+func printSyncStats(stats core.SyncStats) {
+	if stats.RateLimitHits == 0 {
+		return
+	}
+	fmt.Printf("rate limit reached %d times, waited %s\n",
+		stats.RateLimitHits, stats.RateLimitWaitDuration.Round(time.Second))
+}
+
+const progressBarWidth = 30
+
+// progressLine format a single line progress bar, meant to be overwritten
+// by the next one.
+func progressLine(event core.ProgressEvent) string {
+	title := text.TruncateMax(event.CurrentTitle, 40)
+
+	if event.Total <= 0 {
+		return fmt.Sprintf("\r\033[K%d issues processed, %d errors: %s",
+			event.Done, len(event.Errors), title)
+	}
+
+	filled := event.Done * progressBarWidth / event.Total
+	if filled > progressBarWidth {
+		filled = progressBarWidth
+	}
+
+	return fmt.Sprintf("\r\033[K[%s%s] %d/%d, %d errors: %s",
+		strings.Repeat("=", filled),
+		strings.Repeat(" ", progressBarWidth-filled),
+		event.Done, event.Total, len(event.Errors), title)
+}
+
 func parseSince(since string) (time.Time, error) {
 	duration, err := time.ParseDuration(since)
 	if err == nil {
@@ -147,4 +249,7 @@ func init() {
 	bridgeCmd.AddCommand(bridgePullCmd)
 	bridgePullCmd.Flags().BoolVarP(&bridgePullNoResume, "no-resume", "n", false, "force importing all bugs")
 	bridgePullCmd.Flags().StringVarP(&bridgePullImportSince, "since", "s", "", "import only bugs updated after the given date (ex: \"200h\" or \"june 2 2019\")")
+	bridgePullCmd.Flags().DurationVar(&bridgePullTimeout, "timeout", 0, "limit the duration of each API call (default 30s, or as configured)")
+	bridgePullCmd.Flags().DurationVar(&bridgePullTotalTimeout, "total-timeout", 0, "stop the import when it takes longer than this duration")
+	bridgePullCmd.Flags().BoolVar(&bridgePullMigration, "use-migration-api", false, "import the issues from a bulk export of the remote, much faster for a first import of a large repository")
 }
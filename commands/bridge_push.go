@@ -15,6 +15,10 @@ import (
 	"github.com/MichaelMure/git-bug/util/interrupt"
 )
 
+var (
+	bridgePushLabelFilter []string
+)
+
 func runBridgePush(cmd *cobra.Command, args []string) error {
 	backend, err := cache.NewRepoCache(repo)
 	if err != nil {
@@ -35,6 +39,17 @@ func runBridgePush(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	if !b.Capabilities().Has(core.CapExport) {
+		return fmt.Errorf("the %s bridge (%s) is import-only and doesn't support exporting", b.Name, b.Target())
+	}
+
+	if len(bridgePushLabelFilter) > 0 {
+		err = b.SetExportLabelFilter(bridgePushLabelFilter)
+		if err != nil {
+			return err
+		}
+	}
+
 	parentCtx := context.Background()
 	ctx, cancel := context.WithCancel(parentCtx)
 	defer cancel()
@@ -81,6 +96,7 @@ func runBridgePush(cmd *cobra.Command, args []string) error {
 	}
 
 	fmt.Printf("exported %d issues with %s bridge\n", exportedIssues, b.Name)
+	printSyncStats(b.Stats())
 
 	// send done signal
 	close(done)
@@ -97,4 +113,7 @@ var bridgePushCmd = &cobra.Command{
 
 func init() {
 	bridgeCmd.AddCommand(bridgePushCmd)
+
+	bridgePushCmd.Flags().StringSliceVar(&bridgePushLabelFilter, "label-filter", nil,
+		"Only export the bugs having one of these labels, overriding the export label filter of the bridge")
 }
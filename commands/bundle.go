@@ -0,0 +1,62 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/mattn/go-isatty"
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/commands/select"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/util/interrupt"
+)
+
+func runBundle(cmd *cobra.Command, args []string) error {
+	if isatty.IsTerminal(os.Stdout.Fd()) {
+		return fmt.Errorf("the bundle is binary data, redirect the output to a file")
+	}
+
+	backend, err := cache.NewRepoCache(repo)
+	if err != nil {
+		return err
+	}
+	defer backend.Close()
+	interrupt.RegisterCleaner(backend.Close)
+
+	var ids []entity.Id
+
+	if len(args) == 0 {
+		b, _, err := _select.ResolveBug(backend, args)
+		if err != nil {
+			return err
+		}
+		ids = append(ids, b.Id())
+	}
+
+	for _, prefix := range args {
+		b, err := backend.ResolveBugPrefix(prefix)
+		if err != nil {
+			return err
+		}
+		ids = append(ids, b.Id())
+	}
+
+	return backend.BundleBugs(ids, os.Stdout)
+}
+
+var bundleCmd = &cobra.Command{
+	Use:   "bundle [<id>...]",
+	Short: "Write the given bugs as a git bundle on the standard output.",
+	Long: `Write the given bugs, with the identities they reference, as a git bundle on the standard output.
+
+The bundle can be read in another repository with "git bug unbundle", to transfer bugs without a shared remote.`,
+	Example: `git bug bundle 2f9d3e4 > bug.bundle`,
+	PreRunE: loadRepo,
+	RunE:    runBundle,
+}
+
+func init() {
+	RootCmd.AddCommand(bundleCmd)
+}
@@ -0,0 +1,78 @@
+package commands
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/util/interrupt"
+)
+
+var (
+	cleanupForce bool
+)
+
+func runCleanup(cmd *cobra.Command, args []string) error {
+	backend, err := cache.NewRepoCache(repo)
+	if err != nil {
+		return err
+	}
+	defer backend.Close()
+	interrupt.RegisterCleaner(backend.Close)
+
+	targets, err := backend.ObsoleteRefs()
+	if err != nil {
+		return err
+	}
+
+	if len(targets) == 0 {
+		fmt.Println("no obsolete bridge metadata")
+		return nil
+	}
+
+	for _, target := range targets {
+		if !cleanupForce {
+			fmt.Printf("strip the metadata of the deconfigured bridge target %s? [y/N]: ", target)
+
+			line, err := bufio.NewReader(os.Stdin).ReadString('\n')
+			if err != nil {
+				return err
+			}
+
+			line = strings.ToLower(strings.TrimSpace(line))
+			if line != "y" && line != "yes" {
+				continue
+			}
+		}
+
+		count, err := backend.StripTargetMetadata(target)
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("%s: metadata stripped from %d bugs\n", target, count)
+	}
+
+	return nil
+}
+
+var cleanupCmd = &cobra.Command{
+	Use:     "cleanup",
+	Short:   "Strip the metadata of the bridges that are not configured anymore.",
+	PreRunE: loadRepo,
+	RunE:    runCleanup,
+	Args:    cobra.NoArgs,
+}
+
+func init() {
+	RootCmd.AddCommand(cleanupCmd)
+
+	cleanupCmd.Flags().SortFlags = false
+
+	cleanupCmd.Flags().BoolVarP(&cleanupForce, "force", "f", false,
+		"Strip the metadata of all the obsolete targets without asking")
+}
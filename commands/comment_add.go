@@ -11,8 +11,11 @@ import (
 )
 
 var (
-	commentAddMessageFile string
-	commentAddMessage     string
+	commentAddMessageFile       string
+	commentAddMessage           string
+	commentAddOverrideSizeLimit bool
+	commentAddOverrideLock      bool
+	commentAddReferences        []string
 )
 
 func runCommentAdd(cmd *cobra.Command, args []string) error {
@@ -28,6 +31,20 @@ func runCommentAdd(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	if commentAddOverrideLock && len(commentAddReferences) > 0 {
+		return fmt.Errorf("references can't be given with --override-lock")
+	}
+
+	// read large comments from a file directly, with size and binary checks
+	if commentAddMessageFile != "" && commentAddMessageFile != "-" && commentAddMessage == "" &&
+		!commentAddOverrideSizeLimit && !commentAddOverrideLock && len(commentAddReferences) == 0 {
+		err = b.AddCommentFromFile(commentAddMessageFile)
+		if err != nil {
+			return commentAddError(err)
+		}
+		return b.Commit()
+	}
+
 	if commentAddMessageFile != "" && commentAddMessage == "" {
 		commentAddMessage, err = input.BugCommentFileInput(commentAddMessageFile)
 		if err != nil {
@@ -46,14 +63,32 @@ func runCommentAdd(cmd *cobra.Command, args []string) error {
 		}
 	}
 
-	_, err = b.AddComment(commentAddMessage)
+	if commentAddOverrideLock {
+		_, err = b.AddCommentOverrideLock(commentAddMessage, nil)
+	} else if len(commentAddReferences) > 0 {
+		_, err = b.AddCommentWithReferences(commentAddMessage, commentAddReferences)
+	} else {
+		_, err = b.AddComment(commentAddMessage)
+	}
 	if err != nil {
-		return err
+		return commentAddError(err)
+	}
+
+	if commentAddOverrideSizeLimit {
+		return b.CommitOverrideSizeLimit()
 	}
 
 	return b.Commit()
 }
 
+// commentAddError explain how to fix the errors due to the repository policy
+func commentAddError(err error) error {
+	if _, ok := err.(cache.ErrCommentTooShort); ok {
+		return fmt.Errorf("%v, please give more details. The minimum is configured with git-bug.min-comment-words", err)
+	}
+	return err
+}
+
 var commentAddCmd = &cobra.Command{
 	Use:     "add [<id>]",
 	Short:   "Add a new comment to a bug.",
@@ -73,4 +108,16 @@ func init() {
 	commentAddCmd.Flags().StringVarP(&commentAddMessage, "message", "m", "",
 		"Provide the new message from the command line",
 	)
+
+	commentAddCmd.Flags().BoolVar(&commentAddOverrideSizeLimit, "override-size-limit", false,
+		"Allow a comment larger than the configured maximum operation size (git-bug.max-operation-size)",
+	)
+
+	commentAddCmd.Flags().BoolVar(&commentAddOverrideLock, "override-lock", false,
+		"Comment even if the bug is locked, as a maintainer",
+	)
+
+	commentAddCmd.Flags().StringSliceVarP(&commentAddReferences, "reference", "r", nil,
+		"Record an URL or a commit hash the comment refers to. Can be repeated",
+	)
 }
@@ -0,0 +1,137 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/mattn/go-isatty"
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/input"
+	"github.com/MichaelMure/git-bug/util/colors"
+	"github.com/MichaelMure/git-bug/util/interrupt"
+)
+
+var (
+	deduplicateThreshold float64
+	deduplicateNoPrompt  bool
+)
+
+func runDeduplicate(cmd *cobra.Command, args []string) error {
+	backend, err := cache.NewRepoCache(repo)
+	if err != nil {
+		return err
+	}
+	defer backend.Close()
+	interrupt.RegisterCleaner(backend.Close)
+
+	groups, err := backend.DuplicateBugs(deduplicateThreshold)
+	if err != nil {
+		return err
+	}
+
+	if len(groups) == 0 {
+		fmt.Println("No suspected duplicates.")
+		return nil
+	}
+
+	interactive := !deduplicateNoPrompt && isatty.IsTerminal(os.Stdin.Fd())
+
+	for i, group := range groups {
+		if i > 0 {
+			fmt.Println()
+		}
+
+		for j, id := range group {
+			excerpt, err := backend.ResolveBugExcerpt(id)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("%d. %s\t%s\t%s\n",
+				j+1,
+				colors.Cyan(id.Human()),
+				colors.Yellow(excerpt.Status),
+				excerpt.Title,
+			)
+		}
+
+		if !interactive {
+			continue
+		}
+
+		if err := promptDuplicates(backend, group); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// promptDuplicates ask which bug of a group is the original one, and mark
+// the others as its duplicates
+func promptDuplicates(backend *cache.RepoCache, group []entity.Id) error {
+	for {
+		answer, err := input.PromptValue(
+			fmt.Sprintf("Original bug to keep [1-%d], the others being closed as duplicates (empty to skip)", len(group)), "")
+		if err != nil {
+			return err
+		}
+		if answer == "" {
+			return nil
+		}
+
+		index, err := strconv.Atoi(answer)
+		if err != nil || index < 1 || index > len(group) {
+			fmt.Fprintf(os.Stderr, "invalid choice \"%s\"\n", answer)
+			continue
+		}
+
+		original, err := backend.ResolveBug(group[index-1])
+		if err != nil {
+			return err
+		}
+
+		for _, id := range group {
+			if id == original.Id() {
+				continue
+			}
+
+			b, err := backend.ResolveBug(id)
+			if err != nil {
+				return err
+			}
+
+			if err := b.MarkDuplicateOf(original); err != nil {
+				return err
+			}
+
+			fmt.Printf("%s closed as duplicate of %s\n", id.Human(), original.Id().Human())
+		}
+
+		return nil
+	}
+}
+
+var deduplicateCmd = &cobra.Command{
+	Use:   "deduplicate",
+	Short: "Find the bugs with similar titles, and close the duplicates.",
+	Long: `Find the bugs with similar titles, grouped as suspected duplicates.
+
+For each group, the bug to keep can be chosen, the others being closed with the "duplicate" label and a link to the bug kept.`,
+	PreRunE: loadRepoEnsureUser,
+	RunE:    runDeduplicate,
+}
+
+func init() {
+	RootCmd.AddCommand(deduplicateCmd)
+
+	deduplicateCmd.Flags().SortFlags = false
+
+	deduplicateCmd.Flags().Float64VarP(&deduplicateThreshold, "threshold", "t", 0.6,
+		"Minimal similarity of the titles, from 0.0 (no common word) to 1.0 (the same words)")
+	deduplicateCmd.Flags().BoolVar(&deduplicateNoPrompt, "no-prompt", false,
+		"Only display the suspected duplicates, without asking which bugs to close")
+}
@@ -0,0 +1,64 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/util/colors"
+	"github.com/MichaelMure/git-bug/util/interrupt"
+)
+
+func runDoctor(cmd *cobra.Command, args []string) error {
+	backend, err := cache.NewRepoCache(repo)
+	if err != nil {
+		return err
+	}
+	defer backend.Close()
+	interrupt.RegisterCleaner(backend.Close)
+
+	issues := backend.Healthcheck()
+
+	if len(issues) == 0 {
+		fmt.Println("No problem found.")
+		return nil
+	}
+
+	var errorCount int
+	for _, issue := range issues {
+		severity := colors.Yellow(issue.Severity.String())
+		if issue.Severity == cache.HealthError {
+			severity = colors.Red(issue.Severity.String())
+			errorCount++
+		}
+
+		if issue.BugID != "" {
+			fmt.Printf("%s %s: %s\n", severity, colors.Cyan(issue.BugID.Human()), issue.Description)
+		} else {
+			fmt.Printf("%s: %s\n", severity, issue.Description)
+		}
+
+		if issue.Suggestion != "" {
+			fmt.Printf("    suggestion: %s\n", issue.Suggestion)
+		}
+	}
+
+	fmt.Printf("\n%d problem(s) found, %d error(s)\n", len(issues), errorCount)
+
+	if errorCount > 0 {
+		return fmt.Errorf("the repository has errors")
+	}
+	return nil
+}
+
+var doctorCmd = &cobra.Command{
+	Use:     "doctor",
+	Short:   "Check the integrity of the bugs data and of the cache.",
+	PreRunE: loadRepo,
+	RunE:    runDoctor,
+}
+
+func init() {
+	RootCmd.AddCommand(doctorCmd)
+}
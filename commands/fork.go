@@ -0,0 +1,93 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/commands/select"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/input"
+	"github.com/MichaelMure/git-bug/util/interrupt"
+)
+
+var (
+	forkTitle    string
+	forkComments []string
+)
+
+func runFork(cmd *cobra.Command, args []string) error {
+	backend, err := cache.NewRepoCache(repo)
+	if err != nil {
+		return err
+	}
+	defer backend.Close()
+	interrupt.RegisterCleaner(backend.Close)
+
+	b, args, err := _select.ResolveBug(backend, args)
+	if err != nil {
+		return err
+	}
+
+	snap := b.Snapshot()
+
+	commentIds := make([]entity.Id, 0, len(forkComments))
+	for _, prefix := range forkComments {
+		var matching []entity.Id
+		for _, comment := range snap.Comments {
+			if comment.Id().HasPrefix(prefix) {
+				matching = append(matching, comment.Id())
+			}
+		}
+
+		switch len(matching) {
+		case 0:
+			return fmt.Errorf("no comment matching %s", prefix)
+		case 1:
+			commentIds = append(commentIds, matching[0])
+		default:
+			return fmt.Errorf("multiple comments matching %s", prefix)
+		}
+	}
+
+	if forkTitle == "" {
+		forkTitle, err = input.BugTitleEditorInput(repo, snap.Title)
+		if err == input.ErrEmptyTitle {
+			fmt.Println("Empty title, aborting.")
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	fork, err := b.Fork(forkTitle, commentIds)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s forked as %s\n", b.Id().Human(), fork.Id().Human())
+
+	return nil
+}
+
+var forkCmd = &cobra.Command{
+	Use:     "fork [<id>]",
+	Short:   "Split a bug by creating a linked copy with some of its comments.",
+	PreRunE: loadRepoEnsureUser,
+	RunE:    runFork,
+}
+
+func init() {
+	RootCmd.AddCommand(forkCmd)
+
+	forkCmd.Flags().SortFlags = false
+
+	forkCmd.Flags().StringVarP(&forkTitle, "title", "t", "",
+		"Provide a title for the new bug",
+	)
+	forkCmd.Flags().StringArrayVarP(&forkComments, "comment", "c", nil,
+		"Id of a comment to copy in the new bug, can be repeated",
+	)
+}
@@ -0,0 +1,76 @@
+package commands
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/commands/select"
+	"github.com/MichaelMure/git-bug/util/interrupt"
+)
+
+var (
+	formatPatchOutput string
+)
+
+func runFormatPatch(cmd *cobra.Command, args []string) error {
+	backend, err := cache.NewRepoCache(repo)
+	if err != nil {
+		return err
+	}
+	defer backend.Close()
+	interrupt.RegisterCleaner(backend.Close)
+
+	b, args, err := _select.ResolveBug(backend, args)
+	if err != nil {
+		return err
+	}
+
+	output := formatPatchOutput
+	if output == "" {
+		output = fmt.Sprintf("%s.patch", b.Id().Human())
+	}
+
+	var w io.Writer = os.Stdout
+	if output != "-" {
+		f, err := os.Create(output)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+
+	err = backend.FormatPatch(b.Id(), w)
+	if err != nil {
+		return err
+	}
+
+	if output != "-" {
+		fmt.Println(output)
+	}
+
+	return nil
+}
+
+var formatPatchCmd = &cobra.Command{
+	Use:   "format-patch [<id>]",
+	Short: "Write a bug as a patch file, to be sent by email and applied with \"git bug am\".",
+	Long: `Write a bug as a patch file, to be sent by email and applied with "git bug am".
+
+The patch is a mbox in the format of git format-patch, with one message per commit of the bug and of the identities of its authors.`,
+	PreRunE: loadRepo,
+	RunE:    runFormatPatch,
+}
+
+func init() {
+	RootCmd.AddCommand(formatPatchCmd)
+
+	formatPatchCmd.Flags().SortFlags = false
+
+	formatPatchCmd.Flags().StringVarP(&formatPatchOutput, "output", "o", "",
+		"Write the patch in this file instead of <id>.patch, or - for the standard output")
+}
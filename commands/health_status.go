@@ -0,0 +1,84 @@
+package commands
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/bridge/gitlab"
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/commands/select"
+	"github.com/MichaelMure/git-bug/util/interrupt"
+)
+
+var (
+	healthStatusClear bool
+)
+
+func runHealthStatus(cmd *cobra.Command, args []string) error {
+	backend, err := cache.NewRepoCache(repo)
+	if err != nil {
+		return err
+	}
+	defer backend.Close()
+	interrupt.RegisterCleaner(backend.Close)
+
+	b, args, err := _select.ResolveBug(backend, args)
+	if err != nil {
+		return err
+	}
+
+	current, _ := b.Snapshot().LastMetadata(gitlab.MetaKeyHealthStatus)
+
+	var status string
+	switch {
+	case healthStatusClear && len(args) > 0:
+		return fmt.Errorf("--clear can't be used with a status")
+	case healthStatusClear:
+		status = ""
+	case len(args) == 0:
+		if current == "" {
+			fmt.Println("no health status")
+		} else {
+			fmt.Println(current)
+		}
+		return nil
+	case len(args) > 1:
+		return fmt.Errorf("only one health status can be set")
+	case !gitlab.IsHealthStatus(args[0]):
+		return fmt.Errorf("invalid health status %s, expected one of: %s",
+			args[0], strings.Join(gitlab.HealthStatuses, ", "))
+	default:
+		status = args[0]
+	}
+
+	if status == current {
+		fmt.Println("No change, aborting.")
+		return nil
+	}
+
+	_, err = b.OpNoOp(map[string]string{gitlab.MetaKeyHealthStatus: status})
+	if err != nil {
+		return err
+	}
+
+	return b.Commit()
+}
+
+var healthStatusCmd = &cobra.Command{
+	Use:     "health-status [<id>] [<status>]",
+	Short:   "Display or change the Gitlab health status of a bug (on_track, needs_attention or at_risk).",
+	PreRunE: loadRepoEnsureUser,
+	RunE:    runHealthStatus,
+}
+
+func init() {
+	RootCmd.AddCommand(healthStatusCmd)
+
+	healthStatusCmd.Flags().SortFlags = false
+
+	healthStatusCmd.Flags().BoolVar(&healthStatusClear, "clear", false,
+		"Remove the health status",
+	)
+}
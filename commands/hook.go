@@ -0,0 +1,14 @@
+package commands
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var hookCmd = &cobra.Command{
+	Use:   "hook",
+	Short: "Manage the git hooks of git-bug.",
+}
+
+func init() {
+	RootCmd.AddCommand(hookCmd)
+}
@@ -0,0 +1,58 @@
+package commands
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+const postCommitHookCommand = "git bug hook post-commit"
+
+const postCommitHook = `#!/bin/sh
+# installed by git-bug: cross reference the commit with the bugs it fix or close
+` + postCommitHookCommand + `
+`
+
+func runHookInstall(cmd *cobra.Command, args []string) error {
+	hooksDir := path.Join(repo.GetPath(), "hooks")
+	hookPath := path.Join(hooksDir, "post-commit")
+
+	existing, err := ioutil.ReadFile(hookPath)
+	switch {
+	case err == nil && strings.Contains(string(existing), postCommitHookCommand):
+		fmt.Println("The post-commit hook is already installed.")
+		return nil
+	case err == nil:
+		return fmt.Errorf("a post-commit hook already exist, add \"%s\" to %s to cross reference the commits",
+			postCommitHookCommand, hookPath)
+	case !os.IsNotExist(err):
+		return err
+	}
+
+	if err := os.MkdirAll(hooksDir, 0755); err != nil {
+		return err
+	}
+
+	if err := ioutil.WriteFile(hookPath, []byte(postCommitHook), 0755); err != nil {
+		return err
+	}
+
+	fmt.Printf("post-commit hook installed in %s\n", hookPath)
+	return nil
+}
+
+var hookInstallCmd = &cobra.Command{
+	Use:     "install",
+	Short:   "Install a post-commit hook cross referencing the new commits with the bugs they fix or close.",
+	PreRunE: loadRepo,
+	RunE:    runHookInstall,
+	Args:    cobra.NoArgs,
+}
+
+func init() {
+	hookCmd.AddCommand(hookInstallCmd)
+}
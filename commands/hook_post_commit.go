@@ -0,0 +1,52 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/util/interrupt"
+)
+
+func runHookPostCommit(cmd *cobra.Command, args []string) error {
+	backend, err := cache.NewRepoCache(repo)
+	if err != nil {
+		return err
+	}
+	defer backend.Close()
+	interrupt.RegisterCleaner(backend.Close)
+
+	commit, err := repo.ResolveCommit("HEAD")
+	if err != nil {
+		return err
+	}
+
+	message, err := repo.CommitMessage(commit.String())
+	if err != nil {
+		return err
+	}
+
+	ids, err := backend.CrossReferenceCommit(commit.String(), message)
+	if err != nil {
+		return err
+	}
+
+	for _, id := range ids {
+		fmt.Printf("git-bug: commit %s cross referenced with bug %s\n", commit[:7], id.Human())
+	}
+
+	return nil
+}
+
+var hookPostCommitCmd = &cobra.Command{
+	Use:     "post-commit",
+	Short:   "Cross reference the last commit with the bugs its message fix or close, as run by the post-commit hook.",
+	PreRunE: loadRepoEnsureUser,
+	RunE:    runHookPostCommit,
+	Args:    cobra.NoArgs,
+}
+
+func init() {
+	hookCmd.AddCommand(hookPostCommitCmd)
+}
@@ -0,0 +1,58 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/commands/select"
+	"github.com/MichaelMure/git-bug/util/interrupt"
+)
+
+func runLock(locked bool) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		backend, err := cache.NewRepoCache(repo)
+		if err != nil {
+			return err
+		}
+		defer backend.Close()
+		interrupt.RegisterCleaner(backend.Close)
+
+		b, _, err := _select.ResolveBug(backend, args)
+		if err != nil {
+			return err
+		}
+
+		if b.IsLocked() == locked {
+			fmt.Println("No change, aborting.")
+			return nil
+		}
+
+		_, err = b.SetLocked(locked)
+		if err != nil {
+			return err
+		}
+
+		return b.Commit()
+	}
+}
+
+var lockCmd = &cobra.Command{
+	Use:     "lock [<id>]",
+	Short:   "Lock a bug, restricting new comments to the maintainers.",
+	PreRunE: loadRepoEnsureUser,
+	RunE:    runLock(true),
+}
+
+var unlockCmd = &cobra.Command{
+	Use:     "unlock [<id>]",
+	Short:   "Unlock a bug, allowing everyone to comment.",
+	PreRunE: loadRepoEnsureUser,
+	RunE:    runLock(false),
+}
+
+func init() {
+	RootCmd.AddCommand(lockCmd)
+	RootCmd.AddCommand(unlockCmd)
+}
@@ -0,0 +1,101 @@
+package commands
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/commands/select"
+	"github.com/MichaelMure/git-bug/util/colors"
+	"github.com/MichaelMure/git-bug/util/interrupt"
+)
+
+var (
+	logShowDevice bool
+	logFilterKind []string
+)
+
+func runLog(cmd *cobra.Command, args []string) error {
+	backend, err := cache.NewRepoCache(repo)
+	if err != nil {
+		return err
+	}
+	defer backend.Close()
+	interrupt.RegisterCleaner(backend.Close)
+
+	b, args, err := _select.ResolveBug(backend, args)
+	if err != nil {
+		return err
+	}
+
+	kinds := make(map[string]bool, len(logFilterKind))
+	for _, kind := range logFilterKind {
+		if !validOperationKind(kind) {
+			return fmt.Errorf("unknown operation kind \"%s\", valid values are [%s]",
+				kind, strings.Join(bug.OperationKinds(), ","))
+		}
+		kinds[kind] = true
+	}
+
+	it := b.Operations()
+	for i := 0; it.Next(); i++ {
+		op := it.Value()
+
+		if len(kinds) > 0 && !kinds[op.Kind()] {
+			continue
+		}
+
+		// the index allow to display the bug at this point with show --at
+		fmt.Printf("%3d %s %s %-14s %s",
+			i,
+			colors.Cyan(op.Id().Human()),
+			op.Time().Format("2006-01-02 15:04:05"),
+			op.Kind(),
+			colors.Magenta(op.GetAuthor().DisplayName()),
+		)
+
+		if logShowDevice {
+			device := op.GetDeviceID()
+			if device == "" {
+				device = "unknown"
+			} else if len(device) > 7 {
+				device = device[:7]
+			}
+			fmt.Printf(" device:%s", device)
+		}
+
+		fmt.Println()
+	}
+
+	return it.Err()
+}
+
+func validOperationKind(kind string) bool {
+	for _, k := range bug.OperationKinds() {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
+
+var logCmd = &cobra.Command{
+	Use:     "log [<id>]",
+	Short:   "Display the operations of a bug.",
+	PreRunE: loadRepo,
+	RunE:    runLog,
+}
+
+func init() {
+	RootCmd.AddCommand(logCmd)
+
+	logCmd.Flags().SortFlags = false
+
+	logCmd.Flags().BoolVarP(&logShowDevice, "show-device", "", false,
+		"Show the device each operation has been created on, when recorded")
+	logCmd.Flags().StringSliceVar(&logFilterKind, "filter-kind", nil,
+		fmt.Sprintf("Only show the operations of the given kinds. Valid values are [%s]", strings.Join(bug.OperationKinds(), ",")))
+}
@@ -7,7 +7,10 @@ import (
 	text "github.com/MichaelMure/go-term-text"
 	"github.com/spf13/cobra"
 
+	"github.com/MichaelMure/git-bug/bridge/github"
+	"github.com/MichaelMure/git-bug/bridge/gitlab"
 	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/entity"
 	"github.com/MichaelMure/git-bug/util/colors"
 	"github.com/MichaelMure/git-bug/util/interrupt"
 )
@@ -20,8 +23,19 @@ var (
 	lsTitleQuery       []string
 	lsActorQuery       []string
 	lsNoQuery          []string
+	lsQuery            string
+	lsOverdue          bool
+	lsHasTag           []string
+	lsHasChecklist     bool
+	lsChecklistDone    bool
+	lsPinned           bool
+	lsPipelineFailed   bool
+	lsIssueType        string
+	lsGitlabTemplate   string
 	lsSortBy           string
 	lsSortDirection    string
+	lsReferences       bool
+	lsCount            bool
 )
 
 func runLsBug(cmd *cobra.Command, args []string) error {
@@ -32,6 +46,13 @@ func runLsBug(cmd *cobra.Command, args []string) error {
 	defer backend.Close()
 	interrupt.RegisterCleaner(backend.Close)
 
+	if lsQuery != "" && len(args) > 0 {
+		return fmt.Errorf("the query can't be given both with --query and as arguments")
+	}
+	if lsQuery != "" {
+		args = []string{lsQuery}
+	}
+
 	var query *cache.Query
 	if len(args) >= 1 {
 		query, err = cache.ParseQuery(strings.Join(args, " "))
@@ -46,13 +67,47 @@ func runLsBug(cmd *cobra.Command, args []string) error {
 		}
 	}
 
-	allIds := backend.QueryBugs(query)
+	// can be combined with both the query and the flags
+	if lsOverdue {
+		query.Overdue = append(query.Overdue, cache.OverdueFilter())
+	}
+	for _, pattern := range lsHasTag {
+		query.Tag = append(query.Tag, cache.TagFilter(pattern))
+	}
+	if lsHasChecklist {
+		query.Checklist = append(query.Checklist, cache.HasChecklistFilter())
+	}
+	if lsChecklistDone {
+		query.Checklist = append(query.Checklist, cache.ChecklistCompleteFilter())
+	}
+	if lsPinned {
+		query.OnlyPinned = true
+	}
+	if lsPipelineFailed {
+		query.Metadata = append(query.Metadata,
+			cache.LastMetadataFilter(gitlab.MetaKeyPipelineStatus, gitlab.PipelineFailed))
+	}
+	if lsIssueType != "" {
+		query.Metadata = append(query.Metadata, github.IssueTypeFilter(lsIssueType))
+	}
+	if lsGitlabTemplate != "" {
+		query.Metadata = append(query.Metadata, gitlab.TemplateFilter(lsGitlabTemplate))
+	}
 
-	for _, id := range allIds {
-		b, err := backend.ResolveBugExcerpt(id)
-		if err != nil {
-			return err
-		}
+	idLength, err := backend.IdLength()
+	if err != nil {
+		return err
+	}
+
+	if lsCount {
+		fmt.Println(backend.CountBugs(query))
+		return nil
+	}
+
+	it := backend.QueryBugsIter(query)
+
+	for it.Next() {
+		b := it.Excerpt()
 
 		var name string
 		if b.AuthorId != "" {
@@ -68,7 +123,7 @@ func runLsBug(cmd *cobra.Command, args []string) error {
 
 		var labelsTxt strings.Builder
 		for _, l := range b.Labels {
-			lc256 := l.Color().Term256()
+			lc256 := backend.LabelColor(l).Term256()
 			labelsTxt.WriteString(lc256.Escape())
 			labelsTxt.WriteString(" ◼")
 			labelsTxt.WriteString(lc256.Unescape())
@@ -84,8 +139,12 @@ func runLsBug(cmd *cobra.Command, args []string) error {
 			comments = "    ∞ 💬"
 		}
 
+		if lsReferences {
+			comments += fmt.Sprintf("\t%3d 🔗", b.LenReferences)
+		}
+
 		fmt.Printf("%s %s\t%s\t%s\t%s\n",
-			colors.Cyan(b.Id.Human()),
+			colors.Cyan(entity.HumanId(b.Id, idLength)),
 			colors.Yellow(b.Status),
 			titleFmt+labelsFmt,
 			colors.Magenta(authorFmt),
@@ -149,6 +208,12 @@ func lsQueryFromFlags() (*cache.Query, error) {
 		query.OrderBy = cache.OrderByCreation
 	case "edit":
 		query.OrderBy = cache.OrderByEdit
+	case "activity":
+		query.OrderBy = cache.OrderByActivity
+	case "weight":
+		query.OrderBy = cache.OrderByWeight
+	case "sentiment":
+		query.OrderBy = cache.OrderBySentiment
 	default:
 		return nil, fmt.Errorf("unknown sort flag %s", lsSortBy)
 	}
@@ -174,6 +239,9 @@ You can pass an additional query to filter and order the list. This query can be
 	Example: `List open bugs sorted by last edition with a query:
 git bug ls status:open sort:edit-desc
 
+List open bugs labeled bug or enhancement, with "crash" in their title:
+git bug ls --query 'status:open label:bug,enhancement crash'
+
 List closed bugs sorted by creation with flags:
 git bug ls --status closed --by creation
 `,
@@ -186,6 +254,8 @@ func init() {
 
 	lsCmd.Flags().SortFlags = false
 
+	lsCmd.Flags().StringVarP(&lsQuery, "query", "q", "",
+		"Filter and sort with the query language, as an alternative to the query arguments")
 	lsCmd.Flags().StringSliceVarP(&lsStatusQuery, "status", "s", nil,
 		"Filter by status. Valid values are [open,closed]")
 	lsCmd.Flags().StringSliceVarP(&lsAuthorQuery, "author", "a", nil,
@@ -200,8 +270,28 @@ func init() {
 		"Filter by title")
 	lsCmd.Flags().StringSliceVarP(&lsNoQuery, "no", "n", nil,
 		"Filter by absence of something. Valid values are [label]")
+	lsCmd.Flags().BoolVar(&lsOverdue, "overdue", false,
+		"Only show the open bugs past their due date")
+	lsCmd.Flags().StringSliceVar(&lsHasTag, "has-tag", nil,
+		"Only show the bugs with a git tag matching the given glob pattern")
+	lsCmd.Flags().BoolVar(&lsHasChecklist, "has-checklist", false,
+		"Only show the bugs with at least one Markdown task list item")
+	lsCmd.Flags().BoolVar(&lsChecklistDone, "checklist-complete", false,
+		"Only show the bugs with all their Markdown task list items checked")
+	lsCmd.Flags().BoolVar(&lsPinned, "pinned", false,
+		"Only show the pinned bugs")
+	lsCmd.Flags().BoolVar(&lsPipelineFailed, "pipeline-failed", false,
+		"Only show the bugs where the latest CI pipeline of a related merge request failed (Gitlab only)")
+	lsCmd.Flags().StringVar(&lsIssueType, "issue-type", "",
+		"Only show the bugs of the given issue type, like bug, task or feature (Github only)")
+	lsCmd.Flags().StringVar(&lsGitlabTemplate, "gitlab-template", "",
+		"Only show the bugs imported from an issue written with the given issue template (Gitlab only)")
+	lsCmd.Flags().BoolVar(&lsReferences, "references", false,
+		"Show the number of URLs and commit hashes referenced by each bug")
+	lsCmd.Flags().BoolVar(&lsCount, "count", false,
+		"Only print the number of matching bugs")
 	lsCmd.Flags().StringVarP(&lsSortBy, "by", "b", "creation",
-		"Sort the results by a characteristic. Valid values are [id,creation,edit]")
+		"Sort the results by a characteristic. Valid values are [id,creation,edit,activity,weight,sentiment]")
 	lsCmd.Flags().StringVarP(&lsSortDirection, "direction", "d", "asc",
 		"Select the sorting direction. Valid values are [asc,desc]")
 }
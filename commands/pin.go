@@ -0,0 +1,62 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/commands/select"
+	"github.com/MichaelMure/git-bug/util/interrupt"
+)
+
+func runPin(pinned bool) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		backend, err := cache.NewRepoCache(repo)
+		if err != nil {
+			return err
+		}
+		defer backend.Close()
+		interrupt.RegisterCleaner(backend.Close)
+
+		b, _, err := _select.ResolveBug(backend, args)
+		if err != nil {
+			return err
+		}
+
+		if b.Snapshot().Pinned == pinned {
+			fmt.Println("No change, aborting.")
+			return nil
+		}
+
+		if pinned {
+			err = b.Pin()
+		} else {
+			err = b.Unpin()
+		}
+		if err != nil {
+			return err
+		}
+
+		return b.Commit()
+	}
+}
+
+var pinCmd = &cobra.Command{
+	Use:     "pin [<id>]",
+	Short:   "Pin a bug, to display it before the others.",
+	PreRunE: loadRepoEnsureUser,
+	RunE:    runPin(true),
+}
+
+var unpinCmd = &cobra.Command{
+	Use:     "unpin [<id>]",
+	Short:   "Unpin a bug.",
+	PreRunE: loadRepoEnsureUser,
+	RunE:    runPin(false),
+}
+
+func init() {
+	RootCmd.AddCommand(pinCmd)
+	RootCmd.AddCommand(unpinCmd)
+}
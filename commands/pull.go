@@ -11,6 +11,10 @@ import (
 	"github.com/MichaelMure/git-bug/util/interrupt"
 )
 
+var (
+	pullIncludeIdentities bool
+)
+
 func runPull(cmd *cobra.Command, args []string) error {
 	if len(args) > 1 {
 		return errors.New("Only pulling from one remote at a time is supported")
@@ -30,7 +34,7 @@ func runPull(cmd *cobra.Command, args []string) error {
 
 	fmt.Println("Fetching remote ...")
 
-	stdout, err := backend.Fetch(remote)
+	stdout, err := backend.FetchBugRefs(remote, pullIncludeIdentities)
 	if err != nil {
 		return err
 	}
@@ -62,4 +66,7 @@ var pullCmd = &cobra.Command{
 
 func init() {
 	RootCmd.AddCommand(pullCmd)
+
+	pullCmd.Flags().BoolVar(&pullIncludeIdentities, "include-identities", true,
+		"Also fetch the identities. Bugs referencing unknown identities can't be merged.")
 }
@@ -0,0 +1,87 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/commands/select"
+	"github.com/MichaelMure/git-bug/util/colors"
+	"github.com/MichaelMure/git-bug/util/interrupt"
+)
+
+func runReflog(cmd *cobra.Command, args []string) error {
+	backend, err := cache.NewRepoCache(repo)
+	if err != nil {
+		return err
+	}
+	defer backend.Close()
+	interrupt.RegisterCleaner(backend.Close)
+
+	b, _, err := _select.ResolveBug(backend, args)
+	if err != nil {
+		return err
+	}
+
+	entries, err := b.Reflog()
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		fmt.Printf("%-6s %s %s %s\n",
+			entry.Selector,
+			colors.Cyan(entry.Hash.String()[:7]),
+			entry.Time.Format("2006-01-02 15:04:05"),
+			entry.Message,
+		)
+	}
+
+	return nil
+}
+
+func runReset(cmd *cobra.Command, args []string) error {
+	backend, err := cache.NewRepoCache(repo)
+	if err != nil {
+		return err
+	}
+	defer backend.Close()
+	interrupt.RegisterCleaner(backend.Close)
+
+	b, args, err := _select.ResolveBug(backend, args)
+	if err != nil {
+		return err
+	}
+
+	if len(args) != 1 {
+		return fmt.Errorf("a reflog selector like @{1} is required, see \"git bug reflog\"")
+	}
+
+	err = b.Reset(args[0])
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("bug %s reset to %s\n", b.Id().Human(), args[0])
+	return nil
+}
+
+var reflogCmd = &cobra.Command{
+	Use:     "reflog [<id>]",
+	Short:   "Display the past states of the git ref of a bug.",
+	PreRunE: loadRepo,
+	RunE:    runReflog,
+}
+
+var resetCmd = &cobra.Command{
+	Use:     "reset [<id>] <selector>",
+	Short:   "Restore a bug to a past state of its reflog, like @{1}.",
+	PreRunE: loadRepo,
+	RunE:    runReset,
+}
+
+func init() {
+	RootCmd.AddCommand(reflogCmd)
+	RootCmd.AddCommand(resetCmd)
+}
@@ -0,0 +1,56 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/util/interrupt"
+)
+
+func runReindexIdentities(cmd *cobra.Command, args []string) error {
+	backend, err := cache.NewRepoCache(repo)
+	if err != nil {
+		return err
+	}
+	defer backend.Close()
+	interrupt.RegisterCleaner(backend.Close)
+
+	oldIdentity, err := backend.ResolveIdentityPrefix(args[0])
+	if err != nil {
+		return err
+	}
+
+	newIdentity, err := backend.ResolveIdentityPrefix(args[1])
+	if err != nil {
+		return err
+	}
+
+	count, err := backend.ReindexIdentities(oldIdentity.Id(), newIdentity.Id())
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%d operations re-authored from %s to %s\n",
+		count, oldIdentity.Id().Human(), newIdentity.Id().Human())
+
+	return nil
+}
+
+var reindexIdentitiesCmd = &cobra.Command{
+	Use:   "reindex-identities <old-id> <new-id>",
+	Short: "Replace an identity by another as the author of the bugs operations.",
+	Long: `Replace an identity by another as the author of the bugs operations.
+
+This is useful when the same person has been known under two identities. The
+operations are not modified, instead new operations record the change of author.
+`,
+	PreRunE: loadRepoEnsureUser,
+	RunE:    runReindexIdentities,
+	Args:    cobra.ExactArgs(2),
+}
+
+func init() {
+	RootCmd.AddCommand(reindexIdentitiesCmd)
+}
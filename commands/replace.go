@@ -0,0 +1,90 @@
+package commands
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/util/colors"
+	"github.com/MichaelMure/git-bug/util/interrupt"
+)
+
+var (
+	replaceQuery  string
+	replaceDryRun bool
+)
+
+func runReplace(cmd *cobra.Command, args []string) error {
+	backend, err := cache.NewRepoCache(repo)
+	if err != nil {
+		return err
+	}
+	defer backend.Close()
+	interrupt.RegisterCleaner(backend.Close)
+
+	var query *cache.Query
+	if replaceQuery != "" {
+		query, err = cache.ParseQuery(replaceQuery)
+		if err != nil {
+			return err
+		}
+	}
+
+	if replaceDryRun {
+		changes, err := backend.PreviewSearchReplace(args[0], args[1], query)
+		if err != nil {
+			return err
+		}
+
+		for _, change := range changes {
+			fmt.Printf("%s %s\n",
+				colors.Cyan(change.BugId.Human()),
+				colors.Yellow(change.Target.Human()),
+			)
+			for _, line := range strings.Split(change.Before, "\n") {
+				fmt.Println(colors.Red("- " + line))
+			}
+			for _, line := range strings.Split(change.After, "\n") {
+				fmt.Println(colors.Green("+ " + line))
+			}
+			fmt.Println()
+		}
+
+		fmt.Printf("%d comments would be edited\n", len(changes))
+		return nil
+	}
+
+	count, err := backend.BulkSearchReplace(args[0], args[1], query)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%d comments edited\n", count)
+	return nil
+}
+
+var replaceCmd = &cobra.Command{
+	Use:   "replace <pattern> <replacement>",
+	Short: "Search and replace a regular expression in the bugs description and comments.",
+	Long: `Search and replace a regular expression in the bugs description and comments.
+
+The replacement can reference the groups of the pattern with $1, ${name} ...
+`,
+	Example: `git bug replace 'https://old\.example\.com' 'https://new.example.com' --dry-run`,
+	PreRunE: loadRepoEnsureUser,
+	RunE:    runReplace,
+	Args:    cobra.ExactArgs(2),
+}
+
+func init() {
+	RootCmd.AddCommand(replaceCmd)
+
+	replaceCmd.Flags().SortFlags = false
+
+	replaceCmd.Flags().StringVarP(&replaceQuery, "query", "q", "",
+		"Only edit the bugs matching the query (see \"git bug ls\")")
+	replaceCmd.Flags().BoolVarP(&replaceDryRun, "dry-run", "n", false,
+		"Only print what would change")
+}
@@ -5,8 +5,10 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/MichaelMure/git-bug/bug"
 	"github.com/MichaelMure/git-bug/cache"
 	_select "github.com/MichaelMure/git-bug/commands/select"
+	"github.com/MichaelMure/git-bug/entity"
 	"github.com/MichaelMure/git-bug/util/colors"
 	"github.com/MichaelMure/git-bug/util/interrupt"
 	"github.com/spf13/cobra"
@@ -14,6 +16,10 @@ import (
 
 var (
 	showFieldsQuery string
+	showWithRelated bool
+	showAt          int
+	showResolve     bool
+	showCrossRefs   bool
 )
 
 func runShowBug(cmd *cobra.Command, args []string) error {
@@ -29,8 +35,21 @@ func runShowBug(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	idLength, err := backend.IdLength()
+	if err != nil {
+		return err
+	}
+
 	snapshot := b.Snapshot()
 
+	if cmd.Flags().Changed("at") {
+		// the index of the operations start at 0 in the log
+		snapshot, err = backend.SnapshotAt(b.Id(), showAt+1)
+		if err != nil {
+			return err
+		}
+	}
+
 	if len(snapshot.Comments) == 0 {
 		return errors.New("invalid bug: no comment")
 	}
@@ -42,11 +61,15 @@ func runShowBug(cmd *cobra.Command, args []string) error {
 		case "author":
 			fmt.Printf("%s\n", firstComment.Author.DisplayName())
 		case "authorEmail":
-			fmt.Printf("%s\n", firstComment.Author.Email())
+			email, err := commentAuthorEmail(snapshot, firstComment)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("%s\n", email)
 		case "createTime":
 			fmt.Printf("%s\n", firstComment.FormatTime())
 		case "humanId":
-			fmt.Printf("%s\n", snapshot.Id().Human())
+			fmt.Printf("%s\n", entity.HumanId(snapshot.Id(), idLength))
 		case "id":
 			fmt.Printf("%s\n", snapshot.Id())
 		case "labels":
@@ -61,8 +84,16 @@ func runShowBug(cmd *cobra.Command, args []string) error {
 			for _, p := range snapshot.Participants {
 				fmt.Printf("%s\n", p.DisplayName())
 			}
+		case "mentions":
+			mentions, err := mentionNames(backend, b)
+			if err != nil {
+				return err
+			}
+			for _, m := range mentions {
+				fmt.Printf("%s\n", m)
+			}
 		case "shortId":
-			fmt.Printf("%s\n", snapshot.Id().Human())
+			fmt.Printf("%s\n", entity.HumanId(snapshot.Id(), idLength))
 		case "status":
 			fmt.Printf("%s\n", snapshot.Status)
 		case "title":
@@ -77,7 +108,7 @@ func runShowBug(cmd *cobra.Command, args []string) error {
 	// Header
 	fmt.Printf("[%s] %s %s\n\n",
 		colors.Yellow(snapshot.Status),
-		colors.Cyan(snapshot.Id().Human()),
+		colors.Cyan(entity.HumanId(snapshot.Id(), idLength)),
 		snapshot.Title,
 	)
 
@@ -86,6 +117,18 @@ func runShowBug(cmd *cobra.Command, args []string) error {
 		firstComment.FormatTimeRel(),
 	)
 
+	if source := snapshot.Source(); source != nil {
+		fmt.Printf("Imported from: %s\n\n", source.RemoteURL)
+	}
+
+	if snapshot.DueDate != nil {
+		due := snapshot.DueDate.Format("2006-01-02")
+		if snapshot.IsOverdue() {
+			due = colors.Red(due + " (overdue)")
+		}
+		fmt.Printf("Due: %s\n\n", due)
+	}
+
 	// Labels
 	var labels = make([]string, len(snapshot.Labels))
 	for i := range snapshot.Labels {
@@ -112,20 +155,77 @@ func runShowBug(cmd *cobra.Command, args []string) error {
 		participants[i] = snapshot.Participants[i].DisplayName()
 	}
 
-	fmt.Printf("participants: %s\n\n",
+	fmt.Printf("participants: %s\n",
 		strings.Join(participants, ", "),
 	)
 
+	// Mentions
+	mentions, err := mentionNames(backend, b)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("mentions: %s\n\n",
+		strings.Join(mentions, ", "),
+	)
+
+	if len(snapshot.References) > 0 {
+		fmt.Println("references:")
+		for _, ref := range snapshot.References {
+			fmt.Printf("  %s\n", referenceLine(ref))
+		}
+		fmt.Println()
+	}
+
+	if showCrossRefs && len(snapshot.CrossRefs) > 0 {
+		fmt.Println("cross-references:")
+		for _, ref := range snapshot.CrossRefs {
+			target := ref.URL
+			if target == "" {
+				target = referenceLine(ref.Commit.String())
+			}
+			fmt.Printf("  %s %s %s\n",
+				target,
+				colors.Magenta(ref.Author.DisplayName()),
+				colors.GreyBold(ref.UnixTime.Time().Format("2006-01-02")),
+			)
+		}
+		fmt.Println()
+	}
+
+	if showWithRelated {
+		related, err := b.RelatedBugs()
+		if err != nil {
+			return err
+		}
+
+		if len(related) > 0 {
+			fmt.Println("related:")
+			for _, r := range related {
+				fmt.Printf("  %s %s %s\n",
+					colors.Cyan(entity.HumanId(r.Id(), idLength)),
+					colors.Yellow(r.Snapshot().Status),
+					r.Snapshot().Title,
+				)
+			}
+			fmt.Println()
+		}
+	}
+
 	// Comments
 	indent := "  "
 
 	for i, comment := range snapshot.Comments {
 		var message string
+		email, err := commentAuthorEmail(snapshot, comment)
+		if err != nil {
+			return err
+		}
 		fmt.Printf("%s#%d %s <%s>\n\n",
 			indent,
 			i,
 			comment.Author.DisplayName(),
-			comment.Author.Email(),
+			email,
 		)
 
 		if comment.Message == "" {
@@ -143,6 +243,57 @@ func runShowBug(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// commentAuthorEmail return the email of the author of a comment, resolved
+// from the operation that created it
+func commentAuthorEmail(snapshot *bug.Snapshot, comment bug.Comment) (string, error) {
+	for _, op := range snapshot.Operations {
+		if op.Id() == comment.Id() {
+			return op.AuthorEmail(repo)
+		}
+	}
+	return comment.Author.Email(), nil
+}
+
+// referenceLine format a reference, with the summary of the commit if
+// --resolve-commits is given and the reference look like a commit hash
+func referenceLine(ref string) string {
+	if !showResolve || !bug.IsCommitLike(ref) {
+		return ref
+	}
+
+	summary, err := repo.CommitSummary(ref)
+	if err != nil {
+		return fmt.Sprintf("%s %s", ref, colors.GreyBold("(unknown commit)"))
+	}
+
+	// the summary start with the abbreviated hash, already displayed
+	if i := strings.IndexByte(summary, ' '); i >= 0 {
+		summary = summary[i+1:]
+	}
+
+	return fmt.Sprintf("%s %s", ref, colors.Yellow(summary))
+}
+
+// mentionNames return the display name of the identities mentioned in a bug,
+// followed by the mentions without a known identity
+func mentionNames(backend *cache.RepoCache, b *cache.BugCache) ([]string, error) {
+	ids, unresolved := b.Mentions()
+
+	names := make([]string, 0, len(ids)+len(unresolved))
+	for _, id := range ids {
+		i, err := backend.ResolveIdentityExcerpt(id)
+		if err != nil {
+			return nil, err
+		}
+		names = append(names, i.DisplayName())
+	}
+	for _, login := range unresolved {
+		names = append(names, "@"+login)
+	}
+
+	return names, nil
+}
+
 var showCmd = &cobra.Command{
 	Use:     "show [<id>]",
 	Short:   "Display the details of a bug.",
@@ -153,5 +304,13 @@ var showCmd = &cobra.Command{
 func init() {
 	RootCmd.AddCommand(showCmd)
 	showCmd.Flags().StringVarP(&showFieldsQuery, "field", "f", "",
-		"Select field to display. Valid values are [author,authorEmail,createTime,humanId,id,labels,shortId,status,title,actors,participants]")
+		"Select field to display. Valid values are [author,authorEmail,createTime,humanId,id,labels,shortId,status,title,actors,participants,mentions]")
+	showCmd.Flags().BoolVar(&showWithRelated, "with-related", false,
+		"Display the titles of the bugs linked to this bug")
+	showCmd.Flags().IntVar(&showAt, "at", 0,
+		"Display the bug as it was right after the operation of the given index, as numbered by the log command")
+	showCmd.Flags().BoolVar(&showResolve, "resolve-commits", false,
+		"Display the summary of the commits referenced by the bug")
+	showCmd.Flags().BoolVar(&showCrossRefs, "cross-refs", false,
+		"Display the commits, issues and merge requests referencing the bug")
 }
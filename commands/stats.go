@@ -0,0 +1,110 @@
+package commands
+
+import (
+	"fmt"
+	"sort"
+
+	text "github.com/MichaelMure/go-term-text"
+	"github.com/dustin/go-humanize"
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/util/colors"
+	"github.com/MichaelMure/git-bug/util/interrupt"
+)
+
+var (
+	statsSortSize bool
+)
+
+func runStats(cmd *cobra.Command, args []string) error {
+	backend, err := cache.NewRepoCache(repo)
+	if err != nil {
+		return err
+	}
+	defer backend.Close()
+	interrupt.RegisterCleaner(backend.Close)
+
+	if statsSortSize {
+		return runStatsSize(backend)
+	}
+
+	open, closed := 0, 0
+	for _, id := range backend.AllBugsIds() {
+		excerpt, err := backend.ResolveBugExcerpt(id)
+		if err != nil {
+			return err
+		}
+		if excerpt.Status == bug.OpenStatus {
+			open++
+		} else {
+			closed++
+		}
+	}
+
+	fmt.Printf("bugs:       %d (%d open, %d closed)\n", open+closed, open, closed)
+	fmt.Printf("identities: %d\n", len(backend.AllIdentityIds()))
+
+	return nil
+}
+
+// runStatsSize list the bugs by decreasing storage size
+func runStatsSize(backend *cache.RepoCache) error {
+	type bugSize struct {
+		id    entity.Id
+		title string
+		size  int64
+	}
+
+	var sizes []bugSize
+	var total int64
+
+	for _, id := range backend.AllBugsIds() {
+		b, err := backend.ResolveBug(id)
+		if err != nil {
+			return err
+		}
+
+		size, err := b.StorageSize()
+		if err != nil {
+			return err
+		}
+
+		sizes = append(sizes, bugSize{id: id, title: b.Snapshot().Title, size: size})
+		total += size
+	}
+
+	sort.SliceStable(sizes, func(i, j int) bool {
+		return sizes[i].size > sizes[j].size
+	})
+
+	for _, s := range sizes {
+		fmt.Printf("%s %s\t%s\n",
+			colors.Cyan(s.id.Human()),
+			colors.Yellow(text.LeftPadMaxLine(humanize.Bytes(uint64(s.size)), 8, 0)),
+			s.title,
+		)
+	}
+
+	fmt.Printf("total: %s\n", humanize.Bytes(uint64(total)))
+
+	return nil
+}
+
+var statsCmd = &cobra.Command{
+	Use:     "stats",
+	Short:   "Show statistics about the bugs.",
+	PreRunE: loadRepo,
+	RunE:    runStats,
+}
+
+func init() {
+	RootCmd.AddCommand(statsCmd)
+
+	statsCmd.Flags().SortFlags = false
+
+	statsCmd.Flags().BoolVar(&statsSortSize, "sort-size", false,
+		"List the bugs sorted by the size of their storage in git, largest first")
+}
@@ -0,0 +1,50 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/commands/select"
+	"github.com/MichaelMure/git-bug/util/interrupt"
+)
+
+func runTag(cmd *cobra.Command, args []string) error {
+	backend, err := cache.NewRepoCache(repo)
+	if err != nil {
+		return err
+	}
+	defer backend.Close()
+	interrupt.RegisterCleaner(backend.Close)
+
+	b, args, err := _select.ResolveBug(backend, args)
+	if err != nil {
+		return err
+	}
+
+	switch len(args) {
+	case 0:
+		for _, tag := range b.Snapshot().Tags {
+			fmt.Println(tag)
+		}
+		return nil
+	case 1:
+		return b.AddTag(args[0])
+	default:
+		return fmt.Errorf("only one tag can be created at a time")
+	}
+}
+
+var tagCmd = &cobra.Command{
+	Use:     "tag [<id>] [<name>]",
+	Short:   "Display the git tags of a bug, or tag its current state as refs/tags/bug/<id>/<name>.",
+	PreRunE: loadRepo,
+	RunE:    runTag,
+}
+
+func init() {
+	RootCmd.AddCommand(tagCmd)
+
+	tagCmd.Flags().SortFlags = false
+}
@@ -0,0 +1,65 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/commands/select"
+	"github.com/MichaelMure/git-bug/repository"
+	"github.com/MichaelMure/git-bug/util/interrupt"
+)
+
+func runTransfer(cmd *cobra.Command, args []string) error {
+	backend, err := cache.NewRepoCache(repo)
+	if err != nil {
+		return err
+	}
+	defer backend.Close()
+	interrupt.RegisterCleaner(backend.Close)
+
+	b, args, err := _select.ResolveBug(backend, args)
+	if err != nil {
+		return err
+	}
+
+	if len(args) != 1 {
+		return fmt.Errorf("the path of the destination repository is required")
+	}
+
+	destRepo, err := repository.NewGitRepo(args[0], bug.Witnesser)
+	if err == repository.ErrNotARepo {
+		return fmt.Errorf("%s is not a git repository", args[0])
+	}
+	if err != nil {
+		return err
+	}
+
+	dest, err := cache.NewRepoCache(destRepo)
+	if err != nil {
+		return err
+	}
+	defer dest.Close()
+	interrupt.RegisterCleaner(dest.Close)
+
+	err = b.TransferTo(dest)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("bug %s transferred to %s\n", b.Id().Human(), args[0])
+	return nil
+}
+
+var transferCmd = &cobra.Command{
+	Use:     "transfer [<id>] <repository>",
+	Short:   "Move a bug to another repository, closing it here.",
+	PreRunE: loadRepoEnsureUser,
+	RunE:    runTransfer,
+}
+
+func init() {
+	RootCmd.AddCommand(transferCmd)
+}
@@ -0,0 +1,50 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/entity"
+	"github.com/MichaelMure/git-bug/util/interrupt"
+)
+
+func runUnbundle(cmd *cobra.Command, args []string) error {
+	backend, err := cache.NewRepoCache(repo)
+	if err != nil {
+		return err
+	}
+	defer backend.Close()
+	interrupt.RegisterCleaner(backend.Close)
+
+	results, err := backend.UnbundleBugs(os.Stdin)
+	if err != nil {
+		return err
+	}
+
+	for result := range results {
+		if result.Err != nil {
+			fmt.Println(result.Err)
+		}
+
+		if result.Status != entity.MergeStatusNothing {
+			fmt.Printf("%s: %s\n", result.Id.Human(), result)
+		}
+	}
+
+	return nil
+}
+
+var unbundleCmd = &cobra.Command{
+	Use:     "unbundle",
+	Short:   "Read the bugs of a git bundle from the standard input.",
+	Example: `git bug unbundle < bug.bundle`,
+	PreRunE: loadRepo,
+	RunE:    runUnbundle,
+}
+
+func init() {
+	RootCmd.AddCommand(unbundleCmd)
+}
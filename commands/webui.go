@@ -7,9 +7,11 @@ import (
 	"fmt"
 	"io/ioutil"
 	"log"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
+	"strconv"
 	"time"
 
 	"github.com/99designs/gqlgen/handler"
@@ -25,15 +27,38 @@ import (
 )
 
 var (
+	webUIHost   string
 	webUIPort   int
+	webUICert   string
+	webUIKey    string
 	webUIOpen   bool
 	webUINoOpen bool
 )
 
-const webUIOpenConfigKey = "git-bug.webui.open"
+// webUIOpenConfigKey is the config key, under the "git-bug." namespace, to
+// choose if the web UI is opened in the browser
+const webUIOpenConfigKey = "webui.open"
 
 func runWebUI(cmd *cobra.Command, args []string) error {
-	if webUIPort == 0 {
+	if (webUICert == "") != (webUIKey == "") {
+		return fmt.Errorf("--cert and --key must be provided together")
+	}
+	if webUICert == "auto" {
+		// this would require golang.org/x/crypto/acme/autocert
+		return fmt.Errorf("automatic certificates are not supported, please provide a certificate and a key")
+	}
+
+	useTLS := webUICert != ""
+	scheme := "http"
+	if useTLS {
+		scheme = "https"
+	}
+
+	switch {
+	case webUIPort != 0:
+	case useTLS:
+		webUIPort = 443
+	default:
 		var err error
 		webUIPort, err = freeport.GetFreePort()
 		if err != nil {
@@ -41,8 +66,8 @@ func runWebUI(cmd *cobra.Command, args []string) error {
 		}
 	}
 
-	addr := fmt.Sprintf("127.0.0.1:%d", webUIPort)
-	webUiAddr := fmt.Sprintf("http://%s", addr)
+	addr := net.JoinHostPort(webUIHost, strconv.Itoa(webUIPort))
+	webUiAddr := fmt.Sprintf("%s://%s", scheme, addr)
 
 	router := mux.NewRouter()
 
@@ -96,11 +121,11 @@ func runWebUI(cmd *cobra.Command, args []string) error {
 	}()
 
 	fmt.Printf("Web UI: %s\n", webUiAddr)
-	fmt.Printf("Graphql API: http://%s/graphql\n", addr)
-	fmt.Printf("Graphql Playground: http://%s/playground\n", addr)
+	fmt.Printf("Graphql API: %s/graphql\n", webUiAddr)
+	fmt.Printf("Graphql Playground: %s/playground\n", webUiAddr)
 	fmt.Println("Press Ctrl+c to quit")
 
-	configOpen, err := repo.LocalConfig().ReadBool(webUIOpenConfigKey)
+	configOpen, err := repository.NewGitBugConfig(repo).LocalConfig().ReadBool(webUIOpenConfigKey)
 	if err == repository.ErrNoConfigEntry {
 		// default to true
 		configOpen = true
@@ -117,7 +142,11 @@ func runWebUI(cmd *cobra.Command, args []string) error {
 		}
 	}
 
-	err = srv.ListenAndServe()
+	if useTLS {
+		err = srv.ListenAndServeTLS(webUICert, webUIKey)
+	} else {
+		err = srv.ListenAndServe()
+	}
 	if err != nil && err != http.ErrServerClosed {
 		return err
 	}
@@ -260,6 +289,9 @@ func init() {
 
 	webUICmd.Flags().BoolVar(&webUIOpen, "open", false, "Automatically open the web UI in the default browser")
 	webUICmd.Flags().BoolVar(&webUINoOpen, "no-open", false, "Prevent the automatic opening of the web UI in the default browser")
-	webUICmd.Flags().IntVarP(&webUIPort, "port", "p", 0, "Port to listen to (default is random)")
+	webUICmd.Flags().StringVar(&webUIHost, "host", "127.0.0.1", "Network address or hostname to listen to")
+	webUICmd.Flags().IntVarP(&webUIPort, "port", "p", 0, "Port to listen to (default is random, or 443 with TLS)")
+	webUICmd.Flags().StringVar(&webUICert, "cert", "", "TLS certificate file, to serve over HTTPS (requires --key)")
+	webUICmd.Flags().StringVar(&webUIKey, "key", "", "TLS private key file, to serve over HTTPS (requires --cert)")
 
 }
@@ -1,10 +1,14 @@
 package entity
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 )
 
+// ErrAlreadyExist is returned when creating an entity with an Id already in use
+var ErrAlreadyExist = errors.New("entity already exist")
+
 type ErrMultipleMatch struct {
 	entityType string
 	Matching   []Id
@@ -1,6 +1,7 @@
 package entity
 
 import (
+	"crypto/sha256"
 	"fmt"
 	"io"
 	"strings"
@@ -12,11 +13,25 @@ const IdLengthSHA1 = 40
 const IdLengthSHA256 = 64
 const humanIdLength = 7
 
+// the bounds of the length of a human id
+const (
+	HumanIdMinLength = 4
+	HumanIdMaxLength = 64
+)
+
 const UnsetId = Id("unset")
 
 // Id is an identifier for an entity or part of an entity
 type Id string
 
+// NewDeterministicId return an Id derived from the given namespace and content,
+// as sha256(namespace + ":" + content). The same inputs always give the same Id,
+// which allow to compute the expected Id of something before looking for it.
+func NewDeterministicId(namespace, content string) Id {
+	sum := sha256.Sum256([]byte(namespace + ":" + content))
+	return Id(fmt.Sprintf("%x", sum))
+}
+
 // String return the identifier as a string
 func (i Id) String() string {
 	return string(i)
@@ -24,8 +39,21 @@ func (i Id) String() string {
 
 // Human return the identifier, shortened for human consumption
 func (i Id) Human() string {
-	format := fmt.Sprintf("%%.%ds", humanIdLength)
-	return fmt.Sprintf(format, i)
+	return HumanId(i, humanIdLength)
+}
+
+// HumanId return the identifier, shortened to the given length for human
+// consumption. The length is kept between HumanIdMinLength and
+// HumanIdMaxLength.
+func HumanId(id Id, length int) string {
+	if length < HumanIdMinLength {
+		length = HumanIdMinLength
+	}
+	if length > HumanIdMaxLength {
+		length = HumanIdMaxLength
+	}
+	format := fmt.Sprintf("%%.%ds", length)
+	return fmt.Sprintf(format, id)
 }
 
 func (i Id) HasPrefix(prefix string) bool {
@@ -0,0 +1,27 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDeterministicId(t *testing.T) {
+	id1 := NewDeterministicId("gitlab", "https://gitlab.com:42")
+	id2 := NewDeterministicId("gitlab", "https://gitlab.com:42")
+	id3 := NewDeterministicId("gitlab", "https://gitlab.com:43")
+
+	require.NoError(t, id1.Validate())
+	require.Equal(t, id1, id2)
+	require.NotEqual(t, id1, id3)
+	require.NotEqual(t, id1, NewDeterministicId("github", "https://gitlab.com:42"))
+}
+
+func TestHumanId(t *testing.T) {
+	id := NewDeterministicId("gitlab", "https://gitlab.com:42")
+
+	require.Equal(t, string(id[:7]), id.Human())
+	require.Equal(t, string(id[:10]), HumanId(id, 10))
+	require.Equal(t, string(id[:4]), HumanId(id, 1))
+	require.Equal(t, string(id), HumanId(id, 100))
+}
@@ -89,6 +89,7 @@ func TestQueries(t *testing.T) {
                 nodes {
                   files
                   message
+                  viewerCanEdit
                 }
               }
       
@@ -189,8 +190,9 @@ func TestQueries(t *testing.T) {
 					Comments struct {
 						PageInfo models.PageInfo
 						Nodes    []struct {
-							Files   []string
-							Message string
+							Files         []string
+							Message       string
+							ViewerCanEdit bool
 						}
 					}
 
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"strconv"
+	"time"
 
 	"github.com/MichaelMure/git-bug/bug"
 	"github.com/MichaelMure/git-bug/identity"
@@ -17,6 +18,11 @@ type Authored interface {
 	IsAuthored()
 }
 
+// An event of the import of a bridge.
+type BridgeEvent interface {
+	IsBridgeEvent()
+}
+
 type AddCommentInput struct {
 	// A unique identifier for the client performing the mutation.
 	ClientMutationID *string `json:"clientMutationId"`
@@ -39,6 +45,72 @@ type AddCommentPayload struct {
 	Operation *bug.AddCommentOperation `json:"operation"`
 }
 
+// A new bug has been imported.
+type BridgeBugImported struct {
+	// The id of the bug.
+	ID string `json:"id"`
+	// The human version (truncated) identifier of the bug.
+	HumanID string `json:"humanId"`
+	// The title of the bug.
+	Title string `json:"title"`
+}
+
+func (BridgeBugImported) IsBridgeEvent() {}
+
+// Nothing has been done for an item of the remote bug tracker.
+type BridgeBugSkipped struct {
+	// The id of the item, if any.
+	ID *string `json:"id"`
+	// Why nothing has been done.
+	Reason string `json:"reason"`
+}
+
+func (BridgeBugSkipped) IsBridgeEvent() {}
+
+// An error happened during the import.
+type BridgeImportError struct {
+	// The id of the item that failed, if any.
+	ID *string `json:"id"`
+	// The error message.
+	Message string `json:"message"`
+}
+
+func (BridgeImportError) IsBridgeEvent() {}
+
+// The import is finished. This is the last event of an import.
+type BridgeImportFinished struct {
+	// The number of times the import paused to respect the rate limit of the remote API.
+	RateLimitHits int `json:"rateLimitHits"`
+	// The total time spent waiting for the rate limit to reset, in seconds.
+	RateLimitWaitSeconds int `json:"rateLimitWaitSeconds"`
+}
+
+func (BridgeImportFinished) IsBridgeEvent() {}
+
+// The import has started.
+type BridgeImportStarted struct {
+	// The import only consider the changes after this time, if set.
+	Since *time.Time `json:"since"`
+}
+
+func (BridgeImportStarted) IsBridgeEvent() {}
+
+type BridgePullInput struct {
+	// A unique identifier for the client performing the mutation.
+	ClientMutationID *string `json:"clientMutationId"`
+	// "The name of the repository. If not set, the default repository is used.
+	RepoRef *string `json:"repoRef"`
+	// The name of the bridge.
+	BridgeName string `json:"bridgeName"`
+}
+
+type BridgePullPayload struct {
+	// A unique identifier for the client performing the mutation.
+	ClientMutationID *string `json:"clientMutationId"`
+	// The name of the bridge.
+	BridgeName string `json:"bridgeName"`
+}
+
 // The connection type for Bug.
 type BugConnection struct {
 	// A list of edges.
@@ -102,14 +174,14 @@ type CloseBugPayload struct {
 
 type CommentConnection struct {
 	Edges      []*CommentEdge `json:"edges"`
-	Nodes      []*bug.Comment `json:"nodes"`
+	Nodes      []*Comment     `json:"nodes"`
 	PageInfo   *PageInfo      `json:"pageInfo"`
 	TotalCount int            `json:"totalCount"`
 }
 
 type CommentEdge struct {
-	Cursor string       `json:"cursor"`
-	Node   *bug.Comment `json:"node"`
+	Cursor string   `json:"cursor"`
+	Node   *Comment `json:"node"`
 }
 
 type CommitAsNeededInput struct {
@@ -2,6 +2,7 @@
 package models
 
 import (
+	"github.com/MichaelMure/git-bug/bug"
 	"github.com/MichaelMure/git-bug/cache"
 )
 
@@ -21,3 +22,9 @@ type RepositoryMutation struct {
 	Cache *cache.MultiRepoCache
 	Repo  *cache.RepoCache
 }
+
+// Comment is a comment of a bug, along with the repository holding the bug
+type Comment struct {
+	*bug.Comment
+	Repo *cache.RepoCache
+}
@@ -0,0 +1,225 @@
+package resolvers
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/MichaelMure/git-bug/bridge"
+	"github.com/MichaelMure/git-bug/bridge/core"
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/graphql/models"
+)
+
+type bridgeKey struct {
+	repo *cache.RepoCache
+	name string
+}
+
+type runningBridge struct {
+	bridge    *core.Bridge
+	importing bool
+	// the events of the running or last import started by Pull, or of the
+	// next one if none was started yet
+	current *importLog
+}
+
+// importLog record the events of an import, to relay them from its beginning
+// to every subscription
+type importLog struct {
+	started  bool
+	events   []core.BridgeEvent
+	finished bool
+	// closed and replaced when a new event is recorded
+	updated chan struct{}
+}
+
+func newImportLog() *importLog {
+	return &importLog{updated: make(chan struct{})}
+}
+
+// bridgeRegistry keep the bridges loaded by the resolvers, so that an import
+// started by a mutation can be followed by a subscription
+type bridgeRegistry struct {
+	mu      sync.Mutex
+	bridges map[bridgeKey]*runningBridge
+}
+
+func newBridgeRegistry() *bridgeRegistry {
+	return &bridgeRegistry{
+		bridges: make(map[bridgeKey]*runningBridge),
+	}
+}
+
+// get return the bridge with the given name, loading it if needed
+func (r *bridgeRegistry) get(repo *cache.RepoCache, name string) (*runningBridge, error) {
+	key := bridgeKey{repo: repo, name: name}
+
+	if rb, ok := r.bridges[key]; ok {
+		return rb, nil
+	}
+
+	b, err := bridge.LoadBridge(repo, name)
+	if err != nil {
+		return nil, err
+	}
+
+	rb := &runningBridge{bridge: b}
+	r.bridges[key] = rb
+	return rb, nil
+}
+
+// Events return the events of the running or last import of a bridge started
+// with Pull, from its beginning, or of the next one if none was started. The
+// channel is closed after the ImportFinished event, or when the context is
+// done.
+func (r *bridgeRegistry) Events(ctx context.Context, repo *cache.RepoCache, name string) (<-chan core.BridgeEvent, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	rb, err := r.get(repo, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if rb.current == nil {
+		rb.current = newImportLog()
+	}
+	log := rb.current
+
+	out := make(chan core.BridgeEvent)
+
+	go func() {
+		defer close(out)
+
+		for sent := 0; ; {
+			r.mu.Lock()
+			events := log.events[sent:]
+			finished := log.finished
+			updated := log.updated
+			r.mu.Unlock()
+
+			for _, event := range events {
+				select {
+				case out <- event:
+				case <-ctx.Done():
+					return
+				}
+			}
+			sent += len(events)
+
+			if finished {
+				return
+			}
+
+			select {
+			case <-updated:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+// Pull start an import of a bridge in the background. Only one import of a
+// bridge can run at the same time.
+func (r *bridgeRegistry) Pull(repo *cache.RepoCache, name string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	rb, err := r.get(repo, name)
+	if err != nil {
+		return err
+	}
+
+	if rb.importing {
+		return fmt.Errorf("an import of the bridge %s is already running", name)
+	}
+
+	// registered before starting the import to not miss ImportStarted
+	events := rb.bridge.Events()
+
+	// the import outlive the request
+	results, err := rb.bridge.ImportAll(context.Background())
+	if err != nil {
+		return err
+	}
+
+	rb.importing = true
+
+	// the subscriptions made before this import follow it
+	if rb.current == nil || rb.current.started {
+		rb.current = newImportLog()
+	}
+	log := rb.current
+	log.started = true
+
+	go func() {
+		for event := range events {
+			r.mu.Lock()
+			log.events = append(log.events, event)
+			if _, ok := event.(core.ImportFinished); ok {
+				log.finished = true
+			}
+			close(log.updated)
+			log.updated = make(chan struct{})
+			r.mu.Unlock()
+		}
+	}()
+
+	go func() {
+		// the progress is reported with the bridge events
+		for range results {
+		}
+
+		r.mu.Lock()
+		rb.importing = false
+		r.mu.Unlock()
+	}()
+
+	return nil
+}
+
+// bridgeEvent convert a bridge event for the GraphQL API
+func bridgeEvent(event core.BridgeEvent) models.BridgeEvent {
+	optionalId := func(id string) *string {
+		if id == "" {
+			return nil
+		}
+		return &id
+	}
+
+	switch event := event.(type) {
+	case core.ImportStarted:
+		result := models.BridgeImportStarted{}
+		if !event.Since.IsZero() {
+			result.Since = &event.Since
+		}
+		return result
+	case core.BugImported:
+		return models.BridgeBugImported{
+			ID:      event.ID.String(),
+			HumanID: event.ID.Human(),
+			Title:   event.Title,
+		}
+	case core.BugSkipped:
+		return models.BridgeBugSkipped{
+			ID:     optionalId(event.ID.String()),
+			Reason: event.Reason,
+		}
+	case core.ImportError:
+		return models.BridgeImportError{
+			ID:      optionalId(event.ID.String()),
+			Message: event.Err.Error(),
+		}
+	case core.ImportFinished:
+		return models.BridgeImportFinished{
+			RateLimitHits:        event.Stats.RateLimitHits,
+			RateLimitWaitSeconds: int(event.Stats.RateLimitWaitDuration.Seconds()),
+		}
+	default:
+		panic(fmt.Sprintf("unknown bridge event %T", event))
+	}
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"time"
 
+	"github.com/MichaelMure/git-bug/bridge/gitlab"
 	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/cache"
 	"github.com/MichaelMure/git-bug/graphql/connections"
 	"github.com/MichaelMure/git-bug/graphql/graph"
 	"github.com/MichaelMure/git-bug/graphql/models"
@@ -13,21 +15,35 @@ import (
 
 var _ graph.BugResolver = &bugResolver{}
 
-type bugResolver struct{}
+type bugResolver struct {
+	cache *cache.MultiRepoCache
+}
 
 func (bugResolver) ID(ctx context.Context, obj *bug.Snapshot) (string, error) {
 	return obj.Id().String(), nil
 }
 
-func (bugResolver) HumanID(ctx context.Context, obj *bug.Snapshot) (string, error) {
-	return obj.Id().Human(), nil
+func (r bugResolver) HumanID(ctx context.Context, obj *bug.Snapshot) (string, error) {
+	// the length of the human ids is configured by repository
+	repo, err := r.cache.RepoOfBug(obj.Id())
+	if err != nil {
+		return "", err
+	}
+
+	return repo.HumanId(obj.Id()), nil
 }
 
 func (bugResolver) Status(ctx context.Context, obj *bug.Snapshot) (models.Status, error) {
 	return convertStatus(obj.Status)
 }
 
-func (bugResolver) Comments(ctx context.Context, obj *bug.Snapshot, after *string, before *string, first *int, last *int) (*models.CommentConnection, error) {
+func (r bugResolver) Comments(ctx context.Context, obj *bug.Snapshot, after *string, before *string, first *int, last *int) (*models.CommentConnection, error) {
+	// the comments are checked against the user of this repository
+	repo, err := r.cache.RepoOfBug(obj.Id())
+	if err != nil {
+		return nil, err
+	}
+
 	input := models.ConnectionInput{
 		Before: before,
 		After:  after,
@@ -37,15 +53,15 @@ func (bugResolver) Comments(ctx context.Context, obj *bug.Snapshot, after *strin
 
 	edger := func(comment bug.Comment, offset int) connections.Edge {
 		return models.CommentEdge{
-			Node:   &comment,
+			Node:   &models.Comment{Comment: &comment, Repo: repo},
 			Cursor: connections.OffsetToCursor(offset),
 		}
 	}
 
 	conMaker := func(edges []*models.CommentEdge, nodes []bug.Comment, info *models.PageInfo, totalCount int) (*models.CommentConnection, error) {
-		var commentNodes []*bug.Comment
-		for _, c := range nodes {
-			commentNodes = append(commentNodes, &c)
+		var commentNodes []*models.Comment
+		for i := range nodes {
+			commentNodes = append(commentNodes, &models.Comment{Comment: &nodes[i], Repo: repo})
 		}
 		return &models.CommentConnection{
 			Edges:      edges,
@@ -117,6 +133,24 @@ func (bugResolver) LastEdit(ctx context.Context, obj *bug.Snapshot) (*time.Time,
 	return &t, nil
 }
 
+func (bugResolver) HealthStatus(ctx context.Context, obj *bug.Snapshot) (*string, error) {
+	status, ok := obj.LastMetadata(gitlab.MetaKeyHealthStatus)
+	if !ok || status == "" {
+		return nil, nil
+	}
+	return &status, nil
+}
+
+func (bugResolver) ChecklistDone(ctx context.Context, obj *bug.Snapshot) (int, error) {
+	done, _ := obj.ChecklistProgress()
+	return done, nil
+}
+
+func (bugResolver) ChecklistTotal(ctx context.Context, obj *bug.Snapshot) (int, error) {
+	_, total := obj.ChecklistProgress()
+	return total, nil
+}
+
 func (bugResolver) Actors(ctx context.Context, obj *bug.Snapshot, after *string, before *string, first *int, last *int) (*models.IdentityConnection, error) {
 	input := models.ConnectionInput{
 		Before: before,
@@ -144,6 +178,31 @@ func (bugResolver) Actors(ctx context.Context, obj *bug.Snapshot, after *string,
 	return connections.IdentityCon(obj.Actors, edger, conMaker, input)
 }
 
+func (r bugResolver) Mentions(ctx context.Context, obj *bug.Snapshot) ([]identity.Interface, error) {
+	repo, err := r.cache.DefaultRepo()
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := repo.ResolveBug(obj.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	ids, _ := b.Mentions()
+
+	result := make([]identity.Interface, len(ids))
+	for i, id := range ids {
+		iden, err := repo.ResolveIdentity(id)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = iden.Identity
+	}
+
+	return result, nil
+}
+
 func (bugResolver) Participants(ctx context.Context, obj *bug.Snapshot, after *string, before *string, first *int, last *int) (*models.IdentityConnection, error) {
 	input := models.ConnectionInput{
 		Before: before,
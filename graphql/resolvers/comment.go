@@ -0,0 +1,23 @@
+package resolvers
+
+import (
+	"context"
+
+	"github.com/MichaelMure/git-bug/graphql/graph"
+	"github.com/MichaelMure/git-bug/graphql/models"
+)
+
+var _ graph.CommentResolver = &commentResolver{}
+
+type commentResolver struct{}
+
+func (commentResolver) ViewerCanEdit(ctx context.Context, obj *models.Comment) (bool, error) {
+	// the user of the repository holding the bug
+	user, err := obj.Repo.GetUserIdentity()
+	if err != nil {
+		// no identity configured, nothing can be edited
+		return false, nil
+	}
+
+	return obj.CanEditedBy(user.Id()), nil
+}
@@ -12,7 +12,8 @@ import (
 var _ graph.MutationResolver = &mutationResolver{}
 
 type mutationResolver struct {
-	cache *cache.MultiRepoCache
+	cache   *cache.MultiRepoCache
+	bridges *bridgeRegistry
 }
 
 func (r mutationResolver) getRepo(ref *string) (*cache.RepoCache, error) {
@@ -195,9 +196,12 @@ func (r mutationResolver) CommitAsNeeded(ctx context.Context, input models.Commi
 		return nil, err
 	}
 
-	err = b.CommitAsNeeded()
-	if err != nil {
-		return nil, err
+	// Commit rather than CommitAsNeeded, to check the size of the operations
+	if b.NeedCommit() {
+		err = b.Commit()
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	return &models.CommitAsNeededPayload{
@@ -205,3 +209,20 @@ func (r mutationResolver) CommitAsNeeded(ctx context.Context, input models.Commi
 		Bug:              b.Snapshot(),
 	}, nil
 }
+
+func (r mutationResolver) BridgePull(ctx context.Context, input models.BridgePullInput) (*models.BridgePullPayload, error) {
+	repo, err := r.getRepo(input.RepoRef)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.bridges.Pull(repo, input.BridgeName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.BridgePullPayload{
+		ClientMutationID: input.ClientMutationID,
+		BridgeName:       input.BridgeName,
+	}, nil
+}
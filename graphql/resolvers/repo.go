@@ -3,6 +3,7 @@ package resolvers
 import (
 	"context"
 
+	"github.com/MichaelMure/git-bug/bridge"
 	"github.com/MichaelMure/git-bug/bug"
 	"github.com/MichaelMure/git-bug/cache"
 	"github.com/MichaelMure/git-bug/entity"
@@ -35,6 +36,9 @@ func (repoResolver) AllBugs(ctx context.Context, obj *models.Repository, after *
 		query = cache.NewQuery()
 	}
 
+	// pinned bugs are always displayed first
+	query.PinnedFirst = true
+
 	// Simply pass a []string with the ids to the pagination algorithm
 	source := obj.Repo.QueryBugs(query)
 
@@ -67,6 +71,11 @@ func (repoResolver) AllBugs(ctx context.Context, obj *models.Repository, after *
 			nodes[i] = snap
 		}
 
+		// the status index give the count without going through the bugs
+		if query.OnlyStatus() {
+			totalCount = obj.Repo.CountBugs(query)
+		}
+
 		return &models.BugConnection{
 			Edges:      edges,
 			Nodes:      nodes,
@@ -185,3 +194,7 @@ func (resolver repoResolver) ValidLabels(ctx context.Context, obj *models.Reposi
 
 	return connections.LabelCon(obj.Repo.ValidLabels(), edger, conMaker, input)
 }
+
+func (repoResolver) Bridges(ctx context.Context, obj *models.Repository) ([]string, error) {
+	return bridge.ConfiguredBridges(obj.Repo)
+}
@@ -10,11 +10,13 @@ var _ graph.ResolverRoot = &RootResolver{}
 
 type RootResolver struct {
 	cache.MultiRepoCache
+	bridges *bridgeRegistry
 }
 
 func NewRootResolver() *RootResolver {
 	return &RootResolver{
 		MultiRepoCache: cache.NewMultiRepoCache(),
+		bridges:        newBridgeRegistry(),
 	}
 }
 
@@ -26,7 +28,15 @@ func (r RootResolver) Query() graph.QueryResolver {
 
 func (r RootResolver) Mutation() graph.MutationResolver {
 	return &mutationResolver{
-		cache: &r.MultiRepoCache,
+		cache:   &r.MultiRepoCache,
+		bridges: r.bridges,
+	}
+}
+
+func (r RootResolver) Subscription() graph.SubscriptionResolver {
+	return &subscriptionResolver{
+		cache:   &r.MultiRepoCache,
+		bridges: r.bridges,
 	}
 }
 
@@ -34,8 +44,10 @@ func (RootResolver) Repository() graph.RepositoryResolver {
 	return &repoResolver{}
 }
 
-func (RootResolver) Bug() graph.BugResolver {
-	return &bugResolver{}
+func (r RootResolver) Bug() graph.BugResolver {
+	return &bugResolver{
+		cache: &r.MultiRepoCache,
+	}
 }
 
 func (RootResolver) Color() graph.ColorResolver {
@@ -50,6 +62,10 @@ func (r RootResolver) Identity() graph.IdentityResolver {
 	return &identityResolver{}
 }
 
+func (r RootResolver) Comment() graph.CommentResolver {
+	return &commentResolver{}
+}
+
 func (RootResolver) CommentHistoryStep() graph.CommentHistoryStepResolver {
 	return &commentHistoryStepResolver{}
 }
@@ -0,0 +1,85 @@
+package resolvers
+
+import (
+	"context"
+
+	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/cache"
+	"github.com/MichaelMure/git-bug/graphql/graph"
+	"github.com/MichaelMure/git-bug/graphql/models"
+)
+
+var _ graph.SubscriptionResolver = &subscriptionResolver{}
+
+type subscriptionResolver struct {
+	cache   *cache.MultiRepoCache
+	bridges *bridgeRegistry
+}
+
+func (r subscriptionResolver) getRepo(ref *string) (*cache.RepoCache, error) {
+	if ref != nil {
+		return r.cache.ResolveRepo(*ref)
+	}
+
+	return r.cache.DefaultRepo()
+}
+
+func (r subscriptionResolver) BugUpdated(ctx context.Context, repoRef *string, id string) (<-chan *bug.Snapshot, error) {
+	repo, err := r.getRepo(repoRef)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := repo.ResolveBugPrefix(id)
+	if err != nil {
+		return nil, err
+	}
+
+	events := b.Watch(ctx)
+	out := make(chan *bug.Snapshot)
+
+	go func() {
+		defer close(out)
+
+		// the events channel is closed when the context is done
+		for range events {
+			select {
+			case out <- b.Snapshot():
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+func (r subscriptionResolver) BridgeImportProgress(ctx context.Context, repoRef *string, bridgeName string) (<-chan models.BridgeEvent, error) {
+	repo, err := r.getRepo(repoRef)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := r.bridges.Events(ctx, repo, bridgeName)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(chan models.BridgeEvent)
+
+	go func() {
+		defer close(out)
+
+		// the events channel is closed when the import is finished, or when
+		// the context is done
+		for event := range events {
+			select {
+			case out <- bridgeEvent(event):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out, nil
+}
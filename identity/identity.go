@@ -20,7 +20,10 @@ import (
 const identityRefPattern = "refs/identities/"
 const identityRemoteRefPattern = "refs/remotes/%s/identities/"
 const versionEntryName = "version"
-const identityConfigKey = "git-bug.identity"
+
+// identityConfigKey is the config key, under the "git-bug." namespace, of the
+// user identity
+const identityConfigKey = "identity"
 
 var ErrNonFastForwardMerge = errors.New("non fast-forward identity merge")
 var ErrNoIdentitySet = errors.New("No identity is set.\n" +
@@ -42,20 +45,23 @@ type Identity struct {
 	lastCommit git.Hash
 }
 
-func NewIdentity(name string, email string) *Identity {
+// NewIdentity create a new identity, optionally with the public keys its
+// signatures can be verified with
+func NewIdentity(name string, email string, keys ...Key) *Identity {
 	return &Identity{
 		id: entity.UnsetId,
 		versions: []*Version{
 			{
 				name:  name,
 				email: email,
+				keys:  keys,
 				nonce: makeNonce(20),
 			},
 		},
 	}
 }
 
-func NewIdentityFull(name string, email string, login string, avatarUrl string) *Identity {
+func NewIdentityFull(name string, email string, login string, avatarUrl string, keys ...Key) *Identity {
 	return &Identity{
 		id: entity.UnsetId,
 		versions: []*Version{
@@ -64,6 +70,7 @@ func NewIdentityFull(name string, email string, login string, avatarUrl string)
 				email:     email,
 				login:     login,
 				avatarURL: avatarUrl,
+				keys:      keys,
 				nonce:     makeNonce(20),
 			},
 		},
@@ -222,12 +229,12 @@ func NewFromGitUser(repo repository.Repo) (*Identity, error) {
 
 // SetUserIdentity store the user identity's id in the git config
 func SetUserIdentity(repo repository.RepoConfig, identity *Identity) error {
-	return repo.LocalConfig().StoreString(identityConfigKey, identity.Id().String())
+	return repository.NewGitBugConfig(repo).SetConfig(identityConfigKey, identity.Id().String())
 }
 
 // GetUserIdentity read the current user identity, set with a git config entry
 func GetUserIdentity(repo repository.Repo) (*Identity, error) {
-	configs, err := repo.LocalConfig().ReadAll(identityConfigKey)
+	configs, err := repository.NewGitBugConfig(repo).GetConfigAll(identityConfigKey)
 	if err != nil {
 		return nil, err
 	}
@@ -251,7 +258,7 @@ func GetUserIdentity(repo repository.Repo) (*Identity, error) {
 
 	i, err := ReadLocal(repo, id)
 	if err == ErrIdentityNotExist {
-		innerErr := repo.LocalConfig().RemoveAll(identityConfigKey)
+		innerErr := repository.NewGitBugConfig(repo).LocalConfig().RemoveAll(identityConfigKey)
 		if innerErr != nil {
 			_, _ = fmt.Fprintln(os.Stderr, errors.Wrap(innerErr, "can't clear user identity").Error())
 		}
@@ -263,7 +270,7 @@ func GetUserIdentity(repo repository.Repo) (*Identity, error) {
 
 // IsUserIdentitySet say if the user has set his identity
 func IsUserIdentitySet(repo repository.Repo) (bool, error) {
-	configs, err := repo.LocalConfig().ReadAll(identityConfigKey)
+	configs, err := repository.NewGitBugConfig(repo).GetConfigAll(identityConfigKey)
 	if err != nil {
 		return false, err
 	}
@@ -275,6 +282,41 @@ func (i *Identity) AddVersion(version *Version) {
 	i.versions = append(i.versions, version)
 }
 
+// AddKeys add a new version of the identity with the given keys added to the
+// valid ones, if they are not already. Return true if a new version has been
+// added, which then need to be committed.
+func (i *Identity) AddKeys(keys ...Key) bool {
+	last := i.lastVersion()
+
+	known := make(map[string]struct{}, len(last.keys))
+	for _, k := range last.keys {
+		known[k.PubKey] = struct{}{}
+	}
+
+	merged := append([]Key{}, last.keys...)
+	for _, k := range keys {
+		if _, ok := known[k.PubKey]; ok {
+			continue
+		}
+		known[k.PubKey] = struct{}{}
+		merged = append(merged, k)
+	}
+
+	if len(merged) == len(last.keys) {
+		return false
+	}
+
+	i.AddVersion(&Version{
+		name:      last.name,
+		email:     last.email,
+		login:     last.login,
+		avatarURL: last.avatarURL,
+		keys:      merged,
+	})
+
+	return true
+}
+
 // Write the identity into the Repository. In particular, this ensure that
 // the Id is properly set.
 func (i *Identity) Commit(repo repository.ClockedRepo) error {
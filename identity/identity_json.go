@@ -0,0 +1,71 @@
+package identity
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+
+	"github.com/pkg/errors"
+
+	"github.com/MichaelMure/git-bug/repository"
+)
+
+// ExportJSON write the committed versions of an identity as JSON lines, one
+// line per Version, in the same format as they are stored in git.
+func ExportJSON(i *Identity, w io.Writer) error {
+	enc := json.NewEncoder(w)
+
+	for _, v := range i.versions {
+		if v.commitHash == "" {
+			continue
+		}
+		if err := enc.Encode(v); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ImportJSON read an identity written by ExportJSON and store it in the repo
+// as a new identity. As the id of an identity is derived from its first git
+// commit, it will be different from the exported one. The given metadata
+// are added to the first version.
+func ImportJSON(repo repository.ClockedRepo, r io.Reader, metadata map[string]string) (*Identity, error) {
+	i := &Identity{}
+
+	reader := bufio.NewReader(r)
+
+	for {
+		line, err := reader.ReadBytes('\n')
+		if err == io.EOF && len(line) == 0 {
+			break
+		}
+		if err != nil && err != io.EOF {
+			return nil, err
+		}
+
+		v := &Version{}
+		if err := json.Unmarshal(line, v); err != nil {
+			return nil, errors.Wrap(err, "invalid version")
+		}
+
+		if len(i.versions) == 0 {
+			for key, value := range metadata {
+				v.SetMetadata(key, value)
+			}
+		}
+
+		i.versions = append(i.versions, v)
+	}
+
+	if len(i.versions) == 0 {
+		return nil, errors.New("no version")
+	}
+
+	if err := i.Commit(repo); err != nil {
+		return nil, err
+	}
+
+	return i, nil
+}
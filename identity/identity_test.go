@@ -172,6 +172,25 @@ func TestIdentity_ValidKeysAtTime(t *testing.T) {
 	assert.Equal(t, identity.ValidKeysAtTime(3000), []Key{{PubKey: "pubkeyE"}})
 }
 
+func TestIdentity_AddKeys(t *testing.T) {
+	mockRepo := repository.NewMockRepoForTest()
+
+	identity := NewIdentity("René Descartes", "rene.descartes@example.com", Key{PubKey: "pubkeyA"})
+	assert.NoError(t, identity.Commit(mockRepo))
+
+	// already known key
+	assert.False(t, identity.AddKeys(Key{PubKey: "pubkeyA"}))
+	assert.False(t, identity.NeedCommit())
+
+	assert.True(t, identity.AddKeys(Key{PubKey: "pubkeyA"}, Key{PubKey: "pubkeyB"}))
+	assert.NoError(t, identity.Commit(mockRepo))
+
+	loaded, err := ReadLocal(mockRepo, identity.Id())
+	assert.NoError(t, err)
+	assert.Equal(t, []Key{{PubKey: "pubkeyA"}, {PubKey: "pubkeyB"}}, loaded.Keys())
+	assert.Equal(t, "René Descartes", loaded.Name())
+}
+
 // Test the immutable or mutable metadata search
 func TestMetadata(t *testing.T) {
 	mockRepo := repository.NewMockRepoForTest()
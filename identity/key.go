@@ -1,5 +1,11 @@
 package identity
 
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"strings"
+)
+
 type Key struct {
 	// The GPG fingerprint of the key
 	Fingerprint string `json:"fingerprint"`
@@ -11,3 +17,19 @@ func (k *Key) Validate() error {
 
 	return nil
 }
+
+// NewSSHKey create a Key from a SSH public key in the authorized_keys format
+// ("ssh-ed25519 AAAA... comment"), as published by Github and Gitlab
+func NewSSHKey(authorizedKey string) (Key, error) {
+	k := Key{PubKey: strings.TrimSpace(authorizedKey)}
+
+	blob, err := k.SSHPublicKey()
+	if err != nil {
+		return Key{}, err
+	}
+
+	sum := sha256.Sum256(blob)
+	k.Fingerprint = "SHA256:" + base64.RawStdEncoding.EncodeToString(sum[:])
+
+	return k, nil
+}
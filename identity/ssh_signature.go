@@ -0,0 +1,201 @@
+package identity
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/pem"
+	"fmt"
+	"hash"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/MichaelMure/git-bug/repository"
+	"github.com/MichaelMure/git-bug/util/git"
+)
+
+var ErrNoMatchingKey = errors.New("the signature doesn't match any key of the identity")
+
+const (
+	sshSigMagic     = "SSHSIG"
+	sshSigVersion   = 1
+	sshSigNamespace = "git"
+	sshSigPemType   = "SSH SIGNATURE"
+
+	sshKeyTypeEd25519 = "ssh-ed25519"
+)
+
+// sshSignature is a decoded SSH signature, as produced by "ssh-keygen -Y sign"
+// and used by git to sign commits with gpg.format=ssh.
+// See https://github.com/openssh/openssh-portable/blob/master/PROTOCOL.sshsig
+type sshSignature struct {
+	publicKey     []byte
+	namespace     string
+	hashAlgorithm string
+	format        string
+	signature     []byte
+}
+
+// Verify check that the given commit has a valid SSH signature made with one
+// of the keys of the identity
+func (i *Identity) Verify(repo repository.Repo, commit git.Hash) error {
+	return VerifyCommit(repo, i, commit)
+}
+
+// VerifyCommit check that the given commit has a valid SSH signature made
+// with one of the keys of the given author
+func VerifyCommit(repo repository.Repo, author Interface, commit git.Hash) error {
+	signature, payload, err := repo.CommitSignature(commit)
+	if err != nil {
+		return err
+	}
+
+	return verifySSHSignature(author.Keys(), signature, payload)
+}
+
+func verifySSHSignature(keys []Key, armored []byte, payload []byte) error {
+	sig, err := parseSSHSignature(armored)
+	if err != nil {
+		return err
+	}
+
+	if sig.namespace != sshSigNamespace {
+		return fmt.Errorf("unexpected signature namespace %s", sig.namespace)
+	}
+
+	for _, key := range keys {
+		pubKey, err := key.SSHPublicKey()
+		if err != nil {
+			// not a SSH key
+			continue
+		}
+		if bytes.Equal(pubKey, sig.publicKey) {
+			return sig.verify(payload)
+		}
+	}
+
+	return ErrNoMatchingKey
+}
+
+// SSHPublicKey decode the public key, given in the authorized_keys format
+// ("ssh-ed25519 AAAA... comment"), into its wire format
+func (k *Key) SSHPublicKey() ([]byte, error) {
+	fields := strings.Fields(k.PubKey)
+	if len(fields) < 2 {
+		return nil, fmt.Errorf("not a SSH public key")
+	}
+
+	return base64.StdEncoding.DecodeString(fields[1])
+}
+
+func parseSSHSignature(armored []byte) (*sshSignature, error) {
+	block, _ := pem.Decode(armored)
+	if block == nil || block.Type != sshSigPemType {
+		return nil, fmt.Errorf("not a SSH signature")
+	}
+
+	data := block.Bytes
+	if !bytes.HasPrefix(data, []byte(sshSigMagic)) {
+		return nil, fmt.Errorf("invalid SSH signature magic")
+	}
+	data = data[len(sshSigMagic):]
+
+	if len(data) < 4 || binary.BigEndian.Uint32(data) != sshSigVersion {
+		return nil, fmt.Errorf("unsupported SSH signature version")
+	}
+	data = data[4:]
+
+	var sig sshSignature
+	var namespace, reserved, hashAlgorithm, signature []byte
+	var ok bool
+
+	fields := []*[]byte{&sig.publicKey, &namespace, &reserved, &hashAlgorithm, &signature}
+	for _, field := range fields {
+		*field, data, ok = readSSHString(data)
+		if !ok {
+			return nil, fmt.Errorf("truncated SSH signature")
+		}
+	}
+
+	sig.namespace = string(namespace)
+	sig.hashAlgorithm = string(hashAlgorithm)
+
+	format, signature, ok := readSSHString(signature)
+	if !ok {
+		return nil, fmt.Errorf("truncated SSH signature")
+	}
+	sig.format = string(format)
+
+	sig.signature, _, ok = readSSHString(signature)
+	if !ok {
+		return nil, fmt.Errorf("truncated SSH signature")
+	}
+
+	return &sig, nil
+}
+
+// verify check the signature for the given message
+func (sig *sshSignature) verify(message []byte) error {
+	var h hash.Hash
+	switch sig.hashAlgorithm {
+	case "sha256":
+		h = sha256.New()
+	case "sha512":
+		h = sha512.New()
+	default:
+		return fmt.Errorf("unsupported signature hash algorithm %s", sig.hashAlgorithm)
+	}
+	h.Write(message)
+
+	signed := []byte(sshSigMagic)
+	signed = appendSSHString(signed, []byte(sig.namespace))
+	signed = appendSSHString(signed, nil)
+	signed = appendSSHString(signed, []byte(sig.hashAlgorithm))
+	signed = appendSSHString(signed, h.Sum(nil))
+
+	keyType, keyData, ok := readSSHString(sig.publicKey)
+	if !ok {
+		return fmt.Errorf("invalid public key")
+	}
+
+	switch string(keyType) {
+	case sshKeyTypeEd25519:
+		if sig.format != sshKeyTypeEd25519 {
+			return fmt.Errorf("unexpected signature format %s", sig.format)
+		}
+		pubKey, _, ok := readSSHString(keyData)
+		if !ok || len(pubKey) != ed25519.PublicKeySize {
+			return fmt.Errorf("invalid ed25519 public key")
+		}
+		if !ed25519.Verify(pubKey, signed, sig.signature) {
+			return fmt.Errorf("invalid signature")
+		}
+		return nil
+	default:
+		return fmt.Errorf("unsupported key type %s", keyType)
+	}
+}
+
+// readSSHString read a length prefixed string as defined in RFC 4251
+func readSSHString(data []byte) ([]byte, []byte, bool) {
+	if len(data) < 4 {
+		return nil, nil, false
+	}
+	length := binary.BigEndian.Uint32(data)
+	data = data[4:]
+	if uint32(len(data)) < length {
+		return nil, nil, false
+	}
+	return data[:length], data[length:], true
+}
+
+func appendSSHString(buf []byte, s []byte) []byte {
+	var length [4]byte
+	binary.BigEndian.PutUint32(length[:], uint32(len(s)))
+	buf = append(buf, length[:]...)
+	return append(buf, s...)
+}
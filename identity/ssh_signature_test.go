@@ -0,0 +1,86 @@
+package identity
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/pem"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// signSSH produce an armored SSH signature, like "ssh-keygen -Y sign -n git" would
+func signSSH(priv ed25519.PrivateKey, message []byte) []byte {
+	pubKey := appendSSHString(nil, []byte(sshKeyTypeEd25519))
+	pubKey = appendSSHString(pubKey, priv.Public().(ed25519.PublicKey))
+
+	h := sha512.Sum512(message)
+	signed := []byte(sshSigMagic)
+	signed = appendSSHString(signed, []byte(sshSigNamespace))
+	signed = appendSSHString(signed, nil)
+	signed = appendSSHString(signed, []byte("sha512"))
+	signed = appendSSHString(signed, h[:])
+
+	sig := appendSSHString(nil, []byte(sshKeyTypeEd25519))
+	sig = appendSSHString(sig, ed25519.Sign(priv, signed))
+
+	var version [4]byte
+	binary.BigEndian.PutUint32(version[:], sshSigVersion)
+
+	blob := []byte(sshSigMagic)
+	blob = append(blob, version[:]...)
+	blob = appendSSHString(blob, pubKey)
+	blob = appendSSHString(blob, []byte(sshSigNamespace))
+	blob = appendSSHString(blob, nil)
+	blob = appendSSHString(blob, []byte("sha512"))
+	blob = appendSSHString(blob, sig)
+
+	return pem.EncodeToMemory(&pem.Block{Type: sshSigPemType, Bytes: blob})
+}
+
+func sshKey(pub ed25519.PublicKey) Key {
+	blob := appendSSHString(nil, []byte(sshKeyTypeEd25519))
+	blob = appendSSHString(blob, pub)
+	return Key{PubKey: sshKeyTypeEd25519 + " " + base64.StdEncoding.EncodeToString(blob) + " rene@descartes.fr"}
+}
+
+func TestVerifySSHSignature(t *testing.T) {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	otherPub, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	payload := []byte("tree 4b825dc642cb6eb9a060e54bf8d69288fbee4904\n\nmessage\n")
+	signature := signSSH(priv, payload)
+
+	err = verifySSHSignature([]Key{sshKey(pub)}, signature, payload)
+	require.NoError(t, err)
+
+	err = verifySSHSignature([]Key{sshKey(otherPub), sshKey(pub)}, signature, payload)
+	require.NoError(t, err)
+
+	err = verifySSHSignature([]Key{sshKey(otherPub)}, signature, payload)
+	require.Equal(t, ErrNoMatchingKey, err)
+
+	err = verifySSHSignature([]Key{sshKey(pub)}, signature, []byte("tampered"))
+	require.Error(t, err)
+
+	_, err = parseSSHSignature([]byte("garbage"))
+	require.Error(t, err)
+}
+
+func TestNewSSHKey(t *testing.T) {
+	pub, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	key, err := NewSSHKey(sshKey(pub).PubKey + "\n")
+	require.NoError(t, err)
+	require.Equal(t, sshKey(pub).PubKey, key.PubKey)
+	require.Regexp(t, "^SHA256:[A-Za-z0-9+/]{43}$", key.Fingerprint)
+
+	_, err = NewSSHKey("not a key")
+	require.Error(t, err)
+}
@@ -0,0 +1,97 @@
+package repository
+
+import (
+	"strings"
+	"time"
+)
+
+// GitBugNamespace is the config namespace that all git-bug keys live under
+const GitBugNamespace = "git-bug."
+
+var _ RepoConfig = &GitBugConfig{}
+
+// GitBugConfig wrap a RepoConfig to transparently namespace all the keys
+// under "git-bug.", to avoid collisions with the user configuration.
+// Keys given to and returned by the wrapped configs are relative to this
+// namespace.
+type GitBugConfig struct {
+	repo RepoConfig
+}
+
+func NewGitBugConfig(repo RepoConfig) *GitBugConfig {
+	return &GitBugConfig{repo: repo}
+}
+
+// LocalConfig give access to the repository scoped configuration
+func (gbc *GitBugConfig) LocalConfig() Config {
+	return &namespacedConfig{config: gbc.repo.LocalConfig(), namespace: GitBugNamespace}
+}
+
+// GlobalConfig give access to the git global configuration
+func (gbc *GitBugConfig) GlobalConfig() Config {
+	return &namespacedConfig{config: gbc.repo.GlobalConfig(), namespace: GitBugNamespace}
+}
+
+// SetConfig store a value in the repository scoped configuration, under the
+// "git-bug." namespace
+func (gbc *GitBugConfig) SetConfig(key, value string) error {
+	return gbc.LocalConfig().StoreString(key, value)
+}
+
+// GetConfigAll read all the values of the repository scoped configuration
+// whose key start with the given prefix, under the "git-bug." namespace. The
+// returned keys don't include the namespace.
+func (gbc *GitBugConfig) GetConfigAll(keyPrefix string) (map[string]string, error) {
+	return gbc.LocalConfig().ReadAll(keyPrefix)
+}
+
+var _ Config = &namespacedConfig{}
+
+// namespacedConfig prefix every key with a namespace when accessing the
+// underlying config, and strip it from the returned keys.
+type namespacedConfig struct {
+	config    Config
+	namespace string
+}
+
+func (nc *namespacedConfig) StoreString(key, value string) error {
+	return nc.config.StoreString(nc.namespace+key, value)
+}
+
+func (nc *namespacedConfig) StoreTimestamp(key string, value time.Time) error {
+	return nc.config.StoreTimestamp(nc.namespace+key, value)
+}
+
+func (nc *namespacedConfig) StoreBool(key string, value bool) error {
+	return nc.config.StoreBool(nc.namespace+key, value)
+}
+
+func (nc *namespacedConfig) ReadAll(keyPrefix string) (map[string]string, error) {
+	pairs, err := nc.config.ReadAll(nc.namespace + keyPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]string, len(pairs))
+	for key, value := range pairs {
+		result[strings.TrimPrefix(key, nc.namespace)] = value
+	}
+
+	return result, nil
+}
+
+func (nc *namespacedConfig) ReadBool(key string) (bool, error) {
+	return nc.config.ReadBool(nc.namespace + key)
+}
+
+func (nc *namespacedConfig) ReadString(key string) (string, error) {
+	return nc.config.ReadString(nc.namespace + key)
+}
+
+func (nc *namespacedConfig) ReadTimestamp(key string) (time.Time, error) {
+	return nc.config.ReadTimestamp(nc.namespace + key)
+}
+
+func (nc *namespacedConfig) RemoveAll(keyPrefix string) error {
+	return nc.config.RemoveAll(nc.namespace + keyPrefix)
+}
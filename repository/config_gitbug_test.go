@@ -0,0 +1,53 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGitBugConfig(t *testing.T) {
+	repo := NewMockRepoForTest()
+	config := NewGitBugConfig(repo)
+
+	err := config.LocalConfig().StoreString("bridge.foo.target", "github")
+	require.NoError(t, err)
+
+	// the key is namespaced in the underlying config
+	val, err := repo.LocalConfig().ReadString("git-bug.bridge.foo.target")
+	require.NoError(t, err)
+	assert.Equal(t, "github", val)
+
+	err = repo.LocalConfig().StoreString("user.name", "René")
+	require.NoError(t, err)
+
+	// keys outside of the namespace are not visible and are stripped
+	all, err := config.LocalConfig().ReadAll("")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"bridge.foo.target": "github"}, all)
+
+	err = config.LocalConfig().RemoveAll("bridge.foo")
+	require.NoError(t, err)
+
+	_, err = config.LocalConfig().ReadString("bridge.foo.target")
+	assert.Equal(t, ErrNoConfigEntry, err)
+
+	_, err = repo.LocalConfig().ReadString("user.name")
+	assert.NoError(t, err)
+}
+
+func TestGitBugConfigHelpers(t *testing.T) {
+	repo := NewMockRepoForTest()
+	config := NewGitBugConfig(repo)
+
+	require.NoError(t, config.SetConfig("webui.open", "false"))
+
+	val, err := repo.LocalConfig().ReadString("git-bug.webui.open")
+	require.NoError(t, err)
+	assert.Equal(t, "false", val)
+
+	all, err := config.GetConfigAll("webui.")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"webui.open": "false"}, all)
+}
@@ -5,12 +5,18 @@ import (
 	"bytes"
 	"fmt"
 	"io"
+	"io/ioutil"
+	"os"
 	"os/exec"
 	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/pkg/errors"
 
+	"github.com/MichaelMure/git-bug/entity"
 	"github.com/MichaelMure/git-bug/util/git"
 	"github.com/MichaelMure/git-bug/util/lamport"
 )
@@ -18,8 +24,15 @@ import (
 const (
 	createClockFile = "/git-bug/create-clock"
 	editClockFile   = "/git-bug/edit-clock"
+
+	bugsRefPrefix       = "refs/bugs/"
+	identitiesRefPrefix = "refs/identities/"
 )
 
+// BundleRemote is the remote name under which the refs of an unbundled git
+// bundle are stored, waiting to be merged
+const BundleRemote = "git-bug-bundle"
+
 var (
 	// ErrNotARepo is the error returned when the git repo root wan't be found
 	ErrNotARepo = errors.New("not a git repository")
@@ -106,6 +119,18 @@ func NewGitRepo(path string, witnesser Witnesser) (*GitRepo, error) {
 		return nil, ErrNotARepo
 	}
 
+	// In a linked worktree, --git-dir points to a private directory in
+	// .git/worktrees/. The bug database (clocks, cache, lock) must be shared
+	// by all the worktrees, so it lives in the common directory instead.
+	commonDir, err := repo.runGitCommand("rev-parse", "--git-common-dir")
+	// git older than 2.5 doesn't know this flag and echo it back
+	if err == nil && commonDir != "" && commonDir != "--git-common-dir" {
+		stdout = commonDir
+		if !filepath.IsAbs(stdout) && path != "" {
+			stdout = filepath.Join(path, stdout)
+		}
+	}
+
 	// Fix the path to be sure we are at the root
 	repo.Path = stdout
 
@@ -219,6 +244,95 @@ func (repo *GitRepo) FetchRefs(remote, refSpec string) (string, error) {
 	return stdout, err
 }
 
+// FetchBugRefs fetch only the bugs refs, and optionally the identities refs,
+// from a remote. The refs are stored under refs/remotes/<remote>/ so that the
+// local state is only changed when merging.
+func (repo *GitRepo) FetchBugRefs(remote string, includeIdentities bool) (string, error) {
+	args := []string{"fetch", remote, fmt.Sprintf("refs/bugs/*:refs/remotes/%s/bugs/*", remote)}
+	if includeIdentities {
+		args = append(args, fmt.Sprintf("refs/identities/*:refs/remotes/%s/identities/*", remote))
+	}
+
+	stdout, err := repo.runGitCommand(args...)
+
+	if err != nil {
+		return stdout, fmt.Errorf("failed to fetch from the remote '%s': %v", remote, err)
+	}
+
+	return stdout, err
+}
+
+// BundleBugs write a git bundle with the refs of the given bugs and all the
+// commits they reference. The ids can also be the ids of identities, so that
+// the identities used by the bugs are bundled with them.
+func (repo *GitRepo) BundleBugs(ids []entity.Id, w io.Writer) error {
+	if len(ids) == 0 {
+		return fmt.Errorf("nothing to bundle")
+	}
+
+	refs := make([]string, 0, len(ids))
+	for _, id := range ids {
+		ref, err := repo.entityRef(id)
+		if err != nil {
+			return err
+		}
+		refs = append(refs, ref)
+	}
+
+	var stderr bytes.Buffer
+	args := append([]string{"bundle", "create", "-"}, refs...)
+	if err := repo.runGitCommandWithIO(nil, w, &stderr, args...); err != nil {
+		return fmt.Errorf("failed to create the bundle: %s", strings.TrimSpace(stderr.String()))
+	}
+
+	return nil
+}
+
+// entityRef return the git ref of a bug or an identity
+func (repo *GitRepo) entityRef(id entity.Id) (string, error) {
+	for _, prefix := range []string{bugsRefPrefix, identitiesRefPrefix} {
+		exist, err := repo.RefExist(prefix + id.String())
+		if err != nil {
+			return "", err
+		}
+		if exist {
+			return prefix + id.String(), nil
+		}
+	}
+	return "", fmt.Errorf("no bug or identity with the id %s", id)
+}
+
+// UnbundleBugs read a git bundle created with BundleBugs. Like FetchBugRefs,
+// the refs are stored under refs/remotes/<BundleRemote>/ so that the local
+// state is only changed when merging.
+func (repo *GitRepo) UnbundleBugs(r io.Reader) error {
+	// git can't fetch from a bundle read on its standard input
+	f, err := ioutil.TempFile("", "git-bug-bundle")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+
+	_, err = io.Copy(f, r)
+	if err == nil {
+		err = f.Close()
+	} else {
+		_ = f.Close()
+	}
+	if err != nil {
+		return err
+	}
+
+	_, err = repo.runGitCommand("fetch", f.Name(),
+		fmt.Sprintf("+refs/bugs/*:refs/remotes/%s/bugs/*", BundleRemote),
+		fmt.Sprintf("+refs/identities/*:refs/remotes/%s/identities/*", BundleRemote))
+	if err != nil {
+		return fmt.Errorf("failed to read the bundle: %v", err)
+	}
+
+	return nil
+}
+
 // PushRefs push git refs to a remote
 func (repo *GitRepo) PushRefs(remote string, refSpec string) (string, error) {
 	stdout, stderr, err := repo.runGitCommandRaw(nil, "push", remote, refSpec)
@@ -290,7 +404,8 @@ func (repo *GitRepo) StoreCommitWithParent(treeHash git.Hash, parent git.Hash) (
 
 // UpdateRef will create or update a Git reference
 func (repo *GitRepo) UpdateRef(ref string, hash git.Hash) error {
-	_, err := repo.runGitCommand("update-ref", ref, string(hash))
+	// git only keep a reflog for the branches by default
+	_, err := repo.runGitCommand("update-ref", "--create-reflog", "-m", "git-bug: update", ref, string(hash))
 
 	return err
 }
@@ -325,11 +440,21 @@ func (repo *GitRepo) RefExist(ref string) (bool, error) {
 
 // CopyRef will create a new reference with the same value as another one
 func (repo *GitRepo) CopyRef(source string, dest string) error {
-	_, err := repo.runGitCommand("update-ref", dest, source)
+	_, err := repo.runGitCommand("update-ref", "--create-reflog", "-m", "git-bug: copy from "+source, dest, source)
 
 	return err
 }
 
+// RefLog return the past values of a reference, most recent first
+func (repo *GitRepo) RefLog(ref string) ([]RefLogEntry, error) {
+	stdout, err := repo.runGitCommand("reflog", "show", "--date=unix", "--format=%H %gd %gs", ref, "--")
+	if err != nil {
+		return nil, err
+	}
+
+	return parseRefLog(stdout)
+}
+
 // ListCommits will return the list of commit hashes of a ref, in chronological order
 func (repo *GitRepo) ListCommits(ref string) ([]git.Hash, error) {
 	stdout, err := repo.runGitCommand("rev-list", "--first-parent", "--reverse", ref)
@@ -382,6 +507,135 @@ func (repo *GitRepo) GetTreeHash(commit git.Hash) (git.Hash, error) {
 	return git.Hash(stdout), nil
 }
 
+// CommitSignature return the signature of a commit and the signed payload,
+// or ErrNoSignature if the commit is not signed
+func (repo *GitRepo) CommitSignature(commit git.Hash) ([]byte, []byte, error) {
+	// the exact content is needed to verify the signature, so the output
+	// is not trimmed
+	var stdout bytes.Buffer
+	var stderr bytes.Buffer
+	err := repo.runGitCommandWithIO(nil, &stdout, &stderr, "cat-file", "commit", string(commit))
+	if err != nil {
+		return nil, nil, errors.New(strings.TrimSpace(stderr.String()))
+	}
+
+	return splitCommitSignature(stdout.Bytes())
+}
+
+// BugTags list the git tags pointing to any commit of the given bug
+func (repo *GitRepo) BugTags(bugId entity.Id) ([]string, error) {
+	commits, err := repo.ListCommits(bugsRefPrefix + bugId.String())
+	if err != nil {
+		return nil, err
+	}
+
+	// annotated tags are peeled to their commit with *objectname
+	stdout, err := repo.runGitCommand("for-each-ref",
+		"--format=%(refname:strip=2) %(objectname) %(*objectname)", "refs/tags/")
+	if err != nil {
+		return nil, err
+	}
+
+	return matchTags(stdout, commits), nil
+}
+
+// CommitSummary return the one line summary of a commit
+func (repo *GitRepo) CommitSummary(rev string) (string, error) {
+	// make sure the revision is never interpreted as an option or a path
+	return repo.runGitCommand("log", "-1", "--oneline", "--end-of-options", rev, "--")
+}
+
+// CommitMessage return the full message of a commit
+func (repo *GitRepo) CommitMessage(rev string) (string, error) {
+	// make sure the revision is never interpreted as an option or a path
+	return repo.runGitCommand("log", "-1", "--format=%B", "--end-of-options", rev, "--")
+}
+
+// ResolveCommit return the full hash of a commit
+func (repo *GitRepo) ResolveCommit(rev string) (git.Hash, error) {
+	if strings.HasPrefix(rev, "-") {
+		return "", fmt.Errorf("invalid revision %s", rev)
+	}
+
+	stdout, err := repo.runGitCommand("rev-parse", "--verify", rev+"^{commit}")
+	if err != nil {
+		return "", err
+	}
+
+	return git.Hash(stdout), nil
+}
+
+// ReadRawObject return the type and the raw content of a git object
+func (repo *GitRepo) ReadRawObject(hash git.Hash) (string, []byte, error) {
+	objectType, err := repo.runGitCommand("cat-file", "-t", string(hash))
+	if err != nil {
+		return "", nil, err
+	}
+
+	// trees are binary, so the output is read as is
+	var stdout bytes.Buffer
+	var stderr bytes.Buffer
+	err = repo.runGitCommandWithIO(nil, &stdout, &stderr, "cat-file", objectType, string(hash))
+	if err != nil {
+		return "", nil, errors.New(strings.TrimSpace(stderr.String()))
+	}
+
+	return objectType, stdout.Bytes(), nil
+}
+
+// ObjectSize return the size in bytes of a git object, without reading its
+// content
+func (repo *GitRepo) ObjectSize(hash git.Hash) (int64, error) {
+	stdin := strings.NewReader(string(hash) + "\n")
+
+	// output is "<hash> <type> <size>", or "<hash> missing"
+	stdout, err := repo.runGitCommandWithStdin(stdin, "cat-file", "--batch-check")
+	if err != nil {
+		return 0, err
+	}
+
+	fields := strings.Fields(stdout)
+	if len(fields) != 3 {
+		return 0, fmt.Errorf("unknown git object %s", hash)
+	}
+
+	return strconv.ParseInt(fields[2], 10, 64)
+}
+
+// StoreRawObject store a git object of the given type from its raw content
+func (repo *GitRepo) StoreRawObject(objectType string, data []byte) (git.Hash, error) {
+	stdout, err := repo.runGitCommandWithStdin(bytes.NewReader(data),
+		"hash-object", "-t", objectType, "-w", "--stdin")
+
+	return git.Hash(stdout), err
+}
+
+// matchTags select the tags from a for-each-ref output (name, object and
+// peeled object) that point to one of the given commits
+func matchTags(forEachRef string, commits []git.Hash) []string {
+	set := make(map[string]struct{}, len(commits))
+	for _, commit := range commits {
+		set[string(commit)] = struct{}{}
+	}
+
+	var tags []string
+	for _, line := range strings.Split(forEachRef, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			continue
+		}
+		for _, object := range fields[1:] {
+			if _, ok := set[object]; ok {
+				tags = append(tags, fields[0])
+				break
+			}
+		}
+	}
+
+	sort.Strings(tags)
+	return tags
+}
+
 // AddRemote add a new remote to the repository
 // Not in the interface because it's only used for testing
 func (repo *GitRepo) AddRemote(name string, url string) error {
@@ -2,9 +2,13 @@
 package repository
 
 import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestConfig(t *testing.T) {
@@ -65,3 +69,98 @@ func TestConfig(t *testing.T) {
 	err = repo.LocalConfig().RemoveAll("section.key")
 	assert.Error(t, err)
 }
+
+func TestWorktree(t *testing.T) {
+	repo := CreateTestRepo(false)
+	defer CleanupTestRepos(t, repo)
+
+	root := filepath.Dir(repo.GetPath())
+
+	_, err := repo.runGitCommand("-C", root, "commit", "--allow-empty", "-m", "init")
+	require.NoError(t, err)
+
+	dir, err := ioutil.TempDir("", "")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	worktree := filepath.Join(dir, "worktree")
+	_, err = repo.runGitCommand("-C", root, "worktree", "add", "--detach", worktree)
+	require.NoError(t, err)
+
+	noop := func(repo ClockedRepo) error { return nil }
+
+	main, err := NewGitRepo(root, noop)
+	require.NoError(t, err)
+
+	linked, err := NewGitRepo(worktree, noop)
+	require.NoError(t, err)
+
+	// both share the same bug database
+	assert.Equal(t, filepath.Clean(repo.GetPath()), filepath.Clean(main.GetPath()))
+	assert.Equal(t, filepath.Clean(repo.GetPath()), filepath.Clean(linked.GetPath()))
+}
+
+func TestObjectSize(t *testing.T) {
+	repo := CreateTestRepo(false)
+	defer CleanupTestRepos(t, repo)
+
+	hash, err := repo.StoreData([]byte("hello world"))
+	require.NoError(t, err)
+
+	size, err := repo.ObjectSize(hash)
+	require.NoError(t, err)
+	assert.Equal(t, int64(11), size)
+
+	_, err = repo.ObjectSize("0000000000000000000000000000000000000001")
+	assert.Error(t, err)
+}
+
+func TestCommitSummary(t *testing.T) {
+	repo := CreateTestRepo(false)
+	defer CleanupTestRepos(t, repo)
+
+	tree, err := repo.StoreTree(nil)
+	require.NoError(t, err)
+
+	commit, err := repo.runGitCommand("commit-tree", string(tree), "-m", "first commit")
+	require.NoError(t, err)
+
+	hash := commit[:7]
+
+	summary, err := repo.CommitSummary(hash)
+	require.NoError(t, err)
+	assert.Equal(t, hash+" first commit", summary)
+
+	_, err = repo.CommitSummary("--all")
+	assert.Error(t, err)
+
+	message, err := repo.CommitMessage(hash)
+	require.NoError(t, err)
+	assert.Equal(t, "first commit", message)
+}
+
+func TestRefLog(t *testing.T) {
+	repo := CreateTestRepo(false)
+	defer CleanupTestRepos(t, repo)
+
+	tree, err := repo.StoreTree(nil)
+	require.NoError(t, err)
+	commit1, err := repo.StoreCommit(tree)
+	require.NoError(t, err)
+	commit2, err := repo.StoreCommitWithParent(tree, commit1)
+	require.NoError(t, err)
+
+	require.NoError(t, repo.UpdateRef("refs/bugs/test", commit1))
+	require.NoError(t, repo.UpdateRef("refs/bugs/test", commit2))
+
+	entries, err := repo.RefLog("refs/bugs/test")
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+
+	assert.Equal(t, commit2, entries[0].Hash)
+	assert.Equal(t, "@{0}", entries[0].Selector)
+	assert.Equal(t, "git-bug: update", entries[0].Message)
+	assert.Equal(t, commit1, entries[1].Hash)
+	assert.Equal(t, "@{1}", entries[1].Selector)
+	assert.False(t, entries[1].Time.IsZero())
+}
@@ -3,7 +3,11 @@ package repository
 import (
 	"crypto/sha1"
 	"fmt"
+	"io"
+	"strings"
+	"time"
 
+	"github.com/MichaelMure/git-bug/entity"
 	"github.com/MichaelMure/git-bug/util/git"
 	"github.com/MichaelMure/git-bug/util/lamport"
 )
@@ -18,6 +22,7 @@ type mockRepoForTest struct {
 	trees        map[git.Hash]string
 	commits      map[git.Hash]commit
 	refs         map[string]git.Hash
+	reflogs      map[string][]RefLogEntry
 	createClock  lamport.Clock
 	editClock    lamport.Clock
 }
@@ -35,6 +40,7 @@ func NewMockRepoForTest() *mockRepoForTest {
 		trees:        make(map[git.Hash]string),
 		commits:      make(map[git.Hash]commit),
 		refs:         make(map[string]git.Hash),
+		reflogs:      make(map[string][]RefLogEntry),
 		createClock:  lamport.NewClock(),
 		editClock:    lamport.NewClock(),
 	}
@@ -85,6 +91,18 @@ func (r *mockRepoForTest) FetchRefs(remote string, refSpec string) (string, erro
 	return "", nil
 }
 
+func (r *mockRepoForTest) FetchBugRefs(remote string, includeIdentities bool) (string, error) {
+	return "", nil
+}
+
+func (r *mockRepoForTest) BundleBugs(ids []entity.Id, w io.Writer) error {
+	panic("implement me")
+}
+
+func (r *mockRepoForTest) UnbundleBugs(reader io.Reader) error {
+	panic("implement me")
+}
+
 func (r *mockRepoForTest) StoreData(data []byte) (git.Hash, error) {
 	rawHash := sha1.Sum(data)
 	hash := git.Hash(fmt.Sprintf("%x", rawHash))
@@ -132,9 +150,34 @@ func (r *mockRepoForTest) StoreCommitWithParent(treeHash git.Hash, parent git.Ha
 
 func (r *mockRepoForTest) UpdateRef(ref string, hash git.Hash) error {
 	r.refs[ref] = hash
+	r.logRef(ref, hash, "git-bug: update")
 	return nil
 }
 
+// logRef record a new value of a ref in its reflog
+func (r *mockRepoForTest) logRef(ref string, hash git.Hash, message string) {
+	r.reflogs[ref] = append(r.reflogs[ref], RefLogEntry{
+		Hash:    hash,
+		Time:    time.Now(),
+		Message: message,
+	})
+}
+
+func (r *mockRepoForTest) RefLog(ref string) ([]RefLogEntry, error) {
+	log, ok := r.reflogs[ref]
+	if !ok {
+		return nil, fmt.Errorf("unknown ref")
+	}
+
+	// most recent first
+	entries := make([]RefLogEntry, len(log))
+	for i, entry := range log {
+		entry.Selector = fmt.Sprintf("@{%d}", len(log)-1-i)
+		entries[len(log)-1-i] = entry
+	}
+	return entries, nil
+}
+
 func (r *mockRepoForTest) RefExist(ref string) (bool, error) {
 	_, exist := r.refs[ref]
 	return exist, nil
@@ -148,6 +191,7 @@ func (r *mockRepoForTest) CopyRef(source string, dest string) error {
 	}
 
 	r.refs[dest] = hash
+	r.logRef(dest, hash, "git-bug: copy from "+source)
 	return nil
 }
 
@@ -210,9 +254,75 @@ func (r *mockRepoForTest) FindCommonAncestor(hash1 git.Hash, hash2 git.Hash) (gi
 }
 
 func (r *mockRepoForTest) GetTreeHash(commit git.Hash) (git.Hash, error) {
+	c, ok := r.commits[commit]
+	if !ok {
+		return "", fmt.Errorf("unknown commit")
+	}
+
+	return c.treeHash, nil
+}
+
+func (r *mockRepoForTest) CommitSignature(commit git.Hash) ([]byte, []byte, error) {
+	return nil, nil, ErrNoSignature
+}
+
+func (r *mockRepoForTest) ReadRawObject(hash git.Hash) (string, []byte, error) {
 	panic("implement me")
 }
 
+func (r *mockRepoForTest) StoreRawObject(objectType string, data []byte) (git.Hash, error) {
+	panic("implement me")
+}
+
+func (r *mockRepoForTest) ObjectSize(hash git.Hash) (int64, error) {
+	if data, ok := r.blobs[hash]; ok {
+		return int64(len(data)), nil
+	}
+	if data, ok := r.trees[hash]; ok {
+		return int64(len(data)), nil
+	}
+	if c, ok := r.commits[hash]; ok {
+		// approximate the size of a real commit with its headers
+		size := len("tree ") + len(c.treeHash) + 1
+		if c.parent != "" {
+			size += len("parent ") + len(c.parent) + 1
+		}
+		return int64(size), nil
+	}
+	return 0, fmt.Errorf("unknown hash")
+}
+
+func (r *mockRepoForTest) BugTags(bugId entity.Id) ([]string, error) {
+	commits, err := r.ListCommits(bugsRefPrefix + bugId.String())
+	if err != nil {
+		return nil, err
+	}
+
+	var forEachRef strings.Builder
+	for ref, hash := range r.refs {
+		if strings.HasPrefix(ref, "refs/tags/") {
+			fmt.Fprintf(&forEachRef, "%s %s\n", strings.TrimPrefix(ref, "refs/tags/"), hash)
+		}
+	}
+
+	return matchTags(forEachRef.String(), commits), nil
+}
+
+func (r *mockRepoForTest) CommitSummary(rev string) (string, error) {
+	panic("implement me")
+}
+
+func (r *mockRepoForTest) CommitMessage(rev string) (string, error) {
+	panic("implement me")
+}
+
+func (r *mockRepoForTest) ResolveCommit(rev string) (git.Hash, error) {
+	if _, ok := r.commits[git.Hash(rev)]; ok {
+		return git.Hash(rev), nil
+	}
+	return "", fmt.Errorf("unknown revision %s", rev)
+}
+
 func (r *mockRepoForTest) LoadClocks() error {
 	return nil
 }
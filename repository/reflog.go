@@ -0,0 +1,60 @@
+package repository
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/MichaelMure/git-bug/util/git"
+)
+
+// RefLogEntry is a past value of a git ref, recorded in its reflog
+type RefLogEntry struct {
+	Hash git.Hash
+	// Selector designate the entry, as "@{N}" with N the number of updates
+	// of the ref since then
+	Selector string
+	Time     time.Time
+	Message  string
+}
+
+// parseRefLog parse the output of
+// `git reflog show --date=unix --format="%H %gd %gs"`, most recent first.
+// With --date=unix, %gd hold the time of the entry as "<ref>@{<unix time>}".
+func parseRefLog(stdout string) ([]RefLogEntry, error) {
+	var entries []RefLogEntry
+
+	for _, line := range strings.Split(stdout, "\n") {
+		if line == "" {
+			continue
+		}
+
+		fields := strings.SplitN(line, " ", 3)
+		if len(fields) < 2 {
+			return nil, fmt.Errorf("invalid reflog line \"%s\"", line)
+		}
+
+		start := strings.LastIndex(fields[1], "@{")
+		if start < 0 || !strings.HasSuffix(fields[1], "}") {
+			return nil, fmt.Errorf("invalid reflog selector \"%s\"", fields[1])
+		}
+		unixTime, err := strconv.ParseInt(fields[1][start+2:len(fields[1])-1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid reflog selector \"%s\"", fields[1])
+		}
+
+		entry := RefLogEntry{
+			Hash:     git.Hash(fields[0]),
+			Selector: fmt.Sprintf("@{%d}", len(entries)),
+			Time:     time.Unix(unixTime, 0),
+		}
+		if len(fields) == 3 {
+			entry.Message = fields[2]
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
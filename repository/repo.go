@@ -4,8 +4,10 @@ package repository
 import (
 	"bytes"
 	"errors"
+	"io"
 	"strings"
 
+	"github.com/MichaelMure/git-bug/entity"
 	"github.com/MichaelMure/git-bug/util/git"
 	"github.com/MichaelMure/git-bug/util/lamport"
 )
@@ -50,6 +52,20 @@ type Repo interface {
 	// FetchRefs fetch git refs from a remote
 	FetchRefs(remote string, refSpec string) (string, error)
 
+	// FetchBugRefs fetch only the bugs refs, and optionally the identities
+	// refs, from a remote in a single call. Like FetchRefs, this does not
+	// change the local state.
+	FetchBugRefs(remote string, includeIdentities bool) (string, error)
+
+	// BundleBugs write a git bundle with the given bugs and identities, to
+	// transfer them without a shared remote
+	BundleBugs(ids []entity.Id, w io.Writer) error
+
+	// UnbundleBugs read a git bundle created with BundleBugs. Like FetchRefs,
+	// this does not change the local state, the refs are stored under
+	// refs/remotes/<BundleRemote>/ to be merged.
+	UnbundleBugs(r io.Reader) error
+
 	// PushRefs push git refs to a remote
 	PushRefs(remote string, refSpec string) (string, error)
 
@@ -80,6 +96,9 @@ type Repo interface {
 	// CopyRef will create a new reference with the same value as another one
 	CopyRef(source string, dest string) error
 
+	// RefLog return the past values of a reference, most recent first
+	RefLog(ref string) ([]RefLogEntry, error)
+
 	// ListCommits will return the list of tree hashes of a ref, in chronological order
 	ListCommits(ref string) ([]git.Hash, error)
 
@@ -91,6 +110,37 @@ type Repo interface {
 
 	// GetTreeHash return the git tree hash referenced in a commit
 	GetTreeHash(commit git.Hash) (git.Hash, error)
+
+	// CommitSignature return the signature of a commit and the signed payload,
+	// or ErrNoSignature if the commit is not signed
+	CommitSignature(commit git.Hash) (signature []byte, payload []byte, err error)
+
+	// BugTags list the git tags pointing to any commit of the given bug
+	BugTags(bugId entity.Id) ([]string, error)
+
+	// CommitSummary return the one line summary of a commit, as given by
+	// git log --oneline, from anything git rev-parse can resolve
+	CommitSummary(rev string) (string, error)
+
+	// CommitMessage return the full message of a commit, from anything git
+	// rev-parse can resolve
+	CommitMessage(rev string) (string, error)
+
+	// ResolveCommit return the full hash of a commit, from anything git
+	// rev-parse can resolve
+	ResolveCommit(rev string) (git.Hash, error)
+
+	// ReadRawObject return the type ("blob", "tree" or "commit") and the raw
+	// content of a git object
+	ReadRawObject(hash git.Hash) (objectType string, data []byte, err error)
+
+	// StoreRawObject store a git object of the given type from its raw
+	// content, and return its hash
+	StoreRawObject(objectType string, data []byte) (git.Hash, error)
+
+	// ObjectSize return the size in bytes of a git object, without reading
+	// its content
+	ObjectSize(hash git.Hash) (int64, error)
 }
 
 // ClockedRepo is a Repo that also has Lamport clocks
@@ -0,0 +1,54 @@
+package repository
+
+import (
+	"bytes"
+	"errors"
+)
+
+// ErrNoSignature is returned when a commit is not signed
+var ErrNoSignature = errors.New("commit is not signed")
+
+const signatureHeader = "gpgsig"
+
+// splitCommitSignature extract the signature of a raw git commit object, as
+// given by "git cat-file commit". The signature is stored in the gpgsig
+// header, whatever its format (GPG or SSH), with its continuation lines
+// prefixed by a space. The signed payload is the commit object without
+// that header.
+func splitCommitSignature(raw []byte) ([]byte, []byte, error) {
+	// the headers end at the first empty line
+	headerEnd := bytes.Index(raw, []byte("\n\n"))
+	if headerEnd < 0 {
+		headerEnd = len(raw)
+	}
+
+	var signature bytes.Buffer
+	var payload bytes.Buffer
+	inSignature := false
+	found := false
+
+	lines := bytes.SplitAfter(raw[:headerEnd+1], []byte("\n"))
+	for _, line := range lines {
+		switch {
+		case bytes.HasPrefix(line, []byte(signatureHeader+" ")):
+			inSignature = true
+			found = true
+			signature.Write(bytes.TrimPrefix(line, []byte(signatureHeader+" ")))
+		case inSignature && bytes.HasPrefix(line, []byte(" ")):
+			signature.Write(line[1:])
+		default:
+			inSignature = false
+			payload.Write(line)
+		}
+	}
+
+	if !found {
+		return nil, nil, ErrNoSignature
+	}
+
+	if headerEnd+1 < len(raw) {
+		payload.Write(raw[headerEnd+1:])
+	}
+
+	return signature.Bytes(), payload.Bytes(), nil
+}
@@ -0,0 +1,30 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitCommitSignature(t *testing.T) {
+	raw := "tree 4b825dc642cb6eb9a060e54bf8d69288fbee4904\n" +
+		"author René Descartes <rene@descartes.fr> 1580000000 +0100\n" +
+		"committer René Descartes <rene@descartes.fr> 1580000000 +0100\n" +
+		"gpgsig -----BEGIN SSH SIGNATURE-----\n" +
+		" U1NIU0lHAAAAAQ==\n" +
+		" -----END SSH SIGNATURE-----\n" +
+		"\n" +
+		"message\n"
+
+	signature, payload, err := splitCommitSignature([]byte(raw))
+	require.NoError(t, err)
+	require.Equal(t, "-----BEGIN SSH SIGNATURE-----\nU1NIU0lHAAAAAQ==\n-----END SSH SIGNATURE-----\n", string(signature))
+	require.Equal(t, "tree 4b825dc642cb6eb9a060e54bf8d69288fbee4904\n"+
+		"author René Descartes <rene@descartes.fr> 1580000000 +0100\n"+
+		"committer René Descartes <rene@descartes.fr> 1580000000 +0100\n"+
+		"\n"+
+		"message\n", string(payload))
+
+	_, _, err = splitCommitSignature([]byte("tree 4b825dc642cb6eb9a060e54bf8d69288fbee4904\n\nmessage\n"))
+	require.Equal(t, ErrNoSignature, err)
+}
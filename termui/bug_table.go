@@ -118,7 +118,7 @@ func (bt *bugTable) layout(g *gocui.Gui) error {
 		v.Frame = false
 		v.BgColor = gocui.ColorBlue
 
-		_, _ = fmt.Fprintf(v, "[q] Quit [s] Search [←↓↑→,hjkl] Navigation [↵] Open bug [n] New bug [i] Pull [o] Push")
+		_, _ = fmt.Fprintf(v, "[q] Quit [s,/] Search [←↓↑→,hjkl] Navigation [↵] Open bug [n] New bug [i] Pull [o] Push")
 	}
 
 	_, err = g.SetCurrentView(bugTableView)
@@ -206,6 +206,10 @@ func (bt *bugTable) keybindings(g *gocui.Gui) error {
 		bt.changeQuery); err != nil {
 		return err
 	}
+	if err := g.SetKeybinding(bugTableView, '/', gocui.ModNone,
+		bt.changeQuery); err != nil {
+		return err
+	}
 
 	return nil
 }
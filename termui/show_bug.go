@@ -451,11 +451,15 @@ func (sb *showBug) renderSidebar(g *gocui.Gui, sideView *gocui.View) error {
 }
 
 func (sb *showBug) saveAndBack(g *gocui.Gui, v *gocui.View) error {
-	err := sb.bug.CommitAsNeeded()
-	if err != nil {
-		return err
+	// Commit rather than CommitAsNeeded, to check the size of the operations
+	if sb.bug.NeedCommit() {
+		err := sb.bug.Commit()
+		if err != nil {
+			return err
+		}
 	}
-	err = ui.activateWindow(ui.bugTable)
+
+	err := ui.activateWindow(ui.bugTable)
 	if err != nil {
 		return err
 	}
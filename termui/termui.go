@@ -62,6 +62,11 @@ func Run(cache *cache.RepoCache) error {
 
 	ui.activeWindow = ui.bugTable
 
+	// refresh the UI when bugs are changed, for example by a pull
+	events, cancel := cache.WatchBugs()
+	defer cancel()
+	go refreshOnBugChange(events)
+
 	initGui(nil)
 
 	err := <-ui.gError
@@ -74,6 +79,18 @@ func Run(cache *cache.RepoCache) error {
 	return nil
 }
 
+func refreshOnBugChange(events <-chan entity.Id) {
+	for range events {
+		g := ui.g
+		if g == nil {
+			continue
+		}
+		// an empty update is enough to trigger a new layout pass, which
+		// query the cache again
+		g.Update(func(*gocui.Gui) error { return nil })
+	}
+}
+
 func initGui(action func(ui *termUI) error) {
 	g, err := gocui.NewGui(gocui.Output256, false)
 
@@ -1,9 +1,15 @@
 package tests
 
 import (
+	"runtime"
+	"strings"
 	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
 
 	"github.com/MichaelMure/git-bug/bug"
+	"github.com/MichaelMure/git-bug/identity"
 	"github.com/MichaelMure/git-bug/misc/random_bugs"
 	"github.com/MichaelMure/git-bug/repository"
 )
@@ -22,6 +28,33 @@ func TestReadBugs(t *testing.T) {
 	}
 }
 
+func TestReadLazyBugs(t *testing.T) {
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	random_bugs.FillRepoWithSeed(repo, 15, 42)
+
+	bugs := bug.ReadAllLocalBugs(repo)
+	for b := range bugs {
+		require.NoError(t, b.Err)
+
+		lazy, err := bug.ReadLocalLazyBug(repo, b.Bug.Id())
+		require.NoError(t, err)
+
+		snap, err := lazy.Snapshot()
+		require.NoError(t, err)
+
+		expected := b.Bug.Compile()
+		require.Equal(t, expected.Title, snap.Title)
+		require.Equal(t, expected.Status, snap.Status)
+		require.Equal(t, expected.Operations[0].Id(), lazy.FirstOp().Id())
+		require.Equal(t, expected.LastEditUnix(), snap.LastEditUnix())
+		require.Equal(t, len(expected.Comments), len(snap.Comments))
+		require.Equal(t, b.Bug.CreateLamportTime(), lazy.CreateLamportTime())
+		require.Equal(t, b.Bug.EditLamportTime(), lazy.EditLamportTime())
+	}
+}
+
 func benchmarkReadBugs(bugNumber int, t *testing.B) {
 	repo := repository.CreateTestRepo(false)
 	defer repository.CleanupTestRepos(t, repo)
@@ -42,3 +75,124 @@ func benchmarkReadBugs(bugNumber int, t *testing.B) {
 func BenchmarkReadBugs5(b *testing.B)   { benchmarkReadBugs(5, b) }
 func BenchmarkReadBugs25(b *testing.B)  { benchmarkReadBugs(25, b) }
 func BenchmarkReadBugs150(b *testing.B) { benchmarkReadBugs(150, b) }
+
+func benchmarkReadLazyBugs(bugNumber int, t *testing.B) {
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	random_bugs.FillRepoWithSeed(repo, bugNumber, 42)
+	t.ResetTimer()
+	t.ReportAllocs()
+
+	for n := 0; n < t.N; n++ {
+		ids, err := bug.ListLocalIds(repo)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, id := range ids {
+			b, err := bug.ReadLocalLazyBug(repo, id)
+			if err != nil {
+				t.Fatal(err)
+			}
+			_, err = b.Snapshot()
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+}
+
+func BenchmarkReadLazyBugs5(b *testing.B)   { benchmarkReadLazyBugs(5, b) }
+func BenchmarkReadLazyBugs25(b *testing.B)  { benchmarkReadLazyBugs(25, b) }
+func BenchmarkReadLazyBugs150(b *testing.B) { benchmarkReadLazyBugs(150, b) }
+
+// heapInUse return the size of the live heap
+func heapInUse() uint64 {
+	var stats runtime.MemStats
+	runtime.GC()
+	runtime.ReadMemStats(&stats)
+	return stats.HeapAlloc
+}
+
+// benchmarkOperationsMemory report the peak memory used to iterate over the
+// operations of bugs with one OperationPack per operation, like when they
+// are edited over time, either reading the complete bugs or lazily.
+func benchmarkOperationsMemory(bugNumber int, lazy bool, t *testing.B) {
+	repo := repository.CreateTestRepo(false)
+	defer repository.CleanupTestRepos(t, repo)
+
+	author := identity.NewIdentity("René Descartes", "rene@descartes.fr")
+	if err := author.Commit(repo); err != nil {
+		t.Fatal(err)
+	}
+
+	unixTime := time.Now().Unix()
+	for i := 0; i < bugNumber; i++ {
+		b, _, err := bug.Create(author, unixTime, "title", strings.Repeat("message ", 100))
+		if err != nil {
+			t.Fatal(err)
+		}
+		for j := 0; j < 30; j++ {
+			if err := b.Commit(repo); err != nil {
+				t.Fatal(err)
+			}
+			unixTime++
+			_, err := bug.AddComment(b, author, unixTime, strings.Repeat("comment ", 100))
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err := b.Commit(repo); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	ids, err := bug.ListLocalIds(repo)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.ResetTimer()
+	t.ReportAllocs()
+
+	var peaks uint64
+	for n := 0; n < t.N; n++ {
+		for _, id := range ids {
+			base := heapInUse()
+
+			var it bug.OperationIter
+			if lazy {
+				b, err := bug.ReadLocalLazyBug(repo, id)
+				if err != nil {
+					t.Fatal(err)
+				}
+				it = b.Operations()
+			} else {
+				b, err := bug.ReadLocalBug(repo, id)
+				if err != nil {
+					t.Fatal(err)
+				}
+				it = bug.NewOperationIterator(b)
+			}
+
+			var peak uint64
+			for it.Next() {
+				_ = it.Value()
+				if inUse := heapInUse(); inUse > base && inUse-base > peak {
+					peak = inUse - base
+				}
+			}
+			if it.Err() != nil {
+				t.Fatal(it.Err())
+			}
+			runtime.KeepAlive(it)
+
+			peaks += peak
+		}
+	}
+
+	t.ReportMetric(float64(peaks)/float64(t.N*len(ids)), "peak-B/bug")
+}
+
+func BenchmarkOperationsMemory10(b *testing.B)     { benchmarkOperationsMemory(10, false, b) }
+func BenchmarkLazyOperationsMemory10(b *testing.B) { benchmarkOperationsMemory(10, true, b) }
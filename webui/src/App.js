@@ -6,6 +6,7 @@ import React from 'react';
 import { Route, Switch } from 'react-router';
 import { Link } from 'react-router-dom';
 
+import BridgesQuery from './bridge/BridgesQuery';
 import BugQuery from './bug/BugQuery';
 import ListQuery from './list/ListQuery';
 
@@ -15,6 +16,12 @@ const useStyles = makeStyles(theme => ({
     color: 'white',
     textDecoration: 'none',
   },
+  appLink: {
+    ...theme.typography.button,
+    color: 'white',
+    textDecoration: 'none',
+    marginLeft: theme.spacing(4),
+  },
 }));
 
 export default function App() {
@@ -28,11 +35,15 @@ export default function App() {
           <Link to="/" className={classes.appTitle}>
             git-bug webui
           </Link>
+          <Link to="/bridges" className={classes.appLink}>
+            Bridges
+          </Link>
         </Toolbar>
       </AppBar>
       <Switch>
         <Route path="/" exact component={ListQuery} />
         <Route path="/bug/:id" exact component={BugQuery} />
+        <Route path="/bridges" exact component={BridgesQuery} />
       </Switch>
     </>
   );
@@ -0,0 +1,129 @@
+import { makeStyles } from '@material-ui/styles';
+import Paper from '@material-ui/core/Paper';
+import gql from 'graphql-tag';
+import React, { useEffect, useState } from 'react';
+import { Link } from 'react-router-dom';
+
+import subscribe from './subscribe';
+
+const SUBSCRIPTION = gql`
+  subscription BridgeImportProgress($bridgeName: String!) {
+    bridgeImportProgress(bridgeName: $bridgeName) {
+      __typename
+      ... on BridgeImportStarted {
+        since
+      }
+      ... on BridgeBugImported {
+        id
+        humanId
+        title
+      }
+      ... on BridgeBugSkipped {
+        id
+        reason
+      }
+      ... on BridgeImportError {
+        id
+        message
+      }
+      ... on BridgeImportFinished {
+        rateLimitHits
+        rateLimitWaitSeconds
+      }
+    }
+  }
+`;
+
+const useStyles = makeStyles(theme => ({
+  log: {
+    ...theme.typography.body2,
+    fontFamily: 'monospace',
+    padding: theme.spacing(1),
+    marginTop: theme.spacing(2),
+    maxHeight: 400,
+    overflowY: 'auto',
+  },
+  line: {
+    margin: 0,
+    whiteSpace: 'pre-wrap',
+  },
+  error: {
+    color: theme.palette.error.main,
+  },
+}));
+
+function Event({ event }) {
+  const classes = useStyles();
+  switch (event.__typename) {
+    case 'BridgeImportStarted':
+      return (
+        <p className={classes.line}>
+          import started
+          {event.since && ` (changes since ${event.since})`}
+        </p>
+      );
+    case 'BridgeBugImported':
+      return (
+        <p className={classes.line}>
+          imported <Link to={'/bug/' + event.humanId}>{event.humanId}</Link>{' '}
+          {event.title}
+        </p>
+      );
+    case 'BridgeBugSkipped':
+      return (
+        <p className={classes.line}>
+          skipped {event.id && event.id.slice(0, 7)} {event.reason}
+        </p>
+      );
+    case 'BridgeImportError':
+      return (
+        <p className={`${classes.line} ${classes.error}`}>
+          error {event.id && event.id.slice(0, 7)} {event.message}
+        </p>
+      );
+    case 'BridgeImportFinished':
+      return (
+        <p className={classes.line}>
+          import finished
+          {event.rateLimitHits > 0 &&
+            ` (rate limit reached ${event.rateLimitHits} times, waited ${event.rateLimitWaitSeconds}s)`}
+        </p>
+      );
+    default:
+      return null;
+  }
+}
+
+// BridgeLog display live the events of the last import of a bridge
+function BridgeLog({ bridgeName }) {
+  const classes = useStyles();
+  const [events, setEvents] = useState([]);
+  const [error, setError] = useState(null);
+
+  useEffect(() => {
+    setEvents([]);
+    setError(null);
+    return subscribe(
+      SUBSCRIPTION,
+      { bridgeName },
+      {
+        onData: data =>
+          setEvents(events => [...events, data.bridgeImportProgress]),
+        onError: setError,
+      }
+    );
+  }, [bridgeName]);
+
+  return (
+    <Paper className={classes.log}>
+      {events.map((event, index) => (
+        <Event event={event} key={index} />
+      ))}
+      {error && (
+        <p className={`${classes.line} ${classes.error}`}>Error: {error}</p>
+      )}
+    </Paper>
+  );
+}
+
+export default BridgeLog;
@@ -0,0 +1,92 @@
+import { makeStyles } from '@material-ui/styles';
+import Button from '@material-ui/core/Button';
+import CircularProgress from '@material-ui/core/CircularProgress';
+import Typography from '@material-ui/core/Typography';
+import gql from 'graphql-tag';
+import React, { useState } from 'react';
+import { Mutation, Query } from 'react-apollo';
+
+import BridgeLog from './BridgeLog';
+
+const QUERY = gql`
+  query {
+    defaultRepository {
+      bridges
+    }
+  }
+`;
+
+const PULL = gql`
+  mutation BridgePull($bridgeName: String!) {
+    bridgePull(input: { bridgeName: $bridgeName }) {
+      bridgeName
+    }
+  }
+`;
+
+const useStyles = makeStyles(theme => ({
+  main: {
+    maxWidth: 800,
+    margin: 'auto',
+    marginTop: theme.spacing(4),
+  },
+  bridge: {
+    display: 'flex',
+    alignItems: 'center',
+    justifyContent: 'space-between',
+    marginTop: theme.spacing(1),
+  },
+}));
+
+function Bridges({ bridges }) {
+  const classes = useStyles();
+  // the bridge whose import is displayed, and a counter to display each new
+  // import of the same bridge from its beginning
+  const [pulled, setPulled] = useState(null);
+  const onPulled = name =>
+    setPulled(pulled => ({ name, run: pulled ? pulled.run + 1 : 0 }));
+
+  return (
+    <main className={classes.main}>
+      {bridges.length === 0 && (
+        <Typography>
+          No bridge configured, see "git bug bridge configure".
+        </Typography>
+      )}
+      {bridges.map(name => (
+        <div className={classes.bridge} key={name}>
+          <Typography>{name}</Typography>
+          <Mutation mutation={PULL} onCompleted={() => onPulled(name)}>
+            {(pull, { loading, error }) => (
+              <div>
+                {error && (
+                  <Typography color="error">{error.message}</Typography>
+                )}
+                <Button
+                  variant="contained"
+                  disabled={loading}
+                  onClick={() => pull({ variables: { bridgeName: name } })}
+                >
+                  Pull
+                </Button>
+              </div>
+            )}
+          </Mutation>
+        </div>
+      ))}
+      {pulled && <BridgeLog bridgeName={pulled.name} key={pulled.run} />}
+    </main>
+  );
+}
+
+const BridgesQuery = () => (
+  <Query query={QUERY}>
+    {({ loading, error, data }) => {
+      if (loading) return <CircularProgress />;
+      if (error) return <p>Error: {error}</p>;
+      return <Bridges bridges={data.defaultRepository.bridges} />;
+    }}
+  </Query>
+);
+
+export default BridgesQuery;
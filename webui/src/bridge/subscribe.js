@@ -0,0 +1,54 @@
+import { print } from 'graphql/language/printer';
+
+// apollo-boost doesn't support subscriptions, so they are made directly with
+// the websocket protocol of the GraphQL server (graphql-ws).
+export default function subscribe(query, variables, { onData, onError }) {
+  const protocol = window.location.protocol === 'https:' ? 'wss:' : 'ws:';
+  const ws = new WebSocket(
+    `${protocol}//${window.location.host}/graphql`,
+    'graphql-ws'
+  );
+  let done = false;
+
+  ws.onopen = () => {
+    ws.send(JSON.stringify({ type: 'connection_init', payload: {} }));
+    ws.send(
+      JSON.stringify({
+        id: '1',
+        type: 'start',
+        payload: { query: print(query), variables },
+      })
+    );
+  };
+
+  ws.onmessage = ({ data }) => {
+    const message = JSON.parse(data);
+    switch (message.type) {
+      case 'data':
+        if (message.payload.errors) {
+          onError(message.payload.errors[0].message);
+        } else {
+          onData(message.payload.data);
+        }
+        break;
+      case 'error':
+      case 'connection_error':
+        onError(message.payload.message || 'subscription failed');
+        break;
+      case 'complete':
+        done = true;
+        ws.close();
+        break;
+      default:
+    }
+  };
+
+  ws.onclose = () => {
+    if (!done) onError('connection to the server lost');
+  };
+
+  return () => {
+    done = true;
+    ws.close();
+  };
+}
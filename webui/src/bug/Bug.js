@@ -2,7 +2,7 @@ import { makeStyles } from '@material-ui/styles';
 import Typography from '@material-ui/core/Typography/Typography';
 import gql from 'graphql-tag';
 import React from 'react';
-import Author from '../Author';
+import Author, { Avatar } from '../Author';
 import Date from '../Date';
 import TimelineQuery from './TimelineQuery';
 import Label from '../Label';
@@ -41,6 +41,17 @@ const useStyles = makeStyles(theme => ({
     padding: 0,
     margin: 0,
   },
+  mention: {
+    display: 'flex',
+    alignItems: 'center',
+    marginTop: theme.spacing(1),
+    marginBottom: theme.spacing(1),
+  },
+  mentionAvatar: {
+    width: 24,
+    height: 24,
+    marginRight: theme.spacing(1),
+  },
   label: {
     marginTop: theme.spacing(1),
     marginBottom: theme.spacing(1),
@@ -78,6 +89,19 @@ function Bug({ bug }) {
               </li>
             ))}
           </ul>
+          {bug.mentions.length > 0 && (
+            <>
+              <Typography variant={'subtitle1'}>Mentioned</Typography>
+              <ul className={classes.labelList}>
+                {bug.mentions.map(m => (
+                  <li className={classes.mention} key={m.id}>
+                    <Avatar author={m} className={classes.mentionAvatar} />
+                    <Author author={m} />
+                  </li>
+                ))}
+              </ul>
+            </>
+          )}
         </div>
       </div>
     </main>
@@ -94,6 +118,13 @@ Bug.fragment = gql`
       ...Label
     }
     createdAt
+    mentions {
+      id
+      name
+      email
+      displayName
+      avatarUrl
+    }
     ...authored
   }
   ${Label.fragment}
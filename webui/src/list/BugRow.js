@@ -1,9 +1,11 @@
 import { makeStyles } from '@material-ui/styles';
+import LinearProgress from '@material-ui/core/LinearProgress/LinearProgress';
 import TableCell from '@material-ui/core/TableCell/TableCell';
 import TableRow from '@material-ui/core/TableRow/TableRow';
 import Tooltip from '@material-ui/core/Tooltip/Tooltip';
 import Typography from '@material-ui/core/Typography';
 import ErrorOutline from '@material-ui/icons/ErrorOutline';
+import Bookmark from '@material-ui/icons/Bookmark';
 import gql from 'graphql-tag';
 import React from 'react';
 import { Link } from 'react-router-dom';
@@ -34,6 +36,20 @@ const Status = ({ status, className }) => {
   }
 };
 
+const Checklist = ({ done, total, className }) => (
+  <Tooltip title={`${done} of ${total} tasks done`}>
+    <span className={className}>
+      <LinearProgress variant="determinate" value={(done * 100) / total} />
+    </span>
+  </Tooltip>
+);
+
+const Pinned = ({ className }) => (
+  <Tooltip title="Pinned">
+    <Bookmark fontSize="small" className={className} />
+  </Tooltip>
+);
+
 const useStyles = makeStyles(theme => ({
   cell: {
     display: 'flex',
@@ -61,6 +77,17 @@ const useStyles = makeStyles(theme => ({
     lineHeight: '1.5rem',
     color: theme.palette.text.secondary,
   },
+  pinned: {
+    verticalAlign: 'middle',
+    marginRight: theme.spacing(0.5),
+    color: theme.palette.text.secondary,
+  },
+  checklist: {
+    display: 'inline-block',
+    width: '80px',
+    verticalAlign: 'middle',
+    marginLeft: theme.spacing(1),
+  },
   labels: {
     paddingLeft: theme.spacing(1),
     '& > *': {
@@ -78,6 +105,7 @@ function BugRow({ bug }) {
         <div className={classes.expand}>
           <Link to={'bug/' + bug.humanId}>
             <div className={classes.expand}>
+              {bug.pinned && <Pinned className={classes.pinned} />}
               <span className={classes.title}>{bug.title}</span>
               {bug.labels.length > 0 && (
                 <span className={classes.labels}>
@@ -92,6 +120,13 @@ function BugRow({ bug }) {
             {bug.humanId} opened
             <Date date={bug.createdAt} />
             by {bug.author.displayName}
+            {bug.checklistTotal > 0 && (
+              <Checklist
+                done={bug.checklistDone}
+                total={bug.checklistTotal}
+                className={classes.checklist}
+              />
+            )}
           </div>
         </div>
       </TableCell>
@@ -106,6 +141,9 @@ BugRow.fragment = gql`
     title
     status
     createdAt
+    pinned
+    checklistDone
+    checklistTotal
     labels {
       ...Label
     }